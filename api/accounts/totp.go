@@ -0,0 +1,95 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+)
+
+// EnrollTotpResult is the result of EnrollTotp.
+type EnrollTotpResult struct {
+	// ProvisioningUri is the otpauth URI to add to an authenticator app.
+	ProvisioningUri string `json:"provisioning_uri,omitempty"`
+}
+
+// VerifyTotpResult is the result of VerifyTotp.
+type VerifyTotpResult struct {
+	// RecoveryCodes can each be used once in place of a TOTP code. They are
+	// only returned once.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// EnrollTotp starts a TOTP enrollment for the account. The enrollment must
+// be verified with VerifyTotp before it becomes the second factor of the
+// account.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*EnrollTotpResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", accountId), map[string]interface{}{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(EnrollTotpResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return target, nil
+}
+
+// VerifyTotp verifies the TOTP enrollment of the account with a code from
+// the authenticator app and returns new recovery codes for the account.
+func (c *Client) VerifyTotp(ctx context.Context, accountId, code string, opt ...Option) (*VerifyTotpResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into VerifyTotp request")
+	}
+	if code == "" {
+		return nil, fmt.Errorf("empty code value passed into VerifyTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in VerifyTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"code": code,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:verify-totp", accountId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VerifyTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during VerifyTotp call: %w", err)
+	}
+
+	target := new(VerifyTotpResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding VerifyTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return target, nil
+}
@@ -40,3 +40,46 @@ func (c *Client) Authenticate(ctx context.Context, authMethodId string, credenti
 
 	return target, nil
 }
+
+// StartAuthenticationResult is the result of StartAuthentication.
+type StartAuthenticationResult struct {
+	// AuthUrl is the URL the user must visit to log in with the provider.
+	AuthUrl string `json:"auth_url,omitempty"`
+}
+
+// StartAuthentication begins authenticating with an auth method which uses
+// an external provider, such as OIDC. After the user logs in at the returned
+// AuthUrl, the provider redirects to redirectUrl with the credentials to pass
+// to Authenticate.
+func (c *Client) StartAuthentication(ctx context.Context, authMethodId, redirectUrl string, opt ...Option) (*StartAuthenticationResult, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in StartAuthentication request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	reqBody := map[string]interface{}{
+		"redirect_url": redirectUrl,
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("auth-methods/%s:start-authentication", authMethodId), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating StartAuthentication request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during StartAuthentication call: %w", err)
+	}
+
+	target := new(StartAuthenticationResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding StartAuthentication response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	return target, nil
+}
@@ -0,0 +1,14 @@
+// Code generated by "make api"; DO NOT EDIT.
+package authmethods
+
+type LdapAuthMethodAttributes struct {
+	Url          string `json:"url,omitempty"`
+	StartTls     bool   `json:"start_tls,omitempty"`
+	Certificate  string `json:"certificate,omitempty"`
+	BindDn       string `json:"bind_dn,omitempty"`
+	BindPassword string `json:"bind_password,omitempty"`
+	UserDn       string `json:"user_dn,omitempty"`
+	UserAttr     string `json:"user_attr,omitempty"`
+	GroupDn      string `json:"group_dn,omitempty"`
+	GroupAttr    string `json:"group_attr,omitempty"`
+}
@@ -0,0 +1,8 @@
+// Code generated by "make api"; DO NOT EDIT.
+package authmethods
+
+type OidcAuthMethodAttributes struct {
+	Issuer       string `json:"issuer,omitempty"`
+	ClientId     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+}
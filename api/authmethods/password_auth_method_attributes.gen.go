@@ -2,6 +2,13 @@
 package authmethods
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength     uint32 `json:"min_login_name_length,omitempty"`
+	MinPasswordLength      uint32 `json:"min_password_length,omitempty"`
+	LockoutThreshold       uint32 `json:"lockout_threshold,omitempty"`
+	LockoutDurationSeconds uint32 `json:"lockout_duration_seconds,omitempty"`
+	MinCharacterClasses    uint32 `json:"min_character_classes,omitempty"`
+	PasswordHistoryCount   uint32 `json:"password_history_count,omitempty"`
+	MaxPasswordAgeSeconds  uint32 `json:"max_password_age_seconds,omitempty"`
+	DisallowLoginName      bool   `json:"disallow_login_name,omitempty"`
+	MfaRequired            bool   `json:"mfa_required,omitempty"`
 }
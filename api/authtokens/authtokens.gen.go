@@ -24,6 +24,7 @@ type AuthToken struct {
 	UpdatedTime             time.Time         `json:"updated_time,omitempty"`
 	ApproximateLastUsedTime time.Time         `json:"approximate_last_used_time,omitempty"`
 	ExpirationTime          time.Time         `json:"expiration_time,omitempty"`
+	AuthorizedActions       []string          `json:"authorized_actions,omitempty"`
 
 	responseBody *bytes.Buffer
 	responseMap  map[string]interface{}
@@ -72,9 +73,10 @@ func (n AuthTokenDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type AuthTokenListResult struct {
-	Items        []*AuthToken
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*AuthToken
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n AuthTokenListResult) GetItems() interface{} {
@@ -192,7 +194,41 @@ func (c *Client) Delete(ctx context.Context, authTokenId string, opt ...Option)
 	return target, nil
 }
 
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
 func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*AuthTokenListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, scopeId, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, scopeId, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *AuthTokenListIterator {
+	return &AuthTokenListIterator{
+		ctx:          ctx,
+		client:       c,
+		collectionId: scopeId,
+		opt:          opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, scopeId string, opt ...Option) (*AuthTokenListResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into List request")
 	}
@@ -233,3 +269,51 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Auth
 	target.responseMap = resp.Map
 	return target, nil
 }
+
+// AuthTokenListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type AuthTokenListIterator struct {
+	ctx           context.Context
+	client        *Client
+	collectionId  string
+	opt           []Option
+	items         []*AuthToken
+	item          *AuthToken
+	nextPageToken string
+	fetched       bool
+	err           error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *AuthTokenListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *AuthTokenListIterator) Item() *AuthToken {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *AuthTokenListIterator) Err() error {
+	return i.err
+}
@@ -1,6 +1,9 @@
 package authtokens
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/boundary/api"
 )
 
@@ -17,6 +20,10 @@ type options struct {
 	postMap                 map[string]interface{}
 	queryMap                map[string]string
 	withAutomaticVersioning bool
+	withFilter              string
+	withPageSize            uint32
+	withPageToken           string
+	withRecursive           bool
 }
 
 func getDefaultOptions() options {
@@ -32,6 +39,18 @@ func getOpts(opt ...Option) (options, []api.Option) {
 		o(&opts)
 	}
 	var apiOpts []api.Option
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withPageToken != "" {
+		opts.queryMap["page_token"] = opts.withPageToken
+	}
+	if opts.withRecursive {
+		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
+	}
 	return opts, apiOpts
 }
 
@@ -44,3 +63,40 @@ func WithAutomaticVersioning(enable bool) Option {
 		o.withAutomaticVersioning = enable
 	}
 }
+
+// WithFilter tells the API to only return the items of a list which match the
+// given filter expression. The expression is in the format understood by
+// hashicorp/go-bexpr and is evaluated against the JSON form of each item,
+// which is available under "/item", e.g. "/item/name" == "web".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API the largest number of items to return in each page
+// of a list. Zero means the API's default page size is used.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+// WithPageToken tells the API to return the page of a list which the token
+// refers to, as returned in the NextPageToken of the previous page. Only that
+// page is returned by List.
+func WithPageToken(token string) Option {
+	return func(o *options) {
+		o.withPageToken = token
+	}
+}
+
+// WithRecursive tells the API to list the items of the scopes under the given
+// scope as well as those of the scope itself, in every scope the caller is
+// allowed to list them in. It has no effect on collections which aren't
+// contained in a scope.
+func WithRecursive(recurse bool) Option {
+	return func(o *options) {
+		o.withRecursive = recurse
+	}
+}
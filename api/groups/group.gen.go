@@ -14,16 +14,17 @@ import (
 )
 
 type Group struct {
-	Id          string            `json:"id,omitempty"`
-	ScopeId     string            `json:"scope_id,omitempty"`
-	Scope       *scopes.ScopeInfo `json:"scope,omitempty"`
-	Name        string            `json:"name,omitempty"`
-	Description string            `json:"description,omitempty"`
-	CreatedTime time.Time         `json:"created_time,omitempty"`
-	UpdatedTime time.Time         `json:"updated_time,omitempty"`
-	Version     uint32            `json:"version,omitempty"`
-	MemberIds   []string          `json:"member_ids,omitempty"`
-	Members     []*Member         `json:"members,omitempty"`
+	Id                string            `json:"id,omitempty"`
+	ScopeId           string            `json:"scope_id,omitempty"`
+	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	CreatedTime       time.Time         `json:"created_time,omitempty"`
+	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
+	Version           uint32            `json:"version,omitempty"`
+	MemberIds         []string          `json:"member_ids,omitempty"`
+	Members           []*Member         `json:"members,omitempty"`
+	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
 
 	responseBody *bytes.Buffer
 	responseMap  map[string]interface{}
@@ -72,9 +73,10 @@ func (n GroupDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type GroupListResult struct {
-	Items        []*Group
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*Group
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n GroupListResult) GetItems() interface{} {
@@ -301,7 +303,41 @@ func (c *Client) Delete(ctx context.Context, groupId string, opt ...Option) (*Gr
 	return target, nil
 }
 
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
 func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*GroupListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, scopeId, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, scopeId, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *GroupListIterator {
+	return &GroupListIterator{
+		ctx:          ctx,
+		client:       c,
+		collectionId: scopeId,
+		opt:          opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, scopeId string, opt ...Option) (*GroupListResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into List request")
 	}
@@ -343,6 +379,54 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Grou
 	return target, nil
 }
 
+// GroupListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type GroupListIterator struct {
+	ctx           context.Context
+	client        *Client
+	collectionId  string
+	opt           []Option
+	items         []*Group
+	item          *Group
+	nextPageToken string
+	fetched       bool
+	err           error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *GroupListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *GroupListIterator) Item() *Group {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *GroupListIterator) Err() error {
+	return i.err
+}
+
 func (c *Client) AddMembers(ctx context.Context, groupId string, version uint32, memberIds []string, opt ...Option) (*GroupUpdateResult, error) {
 	if groupId == "" {
 		return nil, fmt.Errorf("empty groupId value passed into AddMembers request")
@@ -2,5 +2,8 @@
 package hosts
 
 type StaticHostAttributes struct {
-	Address string `json:"address,omitempty"`
+	Address            string            `json:"address,omitempty"`
+	Port               uint32            `json:"port,omitempty"`
+	AlternateAddresses []string          `json:"alternate_addresses,omitempty"`
+	Attributes         map[string]string `json:"attributes,omitempty"`
 }
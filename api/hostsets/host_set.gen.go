@@ -14,17 +14,18 @@ import (
 )
 
 type HostSet struct {
-	Id            string                 `json:"id,omitempty"`
-	HostCatalogId string                 `json:"host_catalog_id,omitempty"`
-	Scope         *scopes.ScopeInfo      `json:"scope,omitempty"`
-	Name          string                 `json:"name,omitempty"`
-	Description   string                 `json:"description,omitempty"`
-	CreatedTime   time.Time              `json:"created_time,omitempty"`
-	UpdatedTime   time.Time              `json:"updated_time,omitempty"`
-	Version       uint32                 `json:"version,omitempty"`
-	Type          string                 `json:"type,omitempty"`
-	HostIds       []string               `json:"host_ids,omitempty"`
-	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Id                string                 `json:"id,omitempty"`
+	HostCatalogId     string                 `json:"host_catalog_id,omitempty"`
+	Scope             *scopes.ScopeInfo      `json:"scope,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	Description       string                 `json:"description,omitempty"`
+	CreatedTime       time.Time              `json:"created_time,omitempty"`
+	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
+	Version           uint32                 `json:"version,omitempty"`
+	Type              string                 `json:"type,omitempty"`
+	HostIds           []string               `json:"host_ids,omitempty"`
+	Attributes        map[string]interface{} `json:"attributes,omitempty"`
+	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
 
 	responseBody *bytes.Buffer
 	responseMap  map[string]interface{}
@@ -73,9 +74,10 @@ func (n HostSetDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type HostSetListResult struct {
-	Items        []*HostSet
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*HostSet
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n HostSetListResult) GetItems() interface{} {
@@ -302,7 +304,41 @@ func (c *Client) Delete(ctx context.Context, hostSetId string, opt ...Option) (*
 	return target, nil
 }
 
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
 func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option) (*HostSetListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, hostCatalogId, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, hostCatalogId, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, hostCatalogId string, opt ...Option) *HostSetListIterator {
+	return &HostSetListIterator{
+		ctx:          ctx,
+		client:       c,
+		collectionId: hostCatalogId,
+		opt:          opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, hostCatalogId string, opt ...Option) (*HostSetListResult, error) {
 	if hostCatalogId == "" {
 		return nil, fmt.Errorf("empty hostCatalogId value passed into List request")
 	}
@@ -344,6 +380,54 @@ func (c *Client) List(ctx context.Context, hostCatalogId string, opt ...Option)
 	return target, nil
 }
 
+// HostSetListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type HostSetListIterator struct {
+	ctx           context.Context
+	client        *Client
+	collectionId  string
+	opt           []Option
+	items         []*HostSet
+	item          *HostSet
+	nextPageToken string
+	fetched       bool
+	err           error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *HostSetListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *HostSetListIterator) Item() *HostSet {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *HostSetListIterator) Err() error {
+	return i.err
+}
+
 func (c *Client) AddHosts(ctx context.Context, hostSetId string, version uint32, hostIds []string, opt ...Option) (*HostSetUpdateResult, error) {
 	if hostSetId == "" {
 		return nil, fmt.Errorf("empty hostSetId value passed into AddHosts request")
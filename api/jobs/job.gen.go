@@ -0,0 +1,277 @@
+// Code generated by "make api"; DO NOT EDIT.
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/scopes"
+)
+
+type Job struct {
+	Id                  string            `json:"id,omitempty"`
+	Scope               *scopes.ScopeInfo `json:"scope,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	NextScheduledRun    time.Time         `json:"next_scheduled_run,omitempty"`
+	LastRunStart        time.Time         `json:"last_run_start,omitempty"`
+	LastRunEnd          time.Time         `json:"last_run_end,omitempty"`
+	LastRunStatus       string            `json:"last_run_status,omitempty"`
+	LastRunError        string            `json:"last_run_error,omitempty"`
+	LastRunControllerId string            `json:"last_run_controller_id,omitempty"`
+	Running             bool              `json:"running,omitempty"`
+	CreatedTime         time.Time         `json:"created_time,omitempty"`
+	UpdatedTime         time.Time         `json:"updated_time,omitempty"`
+	AuthorizedActions   []string          `json:"authorized_actions,omitempty"`
+
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n Job) ResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n Job) ResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+type JobReadResult struct {
+	Item         *Job
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n JobReadResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n JobReadResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n JobReadResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+type JobCreateResult = JobReadResult
+type JobUpdateResult = JobReadResult
+
+type JobDeleteResult struct {
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n JobDeleteResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n JobDeleteResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+type JobListResult struct {
+	Items         []*Job
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
+}
+
+func (n JobListResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n JobListResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n JobListResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// Client is a client for this collection
+type Client struct {
+	client *api.Client
+}
+
+// Creates a new client for this collection. The submitted API client is cloned;
+// modifications to it after generating this client will not have effect. If you
+// need to make changes to the underlying API client, use ApiClient() to access
+// it.
+func NewClient(c *api.Client) *Client {
+	return &Client{client: c.Clone()}
+}
+
+// ApiClient returns the underlying API client
+func (c *Client) ApiClient() *api.Client {
+	return c.client
+}
+
+func (c *Client) Read(ctx context.Context, jobId string, opt ...Option) (*JobReadResult, error) {
+	if jobId == "" {
+		return nil, fmt.Errorf("empty jobId value passed into Read request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("jobs/%s", jobId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Read request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Read call: %w", err)
+	}
+
+	target := new(JobReadResult)
+	target.Item = new(Job)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Read response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
+
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
+func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*JobListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, scopeId, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, scopeId, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *JobListIterator {
+	return &JobListIterator{
+		ctx:          ctx,
+		client:       c,
+		collectionId: scopeId,
+		opt:          opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, scopeId string, opt ...Option) (*JobListResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into List request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["scope_id"] = scopeId
+
+	req, err := c.client.NewRequest(ctx, "GET", "jobs", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating List request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during List call: %w", err)
+	}
+
+	target := new(JobListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding List response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
+
+// JobListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type JobListIterator struct {
+	ctx           context.Context
+	client        *Client
+	collectionId  string
+	opt           []Option
+	items         []*Job
+	item          *Job
+	nextPageToken string
+	fetched       bool
+	err           error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *JobListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *JobListIterator) Item() *Job {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *JobListIterator) Err() error {
+	return i.err
+}
@@ -14,19 +14,20 @@ import (
 )
 
 type Role struct {
-	Id           string            `json:"id,omitempty"`
-	ScopeId      string            `json:"scope_id,omitempty"`
-	Scope        *scopes.ScopeInfo `json:"scope,omitempty"`
-	Name         string            `json:"name,omitempty"`
-	Description  string            `json:"description,omitempty"`
-	CreatedTime  time.Time         `json:"created_time,omitempty"`
-	UpdatedTime  time.Time         `json:"updated_time,omitempty"`
-	Version      uint32            `json:"version,omitempty"`
-	GrantScopeId string            `json:"grant_scope_id,omitempty"`
-	PrincipalIds []string          `json:"principal_ids,omitempty"`
-	Principals   []*Principal      `json:"principals,omitempty"`
-	GrantStrings []string          `json:"grant_strings,omitempty"`
-	Grants       []*Grant          `json:"grants,omitempty"`
+	Id                string            `json:"id,omitempty"`
+	ScopeId           string            `json:"scope_id,omitempty"`
+	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	CreatedTime       time.Time         `json:"created_time,omitempty"`
+	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
+	Version           uint32            `json:"version,omitempty"`
+	GrantScopeId      string            `json:"grant_scope_id,omitempty"`
+	PrincipalIds      []string          `json:"principal_ids,omitempty"`
+	Principals        []*Principal      `json:"principals,omitempty"`
+	GrantStrings      []string          `json:"grant_strings,omitempty"`
+	Grants            []*Grant          `json:"grants,omitempty"`
+	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
 
 	responseBody *bytes.Buffer
 	responseMap  map[string]interface{}
@@ -75,9 +76,10 @@ func (n RoleDeleteResult) GetResponseMap() map[string]interface{} {
 }
 
 type RoleListResult struct {
-	Items        []*Role
-	responseBody *bytes.Buffer
-	responseMap  map[string]interface{}
+	Items         []*Role
+	NextPageToken string `json:"next_page_token,omitempty"`
+	responseBody  *bytes.Buffer
+	responseMap   map[string]interface{}
 }
 
 func (n RoleListResult) GetItems() interface{} {
@@ -304,7 +306,41 @@ func (c *Client) Delete(ctx context.Context, roleId string, opt ...Option) (*Rol
 	return target, nil
 }
 
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
 func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*RoleListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, scopeId, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, scopeId, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, scopeId string, opt ...Option) *RoleListIterator {
+	return &RoleListIterator{
+		ctx:          ctx,
+		client:       c,
+		collectionId: scopeId,
+		opt:          opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, scopeId string, opt ...Option) (*RoleListResult, error) {
 	if scopeId == "" {
 		return nil, fmt.Errorf("empty scopeId value passed into List request")
 	}
@@ -346,6 +382,54 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Role
 	return target, nil
 }
 
+// RoleListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type RoleListIterator struct {
+	ctx           context.Context
+	client        *Client
+	collectionId  string
+	opt           []Option
+	items         []*Role
+	item          *Role
+	nextPageToken string
+	fetched       bool
+	err           error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *RoleListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *RoleListIterator) Item() *Role {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *RoleListIterator) Err() error {
+	return i.err
+}
+
 func (c *Client) AddGrants(ctx context.Context, roleId string, version uint32, grantStrings []string, opt ...Option) (*RoleUpdateResult, error) {
 	if roleId == "" {
 		return nil, fmt.Errorf("empty roleId value passed into AddGrants request")
@@ -0,0 +1,64 @@
+package scopes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// RotateKeys creates a new version of the root key and each data encryption
+// key of the scope. New data is encrypted with the new versions; existing data
+// remains readable until it is rewrapped.
+func (c *Client) RotateKeys(ctx context.Context, scopeId string, opt ...Option) (*ScopeUpdateResult, error) {
+	return c.keysAction(ctx, scopeId, "rotate-keys", "RotateKeys", opt...)
+}
+
+// RewrapKeys re-encrypts the scope's data encryption keys under the newest
+// root key version and schedules the data encrypted with older key versions to
+// be re-encrypted by the controllers.
+func (c *Client) RewrapKeys(ctx context.Context, scopeId string, opt ...Option) (*ScopeUpdateResult, error) {
+	return c.keysAction(ctx, scopeId, "rewrap-keys", "RewrapKeys", opt...)
+}
+
+func (c *Client) keysAction(ctx context.Context, scopeId, action, callName string, opt ...Option) (*ScopeUpdateResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into %s request", callName)
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:%s", scopeId, action), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", callName, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", callName, err)
+	}
+
+	target := new(ScopeUpdateResult)
+	target.Item = new(Scope)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", callName, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
@@ -0,0 +1,7 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+type SessionRecording struct {
+	ConnectionId string `json:"connection_id,omitempty"`
+	Complete     bool   `json:"complete,omitempty"`
+}
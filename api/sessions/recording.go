@@ -0,0 +1,120 @@
+package sessions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type SessionRecordingListResult struct {
+	Items        []*SessionRecording
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n SessionRecordingListResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n SessionRecordingListResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n SessionRecordingListResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// ListRecordings returns the recordings of the session's connections. The
+// data of each recording is read with DownloadRecording.
+func (c *Client) ListRecordings(ctx context.Context, sessionId string, opt ...Option) (*SessionRecordingListResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into ListRecordings request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:list-recordings", sessionId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ListRecordings request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListRecordings call: %w", err)
+	}
+
+	target := new(SessionRecordingListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListRecordings response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
+
+// DownloadRecording writes the decrypted recording of the connection
+// connectionId of the session to w as it is received. The recording can be
+// replayed with "boundary sessions replay-recording".
+func (c *Client) DownloadRecording(ctx context.Context, sessionId, connectionId string, w io.Writer, opt ...Option) error {
+	if sessionId == "" {
+		return fmt.Errorf("empty sessionId value passed into DownloadRecording request")
+	}
+	if connectionId == "" {
+		return fmt.Errorf("empty connectionId value passed into DownloadRecording request")
+	}
+	if w == nil {
+		return fmt.Errorf("nil writer passed into DownloadRecording request")
+	}
+	if c.client == nil {
+		return errors.New("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:download-recording", sessionId), nil, apiOpts...)
+	if err != nil {
+		return fmt.Errorf("error creating DownloadRecording request: %w", err)
+	}
+	req.URL.RawQuery = url.Values{"connection_id": []string{connectionId}}.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error performing client request during DownloadRecording call: %w", err)
+	}
+
+	if resp.HttpResponse().StatusCode != http.StatusOK {
+		apiErr, err := resp.Decode(nil)
+		if err != nil {
+			return fmt.Errorf("error decoding DownloadRecording response: %w", err)
+		}
+		if apiErr != nil {
+			return apiErr
+		}
+		return fmt.Errorf("unexpected status %d during DownloadRecording call", resp.HttpResponse().StatusCode)
+	}
+	body := resp.HttpResponse().Body
+	defer body.Close()
+	if _, err := io.Copy(w, body); err != nil {
+		return fmt.Errorf("error reading DownloadRecording response: %w", err)
+	}
+	return nil
+}
@@ -2,5 +2,6 @@
 package targets
 
 type TcpTargetAttributes struct {
-	DefaultPort uint32 `json:"default_port,omitempty"`
+	DefaultPort            uint32 `json:"default_port,omitempty"`
+	EnableSessionRecording bool   `json:"enable_session_recording,omitempty"`
 }
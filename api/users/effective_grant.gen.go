@@ -0,0 +1,11 @@
+// Code generated by "make api"; DO NOT EDIT.
+package users
+
+type EffectiveGrant struct {
+	RoleId    string `json:"role_id,omitempty"`
+	ScopeId   string `json:"scope_id,omitempty"`
+	Raw       string `json:"raw,omitempty"`
+	Canonical string `json:"canonical,omitempty"`
+	Deny      bool   `json:"deny,omitempty"`
+	Applies   bool   `json:"applies,omitempty"`
+}
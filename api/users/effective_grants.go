@@ -0,0 +1,98 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// GrantCheck describes an action on a resource against which a user's grants
+// are evaluated. The fields match the parameters of the permissions engine.
+type GrantCheck struct {
+	ScopeId      string
+	Action       string
+	ResourceId   string
+	ResourceType string
+	PinId        string
+}
+
+type EffectiveGrantsResult struct {
+	Items []*EffectiveGrant
+	// Allowed and Denied are only set when a GrantCheck is provided
+	Allowed      bool
+	Denied       bool
+	responseBody *bytes.Buffer
+	responseMap  map[string]interface{}
+}
+
+func (n EffectiveGrantsResult) GetItems() interface{} {
+	return n.Items
+}
+
+func (n EffectiveGrantsResult) GetResponseBody() *bytes.Buffer {
+	return n.responseBody
+}
+
+func (n EffectiveGrantsResult) GetResponseMap() map[string]interface{} {
+	return n.responseMap
+}
+
+// EffectiveGrants returns the grants the user receives from every role. If a
+// check is provided, each grant reports whether it applies to the check's
+// action and resource, and the result reports whether the action is allowed.
+func (c *Client) EffectiveGrants(ctx context.Context, userId string, check *GrantCheck, opt ...Option) (*EffectiveGrantsResult, error) {
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into EffectiveGrants request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if check != nil {
+		for k, v := range map[string]string{
+			"scope_id":      check.ScopeId,
+			"action":        check.Action,
+			"resource_id":   check.ResourceId,
+			"resource_type": check.ResourceType,
+			"pin_id":        check.PinId,
+		} {
+			if v != "" {
+				opts.queryMap[k] = v
+			}
+		}
+	}
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s:effective-grants", userId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EffectiveGrants request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EffectiveGrants call: %w", err)
+	}
+
+	target := new(EffectiveGrantsResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EffectiveGrants response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.responseBody = resp.Body
+	target.responseMap = resp.Map
+	return target, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostsets"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/jobs"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/roles"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/sessions"
@@ -148,6 +149,11 @@ var inputStructs = []*structInfo{
 		outFile:    "users/account.gen.go",
 		outputOnly: true,
 	},
+	{
+		inProto:    &users.EffectiveGrant{},
+		outFile:    "users/effective_grant.gen.go",
+		outputOnly: true,
+	},
 	{
 		inProto: &users.User{},
 		outFile: "users/user.gen.go",
@@ -247,6 +253,16 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/password_auth_method_attributes.gen.go",
 		subtypeName: "PasswordAuthMethod",
 	},
+	{
+		inProto:     &authmethods.OidcAuthMethodAttributes{},
+		outFile:     "authmethods/oidc_auth_method_attributes.gen.go",
+		subtypeName: "OidcAuthMethod",
+	},
+	{
+		inProto:     &authmethods.LdapAuthMethodAttributes{},
+		outFile:     "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName: "LdapAuthMethod",
+	},
 	// Accounts
 	{
 		inProto: &accounts.Account{},
@@ -299,6 +315,11 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &hostcatalogs.DnsHostCatalogAttributes{},
+		outFile:     "hostcatalogs/dns_host_catalog_attributes.gen.go",
+		subtypeName: "DnsHostCatalog",
+	},
 	{
 		inProto: &hosts.Host{},
 		outFile: "hosts/host.gen.go",
@@ -320,6 +341,11 @@ var inputStructs = []*structInfo{
 		outFile:     "hosts/static_host_attributes.gen.go",
 		subtypeName: "StaticHost",
 	},
+	{
+		inProto:     &hosts.DnsHostAttributes{},
+		outFile:     "hosts/dns_host_attributes.gen.go",
+		subtypeName: "DnsHost",
+	},
 	{
 		inProto: &hostsets.HostSet{},
 		outFile: "hostsets/host_set.gen.go",
@@ -339,6 +365,16 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &hostsets.StaticHostSetAttributes{},
+		outFile:     "hostsets/static_host_set_attributes.gen.go",
+		subtypeName: "StaticHostSet",
+	},
+	{
+		inProto:     &hostsets.DnsHostSetAttributes{},
+		outFile:     "hostsets/dns_host_set_attributes.gen.go",
+		subtypeName: "DnsHostSet",
+	},
 	{
 		inProto: &targets.HostSet{},
 		outFile: "targets/host_set.gen.go",
@@ -395,6 +431,21 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.WorkerInfo{},
 		outFile: "sessions/workers.gen.go",
 	},
+	{
+		inProto: &sessions.SessionRecording{},
+		outFile: "sessions/recording.gen.go",
+	},
+	{
+		inProto: &jobs.Job{},
+		outFile: "jobs/job.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			readTemplate,
+			listTemplate,
+		},
+		pathArgs:            []string{"job"},
+		createResponseTypes: true,
+	},
 	{
 		inProto:     &targets.SessionAuthorization{},
 		outFile:     "targets/session_authorization.gen.go",
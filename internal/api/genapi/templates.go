@@ -90,13 +90,15 @@ func fillTemplates() {
 		// We want to generate options per-package, not per-struct, so we
 		// collate them all here for writing later. The map argument of the
 		// package map is to prevent duplicates since we may have multiple e.g.
-		// Name or Description fields.
+		// Name or Description fields. Subtype fields are keyed by their
+		// subtype so they don't replace a field of the same name on the
+		// resource, such as the Attributes of a static host.
 		if !in.outputOnly {
 			pkgOptionMap := map[string]fieldInfo{}
 			for _, val := range input.Fields {
 				if val.GenerateSdkOption {
 					val.SubtypeName = in.subtypeName
-					pkgOptionMap[val.Name] = val
+					pkgOptionMap[in.subtypeName+val.Name] = val
 				}
 			}
 			optionMap := optionsMap[input.Package]
@@ -139,16 +141,16 @@ func fillTemplates() {
 	for pkg, options := range optionsMap {
 		outBuf := new(bytes.Buffer)
 
-		var fieldNames []string
-		for _, v := range options {
-			fieldNames = append(fieldNames, v.Name)
-		}
-		sort.Strings(fieldNames)
-
 		var fields []fieldInfo
-		for _, v := range fieldNames {
-			fields = append(fields, options[v])
+		for _, v := range options {
+			fields = append(fields, v)
 		}
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].Name != fields[j].Name {
+				return fields[i].Name < fields[j].Name
+			}
+			return fields[i].SubtypeName < fields[j].SubtypeName
+		})
 
 		input := templateInput{
 			Package: pkg,
@@ -181,7 +183,41 @@ var listTemplate = template.Must(template.New("").Funcs(
 		"snakeCase": snakeCase,
 	},
 ).Parse(`
+// List returns the items of the collection. Every page of the list is fetched
+// and the items of all of them are returned, unless WithPageToken is used, in
+// which case only that page is returned along with the token of the next page.
+// The response body and map of the result are the ones of the last page.
 func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) (*{{ .Name }}ListResult, error) {
+	opts, _ := getOpts(opt...)
+	target, err := c.listPage(ctx, {{ .CollectionFunctionArg }}, opt...)
+	if err != nil || opts.withPageToken != "" {
+		return target, err
+	}
+	for target.NextPageToken != "" {
+		page, err := c.listPage(ctx, {{ .CollectionFunctionArg }}, append(opt[:len(opt):len(opt)], WithPageToken(target.NextPageToken))...)
+		if err != nil {
+			return nil, err
+		}
+		target.Items = append(target.Items, page.Items...)
+		target.NextPageToken = page.NextPageToken
+		target.responseBody = page.responseBody
+		target.responseMap = page.responseMap
+	}
+	return target, nil
+}
+
+// ListIterator returns an iterator over the items of the collection which
+// fetches the pages of the list as they are needed.
+func (c *Client) ListIterator(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) *{{ .Name }}ListIterator {
+	return &{{ .Name }}ListIterator{
+		ctx: ctx,
+		client: c,
+		collectionId: {{ .CollectionFunctionArg }},
+		opt: opt,
+	}
+}
+
+func (c *Client) listPage(ctx context.Context, {{ .CollectionFunctionArg }} string, opt... Option) (*{{ .Name }}ListResult, error) {
 	if {{ .CollectionFunctionArg }} == "" {
 		return nil, fmt.Errorf("empty {{ .CollectionFunctionArg }} value passed into List request")
 	}
@@ -224,6 +260,54 @@ func (c *Client) List(ctx context.Context, {{ .CollectionFunctionArg }} string,
 	target.responseMap = resp.Map
 	return target, nil
 }
+
+// {{ .Name }}ListIterator iterates over the items of a list, fetching the next
+// page of the list when the items of the current one have been consumed.
+type {{ .Name }}ListIterator struct {
+	ctx context.Context
+	client *Client
+	collectionId string
+	opt []Option
+	items []*{{ .Name }}
+	item *{{ .Name }}
+	nextPageToken string
+	fetched bool
+	err error
+}
+
+// Next advances the iterator to the next item, which is then available from
+// Item. It returns false when there are no more items or an error occurred, in
+// which case the error is available from Err.
+func (i *{{ .Name }}ListIterator) Next() bool {
+	for len(i.items) == 0 {
+		if i.err != nil || (i.fetched && i.nextPageToken == "") {
+			return false
+		}
+		opt := i.opt[:len(i.opt):len(i.opt)]
+		if i.nextPageToken != "" {
+			opt = append(opt, WithPageToken(i.nextPageToken))
+		}
+		page, err := i.client.listPage(i.ctx, i.collectionId, opt...)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		i.fetched = true
+		i.items, i.nextPageToken = page.Items, page.NextPageToken
+	}
+	i.item, i.items = i.items[0], i.items[1:]
+	return true
+}
+
+// Item returns the current item of the iterator.
+func (i *{{ .Name }}ListIterator) Item() *{{ .Name }} {
+	return i.item
+}
+
+// Err returns the error which stopped the iterator, if any.
+func (i *{{ .Name }}ListIterator) Err() error {
+	return i.err
+}
 `))
 
 var readTemplate = template.Must(template.New("").Parse(`
@@ -597,6 +681,7 @@ func (n {{ .Name }}DeleteResult) GetResponseMap() map[string]interface{} {
 
 type {{ .Name }}ListResult struct {
 	Items []*{{ .Name }}
+	NextPageToken string ` + "`json:\"next_page_token,omitempty\"`" + `
 	responseBody *bytes.Buffer
 	responseMap map[string]interface{}
 }
@@ -641,6 +726,8 @@ package {{ .Package }}
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/boundary/api"
@@ -659,6 +746,10 @@ type options struct {
 	postMap map[string]interface{}
 	queryMap map[string]string
 	withAutomaticVersioning bool
+	withFilter string
+	withPageSize uint32
+	withPageToken string
+	withRecursive bool
 }
 
 func getDefaultOptions() options {
@@ -674,6 +765,18 @@ func getOpts(opt ...Option) (options, []api.Option) {
 		o(&opts)
 	}
 	var apiOpts []api.Option
+	if opts.withFilter != "" {
+		opts.queryMap["filter"] = opts.withFilter
+	}
+	if opts.withPageSize != 0 {
+		opts.queryMap["page_size"] = strconv.FormatUint(uint64(opts.withPageSize), 10)
+	}
+	if opts.withPageToken != "" {
+		opts.queryMap["page_token"] = opts.withPageToken
+	}
+	if opts.withRecursive {
+		opts.queryMap["recursive"] = strconv.FormatBool(opts.withRecursive)
+	}
 	return opts, apiOpts
 }
 
@@ -686,6 +789,43 @@ func WithAutomaticVersioning(enable bool) Option {
 		o.withAutomaticVersioning = enable
 	}
 }
+
+// WithFilter tells the API to only return the items of a list which match the
+// given filter expression. The expression is in the format understood by
+// hashicorp/go-bexpr and is evaluated against the JSON form of each item,
+// which is available under "/item", e.g. "/item/name" == "web".
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = strings.TrimSpace(filter)
+	}
+}
+
+// WithPageSize tells the API the largest number of items to return in each page
+// of a list. Zero means the API's default page size is used.
+func WithPageSize(size uint32) Option {
+	return func(o *options) {
+		o.withPageSize = size
+	}
+}
+
+// WithPageToken tells the API to return the page of a list which the token
+// refers to, as returned in the NextPageToken of the previous page. Only that
+// page is returned by List.
+func WithPageToken(token string) Option {
+	return func(o *options) {
+		o.withPageToken = token
+	}
+}
+
+// WithRecursive tells the API to list the items of the scopes under the given
+// scope as well as those of the scope itself, in every scope the caller is
+// allowed to list them in. It has no effect on collections which aren't
+// contained in a scope.
+func WithRecursive(recurse bool) Option {
+	return func(o *options) {
+		o.withRecursive = recurse
+	}
+}
 {{ range .Fields }}
 func With{{ .SubtypeName }}{{ .Name }}(in{{ .Name }} {{ .FieldType }}) Option {
 	return func(o *options) {		{{ if ( not ( eq .SubtypeName "" ) ) }}
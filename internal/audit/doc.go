@@ -0,0 +1,16 @@
+/*
+Package audit provides structured audit events for Boundary.
+
+Events are emitted for every API request that is checked by auth.Verify, for
+every session and connection state transition recorded by the session
+repository, and whenever a password account is locked out or unlocked. An
+Eventer fans each event out to one or more Sinks; this package provides a
+sink that writes JSON lines to a file and one that writes to syslog.
+
+Fields which identify a principal's credentials or location (currently the
+auth token ID and the client IP) are HMAC'd before being written, using a key
+derived from the global scope's database KMS key. This allows operators to
+correlate events for the same value without the value itself being written
+to the audit log.
+*/
+package audit
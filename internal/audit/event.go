@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"time"
+)
+
+// EventType identifies what caused an event to be emitted
+type EventType string
+
+const (
+	// ApiRequest events are emitted for every API request checked by
+	// auth.Verify
+	ApiRequest EventType = "api_request"
+
+	// SessionStateChange events are emitted when a session transitions to
+	// a new state
+	SessionStateChange EventType = "session_state"
+
+	// ConnectionStateChange events are emitted when a session connection
+	// transitions to a new state
+	ConnectionStateChange EventType = "connection_state"
+
+	// AccountLockout events are emitted when an account is locked after too
+	// many failed authentication attempts and when it is unlocked
+	AccountLockout EventType = "account_lockout"
+)
+
+// Event is a single audit event. Only the sections relevant to the event's
+// type are set.
+type Event struct {
+	Id        string    `json:"id"`
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Auth      *Auth     `json:"auth,omitempty"`
+	Request   *Request  `json:"request,omitempty"`
+	Session   *Session  `json:"session,omitempty"`
+	Account   *Account  `json:"account,omitempty"`
+}
+
+// Auth describes the principal making a request and the outcome of the
+// authorization check
+type Auth struct {
+	UserId string `json:"user_id,omitempty"`
+	// AuthTokenId is sensitive and is HMAC'd before the event is written
+	AuthTokenId string `json:"auth_token_id,omitempty"`
+	Allowed     bool   `json:"allowed"`
+}
+
+// Request describes an API request
+type Request struct {
+	Id string `json:"id,omitempty"`
+	// ClientIp is sensitive and is HMAC'd before the event is written
+	ClientIp     string `json:"client_ip,omitempty"`
+	Method       string `json:"method,omitempty"`
+	Path         string `json:"path,omitempty"`
+	ScopeId      string `json:"scope_id,omitempty"`
+	ResourceId   string `json:"resource_id,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Action       string `json:"action,omitempty"`
+}
+
+// Session describes a session or session connection state transition
+type Session struct {
+	SessionId    string `json:"session_id,omitempty"`
+	ConnectionId string `json:"connection_id,omitempty"`
+	Status       string `json:"status,omitempty"`
+	// Reason is the termination reason of a session or the closed reason of
+	// a connection, if any
+	Reason string `json:"reason,omitempty"`
+}
+
+// Account describes a change to the lockout state of an account
+type Account struct {
+	AuthMethodId string `json:"auth_method_id,omitempty"`
+	AccountId    string `json:"account_id,omitempty"`
+	// Status is either "locked" or "unlocked"
+	Status string `json:"status,omitempty"`
+}
+
+// sensitiveFields returns pointers to the fields of the event that must be
+// HMAC'd before the event is written to a sink.
+func (e *Event) sensitiveFields() []*string {
+	var fields []*string
+	if e.Auth != nil && e.Auth.AuthTokenId != "" {
+		fields = append(fields, &e.Auth.AuthTokenId)
+	}
+	if e.Request != nil && e.Request.ClientIp != "" {
+		fields = append(fields, &e.Request.ClientIp)
+	}
+	return fields
+}
+
+// clone returns a deep copy of the event so sinks never see a value which is
+// later modified by the caller.
+func (e *Event) clone() *Event {
+	cp := *e
+	if e.Auth != nil {
+		a := *e.Auth
+		cp.Auth = &a
+	}
+	if e.Request != nil {
+		r := *e.Request
+		cp.Request = &r
+	}
+	if e.Session != nil {
+		s := *e.Session
+		cp.Session = &s
+	}
+	if e.Account != nil {
+		a := *e.Account
+		cp.Account = &a
+	}
+	return &cp
+}
@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+)
+
+// Eventer sends audit events to its sinks. A nil *Eventer is valid and
+// discards all events, so callers do not need to check whether auditing is
+// enabled.
+type Eventer struct {
+	logger hclog.Logger
+	sinks  []Sink
+	kms    *kms.Kms
+
+	hmacKeyLock sync.Mutex
+	hmacKey     []byte
+}
+
+// NewEventer creates an Eventer which writes to sinks. Supported options:
+// WithLogger and WithKms.
+func NewEventer(sinks []Sink, opt ...Option) (*Eventer, error) {
+	if len(sinks) == 0 {
+		return nil, fmt.Errorf("new eventer: missing sinks")
+	}
+	opts := getOpts(opt...)
+	return &Eventer{
+		logger: opts.withLogger,
+		sinks:  sinks,
+		kms:    opts.withKms,
+	}, nil
+}
+
+// Audit sends e to every sink. The event's Id and Timestamp are set if empty
+// and sensitive fields are HMAC'd. Failures to write are logged rather than
+// returned so that auditing never fails the operation being audited.
+func (e *Eventer) Audit(ctx context.Context, ev *Event) {
+	if e == nil || ev == nil {
+		return
+	}
+	ev = ev.clone()
+	if ev.Id == "" {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			e.logger.Error("error generating audit event id", "error", err)
+			return
+		}
+		ev.Id = id
+	}
+	if ev.Timestamp.IsZero() {
+		ev.Timestamp = time.Now()
+	}
+
+	if fields := ev.sensitiveFields(); len(fields) > 0 {
+		key, err := e.getHmacKey(ctx)
+		if err != nil {
+			e.logger.Error("error deriving audit hmac key, redacting sensitive fields", "error", err)
+		}
+		for _, f := range fields {
+			if key == nil {
+				*f = redactedText
+				continue
+			}
+			*f = HmacSha256(key, *f)
+		}
+	}
+
+	for _, s := range e.sinks {
+		if err := s.Write(ev); err != nil {
+			e.logger.Error("error writing audit event", "sink", s.Type(), "event_id", ev.Id, "error", err)
+		}
+	}
+}
+
+// Close closes all of the eventer's sinks
+func (e *Eventer) Close() error {
+	if e == nil {
+		return nil
+	}
+	var result *multierror.Error
+	for _, s := range e.sinks {
+		if err := s.Close(); err != nil {
+			result = multierror.Append(result, fmt.Errorf("error closing %s audit sink: %w", s.Type(), err))
+		}
+	}
+	return result.ErrorOrNil()
+}
+
+// getHmacKey returns the cached HMAC key, deriving it from the global scope's
+// audit key on first use. The audit key is stored apart from the DEKs and is
+// never rotated, so every controller derives the same key before and after a
+// key rotation or restart. A nil key with a nil error means no kms was
+// configured.
+func (e *Eventer) getHmacKey(ctx context.Context) ([]byte, error) {
+	if e.kms == nil {
+		return nil, nil
+	}
+	e.hmacKeyLock.Lock()
+	defer e.hmacKeyLock.Unlock()
+	if e.hmacKey != nil {
+		return e.hmacKey, nil
+	}
+	auditKey, err := e.kms.GetAuditKey(ctx, scope.Global.String())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get global audit key: %w", err)
+	}
+	key, err := DeriveHmacKey(auditKey)
+	if err != nil {
+		return nil, err
+	}
+	e.hmacKey = key
+	return key, nil
+}
@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventer_Audit(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	_, err := NewEventer(nil)
+	assert.Error(err)
+
+	s1, s2 := new(TestSink), new(TestSink)
+	e, err := NewEventer([]Sink{s1, s2})
+	require.NoError(err)
+
+	in := &Event{
+		Type:    ApiRequest,
+		Auth:    &Auth{UserId: "u_1234567890", AuthTokenId: "at_1234567890", Allowed: true},
+		Request: &Request{Id: "req", ClientIp: "127.0.0.1", Method: "GET", Path: "/v1/scopes"},
+	}
+	e.Audit(context.Background(), in)
+	require.Len(s1.Events(), 1)
+	require.Len(s2.Events(), 1)
+
+	got := s1.Events()[0]
+	assert.NotEmpty(got.Id)
+	assert.False(got.Timestamp.IsZero())
+	assert.Equal("u_1234567890", got.Auth.UserId)
+	// Without a kms sensitive values are redacted
+	assert.Equal(redactedText, got.Auth.AuthTokenId)
+	assert.Equal(redactedText, got.Request.ClientIp)
+	// The caller's event is not modified
+	assert.Equal("at_1234567890", in.Auth.AuthTokenId)
+	assert.Empty(in.Id)
+
+	// A nil eventer discards events
+	var nilEventer *Eventer
+	nilEventer.Audit(context.Background(), in)
+	assert.NoError(nilEventer.Close())
+}
+
+func TestHmac(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	keyBytes := make([]byte, 32)
+	_, err := rand.Read(keyBytes)
+	require.NoError(err)
+
+	key, err := DeriveHmacKey(keyBytes)
+	require.NoError(err)
+	assert.Len(key, 32)
+	again, err := DeriveHmacKey(keyBytes)
+	require.NoError(err)
+	assert.Equal(key, again)
+	assert.NotEqual(keyBytes, key)
+
+	h1 := HmacSha256(key, "at_1234567890")
+	assert.True(strings.HasPrefix(h1, hmacPrefix))
+	assert.Equal(h1, HmacSha256(key, "at_1234567890"))
+	assert.NotEqual(h1, HmacSha256(key, "at_0987654321"))
+	assert.NotContains(h1, "at_1234567890")
+
+	_, err = DeriveHmacKey(nil)
+	assert.Error(err)
+}
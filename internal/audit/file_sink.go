@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// FileSink writes events as JSON lines to a file
+type FileSink struct {
+	l    sync.Mutex
+	path string
+	f    *os.File
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink creates a sink which appends events to the file at path,
+// creating it if it does not exist.
+func NewFileSink(path string) (*FileSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("new file sink: missing path")
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("new file sink: %w", err)
+	}
+	return &FileSink{
+		path: path,
+		f:    f,
+	}, nil
+}
+
+// Type returns "file"
+func (s *FileSink) Type() string {
+	return "file"
+}
+
+// Write appends the event to the file as a single line of JSON
+func (s *FileSink) Write(e *Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("file sink: unable to marshal event: %w", err)
+	}
+	b = append(b, '\n')
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.f == nil {
+		return fmt.Errorf("file sink: %s is closed", s.path)
+	}
+	if _, err := s.f.Write(b); err != nil {
+		return fmt.Errorf("file sink: unable to write event to %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// Close closes the underlying file
+func (s *FileSink) Close() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSink(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	s, err := NewFileSink(path)
+	require.NoError(err)
+	assert.Equal("file", s.Type())
+
+	events := []*Event{
+		{Id: "1", Type: ApiRequest, Auth: &Auth{UserId: "u_1234567890", Allowed: true}},
+		{Id: "2", Type: SessionStateChange, Session: &Session{SessionId: "s_1234567890", Status: "active"}},
+	}
+	for _, e := range events {
+		require.NoError(s.Write(e))
+	}
+	require.NoError(s.Close())
+	assert.Error(s.Write(events[0]))
+
+	// Reopening appends rather than truncating
+	s, err = NewFileSink(path)
+	require.NoError(err)
+	require.NoError(s.Write(&Event{Id: "3", Type: ConnectionStateChange}))
+	require.NoError(s.Close())
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		require.NoError(json.Unmarshal(scanner.Bytes(), &e))
+		ids = append(ids, e.Id)
+	}
+	require.NoError(scanner.Err())
+	assert.Equal([]string{"1", "2", "3"}, ids)
+
+	_, err = NewFileSink("")
+	assert.Error(err)
+}
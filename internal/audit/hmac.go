@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	hmacPrefix   = "hmac-sha256:"
+	redactedText = "<redacted>"
+	hmacKeyInfo  = "boundary-audit-hmac"
+)
+
+// DeriveHmacKey derives the key used to HMAC sensitive event fields from
+// auditKey, which is expected to be the global scope's audit key.
+func DeriveHmacKey(auditKey []byte) ([]byte, error) {
+	if len(auditKey) == 0 {
+		return nil, errors.New("missing audit key")
+	}
+	reader := hkdf.New(sha256.New, auditKey, nil, []byte(hmacKeyInfo))
+	key := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// HmacSha256 returns the hex encoded HMAC-SHA256 of data with key, prefixed
+// with "hmac-sha256:".
+func HmacSha256(key []byte, data string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return hmacPrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,74 @@
+package audit
+
+import (
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/go-hclog"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withLogger         hclog.Logger
+	withKms            *kms.Kms
+	withSyslogFacility string
+	withSyslogTag      string
+	withSyslogNetwork  string
+	withSyslogAddress  string
+}
+
+func getDefaultOptions() options {
+	return options{
+		withLogger:         hclog.NewNullLogger(),
+		withSyslogFacility: "AUTH",
+		withSyslogTag:      "boundary",
+	}
+}
+
+// WithLogger specifies the logger used to report errors writing events
+func WithLogger(l hclog.Logger) Option {
+	return func(o *options) {
+		o.withLogger = l
+	}
+}
+
+// WithKms specifies the kms used to derive the key for HMAC'ing sensitive
+// fields. Without it sensitive fields are redacted instead.
+func WithKms(k *kms.Kms) Option {
+	return func(o *options) {
+		o.withKms = k
+	}
+}
+
+// WithSyslogFacility specifies the syslog facility, e.g. "AUTH" or "LOCAL0"
+func WithSyslogFacility(f string) Option {
+	return func(o *options) {
+		o.withSyslogFacility = f
+	}
+}
+
+// WithSyslogTag specifies the tag syslog messages are written with
+func WithSyslogTag(t string) Option {
+	return func(o *options) {
+		o.withSyslogTag = t
+	}
+}
+
+// WithSyslogAddress specifies a remote syslog daemon to write to, e.g.
+// ("udp", "syslog.example.com:514"). If not set the local daemon is used.
+func WithSyslogAddress(network, address string) Option {
+	return func(o *options) {
+		o.withSyslogNetwork = network
+		o.withSyslogAddress = address
+	}
+}
@@ -0,0 +1,14 @@
+package audit
+
+// Sink receives audit events and writes them to a destination. Write may be
+// called concurrently.
+type Sink interface {
+	// Type returns the type of the sink, e.g. "file" or "syslog"
+	Type() string
+
+	// Write persists the event to the sink's destination
+	Write(e *Event) error
+
+	// Close releases any resources held by the sink
+	Close() error
+}
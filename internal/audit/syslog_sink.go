@@ -0,0 +1,91 @@
+// +build !windows
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"strings"
+)
+
+// SyslogSink writes events as JSON to syslog
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// NewSyslogSink creates a sink which writes events to syslog. Supported
+// options: WithSyslogFacility, WithSyslogTag and WithSyslogAddress. By default
+// events are written to the local syslog daemon using the AUTH facility and
+// the tag "boundary".
+func NewSyslogSink(opt ...Option) (*SyslogSink, error) {
+	opts := getOpts(opt...)
+	facility, err := syslogFacility(opts.withSyslogFacility)
+	if err != nil {
+		return nil, fmt.Errorf("new syslog sink: %w", err)
+	}
+	w, err := syslog.Dial(opts.withSyslogNetwork, opts.withSyslogAddress, facility|syslog.LOG_INFO, opts.withSyslogTag)
+	if err != nil {
+		return nil, fmt.Errorf("new syslog sink: %w", err)
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+// Type returns "syslog"
+func (s *SyslogSink) Type() string {
+	return "syslog"
+}
+
+// Write sends the event to syslog as a single JSON message
+func (s *SyslogSink) Write(e *Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("syslog sink: unable to marshal event: %w", err)
+	}
+	if err := s.w.Info(string(b)); err != nil {
+		return fmt.Errorf("syslog sink: unable to write event: %w", err)
+	}
+	return nil
+}
+
+// Close closes the connection to syslog
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
+
+func syslogFacility(f string) (syslog.Priority, error) {
+	switch strings.ToUpper(f) {
+	case "KERN":
+		return syslog.LOG_KERN, nil
+	case "USER":
+		return syslog.LOG_USER, nil
+	case "DAEMON":
+		return syslog.LOG_DAEMON, nil
+	case "AUTH":
+		return syslog.LOG_AUTH, nil
+	case "SYSLOG":
+		return syslog.LOG_SYSLOG, nil
+	case "AUTHPRIV":
+		return syslog.LOG_AUTHPRIV, nil
+	case "LOCAL0":
+		return syslog.LOG_LOCAL0, nil
+	case "LOCAL1":
+		return syslog.LOG_LOCAL1, nil
+	case "LOCAL2":
+		return syslog.LOG_LOCAL2, nil
+	case "LOCAL3":
+		return syslog.LOG_LOCAL3, nil
+	case "LOCAL4":
+		return syslog.LOG_LOCAL4, nil
+	case "LOCAL5":
+		return syslog.LOG_LOCAL5, nil
+	case "LOCAL6":
+		return syslog.LOG_LOCAL6, nil
+	case "LOCAL7":
+		return syslog.LOG_LOCAL7, nil
+	default:
+		return 0, fmt.Errorf("unknown syslog facility %q", f)
+	}
+}
@@ -0,0 +1,30 @@
+package audit
+
+import (
+	"errors"
+)
+
+// SyslogSink is not supported on Windows
+type SyslogSink struct{}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// NewSyslogSink always returns an error on Windows, which has no syslog
+func NewSyslogSink(opt ...Option) (*SyslogSink, error) {
+	return nil, errors.New("new syslog sink: syslog is not supported on windows")
+}
+
+// Type returns "syslog"
+func (s *SyslogSink) Type() string {
+	return "syslog"
+}
+
+// Write is a no-op on Windows
+func (s *SyslogSink) Write(e *Event) error {
+	return nil
+}
+
+// Close is a no-op on Windows
+func (s *SyslogSink) Close() error {
+	return nil
+}
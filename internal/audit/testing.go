@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSink is a Sink which keeps events in memory for inspection in tests
+type TestSink struct {
+	l      sync.Mutex
+	events []*Event
+}
+
+var _ Sink = (*TestSink)(nil)
+
+// Type returns "test"
+func (s *TestSink) Type() string { return "test" }
+
+// Write records the event
+func (s *TestSink) Write(e *Event) error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+// Close is a no-op
+func (s *TestSink) Close() error { return nil }
+
+// Events returns the events written to the sink so far
+func (s *TestSink) Events() []*Event {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return append([]*Event(nil), s.events...)
+}
+
+// TestEventer returns an Eventer writing to a new TestSink, along with the
+// sink.
+func TestEventer(t *testing.T, opt ...Option) (*Eventer, *TestSink) {
+	t.Helper()
+	sink := new(TestSink)
+	e, err := NewEventer([]Sink{sink}, opt...)
+	require.NoError(t, err)
+	return e, sink
+}
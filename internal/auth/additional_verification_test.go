@@ -129,6 +129,7 @@ func TestAdditionalVerification(t *testing.T) {
 				authTokenRepoFn,
 				serversRepoFn,
 				tc.Kms(),
+				nil,
 				auth.RequestInfo{
 					PublicId:       token.Id,
 					EncryptedToken: strings.Split(token.Token, "_")[2],
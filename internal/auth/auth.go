@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -54,6 +55,8 @@ type RequestInfo struct {
 	EncryptedToken string
 	Token          string
 	TokenFormat    TokenFormat
+	RequestId      string
+	ClientIp       string
 
 	// The following are useful for tests
 	scopeIdOverride      string
@@ -78,6 +81,7 @@ type verifier struct {
 	authTokenRepoFn common.AuthTokenRepoFactory
 	serversRepoFn   common.ServersRepoFactory
 	kms             *kms.Kms
+	eventer         *audit.Eventer
 	requestInfo     RequestInfo
 	res             *perms.Resource
 	act             action.Type
@@ -95,6 +99,7 @@ func NewVerifierContext(ctx context.Context,
 	authTokenRepoFn common.AuthTokenRepoFactory,
 	serversRepoFn common.ServersRepoFactory,
 	kms *kms.Kms,
+	eventer *audit.Eventer,
 	requestInfo RequestInfo) context.Context {
 	return context.WithValue(ctx, verifierKey, &verifier{
 		logger:          logger,
@@ -102,6 +107,7 @@ func NewVerifierContext(ctx context.Context,
 		authTokenRepoFn: authTokenRepoFn,
 		serversRepoFn:   serversRepoFn,
 		kms:             kms,
+		eventer:         eventer,
 		requestInfo:     requestInfo,
 	})
 }
@@ -157,6 +163,10 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 		v.res.ScopeId = scope.Global.String()
 	}
 
+	defer func() {
+		v.audit(ret)
+	}()
+
 	if v.requestInfo.EncryptedToken != "" {
 		v.decryptToken()
 	}
@@ -190,6 +200,47 @@ func Verify(ctx context.Context, opt ...Option) (ret VerifyResults) {
 	return
 }
 
+// audit emits an audit event describing the outcome of the authn/authz check
+// performed by Verify.
+func (v *verifier) audit(ret VerifyResults) {
+	if v.eventer == nil {
+		return
+	}
+	ev := &audit.Event{
+		Type: audit.ApiRequest,
+		Auth: &audit.Auth{
+			UserId:      ret.UserId,
+			AuthTokenId: ret.AuthTokenId,
+			Allowed:     ret.Error == nil,
+		},
+		Request: &audit.Request{
+			Id:           v.requestInfo.RequestId,
+			ClientIp:     v.requestInfo.ClientIp,
+			Method:       v.requestInfo.Method,
+			Path:         v.requestInfo.Path,
+			ResourceId:   v.res.Id,
+			ResourceType: v.res.Type.String(),
+			Action:       v.act.String(),
+		},
+	}
+	if ret.Scope != nil {
+		ev.Request.ScopeId = ret.Scope.Id
+	}
+	if ev.Request.ScopeId == "" {
+		ev.Request.ScopeId = v.res.ScopeId
+	}
+	v.eventer.Audit(v.ctx, ev)
+}
+
+// ClientIp returns the IP address of the client making the request, or an
+// empty string if it is not known.
+func (r VerifyResults) ClientIp() string {
+	if r.v == nil {
+		return ""
+	}
+	return r.v.requestInfo.ClientIp
+}
+
 // AdditionalVerification is used to perform checks of additional resources for
 // actions that need to touch more than one.
 func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Option) (ret VerifyResults) {
@@ -285,6 +336,53 @@ func (r *VerifyResults) AdditionalVerification(ctx context.Context, opt ...Optio
 	return
 }
 
+// FetchActionSetForId returns the subset of the available actions the caller is
+// allowed to perform on the resource with the given ID, evaluated with the same
+// ACL as the request. The resource is taken to be in the scope the request was
+// verified against, of the type given to Verify, and pinned to the same ID;
+// these can be overridden with WithScopeId, WithType and WithPin. When the
+// authorization check is bypassed, all of the available actions are returned.
+func (r *VerifyResults) FetchActionSetForId(id string, availableActions action.ActionSet, opt ...Option) action.ActionSet {
+	v := r.v
+	switch {
+	case v == nil:
+		return nil
+	case v.requestInfo.DisableAuthEntirely,
+		v.requestInfo.DisableAuthzFailures,
+		v.requestInfo.TokenFormat == AuthTokenTypeRecoveryKms:
+		return availableActions
+	}
+
+	opts := getOpts(opt...)
+	res := perms.Resource{
+		ScopeId: r.Scope.GetId(),
+		Id:      id,
+		Type:    v.res.Type,
+		Pin:     v.res.Pin,
+	}
+	if opts.withScopeId != "" {
+		res.ScopeId = opts.withScopeId
+	}
+	if opts.withType != resource.Unknown {
+		res.Type = opts.withType
+	}
+	if opts.withPin != "" {
+		res.Pin = opts.withPin
+	}
+	// Global scope has no parent ID; account for this
+	if id == scope.Global.String() && res.Type == resource.Scope {
+		res.ScopeId = scope.Global.String()
+	}
+
+	var ret action.ActionSet
+	for _, a := range availableActions {
+		if v.acl.Allowed(res, a).Allowed {
+			ret = append(ret, a)
+		}
+	}
+	return ret
+}
+
 func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string, scopeInfo *scopes.ScopeInfo, retAcl perms.ACL, retErr error) {
 	// Ensure we return an error by default if we forget to set this somewhere
 	retErr = errors.New("unknown")
@@ -386,12 +484,20 @@ func (v verifier) performAuthCheck() (aclResults perms.ACLResults, userId string
 		retErr = fmt.Errorf("perform auth check: failed to query for user grants: %w", err)
 		return
 	}
+	// Templated grants are expanded with the caller's own user and account so
+	// that a single role can grant every user access to themselves. The
+	// anonymous user is not a user anyone can act as, so its templates are
+	// left unexpanded and match nothing.
+	templateUserId := userId
+	if templateUserId == "u_anon" {
+		templateUserId = ""
+	}
 	parsedGrants = make([]perms.Grant, 0, len(grantPairs))
 	for _, pair := range grantPairs {
 		parsed, err := perms.Parse(
 			pair.ScopeId,
 			pair.Grant,
-			perms.WithUserId(userId),
+			perms.WithUserId(templateUserId),
 			perms.WithAccountId(accountId),
 			perms.WithSkipFinalValidation(true))
 		if err != nil {
@@ -9,10 +9,14 @@ import (
 
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,7 +123,7 @@ func TestAuthTokenAuthenticator(t *testing.T) {
 			if tc.userId == "" {
 				return
 			}
-			ctx := NewVerifierContext(context.Background(), logger, iamRepoFn, tokenRepoFn, serversRepoFn, kms, requestInfo)
+			ctx := NewVerifierContext(context.Background(), logger, iamRepoFn, tokenRepoFn, serversRepoFn, kms, nil, requestInfo)
 
 			v, ok := ctx.Value(verifierKey).(*verifier)
 			require.True(t, ok)
@@ -133,3 +137,173 @@ func TestAuthTokenAuthenticator(t *testing.T) {
 		})
 	}
 }
+
+func TestPerformAuthCheck_Templates(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	logger := hclog.New(nil)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	tokenRepo, err := authtoken.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return tokenRepo, nil
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	other := iam.TestUser(t, iamRepo, o.GetPublicId())
+
+	// A single role given to everyone grants each user access to themselves
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "id={{user.id}};actions=read")
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "id={{account.id}};actions=change-password")
+	iam.TestUserRole(t, conn, role.GetPublicId(), "u_anon")
+
+	encToken, err := authtoken.EncryptToken(context.Background(), kms, o.GetPublicId(), at.GetPublicId(), at.GetToken())
+	require.NoError(t, err)
+	tokValue := at.GetPublicId() + "_" + encToken
+
+	cases := []struct {
+		name    string
+		token   string
+		res     perms.Resource
+		act     action.Type
+		allowed bool
+	}{
+		{
+			name:    "own user",
+			token:   tokValue,
+			res:     perms.Resource{ScopeId: o.GetPublicId(), Id: at.GetIamUserId(), Type: resource.User},
+			act:     action.Read,
+			allowed: true,
+		},
+		{
+			name:  "other user",
+			token: tokValue,
+			res:   perms.Resource{ScopeId: o.GetPublicId(), Id: other.GetPublicId(), Type: resource.User},
+			act:   action.Read,
+		},
+		{
+			name:    "own account",
+			token:   tokValue,
+			res:     perms.Resource{ScopeId: o.GetPublicId(), Id: at.GetAuthAccountId(), Type: resource.Account},
+			act:     action.ChangePassword,
+			allowed: true,
+		},
+		{
+			name: "anonymous user",
+			res:  perms.Resource{ScopeId: o.GetPublicId(), Id: "u_anon", Type: resource.User},
+			act:  action.Read,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "http://127.0.0.1/v1/users", nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.token))
+			}
+			requestInfo := RequestInfo{
+				Path:   req.URL.Path,
+				Method: req.Method,
+			}
+			requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = GetTokenFromRequest(logger, kms, req)
+			ctx := NewVerifierContext(context.Background(), logger, iamRepoFn, tokenRepoFn, serversRepoFn, kms, nil, requestInfo)
+			v, ok := ctx.Value(verifierKey).(*verifier)
+			require.True(t, ok)
+			v.ctx = ctx
+			v.decryptToken()
+			v.res = &tc.res
+			v.act = tc.act
+
+			results, _, _, _, err := v.performAuthCheck()
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, results.Allowed)
+		})
+	}
+}
+
+func TestVerifyResults_FetchActionSetForId(t *testing.T) {
+	available := action.ActionSet{action.Read, action.Update, action.Delete}
+	parse := func(grant string) perms.Grant {
+		g, err := perms.Parse("o_1234567890", grant)
+		require.NoError(t, err)
+		return g
+	}
+	acl := perms.NewACL(
+		parse("id=*;type=target;actions=read,update"),
+		parse("deny=true;id=ttcp_denied;actions=update"),
+	)
+	results := func(info RequestInfo) *VerifyResults {
+		return &VerifyResults{
+			Scope: &scopes.ScopeInfo{Id: "o_1234567890"},
+			v: &verifier{
+				requestInfo: info,
+				res:         &perms.Resource{ScopeId: "o_1234567890", Type: resource.Target},
+				acl:         acl,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		results *VerifyResults
+		id      string
+		opts    []Option
+		want    action.ActionSet
+	}{
+		{
+			name:    "no verifier",
+			results: &VerifyResults{},
+			id:      "ttcp_1234567890",
+		},
+		{
+			name:    "allowed subset",
+			results: results(RequestInfo{}),
+			id:      "ttcp_1234567890",
+			want:    action.ActionSet{action.Read, action.Update},
+		},
+		{
+			name:    "denied action removed",
+			results: results(RequestInfo{}),
+			id:      "ttcp_denied",
+			want:    action.ActionSet{action.Read},
+		},
+		{
+			name:    "other scope",
+			results: results(RequestInfo{}),
+			id:      "ttcp_1234567890",
+			opts:    []Option{WithScopeId("o_other")},
+		},
+		{
+			name:    "other type",
+			results: results(RequestInfo{}),
+			id:      "hcst_1234567890",
+			opts:    []Option{WithType(resource.HostCatalog)},
+		},
+		{
+			name:    "auth disabled",
+			results: results(RequestInfo{DisableAuthEntirely: true}),
+			id:      "ttcp_1234567890",
+			want:    available,
+		},
+		{
+			name:    "authz failures disabled",
+			results: results(RequestInfo{DisableAuthzFailures: true}),
+			id:      "ttcp_1234567890",
+			want:    available,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.results.FetchActionSetForId(tt.id, available, tt.opts...))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package ldap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// An Account contains a login name of an LDAP directory. It is owned by an
+// auth method.
+type Account struct {
+	*store.Account
+	tableName string
+}
+
+func allocAccount() *Account {
+	return &Account{
+		Account: &store.Account{},
+	}
+}
+
+// NewAccount creates a new in memory Account for loginName and the
+// directory entry dn. Name and description are the only valid options. All
+// other options are ignored.
+func NewAccount(authMethodId, loginName, dn string, opt ...Option) (*Account, error) {
+	// The scopeId in the embedded *store.Account is populated by a trigger
+	// in the database.
+	if authMethodId == "" {
+		return nil, fmt.Errorf("new: ldap account: no auth method id: %w", db.ErrInvalidParameter)
+	}
+	if loginName == "" {
+		return nil, fmt.Errorf("new: ldap account: no login name: %w", db.ErrInvalidParameter)
+	}
+	if dn == "" {
+		return nil, fmt.Errorf("new: ldap account: no dn: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	a := &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethodId,
+			LoginName:    loginName,
+			Dn:           dn,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+		},
+	}
+	return a, nil
+}
+
+// Groups returns the names of the groups the account was a member of when
+// it most recently authenticated.
+func (a *Account) Groups() ([]string, error) {
+	if a.MemberOfGroups == "" {
+		return nil, nil
+	}
+	var groups []string
+	if err := json.Unmarshal([]byte(a.MemberOfGroups), &groups); err != nil {
+		return nil, fmt.Errorf("ldap account: groups: %w", err)
+	}
+	return groups, nil
+}
+
+func (a *Account) setGroups(groups []string) error {
+	if len(groups) == 0 {
+		a.MemberOfGroups = ""
+		return nil
+	}
+	b, err := json.Marshal(groups)
+	if err != nil {
+		return fmt.Errorf("ldap account: groups: %w", err)
+	}
+	a.MemberOfGroups = string(b)
+	return nil
+}
+
+func (a *Account) clone() *Account {
+	cp := proto.Clone(a.Account)
+	return &Account{
+		Account: cp.(*store.Account),
+	}
+}
+
+// TableName returns the table name.
+func (a *Account) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_ldap_account"
+}
+
+// SetTableName sets the table name.
+func (a *Account) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *Account) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap account"},
+		"op-type":            []string{op.String()},
+	}
+	if a.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{a.AuthMethodId}
+	}
+	return metadata
+}
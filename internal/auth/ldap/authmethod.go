@@ -0,0 +1,121 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultUserAttr is the attribute matched against login names when an
+	// auth method does not set one.
+	DefaultUserAttr = "uid"
+
+	// DefaultGroupAttr is the attribute of a group entry containing the DNs
+	// of its members when an auth method does not set one.
+	DefaultGroupAttr = "member"
+)
+
+// A AuthMethod contains the configuration needed to authenticate accounts
+// against an LDAP directory, such as Active Directory. It is owned by a
+// scope.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+func allocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+// WithName, WithDescription, WithUrl, WithStartTls, WithCertificate,
+// WithBindDn, WithBindPassword, WithUserDn, WithUserAttr, WithGroupDn and
+// WithGroupAttr are the only valid options. All other options are ignored.
+// If WithUserAttr or WithGroupAttr are not set, DefaultUserAttr and
+// DefaultGroupAttr are used.
+func NewAuthMethod(scopeId string, opt ...Option) (*AuthMethod, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("new: ldap auth method: no scope id: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	if opts.withUserAttr == "" {
+		opts.withUserAttr = DefaultUserAttr
+	}
+	if opts.withGroupAttr == "" {
+		opts.withGroupAttr = DefaultGroupAttr
+	}
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:      scopeId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			Url:          opts.withUrl,
+			StartTls:     opts.withStartTls,
+			Certificate:  opts.withCertificate,
+			BindDn:       opts.withBindDn,
+			BindPassword: opts.withBindPassword,
+			UserDn:       opts.withUserDn,
+			UserAttr:     opts.withUserAttr,
+			GroupDn:      opts.withGroupDn,
+			GroupAttr:    opts.withGroupAttr,
+		},
+	}
+	return a, nil
+}
+
+func (a *AuthMethod) clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "auth_ldap_method"
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error encrypting ldap auth method: %w", err)
+	}
+	a.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return fmt.Errorf("error decrypting ldap auth method: %w", err)
+	}
+	return nil
+}
+
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap auth method"},
+		"op-type":            []string{op.String()},
+	}
+	if a.ScopeId != "" {
+		metadata["scope-id"] = []string{a.ScopeId}
+	}
+	return metadata
+}
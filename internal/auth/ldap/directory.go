@@ -0,0 +1,172 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+// directoryTimeout bounds how long connecting to the directory server and
+// each request sent to it may take.
+const directoryTimeout = 10 * time.Second
+
+// errInvalidCredentials is returned by lookupAccount when the login name
+// does not identify exactly one entry in the directory or the directory
+// rejects the password.
+var errInvalidCredentials = errors.New("invalid credentials")
+
+// tlsConfig returns the TLS configuration used to verify the directory
+// server configured in am.
+func tlsConfig(am *AuthMethod) (*tls.Config, error) {
+	u, err := url.Parse(am.GetUrl())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse url: %w", err)
+	}
+	host, _, err := net.SplitHostPort(u.Host)
+	if err != nil {
+		host = u.Host
+	}
+	cfg := &tls.Config{
+		ServerName: host,
+		MinVersion: tls.VersionTLS12,
+	}
+	if am.GetCertificate() != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(am.GetCertificate())) {
+			return nil, errors.New("certificate is not a PEM encoded certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// dial connects to the directory server configured in am, upgrading the
+// connection with StartTLS if am requires it, and binds as am's bind DN if
+// one is set.
+func dial(am *AuthMethod) (*ldap.Conn, error) {
+	cfg, err := tlsConfig(am)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := ldap.DialURL(am.GetUrl(),
+		ldap.DialWithDialer(&net.Dialer{Timeout: directoryTimeout}),
+		ldap.DialWithTLSConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to %s: %w", am.GetUrl(), err)
+	}
+	conn.SetTimeout(directoryTimeout)
+	if am.GetStartTls() {
+		if err := conn.StartTLS(cfg); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("unable to start tls with %s: %w", am.GetUrl(), err)
+		}
+	}
+	if err := bindService(conn, am); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// bindService binds conn as am's bind DN. It does nothing if am does not
+// have a bind DN.
+func bindService(conn *ldap.Conn, am *AuthMethod) error {
+	if am.GetBindDn() == "" {
+		return nil
+	}
+	if err := conn.Bind(am.GetBindDn(), am.GetBindPassword()); err != nil {
+		return fmt.Errorf("unable to bind as %s: %w", am.GetBindDn(), err)
+	}
+	return nil
+}
+
+// lookupAccount searches the directory configured in am for the entry
+// whose user attribute matches loginName, verifies password by binding as
+// that entry and returns an account populated from the entry and, if am has
+// a group DN, the names of the groups the entry is a member of. The
+// returned account has not been stored. errInvalidCredentials is returned
+// if the login name or password is not valid.
+func lookupAccount(am *AuthMethod, loginName, password string) (*Account, error) {
+	if loginName == "" || password == "" {
+		// An empty password would be an unauthenticated bind, which many
+		// directories accept for any DN.
+		return nil, errInvalidCredentials
+	}
+	conn, err := dial(am)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	res, err := conn.Search(ldap.NewSearchRequest(
+		am.GetUserDn(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(directoryTimeout.Seconds()), false,
+		fmt.Sprintf("(%s=%s)", am.GetUserAttr(), ldap.EscapeFilter(loginName)),
+		[]string{"mail", "displayName", "cn"},
+		nil,
+	))
+	switch {
+	case ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject), ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded):
+		return nil, errInvalidCredentials
+	case err != nil:
+		return nil, fmt.Errorf("unable to search for user: %w", err)
+	case len(res.Entries) != 1:
+		return nil, errInvalidCredentials
+	}
+	entry := res.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, errInvalidCredentials
+		}
+		return nil, fmt.Errorf("unable to bind as user: %w", err)
+	}
+
+	acct, err := NewAccount(am.GetPublicId(), strings.ToLower(loginName), entry.DN)
+	if err != nil {
+		return nil, err
+	}
+	acct.Email = entry.GetAttributeValue("mail")
+	acct.FullName = entry.GetAttributeValue("displayName")
+	if acct.FullName == "" {
+		acct.FullName = entry.GetAttributeValue("cn")
+	}
+
+	if am.GetGroupDn() == "" {
+		return acct, nil
+	}
+	// The user may not be allowed to read the groups, so they are searched
+	// as the bind DN when there is one.
+	if err := bindService(conn, am); err != nil {
+		return nil, err
+	}
+	res, err = conn.Search(ldap.NewSearchRequest(
+		am.GetGroupDn(), ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(directoryTimeout.Seconds()), false,
+		fmt.Sprintf("(%s=%s)", am.GetGroupAttr(), ldap.EscapeFilter(entry.DN)),
+		[]string{"cn"},
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+		return nil, fmt.Errorf("unable to search for groups: %w", err)
+	}
+	var groups []string
+	if res != nil {
+		for _, g := range res.Entries {
+			if cn := g.GetAttributeValue("cn"); cn != "" {
+				groups = append(groups, cn)
+			}
+		}
+	}
+	sort.Strings(groups)
+	if err := acct.setGroups(groups); err != nil {
+		return nil, err
+	}
+	return acct, nil
+}
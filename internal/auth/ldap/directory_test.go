@@ -0,0 +1,126 @@
+package ldap
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_lookupAccount(t *testing.T) {
+	t.Parallel()
+	s := NewTestServer(t)
+	aliceDn := s.AddUser("alice", "alice-password", "alice@example.com", "Alice Smith")
+	bobDn := s.AddUser("bob", "bob-password", "", "")
+	s.AddGroup("admins", aliceDn)
+	s.AddGroup("developers", aliceDn, bobDn)
+
+	newAuthMethod := func(t *testing.T, opt ...Option) *AuthMethod {
+		t.Helper()
+		am, err := NewAuthMethod("o_1234567890", append(s.Options(), opt...)...)
+		require.NoError(t, err)
+		am.PublicId = "amldap_1234567890"
+		return am
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "Alice", "alice-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Equal("amldap_1234567890", acct.AuthMethodId)
+		assert.Equal("alice", acct.LoginName)
+		assert.Equal(aliceDn, acct.Dn)
+		assert.Equal("alice@example.com", acct.Email)
+		assert.Equal("Alice Smith", acct.FullName)
+		groups, err := acct.Groups()
+		require.NoError(err)
+		assert.Equal([]string{"admins", "developers"}, groups)
+	})
+	t.Run("full-name-from-cn", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Empty(acct.Email)
+		assert.Equal("bob", acct.FullName)
+	})
+	t.Run("no-group-dn", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := lookupAccount(newAuthMethod(t, WithGroupDn("")), "alice", "alice-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Empty(acct.MemberOfGroups)
+	})
+	t.Run("start-tls", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := lookupAccount(newAuthMethod(t, WithStartTls(true)), "alice", "alice-password")
+		require.NoError(err)
+		assert.NotNil(acct)
+	})
+	t.Run("start-tls-unknown-certificate", func(t *testing.T) {
+		assert := assert.New(t)
+		other := NewTestServer(t)
+		acct, err := lookupAccount(newAuthMethod(t, WithStartTls(true), WithCertificate(other.Certificate)), "alice", "alice-password")
+		assert.Error(err)
+		assert.False(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("wrong-password", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "alice", "bob-password")
+		assert.True(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("empty-password", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "alice", "")
+		assert.True(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("unknown-user", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "carol", "alice-password")
+		assert.True(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("filter-injection", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t), "*", "alice-password")
+		assert.True(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("wrong-bind-password", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t, WithBindPassword("wrong")), "alice", "alice-password")
+		assert.Error(err)
+		assert.False(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+	t.Run("unreachable", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := lookupAccount(newAuthMethod(t, WithUrl("ldap://127.0.0.1:1")), "alice", "alice-password")
+		assert.Error(err)
+		assert.False(errors.Is(err, errInvalidCredentials))
+		assert.Nil(acct)
+	})
+}
+
+func Test_lookupAccount_RequireStartTls(t *testing.T) {
+	t.Parallel()
+	s := NewTestServer(t)
+	s.AddUser("alice", "alice-password", "", "")
+	s.SetRequireStartTls(true)
+
+	am, err := NewAuthMethod("o_1234567890", s.Options()...)
+	require.NoError(t, err)
+	am.PublicId = "amldap_1234567890"
+	_, err = lookupAccount(am, "alice", "alice-password")
+	assert.Error(t, err)
+
+	am.StartTls = true
+	acct, err := lookupAccount(am, "alice", "alice-password")
+	require.NoError(t, err)
+	assert.NotNil(t, acct)
+}
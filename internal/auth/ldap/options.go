@@ -0,0 +1,142 @@
+package ldap
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName           string
+	withDescription    string
+	withUrl            string
+	withStartTls       bool
+	withCertificate    string
+	withBindDn         string
+	withBindPassword   string
+	withUserDn         string
+	withUserAttr       string
+	withGroupDn        string
+	withGroupAttr      string
+	withLimit          int
+	withPublicId       string
+	withStartPageAfter *db.PageCursor
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithUrl provides an optional ldap or ldaps URL of the directory server.
+func WithUrl(u string) Option {
+	return func(o *options) {
+		o.withUrl = u
+	}
+}
+
+// WithStartTls provides an option to upgrade ldap connections to TLS with
+// StartTLS.
+func WithStartTls(startTls bool) Option {
+	return func(o *options) {
+		o.withStartTls = startTls
+	}
+}
+
+// WithCertificate provides an optional PEM encoded CA certificate used to
+// verify the directory server's certificate.
+func WithCertificate(cert string) Option {
+	return func(o *options) {
+		o.withCertificate = cert
+	}
+}
+
+// WithBindDn provides an optional distinguished name used to search the
+// directory.
+func WithBindDn(dn string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+	}
+}
+
+// WithBindPassword provides an optional password for the bind DN.
+func WithBindPassword(password string) Option {
+	return func(o *options) {
+		o.withBindPassword = password
+	}
+}
+
+// WithUserDn provides an optional base DN under which users are searched.
+func WithUserDn(dn string) Option {
+	return func(o *options) {
+		o.withUserDn = dn
+	}
+}
+
+// WithUserAttr provides an optional attribute matched against login names.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithGroupDn provides an optional base DN under which groups are searched.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional attribute of a group which contains
+// the DNs of its members.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
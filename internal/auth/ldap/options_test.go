@@ -0,0 +1,89 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test id"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test id"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithUrl", func(t *testing.T) {
+		opts := getOpts(WithUrl("ldaps://ldap.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withUrl = "ldaps://ldap.example.com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithStartTls", func(t *testing.T) {
+		opts := getOpts(WithStartTls(true))
+		testOpts := getDefaultOptions()
+		testOpts.withStartTls = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithCertificate", func(t *testing.T) {
+		opts := getOpts(WithCertificate("cert"))
+		testOpts := getDefaultOptions()
+		testOpts.withCertificate = "cert"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithBindDn", func(t *testing.T) {
+		opts := getOpts(WithBindDn("cn=admin,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withBindDn = "cn=admin,dc=example,dc=com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithBindPassword", func(t *testing.T) {
+		opts := getOpts(WithBindPassword("secret"))
+		testOpts := getDefaultOptions()
+		testOpts.withBindPassword = "secret"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithUserDn", func(t *testing.T) {
+		opts := getOpts(WithUserDn("ou=users,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserDn = "ou=users,dc=example,dc=com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithUserAttr", func(t *testing.T) {
+		opts := getOpts(WithUserAttr("sAMAccountName"))
+		testOpts := getDefaultOptions()
+		testOpts.withUserAttr = "sAMAccountName"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupDn", func(t *testing.T) {
+		opts := getOpts(WithGroupDn("ou=groups,dc=example,dc=com"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupDn = "ou=groups,dc=example,dc=com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithGroupAttr", func(t *testing.T) {
+		opts := getOpts(WithGroupAttr("uniqueMember"))
+		testOpts := getDefaultOptions()
+		testOpts.withGroupAttr = "uniqueMember"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,142 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// LookupAccount will look up an account in the repository.  If the account is not
+// found, it will return nil, nil.  All options are ignored.
+func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt ...Option) (*Account, error) {
+	if withPublicId == "" {
+		return nil, fmt.Errorf("lookup: ldap account: missing public id %w", db.ErrInvalidParameter)
+	}
+	a := allocAccount()
+	a.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, a); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: ldap account: failed %w for %s", err, withPublicId)
+	}
+	return a, nil
+}
+
+// ListAccounts in an auth method ordered by create time and supports the
+// WithLimit and WithStartPageAfter options.
+func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
+	if withAuthMethodId == "" {
+		return nil, fmt.Errorf("list: ldap account: missing auth method id %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var accts []*Account
+	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: ldap account: %w", err)
+	}
+	return accts, nil
+}
+
+// DeleteAccount deletes the account for the provided id from the repository returning a count of the
+// number of records deleted.  All options are ignored.
+func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
+	if withPublicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	ac := allocAccount()
+	ac.PublicId = withPublicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			metadata := ac.oplog(oplog.OpType_OP_TYPE_DELETE)
+			dAc := ac.clone()
+			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap account: %s: %w", withPublicId, err)
+	}
+
+	return rowsDeleted, nil
+}
+
+// upsertAccount creates the account for the login name in authMethodId if
+// it does not exist. If it exists, the dn, email, full name and groups are
+// updated when they differ from the values in a. The stored account is
+// returned.
+func (r *Repository) upsertAccount(ctx context.Context, scopeId string, a *Account) (*Account, error) {
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get oplog wrapper: %w", err)
+	}
+
+	var acct *Account
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			acct = allocAccount()
+			err := reader.LookupWhere(ctx, acct, "auth_method_id = ? and login_name = ?", a.AuthMethodId, a.LoginName)
+			switch {
+			case errors.Is(err, db.ErrRecordNotFound):
+				acct = a.clone()
+				if acct.PublicId, err = newAccountId(); err != nil {
+					return err
+				}
+				return w.Create(ctx, acct, db.WithOplog(oplogWrapper, acct.oplog(oplog.OpType_OP_TYPE_CREATE)))
+			case err != nil:
+				return err
+			}
+
+			if acct.Dn == a.Dn && acct.Email == a.Email && acct.FullName == a.FullName && acct.MemberOfGroups == a.MemberOfGroups {
+				return nil
+			}
+			acct.Dn, acct.Email, acct.FullName, acct.MemberOfGroups = a.Dn, a.Email, a.FullName, a.MemberOfGroups
+			var dbMask, nullFields []string
+			for f, v := range map[string]string{"Dn": a.Dn, "Email": a.Email, "FullName": a.FullName, "MemberOfGroups": a.MemberOfGroups} {
+				if v == "" {
+					nullFields = append(nullFields, f)
+					continue
+				}
+				dbMask = append(dbMask, f)
+			}
+			rowsUpdated, err := w.Update(ctx, acct, dbMask, nullFields, db.WithOplog(oplogWrapper, acct.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return acct, nil
+}
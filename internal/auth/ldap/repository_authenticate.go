@@ -0,0 +1,45 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// Authenticate verifies loginName and password against the directory
+// configured in authMethodId and returns the account for loginName. The
+// account is created the first time a login name authenticates and its dn,
+// email, full name and groups are refreshed from the directory on each
+// authentication. Returns nil if authentication fails.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName, password string) (*Account, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("ldap authenticate: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	if loginName == "" {
+		return nil, fmt.Errorf("ldap authenticate: no loginName: %w", db.ErrInvalidParameter)
+	}
+	if password == "" {
+		return nil, fmt.Errorf("ldap authenticate: no password: %w", db.ErrInvalidParameter)
+	}
+	am, err := r.lookupAuthMethodWithSecret(ctx, authMethodId)
+	if err != nil {
+		return nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	if am == nil {
+		return nil, fmt.Errorf("ldap authenticate: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	acct, err := lookupAccount(am, loginName, password)
+	if err != nil {
+		if errors.Is(err, errInvalidCredentials) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	acct, err = r.upsertAccount(ctx, am.GetScopeId(), acct)
+	if err != nil {
+		return nil, fmt.Errorf("ldap authenticate: %w", err)
+	}
+	return acct, nil
+}
@@ -0,0 +1,93 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	s := NewTestServer(t)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, s, WithStartTls(true))
+
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("new-and-existing-account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		bobDn := s.AddUser("bob", "bob-password", "bob@example.com", "Bob")
+		s.AddGroup("developers", bobDn)
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, "Bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		db.AssertPublicId(t, AccountPrefix, acct.PublicId)
+		assert.Equal("bob", acct.LoginName)
+		assert.Equal(bobDn, acct.Dn)
+		assert.Equal("bob@example.com", acct.Email)
+		groups, err := acct.Groups()
+		require.NoError(err)
+		assert.Equal([]string{"developers"}, groups)
+
+		u, err := iamRepo.LookupUserWithLogin(context.Background(), acct.PublicId, iam.WithAutoVivify(true))
+		require.NoError(err)
+		require.NotNil(u)
+
+		s.AddUser("bob", "bob-password", "robert@example.com", "Robert")
+		s.AddGroup("admins", bobDn)
+		acct2, err := repo.Authenticate(context.Background(), am.PublicId, "bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(acct2)
+		assert.Equal(acct.PublicId, acct2.PublicId)
+		assert.Equal("robert@example.com", acct2.Email)
+		assert.Equal("Robert", acct2.FullName)
+		groups, err = acct2.Groups()
+		require.NoError(err)
+		assert.Equal([]string{"admins", "developers"}, groups)
+
+		u2, err := iamRepo.LookupUserWithLogin(context.Background(), acct2.PublicId)
+		require.NoError(err)
+		assert.Equal(u.PublicId, u2.PublicId)
+
+		accts, err := repo.ListAccounts(context.Background(), am.PublicId)
+		require.NoError(err)
+		assert.Len(accts, 1)
+	})
+	t.Run("invalid-password", func(t *testing.T) {
+		assert := assert.New(t)
+		s.AddUser("carol", "carol-password", "", "")
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, "carol", "wrong-password")
+		assert.NoError(err)
+		assert.Nil(acct)
+	})
+	t.Run("unknown-login-name", func(t *testing.T) {
+		assert := assert.New(t)
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, "dave", "dave-password")
+		assert.NoError(err)
+		assert.Nil(acct)
+	})
+	t.Run("missing-parameters", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.Authenticate(context.Background(), am.PublicId, "", "password")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.Authenticate(context.Background(), am.PublicId, "bob", "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("unknown-auth-method", func(t *testing.T) {
+		_, err := repo.Authenticate(context.Background(), AuthMethodPrefix+"_1234567890", "bob", "bob-password")
+		assert.True(t, errors.Is(err, db.ErrRecordNotFound))
+	})
+}
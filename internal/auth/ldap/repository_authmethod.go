@@ -0,0 +1,363 @@
+package ldap
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateAuthMethod inserts m into the repository and returns a new
+// AuthMethod containing the auth method's PublicId. m is not changed. m must
+// contain a valid ScopeId, Url and UserDn. m must not contain a PublicId. The
+// PublicId is generated and assigned by this method. The BindPassword is
+// encrypted with the scope's database key before it is stored and is not
+// included in the returned AuthMethod.
+//
+// WithPublicId is the only valid option. All other options are ignored.
+//
+// Both m.Name and m.Description are optional. If m.Name is set, it must be
+// unique within m.ScopeId.
+func (r *Repository) CreateAuthMethod(ctx context.Context, m *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	if m == nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", db.ErrInvalidParameter)
+	}
+	if m.AuthMethod == nil {
+		return nil, fmt.Errorf("create: ldap auth method: embedded AuthMethod: %w", db.ErrInvalidParameter)
+	}
+	if m.ScopeId == "" {
+		return nil, fmt.Errorf("create: ldap auth method: no scope id: %w", db.ErrInvalidParameter)
+	}
+	if m.PublicId != "" {
+		return nil, fmt.Errorf("create: ldap auth method: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	if err := validateUrl(m.Url); err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", err)
+	}
+	if err := validateCertificate(m.Certificate); err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", err)
+	}
+	if m.UserDn == "" {
+		return nil, fmt.Errorf("create: ldap auth method: no user dn: %w", db.ErrInvalidParameter)
+	}
+	if m.UserAttr == "" {
+		return nil, fmt.Errorf("create: ldap auth method: no user attr: %w", db.ErrInvalidParameter)
+	}
+	if m.GroupAttr == "" {
+		return nil, fmt.Errorf("create: ldap auth method: no group attr: %w", db.ErrInvalidParameter)
+	}
+	m = m.clone()
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, AuthMethodPrefix+"_") {
+			return nil, fmt.Errorf("create: ldap auth method: passed-in public ID %q has wrong prefix, should be %q: %w", opts.withPublicId, AuthMethodPrefix, db.ErrInvalidPublicId)
+		}
+		m.PublicId = opts.withPublicId
+	} else {
+		id, err := newAuthMethodId()
+		if err != nil {
+			return nil, fmt.Errorf("create: ldap auth method: %w", err)
+		}
+		m.PublicId = id
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, m.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: unable to get database wrapper: %w", err)
+	}
+	if err := m.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: %w", err)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, m.GetScopeId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+
+	var newAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newAuthMethod = m.clone()
+			return w.Create(ctx, newAuthMethod, db.WithOplog(oplogWrapper, m.oplog(oplog.OpType_OP_TYPE_CREATE)))
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: ldap auth method: in scope: %s: name %s already exists: %w",
+				m.ScopeId, m.Name, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: ldap auth method: in scope: %s: %w", m.ScopeId, err)
+	}
+	newAuthMethod.BindPassword = ""
+	return newAuthMethod, nil
+}
+
+// LookupAuthMethod will look up an auth method in the repository.  If the auth method is not
+// found, it will return nil, nil.  The BindPassword of the returned auth
+// method is not decrypted.  All options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt ...Option) (*AuthMethod, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: ldap auth method: missing public id %w", db.ErrInvalidParameter)
+	}
+	a := allocAuthMethod()
+	a.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, &a); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: ldap auth method: failed %w for %s", err, publicId)
+	}
+	return &a, nil
+}
+
+// lookupAuthMethodWithSecret looks up an auth method and decrypts its
+// BindPassword.  If the auth method is not found, it will return nil, nil.
+func (r *Repository) lookupAuthMethodWithSecret(ctx context.Context, publicId string) (*AuthMethod, error) {
+	a, err := r.LookupAuthMethod(ctx, publicId)
+	if err != nil || a == nil {
+		return nil, err
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, a.GetScopeId(), kms.KeyPurposeDatabase, kms.WithKeyId(a.GetKeyId()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get database wrapper: %w", err)
+	}
+	if err := a.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ListAuthMethods returns a slice of AuthMethods for the scopeIds ordered by
+// create time. WithLimit and WithStartPageAfter are the only options supported.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	if len(scopeIds) == 0 {
+		return nil, fmt.Errorf("list: ldap auth method: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var authMethods []*AuthMethod
+	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: ldap auth method: %w", err)
+	}
+	return authMethods, nil
+}
+
+// DeleteAuthMethod deletes the auth method for the provided id from the repository returning a count of the
+// number of records deleted.  All options are ignored.
+func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId string, opt ...Option) (int, error) {
+	if publicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: missing public id: %w", db.ErrInvalidParameter)
+	}
+	am := allocAuthMethod()
+	am.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			metadata := am.oplog(oplog.OpType_OP_TYPE_DELETE)
+			dAc := am.clone()
+			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: ldap auth method: %s: %w", publicId, err)
+	}
+
+	return rowsDeleted, nil
+}
+
+// UpdateAuthMethod will update an auth method in the repository and return
+// the written auth method.  fieldMaskPaths provides field_mask.proto paths
+// for fields that should be updated.  Fields will be set to NULL if the field
+// is a zero value and included in fieldMask. Name, Description, Url,
+// StartTls, Certificate, BindDn, BindPassword, UserDn, UserAttr, GroupDn,
+// GroupAttr, MaxTokenDurationSeconds and MaxTokenIdleSeconds are the only
+// updatable fields. Url, UserDn, UserAttr and GroupAttr cannot be set to
+// NULL. Setting StartTls to NULL disables it and the auth token fields are
+// set to zero rather than NULL. If no updatable fields are included in the
+// fieldMaskPaths, then an error is returned.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
+	if authMethod == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: missing authMethod: %w", db.ErrInvalidParameter)
+	}
+	if authMethod.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: missing authMethod public id: %w", db.ErrInvalidParameter)
+	}
+	if authMethod.ScopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: scope id empty: %w", db.ErrInvalidParameter)
+	}
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("name", f):
+		case strings.EqualFold("description", f):
+		case strings.EqualFold("Url", f):
+		case strings.EqualFold("StartTls", f):
+		case strings.EqualFold("Certificate", f):
+		case strings.EqualFold("BindDn", f):
+		case strings.EqualFold("BindPassword", f):
+		case strings.EqualFold("UserDn", f):
+		case strings.EqualFold("UserAttr", f):
+		case strings.EqualFold("GroupDn", f):
+		case strings.EqualFold("GroupAttr", f):
+		case strings.EqualFold("MaxTokenDurationSeconds", f):
+		case strings.EqualFold("MaxTokenIdleSeconds", f):
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	var dbMask, nullFields []string
+	dbMask, nullFields = dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":                    authMethod.Name,
+			"Description":             authMethod.Description,
+			"Url":                     authMethod.Url,
+			"StartTls":                authMethod.StartTls,
+			"Certificate":             authMethod.Certificate,
+			"BindDn":                  authMethod.BindDn,
+			"BindPassword":            authMethod.BindPassword,
+			"UserDn":                  authMethod.UserDn,
+			"UserAttr":                authMethod.UserAttr,
+			"GroupDn":                 authMethod.GroupDn,
+			"GroupAttr":               authMethod.GroupAttr,
+			"MaxTokenDurationSeconds": authMethod.MaxTokenDurationSeconds,
+			"MaxTokenIdleSeconds":     authMethod.MaxTokenIdleSeconds,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", db.ErrEmptyFieldMask)
+	}
+	var newNullFields []string
+	for _, f := range nullFields {
+		switch {
+		case contains([]string{"Url", "UserDn", "UserAttr", "GroupAttr"}, f):
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %s cannot be empty: %w", f, db.ErrInvalidParameter)
+		case contains([]string{"StartTls", "BindPassword", "MaxTokenDurationSeconds", "MaxTokenIdleSeconds"}, f):
+			// start_tls and the auth token settings are not nullable and an
+			// empty bind password is stored encrypted like any other.
+			dbMask = append(dbMask, f)
+		default:
+			newNullFields = append(newNullFields, f)
+		}
+	}
+	nullFields = newNullFields
+	if contains(dbMask, "Url") {
+		if err := validateUrl(authMethod.Url); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+		}
+	}
+	if contains(dbMask, "Certificate") {
+		if err := validateCertificate(authMethod.Certificate); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+		}
+	}
+
+	upAuthMethod := authMethod.clone()
+	if contains(dbMask, "BindPassword") {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, authMethod.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: unable to get database wrapper: %w", err)
+		}
+		if err := upAuthMethod.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w", err)
+		}
+		var newMask []string
+		for _, f := range dbMask {
+			if !strings.EqualFold("BindPassword", f) {
+				newMask = append(newMask, f)
+			}
+		}
+		dbMask = append(newMask, "CtBindPassword", "KeyId")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, authMethod.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			dbOpts := []db.Option{
+				db.WithOplog(oplogWrapper, upAuthMethod.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version),
+			}
+			var err error
+			rowsUpdated, err = w.Update(
+				ctx,
+				upAuthMethod,
+				dbMask,
+				nullFields,
+				dbOpts...,
+			)
+			if err == nil && rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: authMethod %s already exists in scope %s: %w", authMethod.Name, authMethod.ScopeId, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: ldap auth method: %w for %s", err, authMethod.PublicId)
+	}
+	upAuthMethod.BindPassword = ""
+	return upAuthMethod, rowsUpdated, err
+}
+
+// validateUrl returns an error if u is not an absolute ldap or ldaps URL.
+func validateUrl(u string) error {
+	if u == "" {
+		return fmt.Errorf("no url: %w", db.ErrInvalidParameter)
+	}
+	pu, err := url.Parse(u)
+	if err != nil || (pu.Scheme != "ldap" && pu.Scheme != "ldaps") || pu.Host == "" {
+		return fmt.Errorf("url %q is not a valid ldap or ldaps URL: %w", u, db.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// validateCertificate returns an error if cert is set and does not contain a
+// PEM encoded certificate.
+func validateCertificate(cert string) error {
+	if cert == "" {
+		return nil
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(cert)) {
+		return fmt.Errorf("certificate is not a PEM encoded certificate: %w", db.ErrInvalidParameter)
+	}
+	return nil
+}
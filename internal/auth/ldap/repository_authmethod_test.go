@@ -0,0 +1,305 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	validOpts := []Option{WithUrl("ldaps://ldap.example.com"), WithUserDn("ou=users,dc=example,dc=com")}
+	newAm := func(opt ...Option) *AuthMethod {
+		am, err := NewAuthMethod(org.PublicId, opt...)
+		require.NoError(t, err)
+		return am
+	}
+
+	var tests = []struct {
+		name      string
+		in        *AuthMethod
+		opts      []Option
+		wantIsErr error
+	}{
+		{
+			name:      "nil-AuthMethod",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "nil-embedded-AuthMethod",
+			in:        &AuthMethod{},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-no-url",
+			in:        newAm(WithUserDn("ou=users,dc=example,dc=com")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-url-scheme",
+			in:        newAm(WithUrl("https://ldap.example.com"), WithUserDn("ou=users,dc=example,dc=com")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-no-user-dn",
+			in:        newAm(WithUrl("ldaps://ldap.example.com")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-certificate",
+			in:        newAm(append(validOpts, WithCertificate("not a certificate"))...),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-public-id-prefix",
+			in:        newAm(validOpts...),
+			opts:      []Option{WithPublicId("ampw_1234567890")},
+			wantIsErr: db.ErrInvalidPublicId,
+		},
+		{
+			name: "valid",
+			in:   newAm(validOpts...),
+		},
+		{
+			name: "valid-with-bind-dn",
+			in:   newAm(append(validOpts, WithBindDn("cn=admin,dc=example,dc=com"), WithBindPassword("secret"), WithStartTls(true))...),
+		},
+		{
+			name: "valid-with-name",
+			in:   newAm(append(validOpts, WithName("test-name-repo"), WithDescription("test-description-repo"))...),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.CreateAuthMethod(context.Background(), tt.in, tt.opts...)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			db.AssertPublicId(t, AuthMethodPrefix, got.PublicId)
+			assert.Equal(tt.in.Name, got.Name)
+			assert.Equal(tt.in.Description, got.Description)
+			assert.Equal(tt.in.Url, got.Url)
+			assert.Equal(tt.in.StartTls, got.StartTls)
+			assert.Equal(tt.in.BindDn, got.BindDn)
+			assert.Equal(DefaultUserAttr, got.UserAttr)
+			assert.Equal(DefaultGroupAttr, got.GroupAttr)
+			assert.Empty(got.BindPassword)
+			assert.NotEmpty(got.CtBindPassword)
+			assert.NotEmpty(got.KeyId)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE)))
+
+			found, err := repo.lookupAuthMethodWithSecret(context.Background(), got.PublicId)
+			require.NoError(err)
+			assert.Equal(tt.in.BindPassword, found.BindPassword)
+		})
+	}
+
+	t.Run("duplicate-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(err)
+		in := newAm(append(validOpts, WithName("test-name-dup"))...)
+		got, err := repo.CreateAuthMethod(context.Background(), in)
+		require.NoError(err)
+		require.NotNil(got)
+		got2, err := repo.CreateAuthMethod(context.Background(), in)
+		assert.Truef(errors.Is(err, db.ErrNotUnique), "want err: %v got: %v", db.ErrNotUnique, err)
+		assert.Nil(got2)
+	})
+}
+
+func TestRepository_LookupListDeleteAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	s := NewTestServer(t)
+
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+
+	am1 := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, s)
+	am2 := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, s)
+
+	got, err := repo.LookupAuthMethod(context.Background(), am1.PublicId)
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(am1.Url, got.Url)
+	assert.Empty(got.BindPassword)
+
+	got, err = repo.LookupAuthMethod(context.Background(), AuthMethodPrefix+"_1234567890")
+	assert.NoError(err)
+	assert.Nil(got)
+
+	list, err := repo.ListAuthMethods(context.Background(), []string{org.PublicId})
+	require.NoError(err)
+	assert.Len(list, 2)
+
+	list, err = repo.ListAuthMethods(context.Background(), []string{org.PublicId}, WithLimit(1))
+	require.NoError(err)
+	assert.Len(list, 1)
+
+	rows, err := repo.DeleteAuthMethod(context.Background(), org.PublicId, am2.PublicId)
+	require.NoError(err)
+	assert.Equal(1, rows)
+
+	list, err = repo.ListAuthMethods(context.Background(), []string{org.PublicId})
+	require.NoError(err)
+	assert.Len(list, 1)
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	s := NewTestServer(t)
+
+	var tests = []struct {
+		name             string
+		opts             []Option
+		maxTokenDuration uint32
+		masks            []string
+		wantIsErr        error
+	}{
+		{
+			name:  "change-name",
+			opts:  []Option{WithName("updated-name")},
+			masks: []string{"Name"},
+		},
+		{
+			name:  "change-url-and-start-tls",
+			opts:  []Option{WithUrl("ldap://updated.example.com"), WithStartTls(true)},
+			masks: []string{"Url", "StartTls"},
+		},
+		{
+			name:  "disable-start-tls",
+			masks: []string{"StartTls"},
+		},
+		{
+			name:  "change-bind-password",
+			opts:  []Option{WithBindPassword("updated-secret")},
+			masks: []string{"BindPassword"},
+		},
+		{
+			name:  "null-bind-dn-and-password",
+			masks: []string{"BindDn", "BindPassword"},
+		},
+		{
+			name:  "reset-user-attr",
+			masks: []string{"UserAttr"},
+		},
+		{
+			name:      "null-url",
+			masks:     []string{"Url"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "null-user-dn",
+			masks:     []string{"UserDn"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-url",
+			opts:      []Option{WithUrl("ftp://example.com")},
+			masks:     []string{"Url"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:             "change-max-token-duration",
+			maxTokenDuration: 3600,
+			masks:            []string{"MaxTokenDurationSeconds"},
+		},
+		{
+			name:  "zero-max-token-duration",
+			masks: []string{"MaxTokenDurationSeconds", "MaxTokenIdleSeconds"},
+		},
+		{
+			name:      "unknown-field",
+			masks:     []string{"KeyId"},
+			wantIsErr: db.ErrInvalidFieldMask,
+		},
+		{
+			name:      "empty-mask",
+			wantIsErr: db.ErrEmptyFieldMask,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kmsCache)
+			require.NoError(err)
+			orig := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, s, WithUserAttr("sAMAccountName"))
+
+			in, err := NewAuthMethod(org.PublicId, tt.opts...)
+			require.NoError(err)
+			in.PublicId = orig.PublicId
+			in.MaxTokenDurationSeconds = tt.maxTokenDuration
+			got, rows, err := repo.UpdateAuthMethod(context.Background(), in, orig.Version, tt.masks)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(1, rows)
+			assert.Empty(got.BindPassword)
+			assert.NoError(db.TestVerifyOplog(t, rw, orig.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE)))
+
+			found, err := repo.lookupAuthMethodWithSecret(context.Background(), orig.PublicId)
+			require.NoError(err)
+			for _, m := range tt.masks {
+				switch m {
+				case "Name":
+					assert.Equal(in.Name, found.Name)
+				case "MaxTokenDurationSeconds":
+					assert.Equal(in.MaxTokenDurationSeconds, found.MaxTokenDurationSeconds)
+				case "Url":
+					assert.Equal(in.Url, found.Url)
+				case "StartTls":
+					assert.Equal(in.StartTls, found.StartTls)
+				case "BindDn":
+					assert.Equal(in.BindDn, found.BindDn)
+				case "BindPassword":
+					assert.Equal(in.BindPassword, found.BindPassword)
+				case "UserAttr":
+					assert.Equal(DefaultUserAttr, found.UserAttr)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package ldap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates an ldap auth method in the provided DB with the
+// provided scope id which uses the directory s. If any errors are
+// encountered during the creation of the auth method, the test will fail.
+func TestAuthMethod(t *testing.T, conn *gorm.DB, databaseWrapper wrapping.Wrapper, scopeId string, s *TestServer, opt ...Option) *AuthMethod {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+
+	opt = append(s.Options(), opt...)
+	am, err := NewAuthMethod(scopeId, opt...)
+	assert.NoError(err)
+	require.NotNil(am)
+	id, err := newAuthMethodId()
+	assert.NoError(err)
+	require.NotEmpty(id)
+	am.PublicId = id
+	require.NoError(am.encrypt(context.Background(), databaseWrapper))
+
+	require.NoError(w.Create(context.Background(), am))
+	am.BindPassword = ""
+	return am
+}
+
+const startTlsOid = "1.3.6.1.4.1.1466.20037"
+
+// TestServer is a minimal in-process LDAP directory for use in tests. It
+// supports simple binds, searches with and, or, not, equality and presence
+// filters, and StartTLS. Users are stored under UserDn and groups, whose
+// members are listed in the member attribute, under GroupDn. Only BindDn and
+// users may search the directory.
+type TestServer struct {
+	URL          string
+	Certificate  string
+	BindDn       string
+	BindPassword string
+	UserDn       string
+	GroupDn      string
+
+	listener net.Listener
+	tls      *tls.Config
+
+	mu              sync.Mutex
+	entries         map[string]*ldap.Entry
+	passwords       map[string]string
+	requireStartTls bool
+}
+
+// NewTestServer starts a TestServer listening on a loopback address. The
+// server is stopped when the test completes.
+func NewTestServer(t *testing.T) *TestServer {
+	t.Helper()
+	require := require.New(t)
+
+	certPem, cert := testCertificate(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	s := &TestServer{
+		URL:          fmt.Sprintf("ldap://%s", l.Addr().String()),
+		Certificate:  certPem,
+		BindDn:       "cn=admin,dc=example,dc=com",
+		BindPassword: "admin-password",
+		UserDn:       "ou=users,dc=example,dc=com",
+		GroupDn:      "ou=groups,dc=example,dc=com",
+		listener:     l,
+		tls:          &tls.Config{Certificates: []tls.Certificate{cert}},
+		entries:      make(map[string]*ldap.Entry),
+		passwords:    make(map[string]string),
+	}
+	s.passwords[strings.ToLower(s.BindDn)] = s.BindPassword
+	go s.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return s
+}
+
+// Options returns the options for an auth method which uses s.
+func (s *TestServer) Options() []Option {
+	return []Option{
+		WithUrl(s.URL),
+		WithCertificate(s.Certificate),
+		WithBindDn(s.BindDn),
+		WithBindPassword(s.BindPassword),
+		WithUserDn(s.UserDn),
+		WithGroupDn(s.GroupDn),
+	}
+}
+
+// AddUser adds a user with the uid loginName to the directory and returns
+// its DN. An existing user with the same uid is replaced.
+func (s *TestServer) AddUser(loginName, password, email, fullName string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dn := fmt.Sprintf("uid=%s,%s", loginName, s.UserDn)
+	attrs := map[string][]string{
+		"objectClass": {"inetOrgPerson"},
+		"uid":         {loginName},
+		"cn":          {loginName},
+	}
+	if email != "" {
+		attrs["mail"] = []string{email}
+	}
+	if fullName != "" {
+		attrs["displayName"] = []string{fullName}
+	}
+	s.entries[strings.ToLower(dn)] = ldap.NewEntry(dn, attrs)
+	s.passwords[strings.ToLower(dn)] = password
+	return dn
+}
+
+// AddGroup adds a group named name with the members memberDns to the
+// directory. An existing group with the same name is replaced.
+func (s *TestServer) AddGroup(name string, memberDns ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	dn := fmt.Sprintf("cn=%s,%s", name, s.GroupDn)
+	s.entries[strings.ToLower(dn)] = ldap.NewEntry(dn, map[string][]string{
+		"objectClass": {"groupOfNames"},
+		"cn":          {name},
+		"member":      memberDns,
+	})
+}
+
+// SetRequireStartTls sets whether the server rejects binds on connections
+// which have not been upgraded with StartTLS.
+func (s *TestServer) SetRequireStartTls(require bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requireStartTls = require
+}
+
+func (s *TestServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *TestServer) handle(conn net.Conn) {
+	defer conn.Close()
+	var boundDn string
+	var isTls bool
+	for {
+		p, err := ber.ReadPacket(conn)
+		if err != nil || len(p.Children) < 2 {
+			return
+		}
+		msgId, _ := p.Children[0].Value.(int64)
+		op := p.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			if len(op.Children) < 3 {
+				return
+			}
+			dn, pw := op.Children[1].Data.String(), op.Children[2].Data.String()
+			code := s.bind(dn, pw, isTls)
+			boundDn = ""
+			if code == ldap.LDAPResultSuccess {
+				boundDn = dn
+			}
+			if err := writeResult(conn, msgId, ldap.ApplicationBindResponse, code); err != nil {
+				return
+			}
+		case ldap.ApplicationSearchRequest:
+			if len(op.Children) < 8 {
+				return
+			}
+			if boundDn == "" {
+				if err := writeResult(conn, msgId, ldap.ApplicationSearchResultDone, ldap.LDAPResultInsufficientAccessRights); err != nil {
+					return
+				}
+				continue
+			}
+			for _, e := range s.search(op.Children[0].Data.String(), op.Children[6]) {
+				if err := writeEntry(conn, msgId, e); err != nil {
+					return
+				}
+			}
+			if err := writeResult(conn, msgId, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess); err != nil {
+				return
+			}
+		case ldap.ApplicationExtendedRequest:
+			if isTls || len(op.Children) < 1 || op.Children[0].Data.String() != startTlsOid {
+				if err := writeResult(conn, msgId, ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError); err != nil {
+					return
+				}
+				continue
+			}
+			if err := writeResult(conn, msgId, ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess); err != nil {
+				return
+			}
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, isTls = tlsConn, true
+		case ldap.ApplicationUnbindRequest:
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (s *TestServer) bind(dn, password string, isTls bool) uint16 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.requireStartTls && !isTls {
+		return ldap.LDAPResultConfidentialityRequired
+	}
+	if pw, ok := s.passwords[strings.ToLower(dn)]; ok && password != "" && pw == password {
+		return ldap.LDAPResultSuccess
+	}
+	return ldap.LDAPResultInvalidCredentials
+}
+
+func (s *TestServer) search(baseDn string, filter *ber.Packet) []*ldap.Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var found []*ldap.Entry
+	for dn, e := range s.entries {
+		if strings.HasSuffix(dn, strings.ToLower(baseDn)) && matchFilter(e, filter) {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+// matchFilter reports whether e matches the filter encoded in f.
+func matchFilter(e *ldap.Entry, f *ber.Packet) bool {
+	switch f.Tag {
+	case ldap.FilterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(e, c) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, c := range f.Children {
+			if matchFilter(e, c) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterNot:
+		return len(f.Children) == 1 && !matchFilter(e, f.Children[0])
+	case ldap.FilterEqualityMatch:
+		if len(f.Children) != 2 {
+			return false
+		}
+		for _, v := range e.GetEqualFoldAttributeValues(f.Children[0].Data.String()) {
+			if strings.EqualFold(v, f.Children[1].Data.String()) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		return len(e.GetEqualFoldAttributeValues(f.Data.String())) > 0
+	}
+	return false
+}
+
+func writeResult(conn net.Conn, msgId int64, tag ber.Tag, code uint16) error {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, ldap.LDAPResultCodeMap[code], "Diagnostic Message"))
+	return writeMessage(conn, msgId, op)
+}
+
+func writeEntry(conn net.Conn, msgId int64, e *ldap.Entry) error {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "DN"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, a := range e.Attributes {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, a.Name, "Type"))
+		vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range a.Values {
+			vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(vals)
+		attrs.AppendChild(attr)
+	}
+	op.AppendChild(attrs)
+	return writeMessage(conn, msgId, op)
+}
+
+func writeMessage(conn net.Conn, msgId int64, op *ber.Packet) error {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "Message ID"))
+	p.AppendChild(op)
+	_, err := conn.Write(p.Bytes())
+	return err
+}
+
+// testCertificate returns a self-signed certificate for 127.0.0.1 and
+// localhost, PEM encoded and ready to serve.
+func testCertificate(t *testing.T) (string, tls.Certificate) {
+	t.Helper()
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
@@ -0,0 +1,90 @@
+package oidc
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName           string
+	withDescription    string
+	withIssuer         string
+	withClientId       string
+	withClientSecret   string
+	withLimit          int
+	withPublicId       string
+	withStartPageAfter *db.PageCursor
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithPublicId provides an optional public id
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithIssuer provides an optional issuer URL.
+func WithIssuer(issuer string) Option {
+	return func(o *options) {
+		o.withIssuer = issuer
+	}
+}
+
+// WithClientId provides an optional OAuth 2.0 client id.
+func WithClientId(id string) Option {
+	return func(o *options) {
+		o.withClientId = id
+	}
+}
+
+// WithClientSecret provides an optional OAuth 2.0 client secret.
+func WithClientSecret(secret string) Option {
+	return func(o *options) {
+		o.withClientSecret = secret
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
@@ -0,0 +1,53 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithPublicId", func(t *testing.T) {
+		opts := getOpts(WithPublicId("test id"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "test id"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithName", func(t *testing.T) {
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithIssuer", func(t *testing.T) {
+		opts := getOpts(WithIssuer("https://example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withIssuer = "https://example.com"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithClientId", func(t *testing.T) {
+		opts := getOpts(WithClientId("client"))
+		testOpts := getDefaultOptions()
+		testOpts.withClientId = "client"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithClientSecret", func(t *testing.T) {
+		opts := getOpts(WithClientSecret("secret"))
+		testOpts := getDefaultOptions()
+		testOpts.withClientSecret = "secret"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		opts := getOpts(WithLimit(5))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = 5
+		assert.Equal(t, opts, testOpts)
+	})
+}
@@ -0,0 +1,211 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	gooidc "github.com/coreos/go-oidc"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/mr-tron/base58"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
+)
+
+// errAuthenticationFailed marks exchange failures caused by the code or ID
+// token presented by the user rather than by the provider or the
+// configuration of the auth method.
+var errAuthenticationFailed = errors.New("authentication failed")
+
+// stateDuration is how long a user has to complete the login at the
+// provider after authentication has been started.
+const stateDuration = 10 * time.Minute
+
+// claims are the ID token claims, in addition to the subject, which are
+// mapped onto an account.
+type claims struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+func oauthConfig(p *gooidc.Provider, am *AuthMethod, redirectUrl string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     am.GetClientId(),
+		ClientSecret: am.GetClientSecret(),
+		Endpoint:     p.Endpoint(),
+		RedirectURL:  redirectUrl,
+		Scopes:       []string{gooidc.ScopeOpenID, "profile", "email"},
+	}
+}
+
+// authUrl returns the URL of the provider's authorization endpoint the user
+// must visit to log in. The provider redirects back to redirectUrl with a
+// code and the encrypted state.
+func authUrl(ctx context.Context, cipher wrapping.Wrapper, am *AuthMethod, redirectUrl string) (string, error) {
+	if err := validateRedirectUrl(redirectUrl); err != nil {
+		return "", err
+	}
+	p, err := gooidc.NewProvider(ctx, am.GetIssuer())
+	if err != nil {
+		return "", fmt.Errorf("unable to discover provider %s: %w", am.GetIssuer(), err)
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return "", err
+	}
+	exp, err := ptypes.TimestampProto(time.Now().Add(stateDuration).Truncate(time.Second))
+	if err != nil {
+		return "", err
+	}
+	state, err := encryptState(ctx, cipher, &store.State{
+		AuthMethodId:   am.GetPublicId(),
+		RedirectUrl:    redirectUrl,
+		Nonce:          nonce,
+		ExpirationTime: &timestamp.Timestamp{Timestamp: exp},
+	})
+	if err != nil {
+		return "", err
+	}
+	return oauthConfig(p, am, redirectUrl).AuthCodeURL(state, gooidc.Nonce(nonce)), nil
+}
+
+// exchange redeems code at the provider's token endpoint and verifies the
+// returned ID token. The subject and claims of the ID token are returned as
+// an account which has not been stored.
+func exchange(ctx context.Context, am *AuthMethod, st *store.State, code string) (*Account, error) {
+	p, err := gooidc.NewProvider(ctx, am.GetIssuer())
+	if err != nil {
+		return nil, fmt.Errorf("unable to discover provider %s: %w", am.GetIssuer(), err)
+	}
+	tok, err := oauthConfig(p, am, st.GetRedirectUrl()).Exchange(ctx, code)
+	if err != nil {
+		if isInvalidGrant(err) {
+			return nil, fmt.Errorf("unable to exchange code: %w: %v", errAuthenticationFailed, err)
+		}
+		return nil, fmt.Errorf("unable to exchange code: %w", err)
+	}
+	rawIdToken, ok := tok.Extra("id_token").(string)
+	if !ok || rawIdToken == "" {
+		return nil, fmt.Errorf("no id token in token response")
+	}
+	idToken, err := p.Verifier(&gooidc.Config{ClientID: am.GetClientId()}).Verify(ctx, rawIdToken)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify id token: %w: %v", errAuthenticationFailed, err)
+	}
+	if idToken.Nonce != st.GetNonce() {
+		return nil, fmt.Errorf("id token nonce does not match: %w", errAuthenticationFailed)
+	}
+	var c claims
+	if err := idToken.Claims(&c); err != nil {
+		return nil, fmt.Errorf("unable to parse id token claims: %w", err)
+	}
+	acct, err := NewAccount(am.GetPublicId(), idToken.Subject)
+	if err != nil {
+		return nil, err
+	}
+	acct.Email, acct.FullName = c.Email, c.Name
+	return acct, nil
+}
+
+// isInvalidGrant reports whether err is the provider rejecting the code, e.g.
+// because it expired or was already redeemed.
+func isInvalidGrant(err error) bool {
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		return false
+	}
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(retrieveErr.Body, &body); err != nil {
+		return false
+	}
+	return body.Error == "invalid_grant"
+}
+
+func encryptState(ctx context.Context, cipher wrapping.Wrapper, st *store.State) (string, error) {
+	marshaled, err := proto.Marshal(st)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling state: %w", err)
+	}
+	blobInfo, err := cipher.Encrypt(ctx, marshaled, []byte(st.GetAuthMethodId()))
+	if err != nil {
+		return "", fmt.Errorf("error encrypting state: %w", err)
+	}
+	marshaledBlob, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling encrypted state: %w", err)
+	}
+	return base58.FastBase58Encoding(marshaledBlob), nil
+}
+
+// decryptState decrypts and validates state which was created for
+// authMethodId by encryptState.
+func decryptState(ctx context.Context, cipher wrapping.Wrapper, authMethodId, state string) (*store.State, error) {
+	marshaledBlob, err := base58.FastBase58Decoding(state)
+	if err != nil {
+		return nil, fmt.Errorf("error base58-decoding state: %w", err)
+	}
+	var blobInfo wrapping.EncryptedBlobInfo
+	if err := proto.Unmarshal(marshaledBlob, &blobInfo); err != nil {
+		return nil, fmt.Errorf("error unmarshaling encrypted state: %w", err)
+	}
+	marshaled, err := cipher.Decrypt(ctx, &blobInfo, []byte(authMethodId))
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting state: %w", err)
+	}
+	var st store.State
+	if err := proto.Unmarshal(marshaled, &st); err != nil {
+		return nil, fmt.Errorf("error unmarshaling state: %w", err)
+	}
+	if st.GetAuthMethodId() != authMethodId {
+		return nil, fmt.Errorf("state was issued for a different auth method")
+	}
+	exp, err := ptypes.Timestamp(st.GetExpirationTime().GetTimestamp())
+	if err != nil {
+		return nil, fmt.Errorf("error reading state expiration: %w", err)
+	}
+	if time.Now().After(exp) {
+		return nil, fmt.Errorf("state has expired")
+	}
+	return &st, nil
+}
+
+// validateRedirectUrl returns an error if u is not an http URL on a loopback
+// address. Only native clients which listen on a local port for the
+// provider's redirect are supported.
+func validateRedirectUrl(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return fmt.Errorf("invalid redirect url %q: %w", u, db.ErrInvalidParameter)
+	}
+	if parsed.Scheme != "http" {
+		return fmt.Errorf("redirect url %q must use http: %w", u, db.ErrInvalidParameter)
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("redirect url %q must be a loopback address: %w", u, db.ErrInvalidParameter)
+	}
+	return nil
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,187 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/auth/oidc/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthUrlExchange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	p := NewTestProvider(t)
+
+	am, err := NewAuthMethod("o_1234567890", WithIssuer(p.URL), WithClientId(p.ClientId), WithClientSecret(p.ClientSecret))
+	require.NoError(t, err)
+	am.PublicId = AuthMethodPrefix + "_1234567890"
+
+	const redirectUrl = "http://127.0.0.1:9876/callback"
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p.SetUser("alice", "alice@example.com", "Alice Doe")
+		u, err := authUrl(ctx, wrapper, am, redirectUrl)
+		require.NoError(err)
+		parsed, err := url.Parse(u)
+		require.NoError(err)
+		assert.Equal(p.ClientId, parsed.Query().Get("client_id"))
+		assert.Equal(redirectUrl, parsed.Query().Get("redirect_uri"))
+
+		code, state := p.Login(t, u)
+		require.NotEmpty(code)
+		st, err := decryptState(ctx, wrapper, am.PublicId, state)
+		require.NoError(err)
+		assert.Equal(redirectUrl, st.GetRedirectUrl())
+
+		acct, err := exchange(ctx, am, st, code)
+		require.NoError(err)
+		assert.Equal(am.PublicId, acct.AuthMethodId)
+		assert.Equal("alice", acct.Subject)
+		assert.Equal("alice@example.com", acct.Email)
+		assert.Equal("Alice Doe", acct.FullName)
+
+		// codes can only be redeemed once
+		_, err = exchange(ctx, am, st, code)
+		assert.True(errors.Is(err, errAuthenticationFailed))
+	})
+	t.Run("wrong-nonce", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := authUrl(ctx, wrapper, am, redirectUrl)
+		require.NoError(err)
+		code, state := p.Login(t, u)
+		st, err := decryptState(ctx, wrapper, am.PublicId, state)
+		require.NoError(err)
+		st.Nonce = "not-the-nonce"
+		_, err = exchange(ctx, am, st, code)
+		assert.True(errors.Is(err, errAuthenticationFailed))
+	})
+	t.Run("wrong-client-secret", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := authUrl(ctx, wrapper, am, redirectUrl)
+		require.NoError(err)
+		code, state := p.Login(t, u)
+		st, err := decryptState(ctx, wrapper, am.PublicId, state)
+		require.NoError(err)
+		bad := am.clone()
+		bad.ClientSecret = "wrong"
+		_, err = exchange(ctx, bad, st, code)
+		require.Error(err)
+		assert.False(errors.Is(err, errAuthenticationFailed))
+	})
+	t.Run("unreachable-provider", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		u, err := authUrl(ctx, wrapper, am, redirectUrl)
+		require.NoError(err)
+		code, state := p.Login(t, u)
+		st, err := decryptState(ctx, wrapper, am.PublicId, state)
+		require.NoError(err)
+		bad := am.clone()
+		bad.Issuer = "http://127.0.0.1:1"
+		_, err = exchange(ctx, bad, st, code)
+		require.Error(err)
+		assert.False(errors.Is(err, errAuthenticationFailed))
+	})
+	t.Run("non-loopback-redirect", func(t *testing.T) {
+		_, err := authUrl(ctx, wrapper, am, "http://example.com/callback")
+		assert.Error(t, err)
+	})
+}
+
+func TestDecryptState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+
+	newState := func(t *testing.T, authMethodId string, exp time.Time) string {
+		t.Helper()
+		ts, err := ptypes.TimestampProto(exp)
+		require.NoError(t, err)
+		s, err := encryptState(ctx, wrapper, &store.State{
+			AuthMethodId:   authMethodId,
+			RedirectUrl:    "http://localhost:1234/callback",
+			Nonce:          "nonce",
+			ExpirationTime: &timestamp.Timestamp{Timestamp: ts},
+		})
+		require.NoError(t, err)
+		return s
+	}
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		state        string
+		wantErr      bool
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amoidc_1",
+			state:        newState(t, "amoidc_1", time.Now().Add(time.Minute)),
+		},
+		{
+			name:         "expired",
+			authMethodId: "amoidc_1",
+			state:        newState(t, "amoidc_1", time.Now().Add(-time.Minute)),
+			wantErr:      true,
+		},
+		{
+			name:         "other-auth-method",
+			authMethodId: "amoidc_2",
+			state:        newState(t, "amoidc_1", time.Now().Add(time.Minute)),
+			wantErr:      true,
+		},
+		{
+			name:         "garbage",
+			authMethodId: "amoidc_1",
+			state:        "not-a-state",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			st, err := decryptState(ctx, wrapper, tt.authMethodId, tt.state)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal("nonce", st.GetNonce())
+		})
+	}
+}
+
+func TestValidateRedirectUrl(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "http://127.0.0.1:8080/callback"},
+		{url: "http://[::1]:8080/callback"},
+		{url: "http://localhost:8080/callback"},
+		{url: "https://127.0.0.1:8080/callback", wantErr: true},
+		{url: "http://example.com/callback", wantErr: true},
+		{url: "http://10.0.0.1/callback", wantErr: true},
+		{url: "::not a url", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := validateRedirectUrl(tt.url)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package oidc
+
+const (
+	// authMethodsToRewrapQuery returns a page of oidc auth methods whose
+	// client secret is not encrypted with the current database key version
+	// of their scope, starting after the given auth method id.
+	authMethodsToRewrapQuery = `
+select public_id, client_secret, key_id, scope_id
+from auth_oidc_method
+where
+	public_id > $1
+	and key_id not in (
+		select key_version_id
+		from kms_current_key_version
+		where purpose = 'database'
+	)
+order by public_id
+limit $2;
+`
+
+	// rewrapAuthMethodQuery replaces the encrypted client secret of an oidc
+	// auth method if it has not changed in the meantime.
+	rewrapAuthMethodQuery = `
+update auth_oidc_method
+set
+	client_secret = $1,
+	key_id = $2
+where
+	public_id = $3
+	and key_id = $4;
+`
+)
@@ -0,0 +1,89 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// StartAuthentication returns the URL of the authorization endpoint of the
+// provider configured in authMethodId. The user logs in at that URL and the
+// provider redirects the user's browser to redirectUrl with a code and a
+// state which must be passed to Authenticate. redirectUrl must be an http
+// URL on a loopback address.
+func (r *Repository) StartAuthentication(ctx context.Context, authMethodId, redirectUrl string) (string, error) {
+	if authMethodId == "" {
+		return "", fmt.Errorf("oidc start authentication: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	if redirectUrl == "" {
+		return "", fmt.Errorf("oidc start authentication: no redirectUrl: %w", db.ErrInvalidParameter)
+	}
+	am, err := r.LookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+	if am == nil {
+		return "", fmt.Errorf("oidc start authentication: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return "", fmt.Errorf("oidc start authentication: unable to get database wrapper: %w", err)
+	}
+	u, err := authUrl(ctx, databaseWrapper, am, redirectUrl)
+	if err != nil {
+		return "", fmt.Errorf("oidc start authentication: %w", err)
+	}
+	return u, nil
+}
+
+// Authenticate redeems code, returned by the provider configured in
+// authMethodId, for an ID token and returns the account for the subject of
+// the ID token. The account is created the first time a subject
+// authenticates and its email and full name are refreshed from the ID token
+// claims on each authentication. state must be the value returned by the
+// provider along with code. Returns nil if authentication fails because the
+// state, code or ID token is invalid. Errors reaching or discovering the
+// provider, and codes rejected because of the auth method's client
+// credentials, are returned.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, code, state string) (*Account, error) {
+	if authMethodId == "" {
+		return nil, fmt.Errorf("oidc authenticate: no authMethodId: %w", db.ErrInvalidParameter)
+	}
+	if code == "" {
+		return nil, fmt.Errorf("oidc authenticate: no code: %w", db.ErrInvalidParameter)
+	}
+	if state == "" {
+		return nil, fmt.Errorf("oidc authenticate: no state: %w", db.ErrInvalidParameter)
+	}
+	am, err := r.lookupAuthMethodWithSecret(ctx, authMethodId)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	if am == nil {
+		return nil, fmt.Errorf("oidc authenticate: auth method %s: %w", authMethodId, db.ErrRecordNotFound)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.GetScopeId(), kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: unable to get database wrapper: %w", err)
+	}
+	st, err := decryptState(ctx, databaseWrapper, authMethodId, state)
+	if err != nil {
+		// the state is invalid or has expired
+		return nil, nil
+	}
+	acct, err := exchange(ctx, am, st, code)
+	switch {
+	case errors.Is(err, errAuthenticationFailed):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	acct, err = r.upsertAccount(ctx, am.GetScopeId(), acct)
+	if err != nil {
+		return nil, fmt.Errorf("oidc authenticate: %w", err)
+	}
+	return acct, nil
+}
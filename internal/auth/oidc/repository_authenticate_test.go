@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	p := NewTestProvider(t)
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, p)
+
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	const redirectUrl = "http://127.0.0.1:9876/callback"
+	login := func(t *testing.T) (string, string) {
+		t.Helper()
+		u, err := repo.StartAuthentication(context.Background(), am.PublicId, redirectUrl)
+		require.NoError(t, err)
+		return p.Login(t, u)
+	}
+
+	t.Run("new-and-existing-account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p.SetUser("bob", "bob@example.com", "Bob")
+		code, state := login(t)
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, code, state)
+		require.NoError(err)
+		require.NotNil(acct)
+		db.AssertPublicId(t, AccountPrefix, acct.PublicId)
+		assert.Equal("bob", acct.Subject)
+		assert.Equal("bob@example.com", acct.Email)
+
+		u, err := iamRepo.LookupUserWithLogin(context.Background(), acct.PublicId, iam.WithAutoVivify(true))
+		require.NoError(err)
+		require.NotNil(u)
+
+		p.SetUser("bob", "robert@example.com", "Robert")
+		code, state = login(t)
+		acct2, err := repo.Authenticate(context.Background(), am.PublicId, code, state)
+		require.NoError(err)
+		require.NotNil(acct2)
+		assert.Equal(acct.PublicId, acct2.PublicId)
+		assert.Equal("robert@example.com", acct2.Email)
+		assert.Equal("Robert", acct2.FullName)
+
+		u2, err := iamRepo.LookupUserWithLogin(context.Background(), acct2.PublicId)
+		require.NoError(err)
+		assert.Equal(u.PublicId, u2.PublicId)
+
+		accts, err := repo.ListAccounts(context.Background(), am.PublicId)
+		require.NoError(err)
+		assert.Len(accts, 1)
+	})
+	t.Run("invalid-state", func(t *testing.T) {
+		assert := assert.New(t)
+		code, _ := login(t)
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, code, "not-a-state")
+		assert.NoError(err)
+		assert.Nil(acct)
+	})
+	t.Run("invalid-code", func(t *testing.T) {
+		assert := assert.New(t)
+		_, state := login(t)
+		acct, err := repo.Authenticate(context.Background(), am.PublicId, "not-a-code", state)
+		assert.NoError(err)
+		assert.Nil(acct)
+	})
+	t.Run("missing-parameters", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.Authenticate(context.Background(), am.PublicId, "", "state")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.Authenticate(context.Background(), am.PublicId, "code", "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.StartAuthentication(context.Background(), am.PublicId, "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("unknown-auth-method", func(t *testing.T) {
+		_, err := repo.StartAuthentication(context.Background(), AuthMethodPrefix+"_1234567890", redirectUrl)
+		assert.True(t, errors.Is(err, db.ErrRecordNotFound))
+	})
+}
@@ -0,0 +1,282 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	validOpts := []Option{WithIssuer("https://example.com"), WithClientId("client"), WithClientSecret("secret")}
+	newAm := func(opt ...Option) *AuthMethod {
+		am, err := NewAuthMethod(org.PublicId, opt...)
+		require.NoError(t, err)
+		return am
+	}
+
+	var tests = []struct {
+		name      string
+		in        *AuthMethod
+		opts      []Option
+		wantIsErr error
+	}{
+		{
+			name:      "nil-AuthMethod",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "nil-embedded-AuthMethod",
+			in:        &AuthMethod{},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-no-issuer",
+			in:        newAm(WithClientId("client"), WithClientSecret("secret")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-issuer-not-a-url",
+			in:        newAm(WithIssuer("example.com"), WithClientId("client"), WithClientSecret("secret")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-no-client-id",
+			in:        newAm(WithIssuer("https://example.com"), WithClientSecret("secret")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-no-client-secret",
+			in:        newAm(WithIssuer("https://example.com"), WithClientId("client")),
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-public-id-prefix",
+			in:        newAm(validOpts...),
+			opts:      []Option{WithPublicId("ampw_1234567890")},
+			wantIsErr: db.ErrInvalidPublicId,
+		},
+		{
+			name: "valid",
+			in:   newAm(validOpts...),
+		},
+		{
+			name: "valid-with-name",
+			in:   newAm(append(validOpts, WithName("test-name-repo"), WithDescription("test-description-repo"))...),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			require.NotNil(repo)
+			got, err := repo.CreateAuthMethod(context.Background(), tt.in, tt.opts...)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			db.AssertPublicId(t, AuthMethodPrefix, got.PublicId)
+			assert.Equal(tt.in.Name, got.Name)
+			assert.Equal(tt.in.Description, got.Description)
+			assert.Equal(tt.in.Issuer, got.Issuer)
+			assert.Equal(tt.in.ClientId, got.ClientId)
+			assert.Empty(got.ClientSecret)
+			assert.NotEmpty(got.CtClientSecret)
+			assert.NotEmpty(got.KeyId)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE)))
+
+			found, err := repo.lookupAuthMethodWithSecret(context.Background(), got.PublicId)
+			require.NoError(err)
+			assert.Equal(tt.in.ClientSecret, found.ClientSecret)
+		})
+	}
+
+	t.Run("duplicate-name", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		repo, err := NewRepository(rw, rw, kms)
+		require.NoError(err)
+		in := newAm(append(validOpts, WithName("test-name-dup"))...)
+		got, err := repo.CreateAuthMethod(context.Background(), in)
+		require.NoError(err)
+		require.NotNil(got)
+		got2, err := repo.CreateAuthMethod(context.Background(), in)
+		assert.Truef(errors.Is(err, db.ErrNotUnique), "want err: %v got: %v", db.ErrNotUnique, err)
+		assert.Nil(got2)
+	})
+}
+
+func TestRepository_LookupListDeleteAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	p := NewTestProvider(t)
+
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+
+	am1 := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, p)
+	am2 := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, p)
+
+	got, err := repo.LookupAuthMethod(context.Background(), am1.PublicId)
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(am1.Issuer, got.Issuer)
+	assert.Empty(got.ClientSecret)
+
+	got, err = repo.LookupAuthMethod(context.Background(), AuthMethodPrefix+"_1234567890")
+	assert.NoError(err)
+	assert.Nil(got)
+
+	list, err := repo.ListAuthMethods(context.Background(), []string{org.PublicId})
+	require.NoError(err)
+	assert.Len(list, 2)
+
+	list, err = repo.ListAuthMethods(context.Background(), []string{org.PublicId}, WithLimit(1))
+	require.NoError(err)
+	assert.Len(list, 1)
+
+	rows, err := repo.DeleteAuthMethod(context.Background(), org.PublicId, am2.PublicId)
+	require.NoError(err)
+	assert.Equal(1, rows)
+
+	list, err = repo.ListAuthMethods(context.Background(), []string{org.PublicId})
+	require.NoError(err)
+	assert.Len(list, 1)
+}
+
+func TestRepository_UpdateAuthMethod(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	p := NewTestProvider(t)
+
+	var tests = []struct {
+		name             string
+		opts             []Option
+		maxTokenDuration uint32
+		masks            []string
+		wantIsErr        error
+	}{
+		{
+			name:  "change-name",
+			opts:  []Option{WithName("updated-name")},
+			masks: []string{"Name"},
+		},
+		{
+			name:  "change-issuer-and-client-id",
+			opts:  []Option{WithIssuer("https://updated.example.com"), WithClientId("updated-client")},
+			masks: []string{"Issuer", "ClientId"},
+		},
+		{
+			name:  "change-client-secret",
+			opts:  []Option{WithClientSecret("updated-secret")},
+			masks: []string{"ClientSecret"},
+		},
+		{
+			name:      "null-issuer",
+			masks:     []string{"Issuer"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "null-client-secret",
+			masks:     []string{"ClientSecret"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:      "invalid-issuer",
+			opts:      []Option{WithIssuer("ftp://example.com")},
+			masks:     []string{"Issuer"},
+			wantIsErr: db.ErrInvalidParameter,
+		},
+		{
+			name:             "change-max-token-duration",
+			maxTokenDuration: 3600,
+			masks:            []string{"MaxTokenDurationSeconds"},
+		},
+		{
+			name:  "zero-max-token-duration",
+			masks: []string{"MaxTokenDurationSeconds", "MaxTokenIdleSeconds"},
+		},
+		{
+			name:      "unknown-field",
+			masks:     []string{"KeyId"},
+			wantIsErr: db.ErrInvalidFieldMask,
+		},
+		{
+			name:      "empty-mask",
+			wantIsErr: db.ErrEmptyFieldMask,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kmsCache)
+			require.NoError(err)
+			orig := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, p)
+
+			in, err := NewAuthMethod(org.PublicId, tt.opts...)
+			require.NoError(err)
+			in.PublicId = orig.PublicId
+			in.MaxTokenDurationSeconds = tt.maxTokenDuration
+			got, rows, err := repo.UpdateAuthMethod(context.Background(), in, orig.Version, tt.masks)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(1, rows)
+			assert.Empty(got.ClientSecret)
+			assert.NoError(db.TestVerifyOplog(t, rw, orig.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE)))
+
+			found, err := repo.lookupAuthMethodWithSecret(context.Background(), orig.PublicId)
+			require.NoError(err)
+			for _, m := range tt.masks {
+				switch m {
+				case "Name":
+					assert.Equal(in.Name, found.Name)
+				case "MaxTokenDurationSeconds":
+					assert.Equal(in.MaxTokenDurationSeconds, found.MaxTokenDurationSeconds)
+				case "Issuer":
+					assert.Equal(in.Issuer, found.Issuer)
+				case "ClientId":
+					assert.Equal(in.ClientId, found.ClientId)
+				case "ClientSecret":
+					assert.Equal(in.ClientSecret, found.ClientSecret)
+				}
+			}
+		})
+	}
+}
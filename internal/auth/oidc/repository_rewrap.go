@@ -0,0 +1,56 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// RewrapAuthMethods re-encrypts the client secret of every oidc auth method
+// that is not encrypted with the current database key version of its scope
+// under the current version. Returns the number of auth methods that were
+// rewrapped. Supports the WithLimit option, which sets the number of auth
+// methods read from the database at a time.
+func (r *Repository) RewrapAuthMethods(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit > 0 {
+		limit = opts.withLimit
+	}
+
+	var lastId string
+	return r.kms.RewrapDatabaseValues(ctx, "oidc auth methods", func() ([]kms.Rewrappable, error) {
+		rows, err := r.reader.Query(ctx, authMethodsToRewrapQuery, []interface{}{lastId, limit})
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var values []kms.Rewrappable
+		for rows.Next() {
+			am := allocAuthMethod()
+			if err := rows.Scan(&am.PublicId, &am.CtClientSecret, &am.KeyId, &am.ScopeId); err != nil {
+				return nil, err
+			}
+			lastId = am.PublicId
+			oldKeyId := am.KeyId
+			values = append(values, kms.Rewrappable{
+				Id:      am.PublicId,
+				ScopeId: am.ScopeId,
+				KeyId:   oldKeyId,
+				Rewrap: func(ctx context.Context, databaseWrapper wrapping.Wrapper) (int, error) {
+					if err := am.decrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					if err := am.encrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					// rewrapping does not change the auth method, so no oplog
+					// entry is written.
+					return r.writer.Exec(ctx, rewrapAuthMethodQuery, []interface{}{am.CtClientSecret, am.KeyId, am.PublicId, oldKeyId})
+				},
+			})
+		}
+		return values, rows.Err()
+	})
+}
@@ -0,0 +1,253 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TestAuthMethod creates an oidc auth method in the provided DB with the
+// provided scope id which uses the provider p. If any errors are encountered
+// during the creation of the auth method, the test will fail.
+func TestAuthMethod(t *testing.T, conn *gorm.DB, databaseWrapper wrapping.Wrapper, scopeId string, p *TestProvider, opt ...Option) *AuthMethod {
+	t.Helper()
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+
+	opt = append([]Option{WithIssuer(p.URL), WithClientId(p.ClientId), WithClientSecret(p.ClientSecret)}, opt...)
+	am, err := NewAuthMethod(scopeId, opt...)
+	assert.NoError(err)
+	require.NotNil(am)
+	id, err := newAuthMethodId()
+	assert.NoError(err)
+	require.NotEmpty(id)
+	am.PublicId = id
+	require.NoError(am.encrypt(context.Background(), databaseWrapper))
+
+	require.NoError(w.Create(context.Background(), am))
+	am.ClientSecret = ""
+	return am
+}
+
+// TestProvider is a fake OpenID Connect provider for use in tests. It
+// serves discovery, JWKS, authorization and token endpoints. The
+// authorization endpoint does not prompt for credentials; it immediately
+// redirects back with a code for Subject.
+type TestProvider struct {
+	URL          string
+	ClientId     string
+	ClientSecret string
+
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	keyId  string
+
+	mu      sync.Mutex
+	subject string
+	email   string
+	name    string
+	codes   map[string]testAuthRequest
+}
+
+type testAuthRequest struct {
+	nonce       string
+	redirectUrl string
+	subject     string
+	email       string
+	name        string
+}
+
+// NewTestProvider starts a TestProvider which issues ID tokens for the
+// subject "alice". The provider is closed when the test completes.
+func NewTestProvider(t *testing.T) *TestProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &TestProvider{
+		ClientId:     "test-client-id",
+		ClientSecret: "test-client-secret",
+		key:          key,
+		keyId:        "test-key",
+		subject:      "alice",
+		email:        "alice@example.com",
+		name:         "Alice Doe",
+		codes:        make(map[string]testAuthRequest),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/keys", p.handleKeys)
+	mux.HandleFunc("/authorize", p.handleAuthorize)
+	mux.HandleFunc("/token", p.handleToken)
+	p.server = httptest.NewServer(mux)
+	p.URL = p.server.URL
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+// SetUser sets the subject and the claims of the ID tokens issued for
+// subsequent authorization requests.
+func (p *TestProvider) SetUser(subject, email, name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subject, p.email, p.name = subject, email, name
+}
+
+// Login follows authUrl as a user's browser would and returns the code and
+// state the provider redirected back with.
+func (p *TestProvider) Login(t *testing.T, authUrl string) (code, state string) {
+	t.Helper()
+	require := require.New(t)
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authUrl)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusFound, resp.StatusCode)
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(err)
+	return loc.Query().Get("code"), loc.Query().Get("state")
+}
+
+func (p *TestProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, map[string]interface{}{
+		"issuer":                                p.URL,
+		"authorization_endpoint":                p.URL + "/authorize",
+		"token_endpoint":                        p.URL + "/token",
+		"jwks_uri":                              p.URL + "/keys",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *TestProvider) handleKeys(w http.ResponseWriter, _ *http.Request) {
+	writeJson(w, jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{{Key: &p.key.PublicKey, KeyID: p.keyId, Algorithm: string(jose.RS256), Use: "sig"}},
+	})
+}
+
+func (p *TestProvider) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != p.ClientId || q.Get("response_type") != "code" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || redirect.Scheme == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	code, err := newNonce()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.codes[code] = testAuthRequest{
+		nonce:       q.Get("nonce"),
+		redirectUrl: redirect.String(),
+		subject:     p.subject,
+		email:       p.email,
+		name:        p.name,
+	}
+	p.mu.Unlock()
+
+	rq := redirect.Query()
+	rq.Set("code", code)
+	rq.Set("state", q.Get("state"))
+	redirect.RawQuery = rq.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (p *TestProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if id != p.ClientId || secret != p.ClientSecret {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		writeJson(w, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	req, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+	if !ok || req.redirectUrl != r.PostForm.Get("redirect_uri") {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		writeJson(w, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	idToken, err := p.signIdToken(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJson(w, map[string]interface{}{
+		"access_token": "test-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *TestProvider) signIdToken(req testAuthRequest) (string, error) {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: p.key, KeyID: p.keyId}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("unable to create signer: %w", err)
+	}
+	now := time.Now()
+	std := jwt.Claims{
+		Issuer:   p.URL,
+		Subject:  req.subject,
+		Audience: jwt.Audience{p.ClientId},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}
+	extra := map[string]interface{}{
+		"nonce": req.nonce,
+		"email": req.email,
+		"name":  req.name,
+	}
+	return jwt.Signed(signer).Claims(std).Claims(extra).CompactSerialize()
+}
+
+func writeJson(w http.ResponseWriter, v interface{}) {
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -18,4 +18,44 @@ var (
 	// ErrPasswordsEqual is returned from ChangePassword when the old and
 	// new passwords are equal.
 	ErrPasswordsEqual = errors.New("old and new password are equal")
+
+	// ErrAccountLocked is returned from Authenticate and ChangePassword
+	// when the account is locked after too many failed authentication
+	// attempts.
+	ErrAccountLocked = errors.New("account locked")
+
+	// ErrTooFewCharacterClasses results from attempting to set a password
+	// which contains fewer character classes than the password policy of
+	// the auth method requires.
+	ErrTooFewCharacterClasses = errors.New("too few character classes")
+
+	// ErrContainsLoginName results from attempting to set a password which
+	// contains the login name of the account when the password policy of
+	// the auth method disallows it.
+	ErrContainsLoginName = errors.New("contains login name")
+
+	// ErrPasswordReused results from attempting to set a password which
+	// matches one of the recent passwords of the account the password
+	// policy of the auth method prevents from being reused.
+	ErrPasswordReused = errors.New("password reused")
+
+	// ErrPasswordExpired is returned from Authenticate when the password
+	// is correct but older than the maximum password age of the auth
+	// method. The password must be changed with ChangePassword.
+	ErrPasswordExpired = errors.New("password expired")
+
+	// ErrSecondFactorRequired is returned from Authenticate when the
+	// password is correct but the account must also provide a TOTP code or
+	// a recovery code.
+	ErrSecondFactorRequired = errors.New("second factor required")
+
+	// ErrTotpNotEnrolled is returned from Authenticate when the auth method
+	// requires a second factor but the account has no verified TOTP
+	// enrollment, and from VerifyTotp when the account has no TOTP
+	// enrollment to verify.
+	ErrTotpNotEnrolled = errors.New("totp not enrolled")
+
+	// ErrInvalidTotpCode is returned from VerifyTotp when the code does not
+	// match the TOTP enrollment being verified.
+	ErrInvalidTotpCode = errors.New("invalid totp code")
 )
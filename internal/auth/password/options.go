@@ -1,5 +1,10 @@
 package password
 
+import (
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
 // getOpts - iterate the inbound Options and return a struct.
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,14 +19,18 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName        string
-	withDescription string
-	withLoginName   string
-	withLimit       int
-	withConfig      Configuration
-	withPublicId    string
-	password        string
-	withPassword    bool
+	withName           string
+	withDescription    string
+	withLoginName      string
+	withLimit          int
+	withConfig         Configuration
+	withPublicId       string
+	password           string
+	withPassword       bool
+	withStartPageAfter *db.PageCursor
+	withEventer        *audit.Eventer
+	withTotpCode       string
+	withRecoveryCode   string
 }
 
 func getDefaultOptions() options {
@@ -81,3 +90,34 @@ func WithConfiguration(config Configuration) Option {
 		o.withConfig = config
 	}
 }
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
+
+// WithEventer provides an optional eventer used to audit account lockouts.
+func WithEventer(e *audit.Eventer) Option {
+	return func(o *options) {
+		o.withEventer = e
+	}
+}
+
+// WithTotpCode provides an optional TOTP code used by Authenticate as the
+// second factor of an account.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
+
+// WithRecoveryCode provides an optional recovery code used by Authenticate
+// in place of a TOTP code.
+func WithRecoveryCode(code string) Option {
+	return func(o *options) {
+		o.withRecoveryCode = code
+	}
+}
@@ -3,6 +3,7 @@ package password
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,4 +58,23 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withConfig = c
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithEventer", func(t *testing.T) {
+		eventer := &audit.Eventer{}
+		opts := getOpts(WithEventer(eventer))
+		testOpts := getDefaultOptions()
+		testOpts.withEventer = eventer
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTotpCode", func(t *testing.T) {
+		opts := getOpts(WithTotpCode("123456"))
+		testOpts := getDefaultOptions()
+		testOpts.withTotpCode = "123456"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRecoveryCode", func(t *testing.T) {
+		opts := getOpts(WithRecoveryCode("abcd-efgh"))
+		testOpts := getDefaultOptions()
+		testOpts.withRecoveryCode = "abcd-efgh"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -0,0 +1,115 @@
+package password
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"golang.org/x/crypto/argon2"
+)
+
+// characterClasses returns the number of character classes, lowercase
+// letters, uppercase letters, digits and other characters, in password.
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower = 1
+		case unicode.IsUpper(c):
+			upper = 1
+		case unicode.IsDigit(c):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// checkPassword returns an error if password does not satisfy the password
+// policy in c for the account with loginName. The password history is not
+// checked, see checkHistory.
+func (c *currentConfig) checkPassword(loginName, password string) error {
+	switch {
+	case c.MinPasswordLength > len(password):
+		return ErrTooShort
+	case c.MinCharacterClasses > characterClasses(password):
+		return ErrTooFewCharacterClasses
+	case c.DisallowLoginName && loginName != "" && strings.Contains(strings.ToLower(password), strings.ToLower(loginName)):
+		return ErrContainsLoginName
+	default:
+		return nil
+	}
+}
+
+// checkHistory returns ErrPasswordReused if password matches the current
+// password of accountId or one of its historyCount - 1 most recent previous
+// passwords. Nothing is checked if historyCount is zero.
+func (r *Repository) checkHistory(ctx context.Context, scopeId, accountId, password string, historyCount int) error {
+	if historyCount == 0 {
+		return nil
+	}
+	rows, err := r.reader.Query(ctx, credentialHistoryQuery, []interface{}{accountId, historyCount - 1})
+	if err != nil {
+		return err
+	}
+	type credential struct {
+		cred *Argon2Credential
+		conf *store.Argon2Configuration
+	}
+	var creds []credential
+	for rows.Next() {
+		c := credential{
+			cred: &Argon2Credential{Argon2Credential: &store.Argon2Credential{}},
+			conf: &store.Argon2Configuration{},
+		}
+		if err := rows.Scan(&c.cred.PrivateId, &c.cred.CtSalt, &c.cred.DerivedKey, &c.cred.KeyId,
+			&c.conf.Iterations, &c.conf.Memory, &c.conf.Threads, &c.conf.KeyLength); err != nil {
+			rows.Close()
+			return err
+		}
+		creds = append(creds, c)
+	}
+	rows.Close()
+
+	for _, c := range creds {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.cred.KeyId))
+		if err != nil {
+			return fmt.Errorf("unable to get database wrapper: %w", err)
+		}
+		if err := c.cred.decrypt(ctx, databaseWrapper); err != nil {
+			return fmt.Errorf("cannot decrypt credential: %s: %w", c.cred.PrivateId, err)
+		}
+		key := argon2.IDKey([]byte(password), c.cred.Salt, c.conf.Iterations, c.conf.Memory, uint8(c.conf.Threads), c.conf.KeyLength)
+		if subtle.ConstantTimeCompare(key, c.cred.DerivedKey) == 1 {
+			return ErrPasswordReused
+		}
+	}
+	return nil
+}
+
+// retireCredential keeps the credential credId of accountId in the
+// credential history if historyCount requires it and prunes the history of
+// accountId to the historyCount - 1 most recent credentials. It must be
+// called before the credential is deleted.
+func retireCredential(ctx context.Context, w db.Writer, accountId, credId string, historyCount int) error {
+	if historyCount > 1 && credId != "" {
+		if _, err := w.Exec(ctx, insertCredentialHistoryQuery, []interface{}{credId}); err != nil {
+			return fmt.Errorf("retire credential: %w", err)
+		}
+	}
+	keep := historyCount - 1
+	if keep < 0 {
+		keep = 0
+	}
+	if _, err := w.Exec(ctx, pruneCredentialHistoryQuery, []interface{}{accountId, keep}); err != nil {
+		return fmt.Errorf("retire credential: %w", err)
+	}
+	return nil
+}
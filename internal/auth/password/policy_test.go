@@ -0,0 +1,214 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacterClasses(t *testing.T) {
+	var tests = []struct {
+		password string
+		want     int
+	}{
+		{"", 0},
+		{"abcdefgh", 1},
+		{"ABCDEFGH", 1},
+		{"12345678", 1},
+		{"!@#$%^&*", 1},
+		{"abcdEFGH", 2},
+		{"abcd1234", 2},
+		{"abCD12", 3},
+		{"abCD12!!", 4},
+		{"ab CD 12", 4},
+		{"äbÇD", 2},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.password, func(t *testing.T) {
+			assert.Equal(t, tt.want, characterClasses(tt.password))
+		})
+	}
+}
+
+func TestCurrentConfig_checkPassword(t *testing.T) {
+	var tests = []struct {
+		name      string
+		conf      currentConfig
+		loginName string
+		password  string
+		want      error
+	}{
+		{
+			name:     "no-policy",
+			password: "a",
+		},
+		{
+			name:     "too-short",
+			conf:     currentConfig{MinPasswordLength: 8},
+			password: "abcdefg",
+			want:     ErrTooShort,
+		},
+		{
+			name:     "too-few-character-classes",
+			conf:     currentConfig{MinCharacterClasses: 3},
+			password: "abcdEFGH",
+			want:     ErrTooFewCharacterClasses,
+		},
+		{
+			name:     "enough-character-classes",
+			conf:     currentConfig{MinCharacterClasses: 3},
+			password: "abcdEFG1",
+		},
+		{
+			name:      "contains-login-name",
+			conf:      currentConfig{DisallowLoginName: true},
+			loginName: "kazmierczak",
+			password:  "my-KAZMIERCZAK-password",
+			want:      ErrContainsLoginName,
+		},
+		{
+			name:      "contains-login-name-allowed",
+			loginName: "kazmierczak",
+			password:  "my-kazmierczak-password",
+		},
+		{
+			name:      "does-not-contain-login-name",
+			conf:      currentConfig{DisallowLoginName: true},
+			loginName: "kazmierczak",
+			password:  "my-password",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.conf.checkPassword(tt.loginName, tt.password)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Truef(t, errors.Is(err, tt.want), "want err: %q got: %q", tt.want, err)
+		})
+	}
+}
+
+func TestRepository_PasswordPolicy(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	authMethod.MinCharacterClasses = 3
+	authMethod.PasswordHistoryCount = 3
+	authMethod.DisallowLoginName = true
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version,
+		[]string{"MinCharacterClasses", "PasswordHistoryCount", "DisallowLoginName"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), authMethod.MinCharacterClasses)
+	require.Equal(t, uint32(3), authMethod.PasswordHistoryCount)
+	require.True(t, authMethod.DisallowLoginName)
+
+	newAccount := func() *Account {
+		return &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.PublicId,
+				LoginName:    "kazmierczak",
+			},
+		}
+	}
+
+	t.Run("create-account", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.CreateAccount(ctx, o.GetPublicId(), newAccount(), WithPassword("abcdefghijk"))
+		assert.Truef(errors.Is(err, ErrTooFewCharacterClasses), "want err: %q got: %q", ErrTooFewCharacterClasses, err)
+		_, err = repo.CreateAccount(ctx, o.GetPublicId(), newAccount(), WithPassword("Kazmierczak-1"))
+		assert.Truef(errors.Is(err, ErrContainsLoginName), "want err: %q got: %q", ErrContainsLoginName, err)
+	})
+
+	passwords := []string{"Password-1", "Password-2", "Password-3", "Password-4"}
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), newAccount(), WithPassword(passwords[0]))
+	require.NoError(t, err)
+
+	t.Run("change-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[0], "abcdefghijk", acct.Version)
+		assert.Truef(errors.Is(err, ErrTooFewCharacterClasses), "want err: %q got: %q", ErrTooFewCharacterClasses, err)
+
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[0], passwords[1], acct.Version)
+		require.NoError(err)
+		require.NotNil(acct)
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[1], passwords[2], acct.Version)
+		require.NoError(err)
+		require.NotNil(acct)
+
+		// The last three passwords, including the current one, cannot be
+		// reused.
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[2], passwords[0], acct.Version)
+		assert.Truef(errors.Is(err, ErrPasswordReused), "want err: %q got: %q", ErrPasswordReused, err)
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[2], passwords[1], acct.Version)
+		assert.Truef(errors.Is(err, ErrPasswordReused), "want err: %q got: %q", ErrPasswordReused, err)
+
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[2], passwords[3], acct.Version)
+		require.NoError(err)
+		require.NotNil(acct)
+
+		// The first password dropped out of the history.
+		acct, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[3], passwords[0], acct.Version)
+		require.NoError(err)
+		require.NotNil(acct)
+	})
+
+	t.Run("set-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, passwords[3], acct.Version)
+		assert.Truef(errors.Is(err, ErrPasswordReused), "want err: %q got: %q", ErrPasswordReused, err)
+		_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, "my-kazmierczak-1", acct.Version)
+		assert.Truef(errors.Is(err, ErrContainsLoginName), "want err: %q got: %q", ErrContainsLoginName, err)
+
+		acct, err = repo.SetPassword(ctx, o.GetPublicId(), acct.PublicId, passwords[1], acct.Version)
+		require.NoError(err)
+		require.NotNil(acct)
+	})
+
+	t.Run("password-expired", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authMethod.MaxPasswordAgeSeconds = 1
+		authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxPasswordAgeSeconds"})
+		require.NoError(err)
+		time.Sleep(1100 * time.Millisecond)
+
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwords[1])
+		assert.Truef(errors.Is(err, ErrPasswordExpired), "want err: %q got: %q", ErrPasswordExpired, err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.PublicId)
+
+		// A wrong password is not reported as expired
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwords[2])
+		assert.NoError(err)
+		assert.Nil(got)
+
+		// An expired password can be changed
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwords[1], "Password-5", acct.Version)
+		require.NoError(err)
+		authMethod.MaxPasswordAgeSeconds = 3600
+		authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MaxPasswordAgeSeconds"})
+		require.NoError(err)
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "Password-5")
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+}
@@ -20,10 +20,27 @@ select acct.name,                        -- Account.Name
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       meth.lockout_threshold,           -- authAccount.LockoutThreshold
+       meth.lockout_duration_seconds,    -- authAccount.LockoutDurationSeconds
+       coalesce(lockout.failed_attempts, 0)
+         as failed_attempts,             -- authAccount.FailedAttempts
+       coalesce(lockout.locked_until > current_timestamp, false)
+         as is_locked,                   -- authAccount.IsLocked
+       meth.max_password_age_seconds > 0
+         and cred.create_time + meth.max_password_age_seconds * interval '1 second' <= current_timestamp
+         as is_password_expired,         -- authAccount.IsPasswordExpired
+       meth.mfa_required,                -- authAccount.MfaRequired
+       totp.account_id is not null
+         as totp_enrolled                -- authAccount.TotpEnrolled
   from auth_password_argon2_cred cred,
        auth_password_argon2_conf conf,
-       auth_password_account acct,
+       auth_password_account acct
+  left join auth_password_account_lockout lockout
+         on lockout.account_id = acct.public_id
+  left join auth_password_account_totp totp
+         on totp.account_id = acct.public_id
+        and totp.verified,
        auth_password_method meth
  where acct.auth_method_id = $1
    and acct.login_name = $2
@@ -31,6 +48,83 @@ select acct.name,                        -- Account.Name
    and cred.password_account_id = acct.public_id
    and acct.auth_method_id = meth.public_id ;
 `
+	recordFailedAttemptQuery = `
+insert into auth_password_account_lockout
+  (account_id, failed_attempts)
+values
+  ($1, 1)
+on conflict (account_id) do update
+   set failed_attempts = auth_password_account_lockout.failed_attempts + 1;
+`
+
+	lockAccountQuery = `
+update auth_password_account_lockout
+   set failed_attempts = 0,
+       locked_until = case
+         when $2 = 0 then 'infinity'
+         else current_timestamp + $2 * interval '1 second'
+       end
+ where account_id = $1
+   and failed_attempts >= $3;
+`
+
+	clearLockoutQuery = `
+delete
+  from auth_password_account_lockout
+ where account_id = $1;
+`
+
+	credentialHistoryQuery = `
+select cred.private_id,
+       cred.salt,
+       cred.derived_key,
+       cred.key_id,
+       conf.iterations,
+       conf.memory,
+       conf.threads,
+       conf.key_length
+  from auth_password_argon2_cred cred
+  join auth_password_argon2_conf conf
+    on cred.password_conf_id = conf.private_id
+ where cred.password_account_id = $1
+ union all
+(select hist.private_id,
+        hist.salt,
+        hist.derived_key,
+        hist.key_id,
+        conf.iterations,
+        conf.memory,
+        conf.threads,
+        conf.key_length
+   from auth_password_argon2_cred_history hist
+   join auth_password_argon2_conf conf
+     on hist.password_conf_id = conf.private_id
+  where hist.password_account_id = $1
+  order by hist.create_time desc
+  limit $2);
+`
+
+	insertCredentialHistoryQuery = `
+insert into auth_password_argon2_cred_history
+  (private_id, password_account_id, password_conf_id, password_method_id, salt, derived_key, key_id)
+select private_id, password_account_id, password_conf_id, password_method_id, salt, derived_key, key_id
+  from auth_password_argon2_cred
+ where private_id = $1;
+`
+
+	pruneCredentialHistoryQuery = `
+delete
+  from auth_password_argon2_cred_history
+ where password_account_id = $1
+   and private_id not in (
+         select private_id
+           from auth_password_argon2_cred_history
+          where password_account_id = $1
+          order by create_time desc
+          limit $2
+       );
+`
+
 	currentConfigForAccountQuery = `
 select *
   from auth_password_current_conf
@@ -41,4 +135,132 @@ select *
         where public_id = $1
     );
 `
+
+	credentialsToRewrapQuery = `
+select cred.private_id,
+       cred.salt,
+       cred.key_id,
+       meth.scope_id
+  from auth_password_argon2_cred cred
+  join auth_password_method meth
+    on cred.password_method_id = meth.public_id
+ where cred.private_id > $1
+   and cred.key_id not in (
+         select key_version_id
+           from kms_current_key_version
+          where purpose = 'database'
+       )
+ order by cred.private_id
+ limit $2;
+`
+
+	rewrapCredentialQuery = `
+update auth_password_argon2_cred
+   set salt = $1,
+       key_id = $2
+ where private_id = $3
+   and key_id = $4;
+`
+
+	credentialHistoryToRewrapQuery = `
+select hist.private_id,
+       hist.salt,
+       hist.key_id,
+       meth.scope_id
+  from auth_password_argon2_cred_history hist
+  join auth_password_method meth
+    on hist.password_method_id = meth.public_id
+ where hist.private_id > $1
+   and hist.key_id not in (
+         select key_version_id
+           from kms_current_key_version
+          where purpose = 'database'
+       )
+ order by hist.private_id
+ limit $2;
+`
+
+	rewrapCredentialHistoryQuery = `
+update auth_password_argon2_cred_history
+   set salt = $1,
+       key_id = $2
+ where private_id = $3
+   and key_id = $4;
+`
+
+	deletePendingTotpQuery = `
+delete from auth_password_account_totp
+ where account_id = $1
+   and not verified;
+`
+
+	deleteVerifiedTotpQuery = `
+delete from auth_password_account_totp
+ where account_id = $1
+   and verified;
+`
+
+	verifyTotpQuery = `
+update auth_password_account_totp
+   set verified = true,
+       last_used_step = $2
+ where account_id = $1
+   and not verified;
+`
+
+	useTotpStepQuery = `
+update auth_password_account_totp
+   set last_used_step = $2
+ where account_id = $1
+   and verified
+   and last_used_step < $2;
+`
+
+	deleteRecoveryCodesQuery = `
+delete from auth_password_account_recovery_code
+ where account_id = $1;
+`
+
+	insertRecoveryCodeQuery = `
+insert into auth_password_account_recovery_code
+  (account_id, code_hash)
+values
+  ($1, $2);
+`
+
+	useRecoveryCodeQuery = `
+delete from auth_password_account_recovery_code
+ where account_id = $1
+   and code_hash = $2;
+`
+
+	totpsToRewrapQuery = `
+select totp.account_id,
+       totp.verified,
+       totp.secret,
+       totp.key_id,
+       meth.scope_id
+  from auth_password_account_totp totp
+  join auth_password_account acct
+    on totp.account_id = acct.public_id
+  join auth_password_method meth
+    on acct.auth_method_id = meth.public_id
+ where (totp.account_id, totp.verified) > ($1, $2)
+   and totp.key_id not in (
+         select key_version_id
+           from kms_current_key_version
+          where purpose = 'database'
+       )
+ order by totp.account_id, totp.verified
+ limit $3;
+`
+
+	rewrapTotpQuery = `
+update auth_password_account_totp
+   set secret = $1,
+       key_id = $2
+ where account_id = $3
+   and verified = $4
+   and key_id = $5;
+`
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/kms"
 )
@@ -14,6 +15,10 @@ type Repository struct {
 	reader db.Reader
 	writer db.Writer
 	kms    *kms.Kms
+
+	// eventer emits audit events for account lockouts; it may be nil
+	eventer *audit.Eventer
+
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
 }
@@ -21,7 +26,8 @@ type Repository struct {
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it.  WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithEventer sets the eventer used to
+// audit account lockouts.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	switch {
 	case r == nil:
@@ -42,6 +48,7 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		reader:       r,
 		writer:       w,
 		kms:          kms,
+		eventer:      opts.withEventer,
 		defaultLimit: opts.withLimit,
 	}, nil
 }
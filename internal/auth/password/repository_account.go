@@ -65,8 +65,8 @@ func (r *Repository) CreateAccount(ctx context.Context, scopeId string, a *Accou
 
 	var cred *Argon2Credential
 	if opts.withPassword {
-		if cc.MinPasswordLength > len(opts.password) {
-			return nil, fmt.Errorf("create: password account: password: %w", ErrTooShort)
+		if err := cc.checkPassword(a.LoginName, opts.password); err != nil {
+			return nil, fmt.Errorf("create: password account: password: %w", err)
 		}
 		if cred, err = newArgon2Credential(id, opts.password, cc.argon2()); err != nil {
 			return nil, fmt.Errorf("create: password account: %w", err)
@@ -131,7 +131,8 @@ func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt
 	return a, nil
 }
 
-// ListAccounts in an auth method and supports WithLimit option.
+// ListAccounts in an auth method ordered by create time and supports the
+// WithLimit and WithStartPageAfter options.
 func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
 	if withAuthMethodId == "" {
 		return nil, fmt.Errorf("list: password account: missing auth method id %w", db.ErrInvalidParameter)
@@ -143,7 +144,7 @@ func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string,
 		limit = opts.withLimit
 	}
 	var accts []*Account
-	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: password account: %w", err)
 	}
@@ -112,9 +112,10 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt
 	return &a, nil
 }
 
-// ListAuthMethods returns a slice of AuthMethods for the scopeId. WithLimit is the only option supported.
-func (r *Repository) ListAuthMethods(ctx context.Context, scopeId string, opt ...Option) ([]*AuthMethod, error) {
-	if scopeId == "" {
+// ListAuthMethods returns a slice of AuthMethods for the scopeIds ordered by
+// create time. WithLimit and WithStartPageAfter are the only options supported.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	if len(scopeIds) == 0 {
 		return nil, fmt.Errorf("list: password auth method: missing scope id: %w", db.ErrInvalidParameter)
 	}
 	opts := getOpts(opt...)
@@ -124,7 +125,7 @@ func (r *Repository) ListAuthMethods(ctx context.Context, scopeId string, opt ..
 		limit = opts.withLimit
 	}
 	var authMethods []*AuthMethod
-	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &authMethods, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: password auth method: %w", err)
 	}
@@ -178,8 +179,13 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
 // value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// MinLoginNameLength, LockoutThreshold, LockoutDurationSeconds,
+// MinCharacterClasses, PasswordHistoryCount, MaxPasswordAgeSeconds,
+// DisallowLoginName, MfaRequired, MaxTokenDurationSeconds and
+// MaxTokenIdleSeconds are the only updatable fields, If no updatable fields
+// are included in the fieldMaskPaths, then an error is returned. The
+// lockout, password policy, second factor and auth token fields are set to
+// their zero value rather than NULL.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	if authMethod == nil {
 		return nil, db.NoRowsAffected, fmt.Errorf("update: password auth method: missing authMethod: %w", db.ErrInvalidParameter)
@@ -196,6 +202,15 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		case strings.EqualFold("description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("LockoutThreshold", f):
+		case strings.EqualFold("LockoutDurationSeconds", f):
+		case strings.EqualFold("MinCharacterClasses", f):
+		case strings.EqualFold("PasswordHistoryCount", f):
+		case strings.EqualFold("MaxPasswordAgeSeconds", f):
+		case strings.EqualFold("DisallowLoginName", f):
+		case strings.EqualFold("MfaRequired", f):
+		case strings.EqualFold("MaxTokenDurationSeconds", f):
+		case strings.EqualFold("MaxTokenIdleSeconds", f):
 		default:
 			return nil, db.NoRowsAffected, fmt.Errorf("update: password auth method: field: %s: %w", f, db.ErrInvalidFieldMask)
 		}
@@ -203,14 +218,38 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":               authMethod.Name,
-			"Description":        authMethod.Description,
-			"MinPasswordLength":  authMethod.MinPasswordLength,
-			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"Name":                    authMethod.Name,
+			"Description":             authMethod.Description,
+			"MinPasswordLength":       authMethod.MinPasswordLength,
+			"MinLoginNameLength":      authMethod.MinLoginNameLength,
+			"LockoutThreshold":        authMethod.LockoutThreshold,
+			"LockoutDurationSeconds":  authMethod.LockoutDurationSeconds,
+			"MinCharacterClasses":     authMethod.MinCharacterClasses,
+			"PasswordHistoryCount":    authMethod.PasswordHistoryCount,
+			"MaxPasswordAgeSeconds":   authMethod.MaxPasswordAgeSeconds,
+			"DisallowLoginName":       authMethod.DisallowLoginName,
+			"MfaRequired":             authMethod.MfaRequired,
+			"MaxTokenDurationSeconds": authMethod.MaxTokenDurationSeconds,
+			"MaxTokenIdleSeconds":     authMethod.MaxTokenIdleSeconds,
 		},
 		fieldMaskPaths,
 		nil,
 	)
+	var newNullFields []string
+	for _, f := range nullFields {
+		switch {
+		case contains([]string{"LockoutThreshold", "LockoutDurationSeconds",
+			"MinCharacterClasses", "PasswordHistoryCount", "MaxPasswordAgeSeconds", "DisallowLoginName",
+			"MfaRequired", "MaxTokenDurationSeconds", "MaxTokenIdleSeconds"}, f):
+			// zero is a valid value for the lockout, password policy, second
+			// factor and auth token settings which disables them or falls
+			// back to the controller settings, so they are never set to NULL.
+			dbMask = append(dbMask, f)
+		default:
+			newNullFields = append(newNullFields, f)
+		}
+	}
+	nullFields = newNullFields
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, fmt.Errorf("update: password auth method: %w", db.ErrEmptyFieldMask)
 	}
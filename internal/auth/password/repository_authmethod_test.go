@@ -370,7 +370,7 @@ func TestRepository_ListAuthMethods(t *testing.T) {
 
 	var tests = []struct {
 		name      string
-		in        string
+		in        []string
 		opts      []Option
 		want      []*AuthMethod
 		wantIsErr error
@@ -381,12 +381,12 @@ func TestRepository_ListAuthMethods(t *testing.T) {
 		},
 		{
 			name: "Scope with no auth methods",
-			in:   noAuthMethodOrg.GetPublicId(),
+			in:   []string{noAuthMethodOrg.GetPublicId()},
 			want: []*AuthMethod{},
 		},
 		{
 			name: "With populated scope id",
-			in:   o.GetPublicId(),
+			in:   []string{o.GetPublicId()},
 			want: authMethods,
 		},
 	}
@@ -471,7 +471,7 @@ func TestRepository_ListAuthMethods_Limits(t *testing.T) {
 			repo, err := NewRepository(rw, rw, kms, tt.repoOpts...)
 			assert.NoError(err)
 			require.NotNil(repo)
-			got, err := repo.ListAuthMethods(context.Background(), ams[0].GetScopeId(), tt.listOpts...)
+			got, err := repo.ListAuthMethods(context.Background(), []string{ams[0].GetScopeId()}, tt.listOpts...)
 			require.NoError(err)
 			assert.Len(got, tt.wantLen)
 		})
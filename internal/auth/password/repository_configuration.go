@@ -117,6 +117,11 @@ type currentConfig struct {
 	MinLoginNameLength int
 	MinPasswordLength  int
 
+	MinCharacterClasses   int
+	PasswordHistoryCount  int
+	MaxPasswordAgeSeconds int
+	DisallowLoginName     bool
+
 	*Argon2Configuration
 }
 
@@ -0,0 +1,93 @@
+package password
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+const (
+	lockoutStatusLocked   = "locked"
+	lockoutStatusUnlocked = "unlocked"
+)
+
+// UnlockAccount clears the failed authentication attempts recorded for
+// accountId, unlocking the account if it is locked. The account is
+// returned.
+//
+// Returns nil, db.ErrRecordNotFound if the account doesn't exist.
+func (r *Repository) UnlockAccount(ctx context.Context, accountId string) (*Account, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("unlock account: no account id: %w", db.ErrInvalidParameter)
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("unlock account: %w", err)
+	}
+	if acct == nil {
+		return nil, fmt.Errorf("unlock account: account not found: %w", db.ErrRecordNotFound)
+	}
+	rowsDeleted, err := r.writer.Exec(ctx, clearLockoutQuery, []interface{}{accountId})
+	if err != nil {
+		return nil, fmt.Errorf("unlock account: %w", err)
+	}
+	if rowsDeleted > 0 {
+		r.auditLockout(ctx, acct, lockoutStatusUnlocked)
+	}
+	return acct, nil
+}
+
+// recordFailedAttempt records a failed authentication attempt for acct and
+// locks the account if the attempt reaches the lockout threshold of its auth
+// method. Nothing is recorded if lockout is disabled for the auth method.
+func (r *Repository) recordFailedAttempt(ctx context.Context, acct *authAccount) error {
+	if acct.LockoutThreshold == 0 {
+		return nil
+	}
+	var locked bool
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, recordFailedAttemptQuery, []interface{}{acct.PublicId}); err != nil {
+				return err
+			}
+			rowsUpdated, err := w.Exec(ctx, lockAccountQuery, []interface{}{acct.PublicId, acct.LockoutDurationSeconds, acct.LockoutThreshold})
+			if err != nil {
+				return err
+			}
+			locked = rowsUpdated > 0
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("record failed attempt: %w", err)
+	}
+	if locked {
+		r.auditLockout(ctx, acct.Account, lockoutStatusLocked)
+	}
+	return nil
+}
+
+// clearFailedAttempts deletes the failed authentication attempts recorded
+// for acct after it authenticated successfully.
+func (r *Repository) clearFailedAttempts(ctx context.Context, acct *authAccount) error {
+	if acct.FailedAttempts == 0 {
+		return nil
+	}
+	if _, err := r.writer.Exec(ctx, clearLockoutQuery, []interface{}{acct.PublicId}); err != nil {
+		return fmt.Errorf("clear failed attempts: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) auditLockout(ctx context.Context, acct *Account, status string) {
+	r.eventer.Audit(ctx, &audit.Event{
+		Type: audit.AccountLockout,
+		Account: &audit.Account{
+			AuthMethodId: acct.GetAuthMethodId(),
+			AccountId:    acct.GetPublicId(),
+			Status:       status,
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AuthenticateLockout(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	eventer, sink := audit.TestEventer(t)
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, kms, WithEventer(eventer))
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	authMethod.LockoutThreshold = 3
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"LockoutThreshold"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), authMethod.LockoutThreshold)
+	require.Equal(t, uint32(0), authMethod.LockoutDurationSeconds)
+
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(passwd))
+	require.NoError(t, err)
+
+	authenticate := func(password string) (*Account, error) {
+		return repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, password)
+	}
+
+	t.Run("success-clears-failed-attempts", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		for i := 0; i < 2; i++ {
+			got, err := authenticate("wrong-password")
+			require.NoError(err)
+			assert.Nil(got)
+		}
+		got, err := authenticate(passwd)
+		require.NoError(err)
+		require.NotNil(got)
+
+		// Two more failures do not reach the threshold of three as the
+		// successful authentication reset the count.
+		for i := 0; i < 2; i++ {
+			got, err := authenticate("wrong-password")
+			require.NoError(err)
+			assert.Nil(got)
+		}
+		got, err = authenticate(passwd)
+		require.NoError(err)
+		assert.NotNil(got)
+		assert.Empty(sink.Events())
+	})
+
+	t.Run("locked-after-threshold", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		for i := 0; i < 3; i++ {
+			got, err := authenticate("wrong-password")
+			require.NoError(err)
+			assert.Nil(got)
+		}
+		got, err := authenticate(passwd)
+		assert.Truef(errors.Is(err, ErrAccountLocked), "want err: %q got: %q", ErrAccountLocked, err)
+		assert.Nil(got)
+
+		_, err = repo.ChangePassword(ctx, o.GetPublicId(), acct.PublicId, passwd, "abcdefghijk", acct.Version)
+		assert.Truef(errors.Is(err, ErrAccountLocked), "want err: %q got: %q", ErrAccountLocked, err)
+
+		events := sink.Events()
+		require.Len(events, 1)
+		assert.Equal(audit.AccountLockout, events[0].Type)
+		assert.Equal(acct.PublicId, events[0].Account.AccountId)
+		assert.Equal(authMethod.PublicId, events[0].Account.AuthMethodId)
+		assert.Equal("locked", events[0].Account.Status)
+	})
+
+	t.Run("unlock", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.UnlockAccount(ctx, acct.PublicId)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.PublicId)
+
+		got, err = authenticate(passwd)
+		require.NoError(err)
+		assert.NotNil(got)
+
+		events := sink.Events()
+		require.Len(events, 2)
+		assert.Equal("unlocked", events[1].Account.Status)
+
+		// Unlocking an account which is not locked is not audited
+		_, err = repo.UnlockAccount(ctx, acct.PublicId)
+		require.NoError(err)
+		assert.Len(sink.Events(), 2)
+	})
+
+	t.Run("unlock-invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.UnlockAccount(ctx, "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.UnlockAccount(ctx, "apw_doesnotexist")
+		assert.True(errors.Is(err, db.ErrRecordNotFound))
+	})
+
+	t.Run("lockout-disabled", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authMethod.LockoutThreshold = 0
+		updated, _, err := repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"LockoutThreshold"})
+		require.NoError(err)
+		assert.Equal(uint32(0), updated.LockoutThreshold)
+		for i := 0; i < 5; i++ {
+			got, err := authenticate("wrong-password")
+			require.NoError(err)
+			assert.Nil(got)
+		}
+		got, err := authenticate(passwd)
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+}
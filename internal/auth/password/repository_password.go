@@ -17,12 +17,39 @@ type authAccount struct {
 	*Argon2Credential
 	*Argon2Configuration
 	IsCurrentConf bool
+
+	LockoutThreshold       uint32
+	LockoutDurationSeconds uint32
+	FailedAttempts         uint32
+	IsLocked               bool
+	IsPasswordExpired      bool
+
+	MfaRequired  bool
+	TotpEnrolled bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
 // authMethodId. The account for the loginName is returned if authentication
 // is successful. Returns nil if authentication fails.
 //
+// If the auth method has a lockout threshold, failed attempts are counted
+// and the account is locked once the threshold is reached. Returns nil,
+// ErrAccountLocked if the account is locked; the password is not checked.
+// A successful authentication clears the failed attempts of the account.
+//
+// If the account has a verified TOTP enrollment or the auth method requires
+// a second factor, the WithTotpCode or WithRecoveryCode option must provide
+// a valid code after the password is checked. Returns nil,
+// ErrSecondFactorRequired if neither option is provided and nil,
+// ErrTotpNotEnrolled if the auth method requires a second factor the
+// account is not enrolled for. An invalid code counts as a failed attempt
+// and nil is returned. A recovery code can only be used once.
+//
+// If the auth method has a maximum password age and the password of the
+// account is older, the account and ErrPasswordExpired are returned after
+// the second factor is checked. The password must be changed with ChangePassword
+// before the account can authenticate.
+//
 // The CredentialId in the returned account represents a user's current
 // password. A new CredentialId is generated when a user's password is
 // changed and the old one is deleted.
@@ -30,7 +57,7 @@ type authAccount struct {
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	if authMethodId == "" {
 		return nil, fmt.Errorf("password authenticate: no authMethodId: %w", db.ErrInvalidParameter)
 	}
@@ -56,6 +83,19 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 	if acct == nil {
 		return nil, nil
 	}
+	ok, err := r.verifySecondFactor(ctx, scopeId, acct, getOpts(opt...))
+	if err != nil {
+		return nil, fmt.Errorf("password authenticate: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	if err := r.clearFailedAttempts(ctx, acct); err != nil {
+		return nil, fmt.Errorf("password authenticate: %w", err)
+	}
+	if acct.IsPasswordExpired {
+		return acct.Account, fmt.Errorf("password authenticate: %w", ErrPasswordExpired)
+	}
 
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
@@ -104,6 +144,10 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 // Returns nil, db.ErrorRecordNotFound if the account doesn't exist.
 // Returns nil, nil if old does not match the stored password for accountId.
 // Returns nil, ErrPasswordsEqual if old and new are equal.
+// Returns nil, ErrAccountLocked if the account is locked.
+//
+// new must satisfy the password policy of the auth method. An expired
+// password can be changed.
 func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old, new string, version uint32) (*Account, error) {
 	if accountId == "" {
 		return nil, fmt.Errorf("change password: no account id: %w", db.ErrInvalidParameter)
@@ -148,13 +192,19 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	if acct == nil {
 		return nil, nil
 	}
+	if err := r.clearFailedAttempts(ctx, acct); err != nil {
+		return nil, fmt.Errorf("change password: %w", err)
+	}
 
 	cc, err := r.currentConfig(ctx, authAccount.GetAuthMethodId())
 	if err != nil {
 		return nil, fmt.Errorf("change password: retrieve current password configuration: %w", err)
 	}
-	if cc.MinPasswordLength > len(new) {
-		return nil, fmt.Errorf("change password: %w", ErrTooShort)
+	if err := cc.checkPassword(authAccount.GetLoginName(), new); err != nil {
+		return nil, fmt.Errorf("change password: %w", err)
+	}
+	if err := r.checkHistory(ctx, scopeId, accountId, new, cc.PasswordHistoryCount); err != nil {
+		return nil, fmt.Errorf("change password: %w", err)
 	}
 	newCred, err := newArgon2Credential(accountId, new, cc.argon2())
 	if err != nil {
@@ -181,6 +231,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 				return fmt.Errorf("change password: updated account and %d rows updated", rowsUpdated)
 			}
 
+			if err := retireCredential(ctx, w, accountId, oldCred.PrivateId, cc.PasswordHistoryCount); err != nil {
+				return err
+			}
 			rowsDeleted, err := w.Delete(ctx, oldCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 			if err == nil && rowsDeleted > 1 {
 				return db.ErrMultipleRecords
@@ -200,6 +253,9 @@ func (r *Repository) ChangePassword(ctx context.Context, scopeId, accountId, old
 	return updatedAccount, nil
 }
 
+// authenticate checks password for loginName in authMethodId and records a
+// failed attempt if it does not match. Callers clear the failed attempts
+// once every factor of the account is checked.
 func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*authAccount, error) {
 	var accts []authAccount
 
@@ -226,6 +282,9 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	default:
 		acct = accts[0]
 	}
+	if acct.IsLocked {
+		return nil, ErrAccountLocked
+	}
 
 	// We don't pass a wrapper in here because for ecryption we want to indicate the expected key ID
 	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(acct.GetKeyId()))
@@ -240,6 +299,9 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 	inputKey := argon2.IDKey([]byte(password), acct.Salt, acct.Iterations, acct.Memory, uint8(acct.Threads), acct.KeyLength)
 	if subtle.ConstantTimeCompare(inputKey, acct.DerivedKey) == 0 {
 		// authentication failed, password does not match
+		if err := r.recordFailedAttempt(ctx, &acct); err != nil {
+			return nil, err
+		}
 		return nil, nil
 	}
 	return &acct, nil
@@ -247,6 +309,7 @@ func (r *Repository) authenticate(ctx context.Context, scopeId, authMethodId, lo
 
 // SetPassword sets the password for accountId to password. If password
 // contains an empty string, the password for accountId will be deleted.
+// password must satisfy the password policy of the auth method.
 func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, password string, version uint32) (*Account, error) {
 	if accountId == "" {
 		return nil, fmt.Errorf("set password: no accountId: %w", db.ErrInvalidParameter)
@@ -267,17 +330,36 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 		return nil, fmt.Errorf("set password: unable to get database wrapper: %w", err)
 	}
 
+	cc, err := r.currentConfigForAccount(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("set password: retrieve current configuration: %w", err)
+	}
+	var historyCount int
+	if cc != nil {
+		historyCount = cc.PasswordHistoryCount
+	}
+
 	var newCred *Argon2Credential
 	if password != "" {
-		cc, err := r.currentConfigForAccount(ctx, accountId)
-		if err != nil {
-			return nil, fmt.Errorf("set password: retrieve current configuration: %w", err)
-		}
 		if cc == nil {
 			return nil, fmt.Errorf("set password: retrieve current configuration: %w", db.ErrRecordNotFound)
 		}
-		if cc.MinPasswordLength > len(password) {
-			return nil, fmt.Errorf("set password: new password: %w", ErrTooShort)
+		var loginName string
+		if cc.DisallowLoginName {
+			acct, err := r.LookupAccount(ctx, accountId)
+			if err != nil {
+				return nil, fmt.Errorf("set password: lookup account: %w", err)
+			}
+			if acct == nil {
+				return nil, fmt.Errorf("set password: lookup account: account not found: %w", db.ErrRecordNotFound)
+			}
+			loginName = acct.GetLoginName()
+		}
+		if err := cc.checkPassword(loginName, password); err != nil {
+			return nil, fmt.Errorf("set password: new password: %w", err)
+		}
+		if err := r.checkHistory(ctx, scopeId, accountId, password, historyCount); err != nil {
+			return nil, fmt.Errorf("set password: new password: %w", err)
 		}
 		newCred, err = newArgon2Credential(accountId, password, cc.argon2())
 		if err != nil {
@@ -310,6 +392,9 @@ func (r *Repository) SetPassword(ctx context.Context, scopeId, accountId, passwo
 				}
 			}
 			if oldCred.PrivateId != "" {
+				if err := retireCredential(ctx, w, accountId, oldCred.PrivateId, historyCount); err != nil {
+					return err
+				}
 				dCred := oldCred.clone()
 				rowsDeleted, err := w.Delete(ctx, dCred, db.WithOplog(oplogWrapper, oldCred.oplog(oplog.OpType_OP_TYPE_DELETE)))
 				if err == nil && rowsDeleted > 1 {
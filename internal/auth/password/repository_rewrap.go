@@ -0,0 +1,111 @@
+package password
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// RewrapCredentials re-encrypts every argon2 credential, including the
+// credentials kept in the credential history of accounts, and every TOTP
+// secret that is not encrypted with the current database key version of its
+// scope under the current version. Returns the number of credentials and
+// secrets that were rewrapped.
+// Supports the WithLimit option, which sets the number of credentials read
+// from the database at a time.
+func (r *Repository) RewrapCredentials(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit > 0 {
+		limit = opts.withLimit
+	}
+
+	rewrapped, err := r.rewrapCredentials(ctx, limit, credentialsToRewrapQuery, rewrapCredentialQuery)
+	if err != nil {
+		return rewrapped, err
+	}
+	historyRewrapped, err := r.rewrapCredentials(ctx, limit, credentialHistoryToRewrapQuery, rewrapCredentialHistoryQuery)
+	rewrapped += historyRewrapped
+	if err != nil {
+		return rewrapped, err
+	}
+	totpsRewrapped, err := r.rewrapTotps(ctx, limit)
+	return rewrapped + totpsRewrapped, err
+}
+
+func (r *Repository) rewrapCredentials(ctx context.Context, limit int, selectQuery, updateQuery string) (int, error) {
+	var lastId string
+	return r.kms.RewrapDatabaseValues(ctx, "password credentials", func() ([]kms.Rewrappable, error) {
+		rows, err := r.reader.Query(ctx, selectQuery, []interface{}{lastId, limit})
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var values []kms.Rewrappable
+		for rows.Next() {
+			cred := &Argon2Credential{Argon2Credential: &store.Argon2Credential{}}
+			var scopeId string
+			if err := rows.Scan(&cred.PrivateId, &cred.CtSalt, &cred.KeyId, &scopeId); err != nil {
+				return nil, err
+			}
+			lastId = cred.PrivateId
+			oldKeyId := cred.KeyId
+			values = append(values, kms.Rewrappable{
+				Id:      cred.PrivateId,
+				ScopeId: scopeId,
+				KeyId:   oldKeyId,
+				Rewrap: func(ctx context.Context, databaseWrapper wrapping.Wrapper) (int, error) {
+					if err := cred.decrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					if err := cred.encrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					// rewrapping does not change the credential, so no oplog
+					// entry is written.
+					return r.writer.Exec(ctx, updateQuery, []interface{}{cred.CtSalt, cred.KeyId, cred.PrivateId, oldKeyId})
+				},
+			})
+		}
+		return values, rows.Err()
+	})
+}
+
+func (r *Repository) rewrapTotps(ctx context.Context, limit int) (int, error) {
+	var lastId string
+	var lastVerified bool
+	return r.kms.RewrapDatabaseValues(ctx, "totp secrets", func() ([]kms.Rewrappable, error) {
+		rows, err := r.reader.Query(ctx, totpsToRewrapQuery, []interface{}{lastId, lastVerified, limit})
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var values []kms.Rewrappable
+		for rows.Next() {
+			t := allocTotp()
+			var scopeId string
+			if err := rows.Scan(&t.AccountId, &t.Verified, &t.CtSecret, &t.KeyId, &scopeId); err != nil {
+				return nil, err
+			}
+			lastId, lastVerified = t.AccountId, t.Verified
+			oldKeyId := t.KeyId
+			values = append(values, kms.Rewrappable{
+				Id:      t.AccountId,
+				ScopeId: scopeId,
+				KeyId:   oldKeyId,
+				Rewrap: func(ctx context.Context, databaseWrapper wrapping.Wrapper) (int, error) {
+					if err := t.decrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					if err := t.encrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					return r.writer.Exec(ctx, rewrapTotpQuery, []interface{}{t.CtSecret, t.KeyId, t.AccountId, t.Verified, oldKeyId})
+				},
+			})
+		}
+		return values, rows.Err()
+	})
+}
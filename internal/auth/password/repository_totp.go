@@ -0,0 +1,202 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// EnrollTotp starts a TOTP enrollment for accountId and returns the
+// provisioning URI of the new secret. The enrollment replaces any earlier
+// enrollment of the account which has not been verified. It does not become
+// the second factor of the account until it is verified with VerifyTotp, so
+// the current second factor of the account keeps working until then.
+//
+// Returns "", db.ErrRecordNotFound if the account doesn't exist.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (string, error) {
+	if accountId == "" {
+		return "", fmt.Errorf("enroll totp: no account id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return "", fmt.Errorf("enroll totp: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return "", fmt.Errorf("enroll totp: lookup account: %w", err)
+	}
+	if acct == nil {
+		return "", fmt.Errorf("enroll totp: lookup account: account not found: %w", db.ErrRecordNotFound)
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return "", fmt.Errorf("enroll totp: unable to get database wrapper: %w", err)
+	}
+	secret, err := newTotpSecret()
+	if err != nil {
+		return "", fmt.Errorf("enroll totp: %w", err)
+	}
+	t := allocTotp()
+	t.AccountId = accountId
+	t.Secret = secret
+	if err := t.encrypt(ctx, databaseWrapper); err != nil {
+		return "", fmt.Errorf("enroll totp: encrypt: %w", err)
+	}
+
+	// TOTP enrollments, like failed authentication attempts, are not
+	// written to the oplog.
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deletePendingTotpQuery, []interface{}{accountId}); err != nil {
+				return err
+			}
+			return w.Create(ctx, t)
+		},
+	)
+	if err != nil {
+		return "", fmt.Errorf("enroll totp: %w", err)
+	}
+	return totpProvisioningUri(acct.GetLoginName(), secret), nil
+}
+
+// VerifyTotp verifies the TOTP enrollment of accountId started by
+// EnrollTotp with code. The verified enrollment becomes the second factor of
+// the account, replacing the previous one, and the recovery codes of the
+// account are replaced by the returned ones. The recovery codes are not
+// stored and cannot be retrieved again.
+//
+// Returns nil, db.ErrRecordNotFound if the account doesn't exist.
+// Returns nil, ErrTotpNotEnrolled if there is no enrollment to verify.
+// Returns nil, ErrInvalidTotpCode if code is not valid for the enrollment.
+func (r *Repository) VerifyTotp(ctx context.Context, scopeId, accountId, code string) ([]string, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("verify totp: no account id: %w", db.ErrInvalidParameter)
+	}
+	if code == "" {
+		return nil, fmt.Errorf("verify totp: no code: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("verify totp: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, fmt.Errorf("verify totp: lookup account: %w", err)
+	}
+	if acct == nil {
+		return nil, fmt.Errorf("verify totp: lookup account: account not found: %w", db.ErrRecordNotFound)
+	}
+
+	t, err := r.lookupTotp(ctx, scopeId, accountId, false)
+	if err != nil {
+		return nil, fmt.Errorf("verify totp: %w", err)
+	}
+	if t == nil {
+		return nil, fmt.Errorf("verify totp: %w", ErrTotpNotEnrolled)
+	}
+	step, ok := validateTotpCode(t.Secret, code, time.Now(), 0)
+	if !ok {
+		return nil, fmt.Errorf("verify totp: %w", ErrInvalidTotpCode)
+	}
+	recoveryCodes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("verify totp: %w", err)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteVerifiedTotpQuery, []interface{}{accountId}); err != nil {
+				return err
+			}
+			rowsUpdated, err := w.Exec(ctx, verifyTotpQuery, []interface{}{accountId, step})
+			if err != nil {
+				return err
+			}
+			if rowsUpdated != 1 {
+				return fmt.Errorf("verified totp enrollment and %d rows updated", rowsUpdated)
+			}
+			if _, err := w.Exec(ctx, deleteRecoveryCodesQuery, []interface{}{accountId}); err != nil {
+				return err
+			}
+			for _, c := range recoveryCodes {
+				if _, err := w.Exec(ctx, insertRecoveryCodeQuery, []interface{}{accountId, hashRecoveryCode(c)}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("verify totp: %w", err)
+	}
+	return recoveryCodes, nil
+}
+
+// lookupTotp returns the decrypted TOTP enrollment of accountId which is
+// verified or not. Returns nil if the account has no such enrollment.
+func (r *Repository) lookupTotp(ctx context.Context, scopeId, accountId string, verified bool) (*Totp, error) {
+	t := allocTotp()
+	if err := r.reader.LookupWhere(ctx, t, "account_id = ? and verified = ?", accountId, verified); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup totp: %w", err)
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(t.GetKeyId()))
+	if err != nil {
+		return nil, fmt.Errorf("lookup totp: unable to get database wrapper: %w", err)
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, fmt.Errorf("lookup totp: %w", err)
+	}
+	return t, nil
+}
+
+// verifySecondFactor checks the TOTP code or recovery code in opts for acct
+// if the account has a verified TOTP enrollment or its auth method requires
+// a second factor. It reports whether the account passed the check; a
+// failed check is recorded as a failed attempt.
+func (r *Repository) verifySecondFactor(ctx context.Context, scopeId string, acct *authAccount, opts options) (bool, error) {
+	switch {
+	case !acct.MfaRequired && !acct.TotpEnrolled:
+		return true, nil
+	case !acct.TotpEnrolled:
+		return false, ErrTotpNotEnrolled
+	case opts.withTotpCode == "" && opts.withRecoveryCode == "":
+		return false, ErrSecondFactorRequired
+	}
+
+	var ok bool
+	if opts.withTotpCode != "" {
+		t, err := r.lookupTotp(ctx, scopeId, acct.PublicId, true)
+		if err != nil {
+			return false, err
+		}
+		if t != nil {
+			if step, valid := validateTotpCode(t.Secret, opts.withTotpCode, time.Now(), t.LastUsedStep); valid {
+				// The update only succeeds for a step after the last used
+				// one, so a code cannot be used twice concurrently either.
+				rowsUpdated, err := r.writer.Exec(ctx, useTotpStepQuery, []interface{}{acct.PublicId, step})
+				if err != nil {
+					return false, fmt.Errorf("use totp code: %w", err)
+				}
+				ok = rowsUpdated == 1
+			}
+		}
+	} else {
+		rowsDeleted, err := r.writer.Exec(ctx, useRecoveryCodeQuery, []interface{}{acct.PublicId, hashRecoveryCode(opts.withRecoveryCode)})
+		if err != nil {
+			return false, fmt.Errorf("use recovery code: %w", err)
+		}
+		ok = rowsDeleted == 1
+	}
+	if !ok {
+		if err := r.recordFailedAttempt(ctx, acct); err != nil {
+			return false, err
+		}
+	}
+	return ok, nil
+}
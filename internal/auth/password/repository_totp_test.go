@@ -0,0 +1,156 @@
+package password
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Totp(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	authMethod.LockoutThreshold = 5
+	authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"LockoutThreshold"})
+	require.NoError(t, err)
+
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(passwd))
+	require.NoError(t, err)
+
+	authenticate := func(opt ...Option) (*Account, error) {
+		return repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, opt...)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.EnrollTotp(ctx, o.GetPublicId(), "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.EnrollTotp(ctx, o.GetPublicId(), "apw_doesnotexist")
+		assert.True(errors.Is(err, db.ErrRecordNotFound))
+		_, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "123456")
+		assert.Truef(errors.Is(err, ErrTotpNotEnrolled), "want err: %q got: %q", ErrTotpNotEnrolled, err)
+	})
+
+	t.Run("mfa-required-not-enrolled", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		authMethod.MfaRequired = true
+		authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MfaRequired"})
+		require.NoError(err)
+		require.True(authMethod.MfaRequired)
+
+		got, err := authenticate()
+		assert.Truef(errors.Is(err, ErrTotpNotEnrolled), "want err: %q got: %q", ErrTotpNotEnrolled, err)
+		assert.Nil(got)
+
+		authMethod.MfaRequired = false
+		authMethod, _, err = repo.UpdateAuthMethod(ctx, authMethod, authMethod.Version, []string{"MfaRequired"})
+		require.NoError(err)
+	})
+
+	var uri string
+	var recoveryCodes []string
+	t.Run("enroll", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		uri, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(err)
+		assert.Contains(uri, "otpauth://totp/Boundary:kazmierczak?")
+
+		// An unverified enrollment is not required when authenticating
+		got, err := authenticate()
+		require.NoError(err)
+		assert.NotNil(got)
+
+		_, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, "000000")
+		if TestTotpCode(t, uri, time.Now()) != "000000" {
+			assert.Truef(errors.Is(err, ErrInvalidTotpCode), "want err: %q got: %q", ErrInvalidTotpCode, err)
+		}
+
+		recoveryCodes, err = repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, uri, time.Now()))
+		require.NoError(err)
+		assert.Len(recoveryCodes, recoveryCodeCount)
+	})
+
+	t.Run("authenticate-totp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := authenticate()
+		assert.Truef(errors.Is(err, ErrSecondFactorRequired), "want err: %q got: %q", ErrSecondFactorRequired, err)
+		assert.Nil(got)
+
+		// Codes up to the one used to verify the enrollment are rejected
+		got, err = authenticate(WithTotpCode(TestTotpCode(t, uri, time.Now().Add(-totpPeriod))))
+		require.NoError(err)
+		assert.Nil(got)
+
+		code := TestTotpCode(t, uri, time.Now().Add(totpPeriod))
+		got, err = authenticate(WithTotpCode(code))
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.PublicId)
+
+		got, err = authenticate(WithTotpCode(code))
+		require.NoError(err)
+		assert.Nil(got)
+	})
+
+	t.Run("authenticate-recovery-code", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := authenticate(WithRecoveryCode("aaaa-aaaa-aaaa-aaaa"))
+		require.NoError(err)
+		assert.Nil(got)
+
+		got, err = authenticate(WithRecoveryCode(recoveryCodes[0]))
+		require.NoError(err)
+		assert.NotNil(got)
+
+		got, err = authenticate(WithRecoveryCode(recoveryCodes[0]))
+		require.NoError(err)
+		assert.Nil(got)
+	})
+
+	t.Run("re-enroll", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		newUri, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(err)
+		assert.NotEqual(uri, newUri)
+
+		// The verified enrollment is kept until the new one is verified
+		got, err := authenticate(WithRecoveryCode(recoveryCodes[1]))
+		require.NoError(err)
+		assert.NotNil(got)
+
+		newCodes, err := repo.VerifyTotp(ctx, o.GetPublicId(), acct.PublicId, TestTotpCode(t, newUri, time.Now()))
+		require.NoError(err)
+
+		// The old recovery codes are replaced
+		got, err = authenticate(WithRecoveryCode(recoveryCodes[2]))
+		require.NoError(err)
+		assert.Nil(got)
+		got, err = authenticate(WithRecoveryCode(newCodes[0]))
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+}
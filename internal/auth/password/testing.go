@@ -3,7 +3,9 @@ package password
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/jinzhu/gorm"
@@ -78,3 +80,16 @@ func TestAccounts(t *testing.T, conn *gorm.DB, authMethodId string, count int) [
 	}
 	return auts
 }
+
+// TestTotpCode returns the TOTP code at time at for the secret in
+// provisioningUri, as returned by EnrollTotp. If provisioningUri cannot be
+// parsed, the test will fail.
+func TestTotpCode(t *testing.T, provisioningUri string, at time.Time) string {
+	t.Helper()
+	require := require.New(t)
+	u, err := url.Parse(provisioningUri)
+	require.NoError(err)
+	secret, err := base32NoPadding.DecodeString(u.Query().Get("secret"))
+	require.NoError(err)
+	return totpCode(secret, totpStep(at))
+}
@@ -0,0 +1,193 @@
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+const (
+	// totpIssuer is the issuer included in provisioning URIs.
+	totpIssuer = "Boundary"
+
+	// totpSecretLength is the length of TOTP secrets in bytes, the length
+	// of a SHA1 HMAC key recommended by RFC 4226.
+	totpSecretLength = 20
+
+	// totpPeriod is the time step of TOTP codes.
+	totpPeriod = 30 * time.Second
+
+	// totpDigits is the number of digits of TOTP codes.
+	totpDigits = 6
+
+	// totpSkew is the number of time steps before and after the current
+	// one codes are accepted for to allow for clock drift.
+	totpSkew = 1
+
+	// recoveryCodeCount is the number of recovery codes generated when a
+	// TOTP enrollment is verified.
+	recoveryCodeCount = 10
+
+	// recoveryCodeLength is the number of random bytes in a recovery
+	// code.
+	recoveryCodeLength = 10
+)
+
+// base32NoPadding encodes TOTP secrets in provisioning URIs.
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// A Totp is a TOTP enrollment of an Account. A verified enrollment is the
+// active second factor of the account.
+type Totp struct {
+	*store.Totp
+	tableName string
+}
+
+func allocTotp() *Totp {
+	return &Totp{
+		Totp: &store.Totp{},
+	}
+}
+
+// TableName returns the table name.
+func (t *Totp) TableName() string {
+	if t != nil && t.tableName != "" {
+		return t.tableName
+	}
+	return "auth_password_account_totp"
+}
+
+// SetTableName sets the table name.
+func (t *Totp) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *Totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return fmt.Errorf("error encrypting totp secret: %w", err)
+	}
+	t.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (t *Totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return fmt.Errorf("error decrypting totp secret: %w", err)
+	}
+	return nil
+}
+
+// newTotpSecret returns a random TOTP secret.
+func newTotpSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// totpProvisioningUri returns the otpauth URI authenticator apps use to
+// enroll secret for loginName.
+func totpProvisioningUri(loginName string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", base32NoPadding.EncodeToString(secret))
+	v.Set("issuer", totpIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", strconv.Itoa(totpDigits))
+	v.Set("period", strconv.Itoa(int(totpPeriod/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + loginName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// totpStep returns the TOTP time step of t.
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod/time.Second)
+}
+
+// totpCode returns the TOTP code of secret for step as defined by RFC 6238.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// validateTotpCode reports whether code is a valid code of secret at now
+// for a time step after lastUsedStep. The time step of the code is
+// returned if it is valid.
+func validateTotpCode(secret []byte, code string, now time.Time, lastUsedStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := totpStep(now)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// newRecoveryCodes returns recoveryCodeCount random recovery codes
+// formatted as groups of four characters separated by dashes.
+func newRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := strings.ToLower(base32NoPadding.EncodeToString(b))
+		var groups []string
+		for len(s) > 4 {
+			groups, s = append(groups, s[:4]), s[4:]
+		}
+		codes = append(codes, strings.Join(append(groups, s), "-"))
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode returns the hash of code stored in the database. Case,
+// dashes and spaces in code are ignored.
+func hashRecoveryCode(code string) []byte {
+	code = strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	sum := sha256.Sum256([]byte(code))
+	return sum[:]
+}
@@ -0,0 +1,148 @@
+package password
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// Test vectors from RFC 6238 Appendix B for SHA1, truncated to six
+	// digits.
+	secret := []byte("12345678901234567890")
+	var tests = []struct {
+		time int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, totpCode(secret, totpStep(time.Unix(tt.time, 0))))
+		})
+	}
+}
+
+func Test_validateTotpCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := totpStep(now)
+
+	var tests = []struct {
+		name         string
+		code         string
+		lastUsedStep int64
+		wantStep     int64
+		wantOk       bool
+	}{
+		{
+			name:     "current",
+			code:     totpCode(secret, step),
+			wantStep: step,
+			wantOk:   true,
+		},
+		{
+			name:     "previous",
+			code:     totpCode(secret, step-1),
+			wantStep: step - 1,
+			wantOk:   true,
+		},
+		{
+			name:     "next",
+			code:     totpCode(secret, step+1),
+			wantStep: step + 1,
+			wantOk:   true,
+		},
+		{
+			name: "too-old",
+			code: totpCode(secret, step-2),
+		},
+		{
+			name: "too-new",
+			code: totpCode(secret, step+2),
+		},
+		{
+			name:         "replayed",
+			code:         totpCode(secret, step),
+			lastUsedStep: step,
+		},
+		{
+			name:         "after-last-used",
+			code:         totpCode(secret, step+1),
+			lastUsedStep: step,
+			wantStep:     step + 1,
+			wantOk:       true,
+		},
+		{
+			name: "wrong-length",
+			code: totpCode(secret, step)[1:],
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			gotStep, gotOk := validateTotpCode(secret, tt.code, now, tt.lastUsedStep)
+			assert.Equal(tt.wantOk, gotOk)
+			assert.Equal(tt.wantStep, gotStep)
+		})
+	}
+}
+
+func Test_totpProvisioningUri(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	secret := []byte("12345678901234567890")
+	got := totpProvisioningUri("kazmierczak", secret)
+
+	u, err := url.Parse(got)
+	require.NoError(err)
+	assert.Equal("otpauth", u.Scheme)
+	assert.Equal("totp", u.Host)
+	assert.Equal("/Boundary:kazmierczak", u.Path)
+	q := u.Query()
+	assert.Equal("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", q.Get("secret"))
+	assert.Equal("Boundary", q.Get("issuer"))
+	assert.Equal("SHA1", q.Get("algorithm"))
+	assert.Equal("6", q.Get("digits"))
+	assert.Equal("30", q.Get("period"))
+
+	now := time.Now()
+	assert.Equal(totpCode(secret, totpStep(now)), TestTotpCode(t, got, now))
+}
+
+func Test_recoveryCodes(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	codes, err := newRecoveryCodes()
+	require.NoError(err)
+	assert.Len(codes, recoveryCodeCount)
+
+	format := regexp.MustCompile(`^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`)
+	seen := make(map[string]bool)
+	for _, c := range codes {
+		assert.Regexp(format, c)
+		assert.False(seen[c], "duplicate recovery code %s", c)
+		seen[c] = true
+	}
+
+	code := codes[0]
+	want := hashRecoveryCode(code)
+	assert.Len(want, 32)
+	assert.Equal(want, hashRecoveryCode(strings.ToUpper(code)))
+	assert.Equal(want, hashRecoveryCode(strings.ReplaceAll(code, "-", "")))
+	assert.Equal(want, hashRecoveryCode(strings.ReplaceAll(code, "-", " ")))
+	assert.NotEqual(want, hashRecoveryCode(codes[1]))
+}
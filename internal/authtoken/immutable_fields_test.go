@@ -58,6 +58,15 @@ func TestAuthToken_ImmutableFields(t *testing.T) {
 			}(),
 			fieldMask: []string{"AuthAccountId"},
 		},
+		{
+			name: "token with unchanged key_id",
+			update: func() *AuthToken {
+				c := new.clone()
+				c.CtToken = []byte("not the token")
+				return c
+			}(),
+			fieldMask: []string{"CtToken"},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -80,3 +89,36 @@ func TestAuthToken_ImmutableFields(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthToken_RewrapFields(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	w := db.New(conn)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	new := TestAuthToken(t, conn, kmsCache, org.PublicId)
+
+	// The token may change when it is rewrapped under a newer key version,
+	// in which case its key id changes with it.
+	require.NoError(kmsCache.RotateKeys(ctx, org.PublicId))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(err)
+	require.NotEqual(new.KeyId, databaseWrapper.KeyID())
+	wat := new.toWritableAuthToken()
+	require.NoError(wat.encrypt(ctx, databaseWrapper))
+	rowsUpdated, err := w.Exec(ctx, rewrapAuthTokenQuery, []interface{}{wat.CtToken, wat.KeyId, wat.PublicId, new.KeyId})
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+
+	after := new.clone()
+	after.Token = ""
+	require.NoError(w.LookupById(ctx, after))
+	assert.Equal(databaseWrapper.KeyID(), after.KeyId)
+	assert.Equal(new.AuthAccountId, after.AuthAccountId)
+	require.NoError(after.decrypt(ctx, databaseWrapper))
+	assert.Equal(new.Token, after.Token)
+}
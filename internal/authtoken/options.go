@@ -1,5 +1,11 @@
 package authtoken
 
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,8 +20,11 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withTokenValue bool
-	withLimit      int
+	withTokenValue               bool
+	withLimit                    int
+	withStartPageAfter           *db.PageCursor
+	withTokenTimeToLiveDuration  time.Duration
+	withTokenTimeToStaleDuration time.Duration
 }
 
 func getDefaultOptions() options {
@@ -38,3 +47,30 @@ func WithLimit(limit int) Option {
 		o.withLimit = limit
 	}
 }
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
+
+// WithTokenTimeToLiveDuration allows setting the maximum lifetime of auth
+// tokens issued by auth methods which do not set their own. If the duration
+// is zero or less the default is used.
+func WithTokenTimeToLiveDuration(ttl time.Duration) Option {
+	return func(o *options) {
+		o.withTokenTimeToLiveDuration = ttl
+	}
+}
+
+// WithTokenTimeToStaleDuration allows setting how long auth tokens issued by
+// auth methods which do not set their own idle timeout can go unused before
+// they are no longer valid. If the duration is zero or less the default is
+// used.
+func WithTokenTimeToStaleDuration(dur time.Duration) Option {
+	return func(o *options) {
+		o.withTokenTimeToStaleDuration = dur
+	}
+}
@@ -2,6 +2,7 @@ package authtoken
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,4 +17,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withTokenValue = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithTokenTimeToLiveDuration", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTokenTimeToLiveDuration(time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withTokenTimeToLiveDuration = time.Hour
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTokenTimeToStaleDuration", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithTokenTimeToStaleDuration(time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withTokenTimeToStaleDuration = time.Hour
+		assert.Equal(opts, testOpts)
+	})
 }
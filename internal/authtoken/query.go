@@ -0,0 +1,42 @@
+package authtoken
+
+const (
+	// authMethodTokenSettingsQuery returns the auth token settings of an auth
+	// method.
+	authMethodTokenSettingsQuery = `
+select max_token_duration_seconds, max_token_idle_seconds
+from auth_method_token_settings
+where public_id = $1;
+`
+
+	// authTokensToRewrapQuery returns a page of auth tokens which are not
+	// encrypted with the current database key version of their scope,
+	// starting after the given auth token id.
+	authTokensToRewrapQuery = `
+select at.public_id, at.token, at.key_id, aa.scope_id
+from auth_token at
+join auth_account aa
+	on at.auth_account_id = aa.public_id
+where
+	at.public_id > $1
+	and at.key_id not in (
+		select key_version_id
+		from kms_current_key_version
+		where purpose = 'database'
+	)
+order by at.public_id
+limit $2;
+`
+
+	// rewrapAuthTokenQuery replaces the encrypted value of an auth token if
+	// it has not been rewrapped in the meantime.
+	rewrapAuthTokenQuery = `
+update auth_token
+set
+	token = $1,
+	key_id = $2
+where
+	public_id = $3
+	and key_id = $4;
+`
+)
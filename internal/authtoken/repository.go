@@ -12,13 +12,21 @@ import (
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+const (
+	// defaultTokenTimeToLiveDuration is the maximum lifetime of auth tokens
+	// if neither the auth method nor the repository sets one.
+	defaultTokenTimeToLiveDuration = 7 * 24 * time.Hour
+
+	// defaultTokenTimeToStaleDuration is how long auth tokens can go unused
+	// if neither the auth method nor the repository sets an idle timeout.
+	defaultTokenTimeToStaleDuration = 24 * time.Hour
 )
 
-// TODO (ICU-406): Make these fields configurable.
 var (
 	lastAccessedUpdateDuration = 10 * time.Minute
-	maxStaleness               = 24 * time.Hour
-	maxTokenDuration           = 7 * 24 * time.Hour
 	timeSkew                   = time.Duration(0)
 )
 
@@ -30,10 +38,16 @@ type Repository struct {
 	kms    *kms.Kms
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+	// timeToLiveDuration and timeToStaleDuration are the maximum lifetime and
+	// idle timeout of auth tokens issued by auth methods which do not set
+	// their own.
+	timeToLiveDuration  time.Duration
+	timeToStaleDuration time.Duration
 }
 
 // NewRepository creates a new Repository. The returned repository is not safe for concurrent go
-// routines to access it.
+// routines to access it. WithLimit, WithTokenTimeToLiveDuration and
+// WithTokenTimeToStaleDuration are the only valid options.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	switch {
 	case r == nil:
@@ -49,17 +63,26 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		// zero signals the boundary defaults should be used.
 		opts.withLimit = db.DefaultLimit
 	}
+	if opts.withTokenTimeToLiveDuration <= 0 {
+		opts.withTokenTimeToLiveDuration = defaultTokenTimeToLiveDuration
+	}
+	if opts.withTokenTimeToStaleDuration <= 0 {
+		opts.withTokenTimeToStaleDuration = defaultTokenTimeToStaleDuration
+	}
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:              r,
+		writer:              w,
+		kms:                 kms,
+		defaultLimit:        opts.withLimit,
+		timeToLiveDuration:  opts.withTokenTimeToLiveDuration,
+		timeToStaleDuration: opts.withTokenTimeToStaleDuration,
 	}, nil
 }
 
 // CreateAuthToken inserts an Auth Token into the repository and returns a new Auth Token.  The returned auth token
 // contains the auth token value. The provided IAM User ID must be associated to the provided auth account id
-// or an error will be returned. All options are ignored.
+// or an error will be returned. The token expires after the maximum token duration of the auth method of the
+// account, or of the repository if the auth method does not set one. All options are ignored.
 func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User, withAuthAccountId string, opt ...Option) (*AuthToken, error) {
 	if withIamUser == nil {
 		return nil, fmt.Errorf("create: auth token: no user: %w", db.ErrInvalidParameter)
@@ -91,15 +114,6 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 		return nil, fmt.Errorf("create: unable to get database wrapper: %w", err)
 	}
 
-	// TODO: Allow the caller to specify something different than the default duration.
-	// We truncate the expiration time to the nearest second to make testing in different platforms with
-	// different time resolutions easier.
-	expiration, err := ptypes.TimestampProto(time.Now().Add(maxTokenDuration).Truncate(time.Second))
-	if err != nil {
-		return nil, err
-	}
-	at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
-
 	var newAuthToken *writableAuthToken
 	_, err = r.writer.DoTx(
 		ctx,
@@ -118,6 +132,18 @@ func (r *Repository) CreateAuthToken(ctx context.Context, withIamUser *iam.User,
 			at.AuthMethodId = acct.GetAuthMethodId()
 			at.IamUserId = acct.GetIamUserId()
 
+			ttl, _, err := r.tokenDurations(ctx, read, acct.GetAuthMethodId())
+			if err != nil {
+				return fmt.Errorf("create: auth token: %w", err)
+			}
+			// We truncate the expiration time to the nearest second to make testing in different platforms with
+			// different time resolutions easier.
+			expiration, err := ptypes.TimestampProto(time.Now().Add(ttl).Truncate(time.Second))
+			if err != nil {
+				return err
+			}
+			at.ExpirationTime = &timestamp.Timestamp{Timestamp: expiration}
+
 			newAuthToken = at.toWritableAuthToken()
 			if err := newAuthToken.encrypt(ctx, databaseWrapper); err != nil {
 				return err
@@ -174,7 +200,8 @@ func (r *Repository) LookupAuthToken(ctx context.Context, id string, opt ...Opti
 // approximate last accessed time may be updated depending on how long it has been since the last time the token
 // was validated.  If a token is returned it is guaranteed to be valid. For security reasons, the actual token
 // value is not included in the returned AuthToken. If no valid auth token is found nil, nil is returned.
-// All options are ignored.
+// The maximum token duration and idle timeout of the auth method of the token, or of the repository if the
+// auth method does not set them, are checked when the token is validated. All options are ignored.
 //
 // NOTE: Do not log or add the token string to any errors to avoid leaking it as it is a secret.
 func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ...Option) (*AuthToken, error) {
@@ -207,11 +234,25 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 		return nil, fmt.Errorf("validate token: last accessed time : %w", err)
 	}
 
+	created, err := ptypes.Timestamp(retAT.GetCreateTime().GetTimestamp())
+	if err != nil {
+		return nil, fmt.Errorf("validate token: create time : %w", err)
+	}
+	ttl, stale, err := r.tokenDurations(ctx, r.reader, retAT.GetAuthMethodId())
+	if err != nil {
+		return nil, fmt.Errorf("validate token: %w", err)
+	}
+	// The current settings of the auth method apply to tokens issued before
+	// they were tightened.
+	if maxExp := created.Add(ttl); maxExp.Before(exp) {
+		exp = maxExp
+	}
+
 	now := time.Now()
 	sinceLastAccessed := now.Sub(lastAccessed) + timeSkew
 	// TODO (jimlambrt 9/2020) - investigate the need for the timeSkew and see
 	// if it can be eliminated.
-	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= maxStaleness {
+	if now.After(exp.Add(-timeSkew)) || sinceLastAccessed >= stale {
 		// If the token has expired or has become too stale, delete it from the DB.
 		_, err = r.writer.DoTx(
 			ctx,
@@ -238,10 +279,15 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	// retAT.Token set to empty string so the value is not returned as described in the methods' doc.
 	retAT.Token = ""
 
-	if sinceLastAccessed >= lastAccessedUpdateDuration {
+	// A token in use must have its last accessed time updated well before it
+	// becomes stale.
+	updateDuration := lastAccessedUpdateDuration
+	if stale/2 < updateDuration {
+		updateDuration = stale / 2
+	}
+	if sinceLastAccessed >= updateDuration {
 		// To save the db from being updated too frequently, we only update the
-		// LastAccessTime if it hasn't been updated within lastAccessedUpdateDuration.
-		// TODO: Make this duration configurable.
+		// LastAccessTime if it hasn't been updated within updateDuration.
 		_, err = r.writer.DoTx(
 			ctx,
 			db.StdRetryCnt,
@@ -271,10 +317,11 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
-// ListAuthTokens in an org and supports the WithLimit option.
-func (r *Repository) ListAuthTokens(ctx context.Context, withOrgId string, opt ...Option) ([]*AuthToken, error) {
-	if withOrgId == "" {
-		return nil, fmt.Errorf("list users: missing org id %w", db.ErrInvalidParameter)
+// ListAuthTokens in the scopes ordered by create time and supports the
+// WithLimit and WithStartPageAfter options.
+func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
+	if len(withScopeIds) == 0 {
+		return nil, fmt.Errorf("list users: missing scope id %w", db.ErrInvalidParameter)
 	}
 	opts := getOpts(opt...)
 	limit := r.defaultLimit
@@ -283,7 +330,7 @@ func (r *Repository) ListAuthTokens(ctx context.Context, withOrgId string, opt .
 		limit = opts.withLimit
 	}
 	var authTokens []*AuthToken
-	if err := r.reader.SearchWhere(ctx, &authTokens, "auth_account_id in (select public_id from auth_account where scope_id = ?)", []interface{}{withOrgId}, db.WithLimit(limit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &authTokens, "auth_account_id in (select public_id from auth_account where scope_id in (?))", []interface{}{withScopeIds}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter)); err != nil {
 		return nil, fmt.Errorf("list users: %w", err)
 	}
 	for _, at := range authTokens {
@@ -335,9 +382,86 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 	return rowsDeleted, nil
 }
 
+// RewrapAuthTokens re-encrypts the value of every auth token that is not
+// encrypted with the current database key version of its scope under the
+// current version. Returns the number of auth tokens that were rewrapped.
+// Supports the WithLimit option, which sets the number of auth tokens read
+// from the database at a time.
+func (r *Repository) RewrapAuthTokens(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit > 0 {
+		limit = opts.withLimit
+	}
+
+	var lastId string
+	return r.kms.RewrapDatabaseValues(ctx, "auth tokens", func() ([]kms.Rewrappable, error) {
+		rows, err := r.reader.Query(ctx, authTokensToRewrapQuery, []interface{}{lastId, limit})
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		var values []kms.Rewrappable
+		for rows.Next() {
+			at := allocAuthToken()
+			var scopeId string
+			if err := rows.Scan(&at.PublicId, &at.CtToken, &at.KeyId, &scopeId); err != nil {
+				return nil, err
+			}
+			lastId = at.PublicId
+			oldKeyId := at.KeyId
+			values = append(values, kms.Rewrappable{
+				Id:      at.PublicId,
+				ScopeId: scopeId,
+				KeyId:   oldKeyId,
+				Rewrap: func(ctx context.Context, databaseWrapper wrapping.Wrapper) (int, error) {
+					if err := at.decrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					wat := at.toWritableAuthToken()
+					if err := wat.encrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					// tokens are not replicated, so they don't need oplog entries.
+					return r.writer.Exec(ctx, rewrapAuthTokenQuery, []interface{}{wat.CtToken, wat.KeyId, wat.PublicId, oldKeyId})
+				},
+			})
+		}
+		return values, rows.Err()
+	})
+}
+
 func allocAuthToken() *AuthToken {
 	fresh := &AuthToken{
 		AuthToken: &store.AuthToken{},
 	}
 	return fresh
 }
+
+// tokenDurations returns the maximum lifetime and idle timeout of the auth
+// tokens issued by authMethodId. The settings of the repository are used for
+// the settings the auth method does not set.
+func (r *Repository) tokenDurations(ctx context.Context, reader db.Reader, authMethodId string) (time.Duration, time.Duration, error) {
+	ttl, stale := r.timeToLiveDuration, r.timeToStaleDuration
+	rows, err := reader.Query(ctx, authMethodTokenSettingsQuery, []interface{}{authMethodId})
+	if err != nil {
+		return 0, 0, fmt.Errorf("token durations: %w", err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		var maxDuration, maxIdle int64
+		if err := rows.Scan(&maxDuration, &maxIdle); err != nil {
+			return 0, 0, fmt.Errorf("token durations: %w", err)
+		}
+		if maxDuration > 0 {
+			ttl = time.Duration(maxDuration) * time.Second
+		}
+		if maxIdle > 0 {
+			stale = time.Duration(maxIdle) * time.Second
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, fmt.Errorf("token durations: %w", err)
+	}
+	return ttl, stale, nil
+}
@@ -417,9 +417,6 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	wrapper := db.TestWrapper(t)
 	kms := kms.TestKms(t, conn, wrapper)
 	iamRepo := iam.TestRepo(t, conn, wrapper)
-	repo, err := NewRepository(rw, rw, kms)
-	require.NoError(t, err)
-	require.NotNil(t, repo)
 
 	org, _ := iam.TestScopes(t, iamRepo)
 	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
@@ -431,9 +428,6 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, iamUser)
 
-	defaultStaleTime := maxStaleness
-	defaultExpireDuration := maxTokenDuration
-
 	var tests = []struct {
 		name               string
 		staleDuration      time.Duration
@@ -441,21 +435,17 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 		wantReturned       bool
 	}{
 		{
-			name:               "not-stale-or-expired",
-			staleDuration:      maxStaleness,
-			expirationDuration: maxTokenDuration,
-			wantReturned:       true,
+			name:         "not-stale-or-expired",
+			wantReturned: true,
 		},
 		{
-			name:               "stale",
-			staleDuration:      0,
-			expirationDuration: maxTokenDuration,
-			wantReturned:       false,
+			name:          "stale",
+			staleDuration: time.Nanosecond,
+			wantReturned:  false,
 		},
 		{
 			name:               "expired",
-			staleDuration:      maxStaleness,
-			expirationDuration: 0,
+			expirationDuration: time.Nanosecond,
 			wantReturned:       false,
 		},
 	}
@@ -464,8 +454,11 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			maxStaleness = tt.staleDuration
-			maxTokenDuration = tt.expirationDuration
+			repo, err := NewRepository(rw, rw, kms,
+				WithTokenTimeToLiveDuration(tt.expirationDuration),
+				WithTokenTimeToStaleDuration(tt.staleDuration))
+			require.NoError(err)
+			require.NotNil(repo)
 			timeSkew = 20 * time.Millisecond
 
 			ctx := context.Background()
@@ -482,14 +475,77 @@ func TestRepository_ValidateToken_expired(t *testing.T) {
 				assert.Error(db.TestVerifyOplog(t, rw, at.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_DELETE)))
 				assert.Nil(got)
 			}
-
-			// reset the system default params
-			maxStaleness = defaultStaleTime
-			maxTokenDuration = defaultExpireDuration
 		})
 	}
 }
 
+func TestRepository_AuthMethodTokenSettings(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	baseAT := TestAuthToken(t, conn, kms, org.GetPublicId())
+	aAcct := allocAuthAccount()
+	aAcct.PublicId = baseAT.GetAuthAccountId()
+	require.NoError(t, rw.LookupByPublicId(ctx, aAcct))
+	iamUser, _, err := iamRepo.LookupUser(ctx, aAcct.GetIamUserId())
+	require.NoError(t, err)
+
+	repo, err := NewRepository(rw, rw, kms, WithTokenTimeToLiveDuration(time.Hour))
+	require.NoError(t, err)
+
+	setSettings := func(t *testing.T, maxDuration, maxIdle int) {
+		t.Helper()
+		_, err := rw.Exec(ctx,
+			"update auth_password_method set max_token_duration_seconds = $1, max_token_idle_seconds = $2 where public_id = $3",
+			[]interface{}{maxDuration, maxIdle, aAcct.GetAuthMethodId()})
+		require.NoError(t, err)
+	}
+	expiresIn := func(t *testing.T, at *AuthToken) time.Duration {
+		t.Helper()
+		exp, err := ptypes.Timestamp(at.GetExpirationTime().GetTimestamp())
+		require.NoError(t, err)
+		return time.Until(exp)
+	}
+
+	t.Run("repository-setting", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		setSettings(t, 0, 0)
+		at, err := repo.CreateAuthToken(ctx, iamUser, aAcct.GetPublicId())
+		require.NoError(err)
+		assert.InDelta(time.Hour, expiresIn(t, at), float64(2*time.Second))
+	})
+
+	t.Run("auth-method-setting", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		setSettings(t, 600, 0)
+		at, err := repo.CreateAuthToken(ctx, iamUser, aAcct.GetPublicId())
+		require.NoError(err)
+		assert.InDelta(10*time.Minute, expiresIn(t, at), float64(2*time.Second))
+
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+
+	t.Run("tightened-after-issue", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		setSettings(t, 0, 0)
+		at, err := repo.CreateAuthToken(ctx, iamUser, aAcct.GetPublicId())
+		require.NoError(err)
+
+		setSettings(t, 1, 0)
+		time.Sleep(1100 * time.Millisecond)
+		got, err := repo.ValidateToken(ctx, at.GetPublicId(), at.GetToken())
+		require.NoError(err)
+		assert.Nil(got)
+	})
+}
+
 func TestRepository_DeleteAuthToken(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -569,24 +625,23 @@ func TestRepository_ListAuthTokens(t *testing.T) {
 	emptyOrg, _ := iam.TestScopes(t, repo)
 
 	var tests = []struct {
-		name    string
-		orgId   string
-		want    []*AuthToken
-		wantErr error
+		name     string
+		scopeIds []string
+		want     []*AuthToken
+		wantErr  error
 	}{
 		{
-			name:  "populated",
-			orgId: org.GetPublicId(),
-			want:  []*AuthToken{at1, at2, at3},
+			name:     "populated",
+			scopeIds: []string{org.GetPublicId()},
+			want:     []*AuthToken{at1, at2, at3},
 		},
 		{
-			name:  "empty",
-			orgId: emptyOrg.GetPublicId(),
-			want:  []*AuthToken{},
+			name:     "empty",
+			scopeIds: []string{emptyOrg.GetPublicId()},
+			want:     []*AuthToken{},
 		},
 		{
-			name:    "empty-org-id",
-			orgId:   "",
+			name:    "empty-scope-ids",
 			want:    nil,
 			wantErr: db.ErrInvalidParameter,
 		},
@@ -600,7 +655,7 @@ func TestRepository_ListAuthTokens(t *testing.T) {
 			require.NoError(err)
 			require.NotNil(repo)
 
-			got, err := repo.ListAuthTokens(context.Background(), tt.orgId)
+			got, err := repo.ListAuthTokens(context.Background(), tt.scopeIds)
 			if tt.wantErr != nil {
 				assert.Truef(errors.Is(err, tt.wantErr), "want err: %q got: %q", tt.wantErr, err)
 				return
@@ -612,3 +667,44 @@ func TestRepository_ListAuthTokens(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_RewrapAuthTokens(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	at1 := TestAuthToken(t, conn, kms, org.GetPublicId())
+	at2 := TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	// Tokens written with the current key version are left alone
+	rewrapped, err := repo.RewrapAuthTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, rewrapped)
+
+	require.NoError(kms.RotateKeys(ctx, org.GetPublicId()))
+
+	rewrapped, err = repo.RewrapAuthTokens(ctx, WithLimit(1))
+	require.NoError(err)
+	assert.Equal(2, rewrapped)
+
+	rewrapped, err = repo.RewrapAuthTokens(ctx)
+	require.NoError(err)
+	assert.Equal(0, rewrapped)
+
+	for _, at := range []*AuthToken{at1, at2} {
+		stored := allocAuthToken()
+		stored.PublicId = at.GetPublicId()
+		require.NoError(rw.LookupByPublicId(ctx, stored))
+		assert.NotEqual(at.GetKeyId(), stored.GetKeyId())
+
+		got, err := repo.LookupAuthToken(ctx, at.GetPublicId(), withTokenValue())
+		require.NoError(err)
+		assert.Equal(at.GetToken(), got.GetToken())
+	}
+}
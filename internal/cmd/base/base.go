@@ -71,6 +71,9 @@ type Command struct {
 	FlagAuthMethodId  string
 	FlagHostCatalogId string
 	FlagVersion       int
+	FlagFilter        string
+	FlagPageSize      uint
+	FlagRecursive     bool
 
 	client *api.Client
 }
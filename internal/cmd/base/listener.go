@@ -70,6 +70,8 @@ func tcpListenerFactory(l *configutil.Listener, logger hclog.Logger, ui cli.Ui)
 			l.Address = "127.0.0.1:9201"
 		case "proxy":
 			l.Address = "127.0.0.1:9202"
+		case "ops":
+			l.Address = "127.0.0.1:9203"
 		default:
 			l.Address = "127.0.0.1:9200"
 		}
@@ -83,6 +85,8 @@ func tcpListenerFactory(l *configutil.Listener, logger hclog.Logger, ui cli.Ui)
 				port = "9201"
 			case "proxy":
 				port = "9202"
+			case "ops":
+				port = "9203"
 			default:
 				port = "9200"
 			}
@@ -0,0 +1,91 @@
+package base
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// OpsHandler returns the handler served on listeners with the "ops" purpose.
+// It exposes metrics in the Prometheus exposition format at /metrics.
+func (b *Server) OpsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if !b.PrometheusEnabled {
+			http.Error(w, "prometheus metrics are disabled; set telemetry.prometheus_retention_time to enable them", http.StatusNotFound)
+			return
+		}
+		promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{
+			ErrorLog:      b.Logger.Named("metrics").StandardLogger(nil),
+			ErrorHandling: promhttp.ContinueOnError,
+		}).ServeHTTP(w, r)
+	})
+	return mux
+}
+
+// StartOpsListeners starts serving the ops handler on any listeners with the
+// "ops" purpose. The servers are shut down along with the other listeners
+// when the controller or worker stops.
+func (b *Server) StartOpsListeners() error {
+	handler := b.OpsHandler()
+	for _, ln := range b.Listeners {
+		var isOps bool
+		for _, purpose := range ln.Config.Purpose {
+			if purpose == "ops" {
+				isOps = true
+			}
+		}
+		if !isOps {
+			continue
+		}
+
+		server := &http.Server{
+			Handler:           handler,
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			IdleTimeout:       5 * time.Minute,
+			ErrorLog:          b.Logger.StandardLogger(nil),
+		}
+		ln.HTTPServer = server
+
+		if ln.Config.HTTPReadHeaderTimeout > 0 {
+			server.ReadHeaderTimeout = ln.Config.HTTPReadHeaderTimeout
+		}
+		if ln.Config.HTTPReadTimeout > 0 {
+			server.ReadTimeout = ln.Config.HTTPReadTimeout
+		}
+		if ln.Config.HTTPWriteTimeout > 0 {
+			server.WriteTimeout = ln.Config.HTTPWriteTimeout
+		}
+		if ln.Config.HTTPIdleTimeout > 0 {
+			server.IdleTimeout = ln.Config.HTTPIdleTimeout
+		}
+
+		switch ln.Config.TLSDisable {
+		case true:
+			l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+			if err != nil {
+				return fmt.Errorf("error getting non-tls ops listener: %w", err)
+			}
+			if l == nil {
+				return errors.New("could not get non-tls ops listener")
+			}
+			go server.Serve(l)
+
+		default:
+			for _, v := range []string{"", "http/1.1", "h2"} {
+				l := ln.Mux.GetListener(v)
+				if l == nil {
+					return fmt.Errorf("could not get tls proto %q ops listener", v)
+				}
+				go server.Serve(l)
+			}
+		}
+	}
+	return nil
+}
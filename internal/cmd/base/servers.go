@@ -442,6 +442,27 @@ func (b *Server) ConnectToDatabase(dialect string) error {
 	return nil
 }
 
+// VerifyDatabaseSchema returns an error if the database schema is not at the
+// version of the migrations included in this binary, as controllers must not
+// run against a schema they weren't built for.
+func (b *Server) VerifyDatabaseSchema(ctx context.Context, dialect string) error {
+	state, err := db.GetSchemaState(ctx, dialect, b.Database.DB())
+	if err != nil {
+		return fmt.Errorf("unable to check database schema version: %w", err)
+	}
+	switch {
+	case !state.Initialized:
+		return errors.New(`database has not been initialized; run "boundary database init"`)
+	case state.Dirty:
+		return fmt.Errorf("database schema migration %d did not complete and must be repaired before starting the controller: %w", state.CurrentVersion, db.ErrSchemaDirty)
+	case state.TooNew():
+		return fmt.Errorf("database schema is at version %d but the newest migration in this version of Boundary is %d; upgrade Boundary before starting the controller: %w", state.CurrentVersion, state.LatestVersion, db.ErrSchemaTooNew)
+	case len(state.Pending) > 0:
+		return fmt.Errorf(`database schema is at version %d but this version of Boundary requires version %d; run "boundary database migrate" before starting the controller`, state.CurrentVersion, state.LatestVersion)
+	}
+	return nil
+}
+
 func (b *Server) CreateDevDatabase(dialect string, opt ...Option) error {
 	opts := getOpts(opt...)
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostcatalogs"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hosts"
 	"github.com/hashicorp/boundary/internal/cmd/commands/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/commands/jobs"
 	"github.com/hashicorp/boundary/internal/cmd/commands/roles"
 	"github.com/hashicorp/boundary/internal/cmd/commands/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/commands/server"
@@ -70,6 +71,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"authenticate oidc": func() (cli.Command, error) {
+			return &authenticate.OidcCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"authenticate ldap": func() (cli.Command, error) {
+			return &authenticate.LdapCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"accounts": func() (cli.Command, error) {
 			return &accounts.Command{
@@ -106,6 +117,24 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}, nil
 		},
+		"accounts unlock": func() (cli.Command, error) {
+			return &accounts.Command{
+				Command: base.NewCommand(ui),
+				Func:    "unlock",
+			}, nil
+		},
+		"accounts enroll-totp": func() (cli.Command, error) {
+			return &accounts.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enroll-totp",
+			}, nil
+		},
+		"accounts verify-totp": func() (cli.Command, error) {
+			return &accounts.Command{
+				Command: base.NewCommand(ui),
+				Func:    "verify-totp",
+			}, nil
+		},
 		"accounts create": func() (cli.Command, error) {
 			return &accounts.Command{
 				Command: base.NewCommand(ui),
@@ -286,6 +315,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"database migrate": func() (cli.Command, error) {
+			return &database.MigrateCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"groups": func() (cli.Command, error) {
 			return &groups.Command{
@@ -376,6 +410,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-catalogs create dns": func() (cli.Command, error) {
+			return &hostcatalogs.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"host-catalogs update": func() (cli.Command, error) {
 			return &hostcatalogs.Command{
 				Command: base.NewCommand(ui),
@@ -388,6 +428,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-catalogs update dns": func() (cli.Command, error) {
+			return &hostcatalogs.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"host-sets": func() (cli.Command, error) {
 			return &hostsets.Command{
@@ -424,6 +470,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"host-sets create dns": func() (cli.Command, error) {
+			return &hostsets.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"host-sets update": func() (cli.Command, error) {
 			return &hostsets.Command{
 				Command: base.NewCommand(ui),
@@ -436,6 +488,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"host-sets update dns": func() (cli.Command, error) {
+			return &hostsets.DnsCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"host-sets add-hosts": func() (cli.Command, error) {
 			return &hostsets.Command{
 				Command: base.NewCommand(ui),
@@ -503,6 +561,30 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"jobs": func() (cli.Command, error) {
+			return &jobs.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"jobs read": func() (cli.Command, error) {
+			return &jobs.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"jobs list": func() (cli.Command, error) {
+			return &jobs.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"jobs run": func() (cli.Command, error) {
+			return &jobs.Command{
+				Command: base.NewCommand(ui),
+				Func:    "run",
+			}, nil
+		},
+
 		"roles": func() (cli.Command, error) {
 			return &roles.Command{
 				Command: base.NewCommand(ui),
@@ -574,6 +656,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-grants",
 			}, nil
 		},
+		"roles explain": func() (cli.Command, error) {
+			return &roles.ExplainCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"scopes": func() (cli.Command, error) {
 			return &scopes.Command{
@@ -610,6 +697,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"scopes rotate-keys": func() (cli.Command, error) {
+			return &scopes.Command{
+				Command: base.NewCommand(ui),
+				Func:    "rotate-keys",
+			}, nil
+		},
+		"scopes rewrap-keys": func() (cli.Command, error) {
+			return &scopes.Command{
+				Command: base.NewCommand(ui),
+				Func:    "rewrap-keys",
+			}, nil
+		},
 
 		"sessions": func() (cli.Command, error) {
 			return &sessions.Command{
@@ -634,6 +733,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions download-recording": func() (cli.Command, error) {
+			return &sessions.DownloadRecordingCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"sessions replay-recording": func() (cli.Command, error) {
+			return &sessions.ReplayRecordingCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targets.Command{
@@ -760,6 +869,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-accounts",
 			}, nil
 		},
+		"users effective-grants": func() (cli.Command, error) {
+			return &users.EffectiveGrantsCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
 	}
 }
 
@@ -28,6 +28,7 @@ type Command struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
 }
 
 func (c *Command) Synopsis() string {
@@ -36,6 +37,12 @@ func (c *Command) Synopsis() string {
 		return "Directly set the password on an account resource"
 	case "change-password":
 		return "Change the password on an account resource"
+	case "unlock":
+		return "Unlock an account resource locked after failed authentication attempts"
+	case "enroll-totp":
+		return "Start a TOTP enrollment for an account resource"
+	case "verify-totp":
+		return "Verify the TOTP enrollment of an account resource"
 	default:
 		return common.SynopsisFunc(c.Func, "account")
 	}
@@ -44,9 +51,12 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":            {"id"},
 	"delete":          {"id"},
-	"list":            {"auth-method-id"},
+	"list":            {"auth-method-id", "filter", "page-size"},
 	"set-password":    {"id", "password", "version"},
 	"change-password": {"id", "current-password", "new-password", "version"},
+	"unlock":          {"id"},
+	"enroll-totp":     {"id"},
+	"verify-totp":     {"id", "code"},
 }
 
 func (c *Command) Help() string {
@@ -113,6 +123,42 @@ func (c *Command) Help() string {
 			"",
 			"",
 		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command clears the failed authentication attempts recorded for an account, unlocking it if it was locked out by its auth method's lockout threshold. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id apw_1234567890`,
+			"",
+			"",
+		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command starts a TOTP enrollment for a password-type account and outputs a provisioning URI to add to an authenticator app. The enrollment must be verified with the verify-totp command before it is used as the second factor of the account. Example:",
+			"",
+			"    Enroll a password-type account:",
+			"",
+			`      $ boundary accounts enroll-totp -id apw_1234567890`,
+			"",
+			"",
+		})
+	case "verify-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts verify-totp [options] [args]",
+			"",
+			"  This command verifies the TOTP enrollment of a password-type account with a code from the authenticator app. The enrollment becomes the second factor of the account and recovery codes are output, each of which can be used once in place of a TOTP code. The recovery codes are not shown again. Example:",
+			"",
+			"    Verify the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts verify-totp -id apw_1234567890 -code 123456`,
+			"",
+			"",
+		})
 	default:
 		helpStr = helpMap[c.Func]()
 	}
@@ -148,6 +194,12 @@ func (c *Command) Flags() *base.FlagSets {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "The current code of the authenticator app.",
+			})
 		}
 	}
 
@@ -183,6 +235,10 @@ func (c *Command) Run(args []string) int {
 		c.UI.Error("Auth Method ID must be passed in via -auth-method-id")
 		return 1
 	}
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		c.UI.Error("Code is required but not passed in via -code")
+		return 1
+	}
 
 	client, err := c.Client()
 	if err != nil {
@@ -192,6 +248,13 @@ func (c *Command) Run(args []string) int {
 
 	var opts []accounts.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, accounts.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, accounts.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -244,7 +307,7 @@ func (c *Command) Run(args []string) int {
 	// Perform check-and-set when needed
 	var version uint32
 	switch c.Func {
-	case "create":
+	case "create", "unlock", "enroll-totp", "verify-totp":
 		// These don't update so don't need the existing version
 	default:
 		switch c.FlagVersion {
@@ -260,6 +323,8 @@ func (c *Command) Run(args []string) int {
 	existed := true
 	var result api.GenericResult
 	var listResult api.GenericListResult
+	var enrollResult *accounts.EnrollTotpResult
+	var verifyResult *accounts.VerifyTotpResult
 
 	switch c.Func {
 	case "read":
@@ -276,6 +341,12 @@ func (c *Command) Run(args []string) int {
 		result, err = accountClient.SetPassword(c.Context, c.FlagId, c.flagPassword, version, opts...)
 	case "change-password":
 		result, err = accountClient.ChangePassword(c.Context, c.FlagId, c.flagCurrentPassword, c.flagNewPassword, version, opts...)
+	case "unlock":
+		result, err = accountClient.Unlock(c.Context, c.FlagId, opts...)
+	case "enroll-totp":
+		enrollResult, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+	case "verify-totp":
+		verifyResult, err = accountClient.VerifyTotp(c.Context, c.FlagId, c.flagCode, opts...)
 	}
 
 	plural := "account"
@@ -358,6 +429,51 @@ func (c *Command) Run(args []string) int {
 			c.UI.Output(base.WrapForHelpText(output))
 		}
 		return 0
+
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "json":
+			b, err := base.JsonFormatter{}.Format(enrollResult)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+				return 1
+			}
+			c.UI.Output(string(b))
+		case "table":
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"TOTP enrollment information:",
+				fmt.Sprintf("  Provisioning URI:  %s", enrollResult.ProvisioningUri),
+				"",
+				"Add the provisioning URI to an authenticator app and verify the enrollment with the verify-totp command.",
+			}))
+		}
+		return 0
+
+	case "verify-totp":
+		switch base.Format(c.UI) {
+		case "json":
+			b, err := base.JsonFormatter{}.Format(verifyResult)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+				return 1
+			}
+			c.UI.Output(string(b))
+		case "table":
+			output := []string{
+				"",
+				"Recovery codes:",
+			}
+			for _, code := range verifyResult.RecoveryCodes {
+				output = append(output, fmt.Sprintf("  %s", code))
+			}
+			output = append(output,
+				"",
+				"Each recovery code can be used once in place of a TOTP code. Store them safely; they are not shown again.",
+			)
+			c.UI.Output(base.WrapForHelpText(output))
+		}
+		return 0
 	}
 
 	account := result.GetItem().(*accounts.Account)
@@ -1,9 +1,16 @@
 package authenticate
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api/authtokens"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
+	"github.com/zalando/go-keyring"
 )
 
 var _ cli.Command = (*Command)(nil)
@@ -26,6 +33,14 @@ func (c *Command) Help() string {
 		"",
 		"      $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password \"bar\"",
 		"",
+		"    Authenticate with OIDC auth method:",
+		"",
+		"      $ boundary authenticate oidc -auth-method-id amoidc_1234567890",
+		"",
+		"    Authenticate with LDAP auth method:",
+		"",
+		"      $ boundary authenticate ldap -auth-method-id amldap_1234567890 -login-name foo -password \"bar\"",
+		"",
 		"  Please see the auth method subcommand help for detailed usage information.",
 	})
 }
@@ -33,3 +48,48 @@ func (c *Command) Help() string {
 func (c *Command) Run(args []string) int {
 	return cli.RunResultHelp
 }
+
+// saveAndOutputToken prints token in the requested format and stores it in
+// the system credential store under the token name, unless the token name is
+// "none".
+func saveAndOutputToken(c *base.Command, token *authtokens.AuthToken) int {
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(base.WrapForHelpText([]string{
+			"",
+			"Authentication information:",
+			fmt.Sprintf("  Account ID:      %s", token.AccountId),
+			fmt.Sprintf("  Auth Method ID:  %s", token.AuthMethodId),
+			fmt.Sprintf("  Expiration Time: %s", token.ExpirationTime.Local().Format(time.RFC1123)),
+			fmt.Sprintf("  Token:           %s", token.Token),
+			fmt.Sprintf("  User ID:         %s", token.UserId),
+		}))
+
+	case "json":
+		jsonOut, err := base.JsonFormatter{}.Format(token)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(jsonOut))
+	}
+
+	tokenName := "default"
+	if c.FlagTokenName != "" {
+		tokenName = c.FlagTokenName
+	}
+	if tokenName != "none" {
+		marshaled, err := json.Marshal(token)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshaling auth token to save to system credential store: %s", err))
+			return 1
+		}
+		// TODO: potentially look for dbus-launch in advance and don't issue a warning at all
+		if err := keyring.Set("HashiCorp Boundary Auth Token", tokenName, base64.RawStdEncoding.EncodeToString(marshaled)); err != nil {
+			c.UI.Error(fmt.Sprintf("Error saving auth token to system credential store: %s", err))
+			c.UI.Warn("The token printed above must be manually passed in via the BOUNDARY_TOKEN env var or -token flag. Storing the token can also be disabled via -token-name=none.")
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,214 @@
+package authenticate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/api/authtokens"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*OidcCommand)(nil)
+var _ cli.CommandAutocomplete = (*OidcCommand)(nil)
+
+const oidcCallbackPath = "/oidc/callback"
+
+type OidcCommand struct {
+	*base.Command
+
+	flagCallbackPort int
+	flagSkipBrowser  bool
+}
+
+func (c *OidcCommand) Synopsis() string {
+	return wordwrap.WrapString("Invoke the OIDC auth method to authenticate with Boundary", base.TermWidth)
+}
+
+func (c *OidcCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary authenticate oidc [options] [args]",
+		"",
+		"  Invoke the OIDC auth method to authenticate the Boundary CLI. A browser is opened to the",
+		"  provider's login page and the provider redirects back to a listener on the local callback port:",
+		"",
+		`    $ boundary authenticate oidc -auth-method-id amoidc_1234567890`,
+		"",
+		"  The redirect URL http://localhost:<callback-port>" + oidcCallbackPath + " must be allowed by the OIDC client",
+		"  registered with the provider.",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *OidcCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "auth-method-id",
+		EnvVar: envAuthMethodId,
+		Target: &c.FlagAuthMethodId,
+		Usage:  "The auth-method resource to use for the operation",
+	})
+
+	f.IntVar(&base.IntVar{
+		Name:    "callback-port",
+		Target:  &c.flagCallbackPort,
+		Default: 8250,
+		EnvVar:  "BOUNDARY_AUTHENTICATE_OIDC_CALLBACK_PORT",
+		Usage:   "The local port to listen on for the provider's redirect after logging in",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "skip-browser",
+		Target: &c.flagSkipBrowser,
+		Usage:  "If set, the login URL is printed but a browser is not opened",
+	})
+
+	return set
+}
+
+func (c *OidcCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *OidcCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *OidcCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.FlagAuthMethodId == "" {
+		c.UI.Error("Auth method ID must be provided via -auth-method-id")
+		return 1
+	}
+
+	client, err := c.Client(base.WithNoTokenScope(), base.WithNoTokenValue())
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+	amClient := authmethods.NewClient(client)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", c.flagCallbackPort))
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error listening on callback port %d: %s", c.flagCallbackPort, err))
+		return 2
+	}
+	defer listener.Close()
+	redirectUrl := fmt.Sprintf("http://localhost:%d%s", listener.Addr().(*net.TCPAddr).Port, oidcCallbackPath)
+
+	start, err := amClient.StartAuthentication(c.Context, c.FlagAuthMethodId, redirectUrl)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when starting authentication: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to start authentication: %s", err.Error()))
+		return 2
+	}
+
+	creds, err := c.waitForCallback(listener, start.AuthUrl)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error waiting for the provider's redirect: %s", err))
+		return 2
+	}
+
+	// note: Authenticate() calls SetToken() under the hood to set the
+	// auth bearer on the client so we do not need to do anything with the
+	// returned token after this call, so we ignore it
+	result, err := amClient.Authenticate(c.Context, c.FlagAuthMethodId, creds)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing authentication: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to perform authentication: %s", err.Error()))
+		return 2
+	}
+
+	return saveAndOutputToken(c.Command, result.GetItem().(*authtokens.AuthToken))
+}
+
+// waitForCallback directs the user to authUrl and serves the callback on
+// listener until the provider redirects back. The code and state from the
+// redirect are returned as credentials for Authenticate.
+func (c *OidcCommand) waitForCallback(listener net.Listener, authUrl string) (map[string]interface{}, error) {
+	type result struct {
+		creds map[string]interface{}
+		err   error
+	}
+	done := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcCallbackPath, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res result
+		switch {
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("provider returned error %q: %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "" || q.Get("state") == "":
+			res.err = fmt.Errorf("provider redirect is missing the code or state")
+		default:
+			res.creds = map[string]interface{}{
+				"code":  q.Get("code"),
+				"state": q.Get("state"),
+			}
+		}
+		if res.err != nil {
+			http.Error(w, "Authentication failed. You can close this window and return to the terminal.", http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Authentication details received. You can close this window and return to the terminal.")
+		}
+		select {
+		case done <- res:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux}
+	go srv.Serve(listener)
+	defer srv.Shutdown(context.Background())
+
+	c.UI.Output(fmt.Sprintf("Complete the login via your OIDC provider. If a browser does not open, visit the following URL:\n\n    %s\n", authUrl))
+	if !c.flagSkipBrowser {
+		if err := openUrl(authUrl); err != nil {
+			c.UI.Warn(fmt.Sprintf("Unable to open a browser: %s", err))
+		}
+	}
+
+	select {
+	case res := <-done:
+		return res.creds, res.err
+	case <-c.Context.Done():
+		return nil, c.Context.Err()
+	}
+}
+
+// openUrl opens u in the user's default browser.
+func openUrl(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
@@ -1,12 +1,9 @@
 package authenticate
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
@@ -16,7 +13,6 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
-	"github.com/zalando/go-keyring"
 )
 
 var _ cli.Command = (*PasswordCommand)(nil)
@@ -24,13 +20,18 @@ var _ cli.CommandAutocomplete = (*PasswordCommand)(nil)
 
 var envPassword = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
 var envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+var envNewPassword = "BOUNDARY_AUTHENTICATE_PASSWORD_NEW_PASSWORD"
+var envTotpCode = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP_CODE"
 var envAuthMethodId = "BOUNDARY_AUTHENTICATE_AUTH_METHOD_ID"
 
 type PasswordCommand struct {
 	*base.Command
 
-	flagLoginName string
-	flagPassword  string
+	flagLoginName    string
+	flagPassword     string
+	flagNewPassword  string
+	flagTotpCode     string
+	flagRecoveryCode string
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -67,6 +68,26 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "new-password",
+		Target: &c.flagNewPassword,
+		EnvVar: envNewPassword,
+		Usage:  "If set, the password of the account is changed to this value when authenticating. Required if the password has expired.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "totp-code",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "The current code of the authenticator app. Required if the account is enrolled for TOTP or the auth method requires a second factor.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "recovery-code",
+		Target: &c.flagRecoveryCode,
+		Usage:  "A recovery code of the account, which can be used once in place of -totp-code.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -122,11 +143,20 @@ func (c *PasswordCommand) Run(args []string) int {
 	// note: Authenticate() calls SetToken() under the hood to set the
 	// auth bearer on the client so we do not need to do anything with the
 	// returned token after this call, so we ignore it
-	result, err := authmethods.NewClient(client).Authenticate(c.Context, c.FlagAuthMethodId,
-		map[string]interface{}{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	credentials := map[string]interface{}{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagNewPassword != "" {
+		credentials["new_password"] = c.flagNewPassword
+	}
+	if c.flagTotpCode != "" {
+		credentials["totp_code"] = c.flagTotpCode
+	}
+	if c.flagRecoveryCode != "" {
+		credentials["recovery_code"] = c.flagRecoveryCode
+	}
+	result, err := authmethods.NewClient(client).Authenticate(c.Context, c.FlagAuthMethodId, credentials)
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.UI.Error(fmt.Sprintf("Error from controller when performing authentication: %s", base.PrintApiError(apiErr)))
@@ -136,44 +166,5 @@ func (c *PasswordCommand) Run(args []string) int {
 		return 2
 	}
 
-	token := result.GetItem().(*authtokens.AuthToken)
-	switch base.Format(c.UI) {
-	case "table":
-		c.UI.Output(base.WrapForHelpText([]string{
-			"",
-			"Authentication information:",
-			fmt.Sprintf("  Account ID:      %s", token.AccountId),
-			fmt.Sprintf("  Auth Method ID:  %s", token.AuthMethodId),
-			fmt.Sprintf("  Expiration Time: %s", token.ExpirationTime.Local().Format(time.RFC1123)),
-			fmt.Sprintf("  Token:           %s", token.Token),
-			fmt.Sprintf("  User ID:         %s", token.UserId),
-		}))
-
-	case "json":
-		jsonOut, err := base.JsonFormatter{}.Format(token)
-		if err != nil {
-			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
-			return 1
-		}
-		c.UI.Output(string(jsonOut))
-	}
-
-	tokenName := "default"
-	if c.Command.FlagTokenName != "" {
-		tokenName = c.Command.FlagTokenName
-	}
-	if tokenName != "none" {
-		marshaled, err := json.Marshal(token)
-		if err != nil {
-			c.UI.Error(fmt.Sprintf("Error marshaling auth token to save to system credential store: %s", err))
-			return 1
-		}
-		// TODO: potentially look for dbus-launch in advance and don't issue a warning at all
-		if err := keyring.Set("HashiCorp Boundary Auth Token", tokenName, base64.RawStdEncoding.EncodeToString(marshaled)); err != nil {
-			c.UI.Error(fmt.Sprintf("Error saving auth token to system credential store: %s", err))
-			c.UI.Warn("The token printed above must be manually passed in via the BOUNDARY_TOKEN env var or -token flag. Storing the token can also be disabled via -token-name=none.")
-		}
-	}
-
-	return 0
+	return saveAndOutputToken(c.Command, result.GetItem().(*authtokens.AuthToken))
 }
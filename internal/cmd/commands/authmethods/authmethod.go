@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -129,6 +129,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []authmethods.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, authmethods.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, authmethods.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, authmethods.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -18,6 +18,51 @@ func addPasswordFlags(c *PasswordCommand, f *base.FlagSet) {
 		Target: &c.flagMinPasswordLength,
 		Usage:  "The minimum length of passwords",
 	})
+	f.StringVar(&base.StringVar{
+		Name:   "lockout-threshold",
+		Target: &c.flagLockoutThreshold,
+		Usage:  "The number of consecutive failed authentication attempts after which an account is locked. Zero disables lockout.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "lockout-duration",
+		Target: &c.flagLockoutDuration,
+		Usage:  `How long a locked account stays locked, e.g. "15m". Zero keeps an account locked until it is unlocked with "boundary accounts unlock".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "min-character-classes",
+		Target: &c.flagMinCharacterClasses,
+		Usage:  "The number of character classes (lowercase letters, uppercase letters, digits and other characters) a password must contain, between 0 and 4",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "password-history-count",
+		Target: &c.flagPasswordHistoryCount,
+		Usage:  "The number of most recent passwords of an account, including its current password, which cannot be reused. Zero disables the check.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "max-password-age",
+		Target: &c.flagMaxPasswordAge,
+		Usage:  `How long a password can be used before it must be changed, e.g. "2160h". Zero disables password expiration.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "disallow-login-name",
+		Target: &c.flagDisallowLoginName,
+		Usage:  "If true, passwords which contain the login name of the account are rejected",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "mfa-required",
+		Target: &c.flagMfaRequired,
+		Usage:  "If true, every account must authenticate with a TOTP code or a recovery code in addition to its password. Accounts should be enrolled with \"boundary accounts enroll-totp\" before this is set.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "max-token-duration",
+		Target: &c.flagMaxTokenDuration,
+		Usage:  `How long an auth token issued by the auth method is valid for, e.g. "8h". Zero uses the setting of the controller.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "max-token-idle",
+		Target: &c.flagMaxTokenIdle,
+		Usage:  `How long an auth token issued by the auth method can go unused before it is no longer valid, e.g. "30m". Zero uses the setting of the controller.`,
+	})
 }
 
 func generateAuthMethodTableOutput(in *authmethods.AuthMethod) string {
@@ -35,6 +80,12 @@ func generateAuthMethodTableOutput(in *authmethods.AuthMethod) string {
 	if in.Description != "" {
 		nonAttributeMap["Description"] = in.Description
 	}
+	if in.MaxTokenDurationSeconds != 0 {
+		nonAttributeMap["Max Token Duration (Seconds)"] = in.MaxTokenDurationSeconds
+	}
+	if in.MaxTokenIdleSeconds != 0 {
+		nonAttributeMap["Max Token Idle (Seconds)"] = in.MaxTokenIdleSeconds
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, in.Attributes, keySubstMap)
 
@@ -59,6 +110,13 @@ func generateAuthMethodTableOutput(in *authmethods.AuthMethod) string {
 }
 
 var keySubstMap = map[string]string{
-	"min_login_name_length": "Minimum Login Name Length",
-	"min_password_length":   "Minimum Password Length",
+	"min_login_name_length":    "Minimum Login Name Length",
+	"min_password_length":      "Minimum Password Length",
+	"lockout_threshold":        "Lockout Threshold",
+	"lockout_duration_seconds": "Lockout Duration (Seconds)",
+	"min_character_classes":    "Minimum Character Classes",
+	"password_history_count":   "Password History Count",
+	"max_password_age_seconds": "Maximum Password Age (Seconds)",
+	"disallow_login_name":      "Disallow Login Name",
+	"mfa_required":             "MFA Required",
 }
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"net/textproto"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/common"
 	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/hashicorp/vault/sdk/helper/parseutil"
 	"github.com/mitchellh/cli"
 	"github.com/posener/complete"
 )
@@ -24,6 +26,17 @@ type PasswordCommand struct {
 
 	flagMinLoginNameLength string
 	flagMinPasswordLength  string
+	flagLockoutThreshold   string
+	flagLockoutDuration    string
+
+	flagMinCharacterClasses  string
+	flagPasswordHistoryCount string
+	flagMaxPasswordAge       string
+	flagDisallowLoginName    string
+	flagMfaRequired          string
+
+	flagMaxTokenDuration string
+	flagMaxTokenIdle     string
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -128,6 +141,40 @@ func (c *PasswordCommand) Run(args []string) int {
 		opts = append(opts, authmethods.WithDescription(c.FlagDescription))
 	}
 
+	switch c.flagMaxTokenDuration {
+	case "":
+	case "null":
+		opts = append(opts, authmethods.DefaultMaxTokenDurationSeconds())
+	default:
+		duration, err := parseutil.ParseDurationSecond(c.flagMaxTokenDuration)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxTokenDuration, err))
+			return 1
+		}
+		if duration < 0 {
+			c.UI.Error(fmt.Sprintf("Maximum token duration %q must not be negative", c.flagMaxTokenDuration))
+			return 1
+		}
+		opts = append(opts, authmethods.WithMaxTokenDurationSeconds(uint32(duration/time.Second)))
+	}
+
+	switch c.flagMaxTokenIdle {
+	case "":
+	case "null":
+		opts = append(opts, authmethods.DefaultMaxTokenIdleSeconds())
+	default:
+		idle, err := parseutil.ParseDurationSecond(c.flagMaxTokenIdle)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxTokenIdle, err))
+			return 1
+		}
+		if idle < 0 {
+			c.UI.Error(fmt.Sprintf("Maximum token idle time %q must not be negative", c.flagMaxTokenIdle))
+			return 1
+		}
+		opts = append(opts, authmethods.WithMaxTokenIdleSeconds(uint32(idle/time.Second)))
+	}
+
 	var attributes map[string]interface{}
 	addAttribute := func(name string, value interface{}) {
 		if attributes == nil {
@@ -161,6 +208,105 @@ func (c *PasswordCommand) Run(args []string) int {
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	switch c.flagLockoutThreshold {
+	case "":
+	case "null":
+		addAttribute("lockout_threshold", nil)
+	default:
+		threshold, err := strconv.ParseUint(c.flagLockoutThreshold, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLockoutThreshold, err))
+			return 1
+		}
+		addAttribute("lockout_threshold", uint32(threshold))
+	}
+
+	switch c.flagLockoutDuration {
+	case "":
+	case "null":
+		addAttribute("lockout_duration_seconds", nil)
+	default:
+		duration, err := parseutil.ParseDurationSecond(c.flagLockoutDuration)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLockoutDuration, err))
+			return 1
+		}
+		if duration < 0 {
+			c.UI.Error(fmt.Sprintf("Lockout duration %q must not be negative", c.flagLockoutDuration))
+			return 1
+		}
+		addAttribute("lockout_duration_seconds", uint32(duration/time.Second))
+	}
+
+	switch c.flagMinCharacterClasses {
+	case "":
+	case "null":
+		addAttribute("min_character_classes", nil)
+	default:
+		classes, err := strconv.ParseUint(c.flagMinCharacterClasses, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMinCharacterClasses, err))
+			return 1
+		}
+		addAttribute("min_character_classes", uint32(classes))
+	}
+
+	switch c.flagPasswordHistoryCount {
+	case "":
+	case "null":
+		addAttribute("password_history_count", nil)
+	default:
+		count, err := strconv.ParseUint(c.flagPasswordHistoryCount, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagPasswordHistoryCount, err))
+			return 1
+		}
+		addAttribute("password_history_count", uint32(count))
+	}
+
+	switch c.flagMaxPasswordAge {
+	case "":
+	case "null":
+		addAttribute("max_password_age_seconds", nil)
+	default:
+		age, err := parseutil.ParseDurationSecond(c.flagMaxPasswordAge)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMaxPasswordAge, err))
+			return 1
+		}
+		if age < 0 {
+			c.UI.Error(fmt.Sprintf("Maximum password age %q must not be negative", c.flagMaxPasswordAge))
+			return 1
+		}
+		addAttribute("max_password_age_seconds", uint32(age/time.Second))
+	}
+
+	switch c.flagDisallowLoginName {
+	case "":
+	case "null":
+		addAttribute("disallow_login_name", nil)
+	default:
+		disallow, err := strconv.ParseBool(c.flagDisallowLoginName)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDisallowLoginName, err))
+			return 1
+		}
+		addAttribute("disallow_login_name", disallow)
+	}
+
+	switch c.flagMfaRequired {
+	case "":
+	case "null":
+		addAttribute("mfa_required", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagMfaRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMfaRequired, err))
+			return 1
+		}
+		addAttribute("mfa_required", required)
+	}
+
 	if attributes != nil {
 		opts = append(opts, authmethods.WithAttributes(attributes))
 	}
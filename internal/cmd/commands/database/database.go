@@ -27,6 +27,10 @@ func (c *Command) Help() string {
 		"",
 		`      $ boundary database init`,
 		"",
+		"    Migrate the database to the latest schema version:",
+		"",
+		`      $ boundary database migrate`,
+		"",
 		"  Please see the database subcommand help for detailed usage information.",
 	})
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/db"
 )
 
 type RoleInfo struct {
@@ -174,3 +175,49 @@ func generateInitialTargetTableOutput(in *TargetInfo) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+type MigrationInfo struct {
+	DryRun         bool           `json:"dry_run"`
+	CurrentVersion uint           `json:"current_version"`
+	LatestVersion  uint           `json:"latest_version"`
+	Dirty          bool           `json:"dirty"`
+	Migrations     []db.Migration `json:"migrations"`
+}
+
+func generateMigrationTableOutput(in *MigrationInfo) string {
+	nonAttributeMap := map[string]interface{}{
+		"Current Version": in.CurrentVersion,
+		"Latest Version":  in.LatestVersion,
+	}
+	if in.Dirty {
+		nonAttributeMap["Dirty"] = in.Dirty
+	}
+
+	maxLength := 0
+	for k := range nonAttributeMap {
+		if len(k) > maxLength {
+			maxLength = len(k)
+		}
+	}
+
+	ret := []string{
+		"",
+		"Database schema information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+		"",
+	}
+
+	switch {
+	case len(in.Migrations) == 0:
+		ret = append(ret, "  The database schema is up to date.")
+	case in.DryRun:
+		ret = append(ret, "  Pending migrations:")
+	default:
+		ret = append(ret, "  Applied migrations:")
+	}
+	for _, m := range in.Migrations {
+		ret = append(ret, fmt.Sprintf("    %d  %s", m.Version, m.Name))
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -0,0 +1,237 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/sdk/wrapper"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*MigrateCommand)(nil)
+var _ cli.CommandAutocomplete = (*MigrateCommand)(nil)
+
+type MigrateCommand struct {
+	*base.Command
+
+	Config *config.Config
+
+	configWrapper wrapping.Wrapper
+
+	flagConfig       string
+	flagConfigKms    string
+	flagMigrationUrl string
+	flagDryRun       bool
+}
+
+func (c *MigrateCommand) Synopsis() string {
+	return "Migrate Boundary's database to the latest schema version"
+}
+
+func (c *MigrateCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary database migrate [options]",
+		"",
+		"  Apply any migrations included in this version of Boundary that have not yet been applied to an initialized database:",
+		"",
+		"    $ boundary database migrate -config=/etc/boundary/controller.hcl",
+		"",
+		"  Controllers will not start while the database schema is behind the version included in their binary, so this should be run when upgrading Boundary, before starting the upgraded controllers. It is safe to run concurrently; only one migration runs at a time and later runs find nothing left to do.",
+		"",
+		"  To see the current and latest schema versions and the migrations that would be applied, without applying them:",
+		"",
+		"    $ boundary database migrate -config=/etc/boundary/controller.hcl -dry-run",
+		"",
+		"  For a full list of examples, please see the documentation.",
+	}) + c.Flags().Help()
+}
+
+func (c *MigrateCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	f = set.NewFlagSet("Migrate Options")
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "dry-run",
+		Target: &c.flagDryRun,
+		Usage:  "If set, reports the current and latest schema versions and lists the pending migrations without applying them.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "migration-url",
+		Target: &c.flagMigrationUrl,
+		Usage:  `If set, overrides a migration URL set in config, and specifies the URL used to connect to the database for migration. This can allow different permissions for the user running migrations vs. normal operation. This can refer to a file on disk (file://) from which a URL will be read; an env var (env://) from which the URL will be read; or a direct database URL.`,
+	})
+
+	return set
+}
+
+func (c *MigrateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *MigrateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *MigrateCommand) Run(args []string) (retCode int) {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+
+	if c.configWrapper != nil {
+		defer func() {
+			if err := c.configWrapper.Finalize(c.Context); err != nil {
+				c.UI.Warn(fmt.Errorf("Error finalizing config kms: %w", err).Error())
+			}
+		}()
+	}
+
+	if c.Config.Controller == nil || c.Config.Controller.Database == nil {
+		c.UI.Error(`"controller.database" config block not found`)
+		return 1
+	}
+
+	migrationUrlToParse := c.Config.Controller.Database.MigrationUrl
+	if c.flagMigrationUrl != "" {
+		migrationUrlToParse = c.flagMigrationUrl
+	}
+	// Fallback to using database URL for everything
+	if migrationUrlToParse == "" {
+		migrationUrlToParse = c.Config.Controller.Database.Url
+	}
+	if migrationUrlToParse == "" {
+		c.UI.Error(`"url" not specified in "database" config block"`)
+		return 1
+	}
+	migrationUrl, err := config.ParseAddress(migrationUrlToParse)
+	if err != nil && err != config.ErrNotAUrl {
+		c.UI.Error(fmt.Errorf("Error parsing migration url: %w", err).Error())
+		return 1
+	}
+	migrationUrl = strings.TrimSpace(migrationUrl)
+
+	var state *db.SchemaState
+	if c.flagDryRun {
+		ldb, err := sql.Open("postgres", migrationUrl)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error opening database to check schema version: %w", err).Error())
+			return 1
+		}
+		defer ldb.Close()
+		state, err = db.GetSchemaState(c.Context, "postgres", ldb)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error checking database schema version: %w", err).Error())
+			return 1
+		}
+		if !state.Initialized {
+			c.UI.Error(`Database has not been initialized; use "boundary database init" instead.`)
+			return 1
+		}
+		if state.TooNew() {
+			c.UI.Error(fmt.Sprintf("Database schema is at version %d but the newest migration in this version of Boundary is %d.", state.CurrentVersion, state.LatestVersion))
+			return 1
+		}
+	} else {
+		state, err = db.MigrateStore(c.Context, "postgres", migrationUrl)
+		if err != nil {
+			if state != nil && !state.Initialized {
+				c.UI.Error(`Database has not been initialized; use "boundary database init" instead.`)
+				return 1
+			}
+			c.UI.Error(fmt.Errorf("Error migrating database: %w", err).Error())
+			return 1
+		}
+	}
+
+	info := &MigrationInfo{
+		DryRun:         c.flagDryRun,
+		CurrentVersion: state.CurrentVersion,
+		LatestVersion:  state.LatestVersion,
+		Dirty:          state.Dirty,
+		Migrations:     state.Pending,
+	}
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateMigrationTableOutput(info))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(info)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+	return 0
+}
+
+func (c *MigrateCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	wrapperPath := c.flagConfig
+	if c.flagConfigKms != "" {
+		wrapperPath = c.flagConfigKms
+	}
+	wrapper, err := wrapper.GetWrapperFromPath(wrapperPath, "config")
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if wrapper != nil {
+		c.configWrapper = wrapper
+		if err := wrapper.Init(c.Context); err != nil {
+			c.UI.Error(fmt.Errorf("Could not initialize kms: %w", err).Error())
+			return 1
+		}
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return 1
+	}
+
+	c.Config, err = config.LoadFile(c.flagConfig, wrapper)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return 1
+	}
+
+	return 0
+}
@@ -344,7 +344,7 @@ func (c *Command) Run(args []string) int {
 	c.Info["[Recovery] AEAD Key Bytes"] = c.Config.DevRecoveryKey
 
 	// Initialize the listeners
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
@@ -401,6 +401,11 @@ func (c *Command) Run(args []string) int {
 	c.PrintInfo(c.UI)
 	c.ReleaseLogGate()
 
+	if err := c.StartOpsListeners(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
 	{
 		conf := &controller.Config{
 			RawConfig: c.Config,
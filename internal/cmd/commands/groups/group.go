@@ -46,7 +46,7 @@ var flagsMap = map[string][]string{
 	"update":         {"id", "name", "description", "version"},
 	"read":           {"id"},
 	"delete":         {"id"},
-	"list":           {"scope-id"},
+	"list":           {"scope-id", "filter", "page-size", "recursive"},
 	"add-members":    {"id", "member", "version"},
 	"set-members":    {"id", "member", "version"},
 	"remove-members": {"id", "member", "version"},
@@ -105,6 +105,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []groups.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, groups.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, groups.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, groups.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -0,0 +1,195 @@
+package hostcatalogs
+
+import (
+	"fmt"
+	"net/textproto"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostcatalogs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*DnsCommand)(nil)
+var _ cli.CommandAutocomplete = (*DnsCommand)(nil)
+
+type DnsCommand struct {
+	*base.Command
+
+	Func string
+
+	flagResolverAddress string
+}
+
+func (c *DnsCommand) Synopsis() string {
+	return fmt.Sprintf("%s a dns-type host catalog", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var dnsFlagsMap = map[string][]string{
+	"create": {"scope-id", "name", "description", "resolver-address"},
+	"update": {"id", "name", "description", "version", "resolver-address"},
+}
+
+func (c *DnsCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs create dns [options] [args]",
+			"",
+			"  Create a dns-type host catalog. Example:",
+			"",
+			`    $ boundary host-catalogs create dns -name prodops -description "DNS host-catalog for ProdOps" -resolver-address 10.0.0.53:53`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-catalogs update dns [options] [args]",
+			"",
+			"  Update a dns-type host catalog given its ID. Example:",
+			"",
+			`    $ boundary host-catalogs update dns -id hcdns_1234567890 -resolver-address null`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *DnsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "dns-type host catalog", dnsFlagsMap[c.Func])
+
+	f = set.NewFlagSet("DNS Host Catalog Options")
+
+	for _, name := range dnsFlagsMap[c.Func] {
+		switch name {
+		case "resolver-address":
+			f.StringVar(&base.StringVar{
+				Name:   "resolver-address",
+				Target: &c.flagResolverAddress,
+				Usage:  "The host and port of the DNS server used to resolve the record names of the catalog's host sets. If not set the controller's resolver is used.",
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *DnsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DnsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DnsCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(dnsFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(dnsFlagsMap[c.Func], "scope-id") && c.FlagScopeId == "" {
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []hostcatalogs.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultName())
+	default:
+		opts = append(opts, hostcatalogs.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultDescription())
+	default:
+		opts = append(opts, hostcatalogs.WithDescription(c.FlagDescription))
+	}
+
+	switch c.flagResolverAddress {
+	case "":
+	case "null":
+		opts = append(opts, hostcatalogs.DefaultDnsHostCatalogResolverAddress())
+	default:
+		opts = append(opts, hostcatalogs.WithDnsHostCatalogResolverAddress(c.flagResolverAddress))
+	}
+
+	hostcatalogClient := hostcatalogs.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, hostcatalogs.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = hostcatalogClient.Create(c.Context, "dns", c.FlagScopeId, opts...)
+	case "update":
+		result, err = hostcatalogClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "dns-type host-catalog"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	catalog := result.GetItem().(*hostcatalogs.HostCatalog)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateHostCatalogTableOutput(catalog))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(catalog)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -46,5 +46,6 @@ func generateHostCatalogTableOutput(in *hostcatalogs.HostCatalog) string {
 }
 
 var keySubstMap = map[string]string{
-	"address": "Address",
+	"address":          "Address",
+	"resolver_address": "Resolver Address",
 }
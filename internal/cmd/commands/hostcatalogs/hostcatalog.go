@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -59,6 +59,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-catalogs create static -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create a dns-type host catalog:",
+			"",
+			`      $ boundary host-catalogs create dns -name web -resolver-address 10.0.0.53:53`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -71,6 +75,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-catalogs update static -id hcst_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a dns-type host catalog:",
+			"",
+			`      $ boundary host-catalogs update dns -id hcdns_1234567890 -resolver-address null`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -130,6 +138,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []hostcatalogs.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, hostcatalogs.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostcatalogs.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, hostcatalogs.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"delete": {"id"},
-	"list":   {"host-catalog-id"},
+	"list":   {"host-catalog-id", "filter", "page-size"},
 }
 
 func (c *Command) Help() string {
@@ -129,6 +129,13 @@ func (c *Command) Run(args []string) int {
 
 	var opts []hosts.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, hosts.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hosts.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
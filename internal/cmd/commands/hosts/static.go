@@ -3,6 +3,8 @@ package hosts
 import (
 	"fmt"
 	"net/textproto"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/hosts"
@@ -21,7 +23,10 @@ type StaticCommand struct {
 
 	Func string
 
-	flagAddress string
+	flagAddress            string
+	flagPort               string
+	flagAlternateAddresses []string
+	flagAttributes         []string
 }
 
 func (c *StaticCommand) Synopsis() string {
@@ -29,8 +34,8 @@ func (c *StaticCommand) Synopsis() string {
 }
 
 var staticFlagsMap = map[string][]string{
-	"create": {"host-catalog-id", "name", "description", "address"},
-	"update": {"id", "name", "description", "version", "address"},
+	"create": {"host-catalog-id", "name", "description", "address", "port", "alternate-address", "attribute"},
+	"update": {"id", "name", "description", "version", "address", "port", "alternate-address", "attribute"},
 }
 
 func (c *StaticCommand) Help() string {
@@ -42,7 +47,7 @@ func (c *StaticCommand) Help() string {
 			"",
 			"  Create a static-type host. Example:",
 			"",
-			`    $ boundary hosts static create -name prodops -description "Static host for ProdOps" -address "127.0.0.1"`,
+			`    $ boundary hosts static create -name prodops -description "Static host for ProdOps" -address "127.0.0.1" -port 2222 -alternate-address "::1" -attribute env=prod -attribute role=db`,
 			"",
 			"",
 		})
@@ -76,6 +81,24 @@ func (c *StaticCommand) Flags() *base.FlagSets {
 				Target: &c.flagAddress,
 				Usage:  "The address of the host",
 			})
+		case "port":
+			f.StringVar(&base.StringVar{
+				Name:   "port",
+				Target: &c.flagPort,
+				Usage:  "The port to connect to on the host. Takes precedence over the default port of the target.",
+			})
+		case "alternate-address":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "alternate-address",
+				Target: &c.flagAlternateAddresses,
+				Usage:  "An address to try, in the order given, when connecting to the host's address fails. May be specified multiple times; on update the given addresses replace the existing ones, and \"null\" removes them.",
+			})
+		case "attribute":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "attribute",
+				Target: &c.flagAttributes,
+				Usage:  "An attribute of the host in the form key=value, which the selectors of host sets are matched against. May be specified multiple times; on update the given attributes replace the existing ones, and \"null\" removes them.",
+			})
 		}
 	}
 
@@ -147,6 +170,44 @@ func (c *StaticCommand) Run(args []string) int {
 		opts = append(opts, hosts.WithStaticHostAddress(c.flagAddress))
 	}
 
+	switch c.flagPort {
+	case "":
+	case "null":
+		opts = append(opts, hosts.DefaultStaticHostPort())
+	default:
+		port, err := strconv.ParseUint(c.flagPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagPort, err))
+			return 1
+		}
+		opts = append(opts, hosts.WithStaticHostPort(uint32(port)))
+	}
+
+	switch {
+	case len(c.flagAlternateAddresses) == 0:
+	case len(c.flagAlternateAddresses) == 1 && c.flagAlternateAddresses[0] == "null":
+		opts = append(opts, hosts.DefaultStaticHostAlternateAddresses())
+	default:
+		opts = append(opts, hosts.WithStaticHostAlternateAddresses(c.flagAlternateAddresses))
+	}
+
+	switch {
+	case len(c.flagAttributes) == 0:
+	case len(c.flagAttributes) == 1 && c.flagAttributes[0] == "null":
+		opts = append(opts, hosts.DefaultStaticHostAttributes())
+	default:
+		attrs := make(map[string]string, len(c.flagAttributes))
+		for _, kv := range c.flagAttributes {
+			idx := strings.Index(kv, "=")
+			if idx < 1 {
+				c.UI.Error(fmt.Sprintf("Error parsing attribute %q: must be of the form key=value", kv))
+				return 1
+			}
+			attrs[kv[:idx]] = kv[idx+1:]
+		}
+		opts = append(opts, hosts.WithStaticHostAttributes(attrs))
+	}
+
 	hostClient := hosts.NewClient(client)
 
 	// Perform check-and-set when needed
@@ -0,0 +1,195 @@
+package hostsets
+
+import (
+	"fmt"
+	"net/textproto"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*DnsCommand)(nil)
+var _ cli.CommandAutocomplete = (*DnsCommand)(nil)
+
+type DnsCommand struct {
+	*base.Command
+
+	Func string
+
+	flagRecordNames []string
+}
+
+func (c *DnsCommand) Synopsis() string {
+	return fmt.Sprintf("%s a dns-type host set", textproto.CanonicalMIMEHeaderKey(c.Func))
+}
+
+var dnsFlagsMap = map[string][]string{
+	"create": {"host-catalog-id", "name", "description", "record-name"},
+	"update": {"id", "name", "description", "version", "record-name"},
+}
+
+func (c *DnsCommand) Help() string {
+	var info string
+	switch c.Func {
+	case "create":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets create dns [options] [args]",
+			"",
+			"  Create a dns-type host set. Example:",
+			"",
+			`    $ boundary host-sets create dns -host-catalog-id hcdns_1234567890 -name web -record-name _https._tcp.web.example.com -record-name web.example.com`,
+			"",
+			"",
+		})
+
+	case "update":
+		info = base.WrapForHelpText([]string{
+			"Usage: boundary host-sets update dns [options] [args]",
+			"",
+			"  Update a dns-type host set given its ID. Example:",
+			"",
+			`    $ boundary host-sets update dns -id hsdns_1234567890 -record-name web-v2.example.com`,
+			"",
+			"",
+		})
+	}
+	return info + c.Flags().Help()
+}
+
+func (c *DnsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "dns-type host set", dnsFlagsMap[c.Func])
+
+	f = set.NewFlagSet("DNS Host Set Options")
+
+	for _, name := range dnsFlagsMap[c.Func] {
+		switch name {
+		case "record-name":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "record-name",
+				Target: &c.flagRecordNames,
+				Usage:  "A DNS record name the hosts of the set are resolved from. Names of the form _service._proto.name are looked up as SRV records, others as A and AAAA records. May be specified multiple times; on update the given names replace the existing ones, and \"null\" removes them.",
+			})
+		}
+	}
+
+	return set
+}
+
+func (c *DnsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DnsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DnsCommand) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(dnsFlagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+	if strutil.StrListContains(dnsFlagsMap[c.Func], "host-catalog-id") && c.FlagHostCatalogId == "" {
+		c.UI.Error("Host Catalog ID must be passed in via -host-catalog-id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	var opts []hostsets.Option
+
+	switch c.FlagName {
+	case "":
+	case "null":
+		opts = append(opts, hostsets.DefaultName())
+	default:
+		opts = append(opts, hostsets.WithName(c.FlagName))
+	}
+
+	switch c.FlagDescription {
+	case "":
+	case "null":
+		opts = append(opts, hostsets.DefaultDescription())
+	default:
+		opts = append(opts, hostsets.WithDescription(c.FlagDescription))
+	}
+
+	switch {
+	case len(c.flagRecordNames) == 0:
+	case len(c.flagRecordNames) == 1 && c.flagRecordNames[0] == "null":
+		opts = append(opts, hostsets.DefaultDnsHostSetRecordNames())
+	default:
+		opts = append(opts, hostsets.WithDnsHostSetRecordNames(c.flagRecordNames))
+	}
+
+	hostsetClient := hostsets.NewClient(client)
+
+	// Perform check-and-set when needed
+	var version uint32
+	switch c.Func {
+	case "create":
+		// These don't update so don't need the existing version
+	default:
+		switch c.FlagVersion {
+		case 0:
+			opts = append(opts, hostsets.WithAutomaticVersioning(true))
+		default:
+			version = uint32(c.FlagVersion)
+		}
+	}
+
+	var result api.GenericResult
+
+	switch c.Func {
+	case "create":
+		result, err = hostsetClient.Create(c.Context, c.FlagHostCatalogId, opts...)
+	case "update":
+		result, err = hostsetClient.Update(c.Context, c.FlagId, version, opts...)
+	}
+
+	plural := "dns-type host-set"
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	set := result.GetItem().(*hostsets.HostSet)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateHostSetTableOutput(set))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(set)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -54,4 +54,6 @@ func generateHostSetTableOutput(in *hostsets.HostSet) string {
 	return base.WrapForHelpText(ret)
 }
 
-var keySubstMap = map[string]string{}
+var keySubstMap = map[string]string{
+	"record_names": "Record Names",
+}
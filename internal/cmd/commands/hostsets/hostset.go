@@ -41,7 +41,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":         {"id"},
 	"delete":       {"id"},
-	"list":         {"host-catalog-id"},
+	"list":         {"host-catalog-id", "filter", "page-size"},
 	"add-hosts":    {"id", "host", "version"},
 	"set-hosts":    {"id", "host", "version"},
 	"remove-hosts": {"id", "host", "version"},
@@ -73,6 +73,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-sets create static -name prodops -description "For ProdOps usage"`,
 			"",
+			"    Create a dns-type host set:",
+			"",
+			`      $ boundary host-sets create dns -host-catalog-id hcdns_1234567890 -name web -record-name web.example.com`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -85,6 +89,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary host-sets update static -id hsst_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a dns-type host set:",
+			"",
+			`      $ boundary host-sets update dns -id hsdns_1234567890 -record-name web-v2.example.com`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "add-hosts":
@@ -183,6 +191,13 @@ func (c *Command) Run(args []string) int {
 
 	var opts []hostsets.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, hostsets.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, hostsets.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/api/jobs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func generateJobTableOutput(in *jobs.Job) string {
+	nonAttributeMap := map[string]interface{}{
+		"ID":                 in.Id,
+		"Description":        in.Description,
+		"Running":            in.Running,
+		"Next Scheduled Run": in.NextScheduledRun.Local().Format(time.RFC1123),
+		"Created Time":       in.CreatedTime.Local().Format(time.RFC1123),
+		"Updated Time":       in.UpdatedTime.Local().Format(time.RFC1123),
+	}
+	if !in.LastRunStart.IsZero() {
+		nonAttributeMap["Last Run Start"] = in.LastRunStart.Local().Format(time.RFC1123)
+		nonAttributeMap["Last Run Controller ID"] = in.LastRunControllerId
+	}
+	if !in.LastRunEnd.IsZero() {
+		nonAttributeMap["Last Run End"] = in.LastRunEnd.Local().Format(time.RFC1123)
+	}
+	if in.LastRunStatus != "" {
+		nonAttributeMap["Last Run Status"] = in.LastRunStatus
+	}
+	if in.LastRunError != "" {
+		nonAttributeMap["Last Run Error"] = in.LastRunError
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Job information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+		"",
+		"  Scope:",
+		base.ScopeInfoForOutput(in.Scope, maxLength),
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -0,0 +1,223 @@
+package jobs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/jobs"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*Command)(nil)
+var _ cli.CommandAutocomplete = (*Command)(nil)
+
+type Command struct {
+	*base.Command
+
+	Func string
+}
+
+func (c *Command) Synopsis() string {
+	return common.SynopsisFunc(c.Func, "job")
+}
+
+var flagsMap = map[string][]string{
+	"read": {"id"},
+	"run":  {"id"},
+	"list": {"filter", "page-size"},
+}
+
+func (c *Command) Help() string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary jobs [sub command] [options] [args]",
+			"",
+			"  This command allows operations on the background jobs run by Boundary",
+			"  controllers. Jobs are identified by name. Example:",
+			"",
+			"    List all jobs:",
+			"",
+			`      $ boundary jobs list`,
+			"",
+			"  Please see the jobs subcommand help for detailed usage information.",
+		})
+	case "read":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary jobs read [options] [args]",
+			"",
+			"  Read the status of the job specified by name. Example:",
+			"",
+			`    $ boundary jobs read -id terminate_completed_sessions`,
+			"",
+			"",
+		})
+	case "list":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary jobs list [options] [args]",
+			"",
+			"  List the status of all jobs. Example:",
+			"",
+			`    $ boundary jobs list`,
+			"",
+			"",
+		})
+	case "run":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary jobs run [options] [args]",
+			"",
+			"  Run the job specified by name as soon as possible, regardless of its",
+			"  interval. Example:",
+			"",
+			`    $ boundary jobs run -id terminate_completed_sessions`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, resource.Job.String(), flagsMap[c.Func])
+
+	return set
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	if c.Func == "" {
+		return cli.RunResultHelp
+	}
+
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if strutil.StrListContains(flagsMap[c.Func], "id") && c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	jobClient := jobs.NewClient(client)
+
+	var opts []jobs.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, jobs.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, jobs.WithPageSize(uint32(c.FlagPageSize)))
+	}
+
+	var result api.GenericResult
+	var listResult api.GenericListResult
+
+	switch c.Func {
+	case "read":
+		result, err = jobClient.Read(c.Context, c.FlagId)
+	case "run":
+		result, err = jobClient.Run(c.Context, c.FlagId)
+	case "list":
+		listResult, err = jobClient.List(c.Context, scope.Global.String(), opts...)
+	}
+
+	plural := "job"
+	if c.Func == "list" {
+		plural = "jobs"
+	}
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing %s on %s: %s", c.Func, plural, base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to %s %s: %s", c.Func, plural, err.Error()))
+		return 2
+	}
+
+	switch c.Func {
+	case "list":
+		listedJobs := listResult.GetItems().([]*jobs.Job)
+		switch base.Format(c.UI) {
+		case "json":
+			if len(listedJobs) == 0 {
+				c.UI.Output("null")
+				return 0
+			}
+			b, err := base.JsonFormatter{}.Format(listedJobs)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+				return 1
+			}
+			c.UI.Output(string(b))
+
+		case "table":
+			if len(listedJobs) == 0 {
+				c.UI.Output("No jobs found")
+				return 0
+			}
+			var output []string
+			output = []string{
+				"",
+				"Job information:",
+			}
+			for i, j := range listedJobs {
+				if i > 0 {
+					output = append(output, "")
+				}
+				output = append(output,
+					fmt.Sprintf("  ID:                    %s", j.Id),
+					fmt.Sprintf("    Description:         %s", j.Description),
+					fmt.Sprintf("    Running:             %t", j.Running),
+					fmt.Sprintf("    Next Scheduled Run:  %s", j.NextScheduledRun.Local().Format(time.RFC1123)),
+				)
+				if j.LastRunStatus != "" {
+					output = append(output,
+						fmt.Sprintf("    Last Run Status:     %s", j.LastRunStatus),
+					)
+				}
+			}
+			c.UI.Output(base.WrapForHelpText(output))
+		}
+		return 0
+	}
+
+	job := result.GetItem().(*jobs.Job)
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(generateJobTableOutput(job))
+	case "json":
+		b, err := base.JsonFormatter{}.Format(job)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+	}
+
+	return 0
+}
@@ -0,0 +1,189 @@
+package roles
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*ExplainCommand)(nil)
+var _ cli.CommandAutocomplete = (*ExplainCommand)(nil)
+
+// ExplainCommand shows which of a user's grants apply to an action on a
+// resource and whether they allow it
+type ExplainCommand struct {
+	*base.Command
+
+	flagUserId       string
+	flagAction       string
+	flagResourceId   string
+	flagResourceType string
+	flagPinId        string
+}
+
+func (c *ExplainCommand) Synopsis() string {
+	return "Explain whether a user's grants allow an action on a resource"
+}
+
+func (c *ExplainCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary roles explain [options] [args]",
+		"",
+		"  Evaluate the grants the user receives from every role against an action on a resource, showing the grants that apply, whether each allows or denies the action, and the roles and scopes they come from. The resource is described by the parameters of the permissions engine, as listed in the permissions documentation. Example:",
+		"",
+		`    $ boundary roles explain -user-id u_1234567890 -scope-id p_1234567890 -action delete -resource-id ttcp_1234567890 -resource-type target`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ExplainCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "user-id",
+		Target: &c.flagUserId,
+		Usage:  "ID of the user whose grants are evaluated",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "scope-id",
+		Target: &c.FlagScopeId,
+		Usage:  "ID of the scope containing the resource",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "action",
+		Target: &c.flagAction,
+		Usage:  "The action to evaluate, e.g. \"read\"",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-id",
+		Target: &c.flagResourceId,
+		Usage:  "ID of the resource, if the action is on a specific resource",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-type",
+		Target: &c.flagResourceType,
+		Usage:  "Type of the resource, e.g. \"target\"",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "pin-id",
+		Target: &c.flagPinId,
+		Usage:  "ID of the resource containing the resource, such as the host catalog of a host",
+	})
+
+	return set
+}
+
+func (c *ExplainCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ExplainCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExplainCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	switch {
+	case c.flagUserId == "":
+		c.UI.Error("User ID must be passed in via -user-id")
+		return 1
+	case c.FlagScopeId == "":
+		c.UI.Error("Scope ID must be passed in via -scope-id")
+		return 1
+	case c.flagAction == "":
+		c.UI.Error("Action must be passed in via -action")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	result, err := users.NewClient(client).EffectiveGrants(c.Context, c.flagUserId, &users.GrantCheck{
+		ScopeId:      c.FlagScopeId,
+		Action:       c.flagAction,
+		ResourceId:   c.flagResourceId,
+		ResourceType: c.flagResourceType,
+		PinId:        c.flagPinId,
+	})
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing explain on roles: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to explain roles: %s", err.Error()))
+		return 2
+	}
+
+	var applies []*users.EffectiveGrant
+	for _, g := range result.Items {
+		if g.Applies {
+			applies = append(applies, g)
+		}
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(struct {
+			Allowed bool                    `json:"allowed"`
+			Denied  bool                    `json:"denied"`
+			Grants  []*users.EffectiveGrant `json:"grants"`
+		}{
+			Allowed: result.Allowed,
+			Denied:  result.Denied,
+			Grants:  applies,
+		})
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+
+	case "table":
+		decision := "denied, no grant allows it"
+		switch {
+		case result.Denied:
+			decision = "denied by a deny grant"
+		case result.Allowed:
+			decision = "allowed"
+		}
+		output := []string{
+			"",
+			fmt.Sprintf("Action %q is %s", c.flagAction, decision),
+		}
+		if len(applies) > 0 {
+			output = append(output, "", "Grants that apply:")
+		}
+		for i, g := range applies {
+			if i > 0 {
+				output = append(output, "")
+			}
+			effect := "allow"
+			if g.Deny {
+				effect = "deny"
+			}
+			output = append(output,
+				fmt.Sprintf("  Grant:        %s", g.Canonical),
+				fmt.Sprintf("    Effect:     %s", effect),
+				fmt.Sprintf("    Scope ID:   %s", g.ScopeId),
+				fmt.Sprintf("    Role ID:    %s", g.RoleId),
+			)
+		}
+		c.UI.Output(base.WrapForHelpText(output))
+	}
+	return 0
+}
@@ -56,7 +56,7 @@ var flagsMap = map[string][]string{
 	"update":            {"id", "name", "description", "grantscopeid", "version"},
 	"read":              {"id"},
 	"delete":            {"id"},
-	"list":              {"scope-id"},
+	"list":              {"scope-id", "filter", "page-size", "recursive"},
 	"add-principals":    {"id", "principal", "version"},
 	"set-principals":    {"id", "principal", "version"},
 	"remove-principals": {"id", "principal", "version"},
@@ -118,6 +118,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []roles.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, roles.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, roles.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, roles.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
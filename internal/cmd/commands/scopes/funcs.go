@@ -35,3 +35,27 @@ func generateScopeTableOutput(in *scopes.Scope) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func rotateKeysHelp() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes rotate-keys [options] [args]",
+		"",
+		"  Creates a new version of the root key and each data encryption key of the scope given its ID. New data is encrypted with the new versions; existing data remains readable with the previous versions until it is rewrapped. Example:",
+		"",
+		`    $ boundary scopes rotate-keys -id o_1234567890`,
+		"",
+		"",
+	})
+}
+
+func rewrapKeysHelp() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary scopes rewrap-keys [options] [args]",
+		"",
+		"  Re-encrypts the data encryption keys of the scope given its ID under its newest root key version, and schedules the data encrypted with older key versions to be re-encrypted in the background. Example:",
+		"",
+		`    $ boundary scopes rewrap-keys -id o_1234567890`,
+		"",
+		"",
+	})
+}
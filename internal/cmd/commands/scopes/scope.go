@@ -27,23 +27,38 @@ type Command struct {
 }
 
 func (c *Command) Synopsis() string {
+	switch c.Func {
+	case "rotate-keys":
+		return "Rotate a scope's keys"
+	case "rewrap-keys":
+		return "Rewrap a scope's keys and data under their newest versions"
+	}
 	return common.SynopsisFunc(c.Func, "scope")
 }
 
+var helpMap = func() map[string]func() string {
+	ret := common.HelpMap("scope")
+	ret["rotate-keys"] = rotateKeysHelp
+	ret["rewrap-keys"] = rewrapKeysHelp
+	return ret
+}
+
 var flagsMap = map[string][]string{
-	"create": {"scope-id", "name", "description", "skip-admin-role-creation", "skip-default-role-creation"},
-	"update": {"id", "name", "description", "version"},
-	"read":   {"id"},
-	"delete": {"id"},
-	"list":   {"scope-id"},
+	"create":      {"scope-id", "name", "description", "skip-admin-role-creation", "skip-default-role-creation"},
+	"update":      {"id", "name", "description", "version"},
+	"read":        {"id"},
+	"delete":      {"id"},
+	"list":        {"scope-id", "filter", "page-size", "recursive"},
+	"rotate-keys": {"id"},
+	"rewrap-keys": {"id"},
 }
 
 func (c *Command) Help() string {
-	helpMap := common.HelpMap("scope")
+	hm := helpMap()
 	if c.Func == "" {
-		return helpMap["base"]()
+		return hm["base"]()
 	}
-	return helpMap[c.Func]() + c.Flags().Help()
+	return hm[c.Func]() + c.Flags().Help()
 }
 
 func (c *Command) Flags() *base.FlagSets {
@@ -104,6 +119,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []scopes.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, scopes.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, scopes.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, scopes.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -132,7 +157,7 @@ func (c *Command) Run(args []string) int {
 	// Perform check-and-set when needed
 	var version uint32
 	switch c.Func {
-	case "create", "read", "delete", "list":
+	case "create", "read", "delete", "list", "rotate-keys", "rewrap-keys":
 		// These don't udpate so don't need the existing version
 	default:
 		switch c.FlagVersion {
@@ -162,6 +187,10 @@ func (c *Command) Run(args []string) int {
 		}
 	case "list":
 		listResult, err = scopeClient.List(c.Context, c.FlagScopeId, opts...)
+	case "rotate-keys":
+		result, err = scopeClient.RotateKeys(c.Context, c.FlagId, opts...)
+	case "rewrap-keys":
+		result, err = scopeClient.RewrapKeys(c.Context, c.FlagId, opts...)
 	}
 
 	plural := "scope"
@@ -193,6 +193,7 @@ func (c *Command) Run(args []string) int {
 				foundApi = true
 			case "proxy":
 				foundProxy = true
+			case "ops":
 			default:
 				c.UI.Error(fmt.Sprintf("Unknown listener purpose %q", lnConfig.Purpose[0]))
 				return 1
@@ -233,7 +234,7 @@ func (c *Command) Run(args []string) int {
 			c.Config.Worker.Controllers = []string{clusterAddr}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "ops"}); err != nil {
 		c.UI.Error(err.Error())
 		return 1
 	}
@@ -268,6 +269,10 @@ func (c *Command) Run(args []string) int {
 			c.UI.Error(fmt.Errorf("Error connecting to database: %w", err).Error())
 			return 1
 		}
+		if err := c.VerifyDatabaseSchema(c.Context, "postgres"); err != nil {
+			c.UI.Error(fmt.Errorf("Error verifying database schema: %w", err).Error())
+			return 1
+		}
 	}
 
 	defer func() {
@@ -279,6 +284,11 @@ func (c *Command) Run(args []string) int {
 	c.PrintInfo(c.UI)
 	c.ReleaseLogGate()
 
+	if err := c.StartOpsListeners(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
 	if c.Config.Controller != nil {
 		if err := c.StartController(); err != nil {
 			c.UI.Error(err.Error())
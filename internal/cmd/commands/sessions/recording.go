@@ -0,0 +1,291 @@
+package sessions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessions"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*DownloadRecordingCommand)(nil)
+var _ cli.CommandAutocomplete = (*DownloadRecordingCommand)(nil)
+
+// DownloadRecordingCommand writes the recordings of a session's connections
+// to files which can be replayed with ReplayRecordingCommand.
+type DownloadRecordingCommand struct {
+	*base.Command
+
+	flagOutputDir    string
+	flagConnectionId string
+}
+
+func (c *DownloadRecordingCommand) Synopsis() string {
+	return "Download the recordings of a session's connections"
+}
+
+func (c *DownloadRecordingCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary sessions download-recording [options] [args]",
+		"",
+		"  Download the recordings of the connections of the session specified by ID. Each connection's recording is written to a file named after the connection ID in the output directory as it is received. Example:",
+		"",
+		`    $ boundary sessions download-recording -id s_1234567890 -output-dir ./recordings`,
+		"",
+		"  Only the recording of one connection is downloaded when its ID is given:",
+		"",
+		`    $ boundary sessions download-recording -id s_1234567890 -connection-id sc_1234567890`,
+		"",
+		"  The recordings can be replayed with \"boundary sessions replay-recording\".",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *DownloadRecordingCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "ID of the session whose recordings should be downloaded",
+	})
+	f.StringVar(&base.StringVar{
+		Name:       "output-dir",
+		Target:     &c.flagOutputDir,
+		Default:    ".",
+		Completion: complete.PredictDirs("*"),
+		Usage:      "Directory to which the recordings are written",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "connection-id",
+		Target: &c.flagConnectionId,
+		Usage:  "ID of the only connection whose recording should be downloaded",
+	})
+
+	return set
+}
+
+func (c *DownloadRecordingCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DownloadRecordingCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DownloadRecordingCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	sessClient := sessions.NewClient(client)
+	result, err := sessClient.ListRecordings(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing list-recordings on session: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to list-recordings session: %s", err.Error()))
+		return 2
+	}
+
+	type downloaded struct {
+		ConnectionId string `json:"connection_id"`
+		Complete     bool   `json:"complete"`
+		Path         string `json:"path"`
+	}
+	var files []downloaded
+	for _, rec := range result.Items {
+		if c.flagConnectionId != "" && rec.ConnectionId != c.flagConnectionId {
+			continue
+		}
+		path := filepath.Join(c.flagOutputDir, rec.ConnectionId+recording.FileExtension)
+		if err := c.download(sessClient, rec.ConnectionId, path); err != nil {
+			if apiErr := api.AsServerError(err); apiErr != nil {
+				c.UI.Error(fmt.Sprintf("Error from controller when performing download-recording on session: %s", base.PrintApiError(apiErr)))
+				return 1
+			}
+			c.UI.Error(fmt.Sprintf("Error downloading recording of connection %s: %s", rec.ConnectionId, err.Error()))
+			return 2
+		}
+		files = append(files, downloaded{ConnectionId: rec.ConnectionId, Complete: rec.Complete, Path: path})
+	}
+	if c.flagConnectionId != "" && len(files) == 0 {
+		c.UI.Error(fmt.Sprintf("Connection %s of the session was not recorded", c.flagConnectionId))
+		return 1
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if len(files) == 0 {
+			c.UI.Output("null")
+			return 0
+		}
+		b, err := base.JsonFormatter{}.Format(files)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+
+	case "table":
+		if len(files) == 0 {
+			c.UI.Output("No recordings found")
+			return 0
+		}
+		output := []string{
+			"",
+			"Recordings downloaded:",
+		}
+		for i, f := range files {
+			if i > 0 {
+				output = append(output, "")
+			}
+			output = append(output,
+				fmt.Sprintf("  Connection ID:  %s", f.ConnectionId),
+				fmt.Sprintf("    Path:         %s", f.Path),
+				fmt.Sprintf("    Complete:     %t", f.Complete),
+			)
+		}
+		c.UI.Output(base.WrapForHelpText(output))
+	}
+	return 0
+}
+
+// download writes the recording of the connection connectionId to a file at
+// path as it is received.  The file is removed if the download fails.
+func (c *DownloadRecordingCommand) download(client *sessions.Client, connectionId, path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	err = client.DownloadRecording(c.Context, c.FlagId, connectionId, file)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return err
+}
+
+var _ cli.Command = (*ReplayRecordingCommand)(nil)
+var _ cli.CommandAutocomplete = (*ReplayRecordingCommand)(nil)
+
+// ReplayRecordingCommand replays a recording downloaded with
+// DownloadRecordingCommand.  It does not contact the controller.
+type ReplayRecordingCommand struct {
+	*base.Command
+
+	flagFile    string
+	flagSpeed   float64
+	flagMaxIdle time.Duration
+}
+
+func (c *ReplayRecordingCommand) Synopsis() string {
+	return "Replay a downloaded session recording"
+}
+
+func (c *ReplayRecordingCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary sessions replay-recording [options] [args]",
+		"",
+		"  Replay a recording downloaded with \"boundary sessions download-recording\". Terminal sessions are replayed with their original timing; SSH sessions are encrypted end to end, so only a description of the connection is printed. Example:",
+		"",
+		`    $ boundary sessions replay-recording -file ./recordings/sc_1234567890.rec -speed 2`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ReplayRecordingCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetNone)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:       "file",
+		Target:     &c.flagFile,
+		Completion: complete.PredictFiles("*" + recording.FileExtension),
+		Usage:      "Path to the recording to replay",
+	})
+	f.Float64Var(&base.Float64Var{
+		Name:    "speed",
+		Target:  &c.flagSpeed,
+		Default: 1,
+		Usage:   "Speed of the replay relative to the original session. Zero replays without delay.",
+	})
+	f.DurationVar(&base.DurationVar{
+		Name:    "max-idle",
+		Target:  &c.flagMaxIdle,
+		Default: 2 * time.Second,
+		Usage:   "Maximum delay between two writes, regardless of how long the session was idle",
+	})
+
+	return set
+}
+
+func (c *ReplayRecordingCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *ReplayRecordingCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ReplayRecordingCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.flagFile == "" {
+		c.UI.Error("Recording file must be passed in via -file")
+		return 1
+	}
+
+	file, err := os.Open(c.flagFile)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error opening recording: %s", err.Error()))
+		return 1
+	}
+	defer file.Close()
+
+	r, err := recording.NewReader(file, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading recording: %s", err.Error()))
+		return 1
+	}
+	summary, err := recording.Replay(os.Stdout, r, recording.WithSpeed(c.flagSpeed), recording.WithMaxIdle(c.flagMaxIdle))
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error replaying recording: %s", err.Error()))
+		return 1
+	}
+	if summary.Protocol == recording.ProtocolTerminal {
+		c.UI.Output(fmt.Sprintf("\nEnd of recording (%s)", summary.Duration))
+	}
+	return 0
+}
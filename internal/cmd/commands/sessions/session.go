@@ -30,7 +30,7 @@ func (c *Command) Synopsis() string {
 var flagsMap = map[string][]string{
 	"read":   {"id"},
 	"cancel": {"id"},
-	"list":   {"scope-id"},
+	"list":   {"scope-id", "filter", "page-size", "recursive"},
 }
 
 func (c *Command) Help() string {
@@ -47,6 +47,10 @@ func (c *Command) Help() string {
 			"",
 			`      $ boundary sessions read -id s_1234567890`,
 			"",
+			"    Download the recordings of a session's connections:",
+			"",
+			`      $ boundary sessions download-recording -id s_1234567890`,
+			"",
 			"  Please see the sessions subcommand help for detailed usage information.",
 		})
 	case "cancel":
@@ -110,6 +114,17 @@ func (c *Command) Run(args []string) int {
 
 	sessionClient := sessions.NewClient(client)
 
+	var opts []sessions.Option
+	if c.FlagFilter != "" {
+		opts = append(opts, sessions.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, sessions.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, sessions.WithRecursive(true))
+	}
+
 	var result api.GenericResult
 	var listResult api.GenericListResult
 
@@ -119,7 +134,7 @@ func (c *Command) Run(args []string) int {
 	case "cancel":
 		result, err = sessionClient.Cancel(c.Context, c.FlagId, 0, sessions.WithAutomaticVersioning(true))
 	case "list":
-		listResult, err = sessionClient.List(c.Context, c.FlagScopeId)
+		listResult, err = sessionClient.List(c.Context, c.FlagScopeId, opts...)
 	}
 
 	plural := "session"
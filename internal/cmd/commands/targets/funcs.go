@@ -41,6 +41,9 @@ func generateTargetTableOutput(in *targets.Target) string {
 	if in.Description != "" {
 		nonAttributeMap["Description"] = in.Description
 	}
+	if in.WorkerFilter != "" {
+		nonAttributeMap["Worker Filter"] = in.WorkerFilter
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, in.Attributes, keySubstMap)
 
@@ -122,7 +125,8 @@ func generateAuthorizationTableOutput(in *targets.SessionAuthorization) string {
 }
 
 var keySubstMap = map[string]string{
-	"default_port": "Default Port",
+	"default_port":             "Default Port",
+	"enable_session_recording": "Enable Session Recording",
 }
 
 func exampleOutput() string {
@@ -42,7 +42,7 @@ var flagsMap = map[string][]string{
 	"authorize-session": {"id", "host-id"},
 	"read":              {"id"},
 	"delete":            {"id"},
-	"list":              {"scope-id"},
+	"list":              {"scope-id", "filter", "page-size", "recursive"},
 	"add-host-sets":     {"id", "host-set", "version"},
 	"remove-host-sets":  {"id", "host-set", "version"},
 	"set-host-sets":     {"id", "host-set", "version"},
@@ -214,6 +214,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []targets.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, targets.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, targets.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, targets.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -25,6 +25,8 @@ type TcpCommand struct {
 	flagDefaultPort            string
 	flagSessionMaxSeconds      string
 	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagEnableSessionRecording string
 }
 
 func (c *TcpCommand) Synopsis() string {
@@ -32,8 +34,8 @@ func (c *TcpCommand) Synopsis() string {
 }
 
 var tcpFlagsMap = map[string][]string{
-	"create": {"scope-id", "name", "description", "default-port", "session-max-seconds", "session-connection-limit"},
-	"update": {"id", "name", "description", "version", "default-port", "session-max-seconds", "session-connection-limit"},
+	"create": {"scope-id", "name", "description", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "enable-session-recording"},
+	"update": {"id", "name", "description", "version", "default-port", "session-max-seconds", "session-connection-limit", "worker-filter", "enable-session-recording"},
 }
 
 func (c *TcpCommand) Help() string {
@@ -89,6 +91,18 @@ func (c *TcpCommand) Flags() *base.FlagSets {
 				Target: &c.flagSessionConnectionLimit,
 				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
 			})
+		case "worker-filter":
+			f.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  `A boolean expression to filter which workers can handle sessions for this target, evaluated against each worker's "/name" and "/tags".`,
+			})
+		case "enable-session-recording":
+			f.StringVar(&base.StringVar{
+				Name:   "enable-session-recording",
+				Target: &c.flagEnableSessionRecording,
+				Usage:  `If "true", the connections of sessions for this target are recorded by the worker proxying them.`,
+			})
 		}
 	}
 
@@ -194,6 +208,27 @@ func (c *TcpCommand) Run(args []string) int {
 		opts = append(opts, targets.WithSessionConnectionLimit(int32(limit)))
 	}
 
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultWorkerFilter())
+	default:
+		opts = append(opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagEnableSessionRecording {
+	case "":
+	case "null":
+		opts = append(opts, targets.DefaultTcpTargetEnableSessionRecording())
+	default:
+		enable, err := strconv.ParseBool(c.flagEnableSessionRecording)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagEnableSessionRecording, err))
+			return 1
+		}
+		opts = append(opts, targets.WithTcpTargetEnableSessionRecording(enable))
+	}
+
 	targetClient := targets.NewClient(client)
 
 	// Perform check-and-set when needed
@@ -0,0 +1,125 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var _ cli.Command = (*EffectiveGrantsCommand)(nil)
+var _ cli.CommandAutocomplete = (*EffectiveGrantsCommand)(nil)
+
+// EffectiveGrantsCommand lists the grants a user receives from every role
+type EffectiveGrantsCommand struct {
+	*base.Command
+}
+
+func (c *EffectiveGrantsCommand) Synopsis() string {
+	return "List the grants a user receives from every role"
+}
+
+func (c *EffectiveGrantsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary users effective-grants [options] [args]",
+		"",
+		"  List the grants the user specified by ID receives from every role of which it, its groups, or the anonymous and authenticated users are principals, along with the scope each grant applies to. Example:",
+		"",
+		`    $ boundary users effective-grants -id u_1234567890`,
+		"",
+		"  To find out why an action is allowed or denied, use \"boundary roles explain\".",
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *EffectiveGrantsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "ID of the user whose grants should be listed",
+	})
+
+	return set
+}
+
+func (c *EffectiveGrantsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *EffectiveGrantsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *EffectiveGrantsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.FlagId == "" {
+		c.UI.Error("ID is required but not passed in via -id")
+		return 1
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error creating API client: %s", err.Error()))
+		return 2
+	}
+
+	result, err := users.NewClient(client).EffectiveGrants(c.Context, c.FlagId, nil)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.UI.Error(fmt.Sprintf("Error from controller when performing effective-grants on user: %s", base.PrintApiError(apiErr)))
+			return 1
+		}
+		c.UI.Error(fmt.Sprintf("Error trying to effective-grants user: %s", err.Error()))
+		return 2
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if len(result.Items) == 0 {
+			c.UI.Output("null")
+			return 0
+		}
+		b, err := base.JsonFormatter{}.Format(result.Items)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return 1
+		}
+		c.UI.Output(string(b))
+
+	case "table":
+		if len(result.Items) == 0 {
+			c.UI.Output("No grants found")
+			return 0
+		}
+		output := []string{
+			"",
+			"Effective grants:",
+		}
+		for i, g := range result.Items {
+			if i > 0 {
+				output = append(output, "")
+			}
+			output = append(output,
+				fmt.Sprintf("  Grant:        %s", g.Canonical),
+				fmt.Sprintf("    Raw:        %s", g.Raw),
+				fmt.Sprintf("    Scope ID:   %s", g.ScopeId),
+				fmt.Sprintf("    Role ID:    %s", g.RoleId),
+			)
+		}
+		c.UI.Output(base.WrapForHelpText(output))
+	}
+	return 0
+}
@@ -46,7 +46,7 @@ var flagsMap = map[string][]string{
 	"update":          {"id", "name", "description", "version"},
 	"read":            {"id"},
 	"delete":          {"id"},
-	"list":            {"scope-id"},
+	"list":            {"scope-id", "filter", "page-size", "recursive"},
 	"add-accounts":    {"id", "account", "version"},
 	"set-accounts":    {"id", "account", "version"},
 	"remove-accounts": {"id", "account", "version"},
@@ -105,6 +105,16 @@ func (c *Command) Run(args []string) int {
 
 	var opts []users.Option
 
+	if c.FlagFilter != "" {
+		opts = append(opts, users.WithFilter(c.FlagFilter))
+	}
+	if c.FlagPageSize != 0 {
+		opts = append(opts, users.WithPageSize(uint32(c.FlagPageSize)))
+	}
+	if c.FlagRecursive {
+		opts = append(opts, users.WithRecursive(true))
+	}
+
 	switch c.FlagName {
 	case "":
 	case "null":
@@ -57,6 +57,24 @@ func PopulateCommonFlags(c *base.Command, f *base.FlagSet, resourceType string,
 				Target: &c.FlagHostCatalogId,
 				Usage:  "The host-catalog resource to use for the operation",
 			})
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   "filter",
+				Target: &c.FlagFilter,
+				Usage:  fmt.Sprintf(`If set, only the %ss matching this filter expression are listed. The expression is evaluated against the JSON form of each %s, available under "/item", e.g. '"/item/name" == "web"'. Single quotes are recommended as filters contain double quotes.`, resourceType, resourceType),
+			})
+		case "page-size":
+			f.UintVar(&base.UintVar{
+				Name:   "page-size",
+				Target: &c.FlagPageSize,
+				Usage:  fmt.Sprintf("The number of %ss to request from the controller at a time. Every page is fetched and all of the %ss are listed. If not set, the controller's default page size is used.", resourceType, resourceType),
+			})
+		case "recursive":
+			f.BoolVar(&base.BoolVar{
+				Name:   "recursive",
+				Target: &c.FlagRecursive,
+				Usage:  fmt.Sprintf("If set, the %ss of the scopes under the given scope are listed as well, in every scope where listing them is allowed.", resourceType),
+			})
 		}
 	}
 }
@@ -8,13 +8,16 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/shared-secure-libs/configutil"
+	"github.com/hashicorp/vault/sdk/helper/parseutil"
 )
 
 const (
@@ -75,6 +78,9 @@ worker {
 	name = "dev-worker"
 	description = "A default worker created in dev mode"
 	controllers = ["127.0.0.1"]
+	tags {
+		type = ["dev", "local"]
+	}
 }
 `
 )
@@ -85,6 +91,7 @@ type Config struct {
 
 	Worker     *Worker     `hcl:"worker"`
 	Controller *Controller `hcl:"controller"`
+	Events     *Events     `hcl:"events"`
 
 	// Dev-related options
 	DevController        bool   `hcl:"-"`
@@ -95,9 +102,106 @@ type Config struct {
 }
 
 type Controller struct {
-	Name        string    `hcl:"name"`
-	Description string    `hcl:"description"`
-	Database    *Database `hcl:"database"`
+	Name        string     `hcl:"name"`
+	Description string     `hcl:"description"`
+	Database    *Database  `hcl:"database"`
+	Scheduler   *Scheduler `hcl:"scheduler"`
+
+	// StatusInterval is how often the controller records its status in the
+	// database, e.g. "10s"
+	StatusInterval         string        `hcl:"status_interval"`
+	StatusIntervalDuration time.Duration `hcl:"-"`
+
+	// HostCatalogPlugins are the host catalog plugins the controller uses
+	// for the types of host catalogs it doesn't support itself
+	HostCatalogPlugins []*HostCatalogPlugin `hcl:"host_catalog_plugin"`
+
+	// AuthenticateThrottle limits how often a single client IP can
+	// authenticate to auth methods
+	AuthenticateThrottle *AuthenticateThrottle `hcl:"authenticate_throttle"`
+
+	// AuthTokenTimeToLive is the maximum lifetime of auth tokens issued by
+	// auth methods which do not set their own, e.g. "168h"
+	AuthTokenTimeToLive         string        `hcl:"auth_token_time_to_live"`
+	AuthTokenTimeToLiveDuration time.Duration `hcl:"-"`
+
+	// AuthTokenTimeToStale is how long auth tokens issued by auth methods
+	// which do not set their own idle timeout can go unused before they are
+	// no longer valid, e.g. "24h"
+	AuthTokenTimeToStale         string        `hcl:"auth_token_time_to_stale"`
+	AuthTokenTimeToStaleDuration time.Duration `hcl:"-"`
+}
+
+// AuthenticateThrottle configures the throttling of authenticate requests
+// per client IP
+type AuthenticateThrottle struct {
+	// Attempts is how many authenticate requests a client IP may make per
+	// period. A negative value disables throttling.
+	Attempts int `hcl:"attempts"`
+
+	// Period is the length of the window attempts are counted in, e.g. "1m"
+	Period         string        `hcl:"period"`
+	PeriodDuration time.Duration `hcl:"-"`
+}
+
+// HostCatalogPlugin is a host catalog plugin running in its own process,
+// given by name as the block label
+type HostCatalogPlugin struct {
+	Name string `hcl:",key"`
+
+	// Address is the gRPC address the plugin listens on, e.g.
+	// "127.0.0.1:9300" or "unix:///var/run/boundary/cmdb.sock". It must be
+	// a unix socket or a loopback address; see ValidateHostCatalogPluginAddress
+	Address string `hcl:"address"`
+}
+
+// ValidateHostCatalogPluginAddress returns an error if addr is not a unix
+// socket or a loopback IP address and port. The connection to a plugin is
+// not encrypted, so a plugin must not be reachable over the network.
+func ValidateHostCatalogPluginAddress(addr string) error {
+	if strings.HasPrefix(addr, "unix:") {
+		if strings.TrimPrefix(strings.TrimPrefix(addr, "unix:"), "//") == "" {
+			return fmt.Errorf("unix socket path is empty")
+		}
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%q is not a unix socket or loopback address", addr)
+	}
+	return nil
+}
+
+// Scheduler configures the background jobs run by controllers
+type Scheduler struct {
+	Jobs []*SchedulerJob `hcl:"job"`
+}
+
+// SchedulerJob overrides the settings of a single background job, given by
+// name as the block label
+type SchedulerJob struct {
+	Name string `hcl:",key"`
+
+	// Interval is how often the job runs, e.g. "30s" or "5m"
+	Interval         string        `hcl:"interval"`
+	IntervalDuration time.Duration `hcl:"-"`
+}
+
+// JobInterval returns the configured interval for the named job, or def if
+// none is configured. It is safe to call on a nil Scheduler.
+func (s *Scheduler) JobInterval(name string, def time.Duration) time.Duration {
+	if s == nil {
+		return def
+	}
+	for _, j := range s.Jobs {
+		if j.Name == name && j.IntervalDuration > 0 {
+			return j.IntervalDuration
+		}
+	}
+	return def
 }
 
 type Worker struct {
@@ -105,6 +209,40 @@ type Worker struct {
 	Description string   `hcl:"description"`
 	Controllers []string `hcl:"controllers"`
 	PublicAddr  string   `hcl:"public_addr"`
+
+	// Tags are key/value pairs describing the worker, used by target worker
+	// filters to determine which workers are eligible to handle a session
+	Tags map[string][]string `hcl:"tags"`
+
+	// RecordingPath is the directory session recordings are written to
+	// until they have been uploaded to a controller. Recordings left in it
+	// are uploaded when the worker starts. Defaults to the system's
+	// temporary directory.
+	RecordingPath string `hcl:"recording_path"`
+}
+
+// Events configures where the controller sends audit events
+type Events struct {
+	Sinks []*EventSink `hcl:"sink"`
+}
+
+// EventSink is a single destination for audit events. The type is given as
+// the block label and is either "file" or "syslog".
+type EventSink struct {
+	Type string `hcl:",key"`
+
+	// Path is the file events are appended to, for file sinks
+	Path string `hcl:"path"`
+
+	// Facility and Tag are used for syslog sinks; they default to "AUTH" and
+	// "boundary"
+	Facility string `hcl:"facility"`
+	Tag      string `hcl:"tag"`
+
+	// Network and Address specify a remote syslog daemon, e.g. "udp" and
+	// "syslog.example.com:514". If unset the local daemon is used.
+	Network string `hcl:"network"`
+	Address string `hcl:"address"`
 }
 
 type Database struct {
@@ -204,12 +342,81 @@ func Parse(d string) (*Config, error) {
 		return nil, err
 	}
 
-	// Nothing to do here right now
 	result := New()
 	if err := hcl.DecodeObject(result, obj); err != nil {
 		return nil, err
 	}
 
+	if result.Controller != nil && result.Controller.Scheduler != nil {
+		for _, j := range result.Controller.Scheduler.Jobs {
+			if j.Interval == "" {
+				continue
+			}
+			j.IntervalDuration, err = parseutil.ParseDurationSecond(j.Interval)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing interval for scheduler job %q: %w", j.Name, err)
+			}
+			if j.IntervalDuration <= 0 {
+				return nil, fmt.Errorf("interval for scheduler job %q must be positive", j.Name)
+			}
+		}
+	}
+
+	if result.Controller != nil {
+		for _, p := range result.Controller.HostCatalogPlugins {
+			if p.Address == "" {
+				return nil, fmt.Errorf("address for host catalog plugin %q must be set", p.Name)
+			}
+			if err := ValidateHostCatalogPluginAddress(p.Address); err != nil {
+				return nil, fmt.Errorf("invalid address for host catalog plugin %q: %w", p.Name, err)
+			}
+		}
+	}
+
+	if result.Controller != nil && result.Controller.AuthenticateThrottle != nil {
+		t := result.Controller.AuthenticateThrottle
+		if t.Period != "" {
+			t.PeriodDuration, err = parseutil.ParseDurationSecond(t.Period)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing authenticate throttle period: %w", err)
+			}
+			if t.PeriodDuration <= 0 {
+				return nil, fmt.Errorf("authenticate throttle period must be positive")
+			}
+		}
+	}
+
+	if result.Controller != nil {
+		c := result.Controller
+		if c.StatusInterval != "" {
+			c.StatusIntervalDuration, err = parseutil.ParseDurationSecond(c.StatusInterval)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing status interval: %w", err)
+			}
+			if c.StatusIntervalDuration <= 0 {
+				return nil, fmt.Errorf("status interval must be positive")
+			}
+		}
+		if c.AuthTokenTimeToLive != "" {
+			c.AuthTokenTimeToLiveDuration, err = parseutil.ParseDurationSecond(c.AuthTokenTimeToLive)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing auth token time to live: %w", err)
+			}
+			if c.AuthTokenTimeToLiveDuration <= 0 {
+				return nil, fmt.Errorf("auth token time to live must be positive")
+			}
+		}
+		if c.AuthTokenTimeToStale != "" {
+			c.AuthTokenTimeToStaleDuration, err = parseutil.ParseDurationSecond(c.AuthTokenTimeToStale)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing auth token time to stale: %w", err)
+			}
+			if c.AuthTokenTimeToStaleDuration <= 0 {
+				return nil, fmt.Errorf("auth token time to stale must be positive")
+			}
+		}
+	}
+
 	sharedConfig, err := configutil.ParseConfig(d)
 	if err != nil {
 		return nil, err
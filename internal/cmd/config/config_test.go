@@ -108,6 +108,9 @@ func TestDevWorker(t *testing.T) {
 			Name:        "dev-worker",
 			Description: "A default worker created in dev mode",
 			Controllers: []string{"127.0.0.1"},
+			Tags: map[string][]string{
+				"type": {"dev", "local"},
+			},
 		},
 	}
 
@@ -115,3 +118,245 @@ func TestDevWorker(t *testing.T) {
 
 	assert.Equal(t, exp, actual)
 }
+
+func TestWorkerTags(t *testing.T) {
+	actual, err := Parse(`
+worker {
+	name = "tagged-worker"
+	tags {
+		type   = ["prod", "web"]
+		region = ["us-east-1"]
+	}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Worker{
+		Name: "tagged-worker",
+		Tags: map[string][]string{
+			"type":   {"prod", "web"},
+			"region": {"us-east-1"},
+		},
+	}
+	assert.Equal(t, exp, actual.Worker)
+}
+
+func TestEvents(t *testing.T) {
+	actual, err := Parse(`
+events {
+	sink "file" {
+		path = "/var/log/boundary/audit.log"
+	}
+	sink "syslog" {
+		facility = "LOCAL0"
+		tag      = "boundary-audit"
+		network  = "udp"
+		address  = "syslog.example.com:514"
+	}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Events{
+		Sinks: []*EventSink{
+			{
+				Type: "file",
+				Path: "/var/log/boundary/audit.log",
+			},
+			{
+				Type:     "syslog",
+				Facility: "LOCAL0",
+				Tag:      "boundary-audit",
+				Network:  "udp",
+				Address:  "syslog.example.com:514",
+			},
+		},
+	}
+	assert.Equal(t, exp, actual.Events)
+}
+
+func TestScheduler(t *testing.T) {
+	actual, err := Parse(`
+controller {
+	name = "test-controller"
+	scheduler {
+		job "terminate_completed_sessions" {
+			interval = "30s"
+		}
+		job "recovery_nonce_cleanup" {
+			interval = 600
+		}
+	}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &Scheduler{
+		Jobs: []*SchedulerJob{
+			{
+				Name:             "terminate_completed_sessions",
+				Interval:         "30s",
+				IntervalDuration: 30 * time.Second,
+			},
+			{
+				Name:             "recovery_nonce_cleanup",
+				Interval:         "600",
+				IntervalDuration: 10 * time.Minute,
+			},
+		},
+	}
+	assert.Equal(t, exp, actual.Controller.Scheduler)
+	assert.Equal(t, 30*time.Second, actual.Controller.Scheduler.JobInterval("terminate_completed_sessions", time.Minute))
+	assert.Equal(t, time.Minute, actual.Controller.Scheduler.JobInterval("unknown", time.Minute))
+
+	var nilScheduler *Scheduler
+	assert.Equal(t, time.Minute, nilScheduler.JobInterval("terminate_completed_sessions", time.Minute))
+
+	_, err = Parse(`
+controller {
+	scheduler {
+		job "terminate_completed_sessions" {
+			interval = "-1s"
+		}
+	}
+}`)
+	assert.Error(t, err)
+}
+
+func TestHostCatalogPlugins(t *testing.T) {
+	actual, err := Parse(`
+controller {
+	name = "test-controller"
+	host_catalog_plugin "cmdb" {
+		address = "unix:///var/run/boundary/cmdb.sock"
+	}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []*HostCatalogPlugin{
+		{
+			Name:    "cmdb",
+			Address: "unix:///var/run/boundary/cmdb.sock",
+		},
+	}
+	assert.Equal(t, exp, actual.Controller.HostCatalogPlugins)
+
+	_, err = Parse(`
+controller {
+	host_catalog_plugin "cmdb" {}
+}`)
+	assert.Error(t, err)
+
+	_, err = Parse(`
+controller {
+	host_catalog_plugin "cmdb" {
+		address = "10.0.0.5:9300"
+	}
+}`)
+	assert.Error(t, err)
+}
+
+func TestValidateHostCatalogPluginAddress(t *testing.T) {
+	tests := []struct {
+		addr    string
+		wantErr bool
+	}{
+		{addr: "unix:///var/run/boundary/cmdb.sock"},
+		{addr: "unix:cmdb.sock"},
+		{addr: "127.0.0.1:9300"},
+		{addr: "[::1]:9300"},
+		{addr: "unix://", wantErr: true},
+		{addr: "10.0.0.5:9300", wantErr: true},
+		{addr: "0.0.0.0:9300", wantErr: true},
+		{addr: "localhost:9300", wantErr: true},
+		{addr: "cmdb.example.com:9300", wantErr: true},
+		{addr: "127.0.0.1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			err := ValidateHostCatalogPluginAddress(tt.addr)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthenticateThrottle(t *testing.T) {
+	actual, err := Parse(`
+controller {
+	name = "test-controller"
+	authenticate_throttle {
+		attempts = 5
+		period = "30s"
+	}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := &AuthenticateThrottle{
+		Attempts:       5,
+		Period:         "30s",
+		PeriodDuration: 30 * time.Second,
+	}
+	assert.Equal(t, exp, actual.Controller.AuthenticateThrottle)
+
+	_, err = Parse(`
+controller {
+	authenticate_throttle {
+		period = "-1s"
+	}
+}`)
+	assert.Error(t, err)
+}
+
+func TestAuthTokenDurations(t *testing.T) {
+	actual, err := Parse(`
+controller {
+	name = "test-controller"
+	auth_token_time_to_live = "8h"
+	auth_token_time_to_stale = "900"
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 8*time.Hour, actual.Controller.AuthTokenTimeToLiveDuration)
+	assert.Equal(t, 15*time.Minute, actual.Controller.AuthTokenTimeToStaleDuration)
+
+	_, err = Parse(`
+controller {
+	auth_token_time_to_live = "0s"
+}`)
+	assert.Error(t, err)
+
+	_, err = Parse(`
+controller {
+	auth_token_time_to_stale = "soon"
+}`)
+	assert.Error(t, err)
+}
+
+func TestStatusInterval(t *testing.T) {
+	actual, err := Parse(`
+controller {
+	status_interval = "30s"
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 30*time.Second, actual.Controller.StatusIntervalDuration)
+
+	_, err = Parse(`
+controller {
+	status_interval = "-5s"
+}`)
+	assert.Error(t, err)
+}
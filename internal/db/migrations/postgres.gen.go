@@ -5355,6 +5355,2361 @@ begin;
 
 commit;
 
+`),
+	},
+	"migrations/70_server_tags.down.sql": {
+		name: "70_server_tags.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table server_tag;
+
+commit;
+
+`),
+	},
+	"migrations/70_server_tags.up.sql": {
+		name: "70_server_tags.up.sql",
+		bytes: []byte(`
+begin;
+
+-- server_tag contains the tags reported by workers in their status requests.
+-- A tag is a key with one or more values; each value is stored as a separate
+-- row. Tags are replaced as a whole whenever a worker reports them.
+create table server_tag (
+    server_id text not null,
+    server_type text not null,
+    key text not null
+      constraint server_tag_key_must_not_be_empty
+      check(length(trim(key)) > 0),
+    value text not null
+      constraint server_tag_value_must_not_be_empty
+      check(length(trim(value)) > 0),
+    foreign key (server_id, server_type)
+      references server(private_id, type)
+      on delete cascade
+      on update cascade,
+    primary key (server_id, server_type, key, value)
+  );
+
+commit;
+
+`),
+	},
+	"migrations/71_target_worker_filter.down.sql": {
+		name: "71_target_worker_filter.down.sql",
+		bytes: []byte(`
+begin;
+
+drop view target_all_subtypes;
+
+alter table target_tcp
+  drop column worker_filter;
+
+create view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type
+  from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/71_target_worker_filter.up.sql": {
+		name: "71_target_worker_filter.up.sql",
+		bytes: []byte(`
+begin;
+
+-- worker_filter is an optional boolean expression evaluated against the name
+-- and tags of each worker when authorizing a session. Only workers matching
+-- the filter are returned to the client.
+alter table target_tcp
+  add column worker_filter text
+    constraint worker_filter_must_not_be_empty
+    check(length(trim(worker_filter)) > 0);
+
+-- target_all_subtypes is a union of all target subtypes 
+create or replace view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter
+  from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/72_auth_oidc.down.sql": {
+		name: "72_auth_oidc.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table if exists auth_oidc_account;
+  drop table if exists auth_oidc_method;
+
+  delete from oplog_ticket
+   where name in ('auth_oidc_method', 'auth_oidc_account');
+
+commit;
+
+`),
+	},
+	"migrations/72_auth_oidc.up.sql": {
+		name: "72_auth_oidc.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐
+       │  auth_method   │                 │   auth_oidc_method   │
+       ├────────────────┤                 ├──────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │
+       │                │                 │ ...                  │
+       └────────────────┘                 └──────────────────────┘
+                ┼                                     ┼
+                ┼                                     ┼
+                │                                     │
+                │ ▲fk1                                │ ▲fk1
+                │                                     │
+                ○                                     ○
+               ╱│╲                                   ╱│╲
+  ┌──────────────────────────┐          ┌──────────────────────────┐
+  │       auth_account       │          │    auth_oidc_account     │
+  ├──────────────────────────┤          ├──────────────────────────┤
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ ...                      │
+  │ iam_user_id       (fk2)  │          └──────────────────────────┘
+  └──────────────────────────┘
+
+  An auth_oidc_method is an auth_method subtype. For every row in
+  auth_oidc_method there is one row in auth_method with the same public_id and
+  scope_id.
+
+  An auth_oidc_account is an auth_account subtype. For every row in
+  auth_oidc_account there is one row in auth_account with the same public_id,
+  scope_id, and auth_method_id.
+
+  An auth_oidc_account is created the first time a subject authenticates with
+  an auth_oidc_method. The subject is unique within the auth_oidc_method.
+
+*/
+
+  create table auth_oidc_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    issuer text not null
+      constraint issuer_must_not_be_empty
+      check(length(trim(issuer)) > 0),
+    client_id text not null
+      constraint client_id_must_not_be_empty
+      check(length(trim(client_id)) > 0),
+    client_secret bytea not null
+      constraint client_secret_must_not_be_empty
+      check(length(client_secret) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_oidc_method
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_method_subtype
+  before insert on auth_oidc_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_oidc_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE(mgaffney): The scope_id type is not wt_scope_id because the domain
+    -- check is executed before the insert trigger which retrieves the scope_id
+    -- causing an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    subject text not null
+      constraint subject_must_not_be_empty
+      check(length(trim(subject)) > 0),
+    email text,
+    full_name text,
+    version wt_version,
+    foreign key (scope_id, auth_method_id)
+      references auth_oidc_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, subject),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_oidc_account
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_account_subtype
+  before insert on auth_oidc_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  --
+  -- triggers for time columns
+  --
+
+  create trigger
+    update_time_column
+  before
+  update on auth_oidc_method
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_oidc_method
+    for each row execute procedure immutable_columns('create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_oidc_method
+    for each row execute procedure default_create_time();
+
+  create trigger
+    update_time_column
+  before
+  update on auth_oidc_account
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_oidc_account
+    for each row execute procedure immutable_columns('create_time', 'subject');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_oidc_account
+    for each row execute procedure default_create_time();
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_oidc_method', 1),
+    ('auth_oidc_account', 1);
+
+commit;
+
+`),
+	},
+	"migrations/73_job.down.sql": {
+		name: "73_job.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table job;
+  drop table job_run_status_enm;
+
+commit;
+
+`),
+	},
+	"migrations/73_job.up.sql": {
+		name: "73_job.up.sql",
+		bytes: []byte(`
+begin;
+
+  -- job_run_status_enm contains the possible outcomes of a job run.
+  create table job_run_status_enm (
+    name text primary key
+      constraint only_predefined_job_run_statuses_allowed
+      check(name in ('succeeded', 'failed'))
+  );
+
+  insert into job_run_status_enm (name)
+  values
+    ('succeeded'),
+    ('failed');
+
+  -- job contains the background jobs run by the controller scheduler. Jobs
+  -- are registered by name when a controller starts. Before running a job a
+  -- controller must acquire its lease by setting lease_holder and
+  -- lease_expiration, which ensures only one controller runs a job at a time.
+  -- Expired leases may be taken over by any controller, so a job whose
+  -- controller went away is not stuck.
+  create table job (
+    name text primary key
+      constraint job_name_must_not_be_empty
+      check(length(trim(name)) > 0),
+    description text not null,
+    next_scheduled_run timestamp with time zone not null
+      default current_timestamp,
+    last_run_start timestamp with time zone,
+    last_run_end timestamp with time zone,
+    last_run_status text
+      references job_run_status_enm(name)
+      on delete restrict
+      on update cascade,
+    last_run_error text,
+    last_run_controller_id text,
+    lease_holder text,
+    lease_expiration timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint job_lease_holder_and_expiration_set_together
+      check(
+        (lease_holder is null and lease_expiration is null)
+        or
+        (lease_holder is not null and lease_expiration is not null)
+      )
+  );
+
+  create trigger
+    immutable_columns
+  before
+  update on job
+    for each row execute procedure immutable_columns('name', 'create_time');
+
+  create trigger
+    update_time_column
+  before update on job
+    for each row execute procedure update_time_column();
+
+  create trigger
+    default_create_time_column
+  before
+  insert on job
+    for each row execute procedure default_create_time();
+
+commit;
+
+`),
+	},
+	"migrations/74_kms_rewrap.down.sql": {
+		name: "74_kms_rewrap.down.sql",
+		bytes: []byte(`
+begin;
+
+drop trigger immutable_columns on oplog_entry;
+create trigger
+  immutable_columns
+before
+update on oplog_entry
+  for each row execute procedure immutable_columns('id','update_time','create_time','version','aggregate_name', 'data');
+
+alter table oplog_entry
+  drop column key_id;
+
+create or replace function
+  immutable_auth_token_columns()
+  returns trigger
+as $$
+begin
+  if new.auth_account_id is distinct from old.auth_account_id then
+    raise exception 'auth_account_id is read-only';
+  end if;
+  if new.token is distinct from old.token then
+    raise exception 'token is read-only';
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+drop view kms_current_key_version;
+
+drop trigger immutable_columns on kms_token_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_token_key_version
+  for each row execute procedure immutable_columns('private_id', 'token_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_session_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_session_key_version
+  for each row execute procedure immutable_columns('private_id', 'session_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_oplog_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_oplog_key_version
+  for each row execute procedure immutable_columns('private_id', 'oplog_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_database_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_database_key_version
+  for each row execute procedure immutable_columns('private_id', 'database_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+commit;
+
+`),
+	},
+	"migrations/74_kms_rewrap.up.sql": {
+		name: "74_kms_rewrap.up.sql",
+		bytes: []byte(`
+begin;
+
+-- DEK versions can be rewrapped under a newer root key version, which changes
+-- the encrypted key and the root key version it references. Everything else
+-- about a key version remains immutable.
+drop trigger immutable_columns on kms_database_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_database_key_version
+  for each row execute procedure immutable_columns('private_id', 'database_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_oplog_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_oplog_key_version
+  for each row execute procedure immutable_columns('private_id', 'oplog_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_session_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_session_key_version
+  for each row execute procedure immutable_columns('private_id', 'session_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_token_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_token_key_version
+  for each row execute procedure immutable_columns('private_id', 'token_key_id', 'version', 'create_time');
+
+-- kms_current_key_version contains the newest key version for each scope and
+-- purpose. This is the key version new data is encrypted with, and the one
+-- existing data is rewrapped under.
+create view kms_current_key_version as
+  (select distinct on (kv.database_key_id)
+          rk.scope_id,
+          'database' as purpose,
+          kv.private_id as key_version_id
+     from kms_database_key_version kv
+     join kms_database_key k
+       on kv.database_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.database_key_id, kv.version desc)
+union all
+  (select distinct on (kv.oplog_key_id)
+          rk.scope_id,
+          'oplog' as purpose,
+          kv.private_id as key_version_id
+     from kms_oplog_key_version kv
+     join kms_oplog_key k
+       on kv.oplog_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.oplog_key_id, kv.version desc)
+union all
+  (select distinct on (kv.session_key_id)
+          rk.scope_id,
+          'sessions' as purpose,
+          kv.private_id as key_version_id
+     from kms_session_key_version kv
+     join kms_session_key k
+       on kv.session_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.session_key_id, kv.version desc)
+union all
+  (select distinct on (kv.token_key_id)
+          rk.scope_id,
+          'tokens' as purpose,
+          kv.private_id as key_version_id
+     from kms_token_key_version kv
+     join kms_token_key k
+       on kv.token_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.token_key_id, kv.version desc);
+
+-- The encrypted token value of an auth token may only change when it is
+-- rewrapped, in which case the key id changes with it.
+create or replace function
+  immutable_auth_token_columns()
+  returns trigger
+as $$
+begin
+  if new.auth_account_id is distinct from old.auth_account_id then
+    raise exception 'auth_account_id is read-only';
+  end if;
+  if new.token is distinct from old.token and new.key_id is not distinct from old.key_id then
+    raise exception 'token is read-only';
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+-- key_id is the oplog key version the entry's data is encrypted with. It is
+-- null until the entry is first visited by a rewrap, since the key id is only
+-- recorded within the encrypted data when the entry is written.
+alter table oplog_entry
+  add column key_id text;
+
+-- Entry data can be rewrapped under a newer key version.
+drop trigger immutable_columns on oplog_entry;
+create trigger
+  immutable_columns
+before
+update on oplog_entry
+  for each row execute procedure immutable_columns('id','update_time','create_time','version','aggregate_name');
+
+commit;
+
+`),
+	},
+	"migrations/75_session_recording.down.sql": {
+		name: "75_session_recording.down.sql",
+		bytes: []byte(`
+begin;
+
+drop table session_recording_chunk;
+drop table session_recording;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint');
+
+alter table session
+  drop column enable_session_recording;
+
+drop view target_all_subtypes;
+
+alter table target_tcp
+  drop column enable_session_recording;
+
+create view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter
+  from target_tcp;
+
+commit;
+
+`),
+	},
+	"migrations/75_session_recording.up.sql": {
+		name: "75_session_recording.up.sql",
+		bytes: []byte(`
+begin;
+
+-- enable_session_recording determines whether the connections of sessions
+-- authorized by the target are recorded by the worker proxying them.
+alter table target_tcp
+  add column enable_session_recording boolean not null default false;
+
+-- target_all_subtypes is a union of all target subtypes 
+create or replace view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter,
+  enable_session_recording
+  from target_tcp;
+
+-- enable_session_recording is copied from the target when the session is
+-- authorized, so changing the target does not affect existing sessions.
+alter table session
+  add column enable_session_recording boolean not null default false;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording');
+
+-- session_recording contains the recording of a single session connection,
+-- uploaded by the worker that proxied the connection.  The recording is
+-- encrypted by the worker with a key derived from the session's private key,
+-- which in turn is derived from the version of the scope's sessions key
+-- identified by key_id.
+create table session_recording (
+  connection_id wt_public_id primary key
+    references session_connection (public_id)
+    on delete cascade
+    on update cascade,
+  session_id wt_public_id not null
+    references session (public_id)
+    on delete cascade
+    on update cascade,
+  key_id text not null
+    constraint key_id_must_not_be_empty
+    check(length(trim(key_id)) > 0),
+  -- complete is set once the worker has uploaded the final chunk
+  complete boolean not null default false,
+  create_time wt_timestamp,
+  update_time wt_timestamp
+);
+
+create trigger 
+  immutable_columns
+before
+update on session_recording
+  for each row execute procedure immutable_columns('connection_id', 'session_id', 'key_id', 'create_time');
+
+create trigger 
+  update_time_column 
+before update on session_recording 
+  for each row execute procedure update_time_column();
+
+create trigger 
+  default_create_time_column
+before
+insert on session_recording
+  for each row execute procedure default_create_time();
+
+-- session_recording_chunk contains the chunks of a recording in the order
+-- they were uploaded.  Chunks are opaque to the controller; concatenated in
+-- sequence order they form the recording file.
+create table session_recording_chunk (
+  connection_id wt_public_id not null
+    references session_recording (connection_id)
+    on delete cascade
+    on update cascade,
+  sequence integer not null
+    constraint sequence_must_not_be_negative
+    check(sequence >= 0),
+  data bytea not null,
+  create_time wt_timestamp,
+  primary key(connection_id, sequence)
+);
+
+create trigger 
+  immutable_columns
+before
+update on session_recording_chunk
+  for each row execute procedure immutable_columns('connection_id', 'sequence', 'data', 'create_time');
+
+create trigger 
+  default_create_time_column
+before
+insert on session_recording_chunk
+  for each row execute procedure default_create_time();
+
+commit;
+
+`),
+	},
+	"migrations/76_static_host_address.down.sql": {
+		name: "76_static_host_address.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table static_host_address;
+
+  alter table static_host
+    drop column port;
+
+commit;
+
+`),
+	},
+	"migrations/76_static_host_address.up.sql": {
+		name: "76_static_host_address.up.sql",
+		bytes: []byte(`
+begin;
+
+-- port is the optional port of a static host. When set it is used instead of
+-- the default port of the target when connecting to the host.
+alter table static_host
+  add column port integer
+    constraint port_must_be_a_valid_port_number
+    check(port > 0 and port < 65536);
+
+-- static_host_address contains the alternate addresses of a static host. They
+-- are tried in order of priority, lowest first, when connecting to the host's
+-- address fails. The alternate addresses of a host are replaced as a whole
+-- whenever they are updated.
+create table static_host_address (
+    host_id wt_public_id not null
+      references static_host (public_id)
+      on delete cascade
+      on update cascade,
+    address text not null
+      constraint address_must_be_more_than_2_characters
+      check(length(trim(address)) > 2)
+      constraint address_must_be_less_than_256_characters
+      check(length(trim(address)) < 256),
+    priority integer not null
+      constraint priority_must_be_greater_than_0
+      check(priority > 0),
+    primary key(host_id, address),
+    unique(host_id, priority)
+  );
+
+create trigger immutable_columns before update on static_host_address
+  for each row execute procedure immutable_columns('host_id', 'address', 'priority');
+
+commit;
+
+`),
+	},
+	"migrations/77_dns_host.down.sql": {
+		name: "77_dns_host.down.sql",
+		bytes: []byte(`
+begin;
+
+  -- restore whx_host_dimension_source from 65_wh_session_dimensions.up.sql
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  drop table dns_host_set_member;
+  drop function insert_dns_host_set_member;
+  drop table dns_host_set_record_name;
+  drop table dns_host_set;
+  drop table dns_host;
+  drop table dns_host_catalog;
+
+  delete from oplog_ticket
+   where name in ('dns_host_catalog', 'dns_host_set');
+
+commit;
+
+`),
+	},
+	"migrations/77_dns_host.up.sql": {
+		name: "77_dns_host.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+  ┌─────────────────┐          ┌─────────────────────┐
+  │      host       │          │      dns_host       │
+  ├─────────────────┤          ├─────────────────────┤
+  │ public_id  (pk) │          │ public_id  (pk)     │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │┼┼─────────────────────┐
+  │                 │          │ address             │             ◀fk1      │
+  └─────────────────┘          │ port                │                       │
+          ╲│╱                  └─────────────────────┘                       │
+           ○                             ╲│╱                                 │
+           │                              ○                                  ○
+           ┼                              ┼                                 ╱│╲
+           ┼                              ┼                     ┌────────────────────────┐
+  ┌─────────────────┐          ┌─────────────────────┐          │  dns_host_set_member   │
+  │  host_catalog   │          │  dns_host_catalog   │          ├────────────────────────┤
+  ├─────────────────┤          ├─────────────────────┤          │ host_id    (pk,fk1)    │
+  │ public_id (pk)  │          │ public_id (pk)      │          │ set_id     (pk,fk2)    │
+  │ scope_id  (fk)  │┼┼──────○┼│ scope_id  (fk)      │          │ catalog_id (fk1,fk2)   │
+  │                 │          │ resolver_address    │          └────────────────────────┘
+  └─────────────────┘          └─────────────────────┘                      ╲│╱
+           ┼                              ┼                                  ○
+           ┼                              ┼                                  │
+           │                              │                                  │
+           ○                              ○                                  │
+          ╱│╲                            ╱│╲                                 │
+  ┌─────────────────┐          ┌─────────────────────┐                       │
+  │    host_set     │          │    dns_host_set     │             ◀fk2      │
+  ├─────────────────┤          ├─────────────────────┤                       │
+  │ public_id  (pk) │          │ public_id  (pk)     │┼┼─────────────────────┘
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │
+  │                 │          │                     │┼┼──────────────────────┐
+  └─────────────────┘          └─────────────────────┘                        │
+                                                                              ○
+                                                                             ╱│╲
+                                                            ┌──────────────────────────┐
+                                                            │ dns_host_set_record_name │
+                                                            ├──────────────────────────┤
+                                                            │ set_id      (pk,fk)      │
+                                                            │ record_name (pk)         │
+                                                            └──────────────────────────┘
+
+  A dns_host_set contains the DNS record names which are resolved to find the
+  hosts in the set. The dns_host rows and the dns_host_set_member rows are not
+  changed by users; they are written by the controller each time the record
+  names of a set are resolved.
+
+*/
+
+  create table dns_host_catalog (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    resolver_address text
+      constraint resolver_address_must_not_be_empty
+      check(length(trim(resolver_address)) > 0),
+    foreign key (scope_id, public_id)
+      references host_catalog (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name)
+  );
+
+  create trigger update_version_column after update on dns_host_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dns_host_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host_catalog
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger insert_host_catalog_subtype before insert on dns_host_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on dns_host_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  create table dns_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dns_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    address text not null
+      constraint address_must_not_be_empty
+      check(length(trim(address)) > 0),
+    port integer not null default 0
+      constraint port_must_be_0_or_a_valid_port_number
+      check(port >= 0 and port < 65536),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    unique(catalog_id, address, port),
+
+    foreign key (catalog_id, public_id)
+      references host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_time_column before update on dns_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'address', 'port', 'create_time');
+
+  create trigger insert_host_subtype before insert on dns_host
+    for each row execute procedure insert_host_subtype();
+
+  create trigger delete_host_subtype after delete on dns_host
+    for each row execute procedure delete_host_subtype();
+
+  create table dns_host_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dns_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(catalog_id, name),
+    foreign key (catalog_id, public_id)
+      references host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on dns_host_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dns_host_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'create_time');
+
+  create trigger insert_host_set_subtype before insert on dns_host_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on dns_host_set
+    for each row execute procedure delete_host_set_subtype();
+
+  -- dns_host_set_record_name contains the record names of a dns host set. The
+  -- record names of a set are replaced as a whole whenever they are updated.
+  create table dns_host_set_record_name (
+    set_id wt_public_id not null
+      references dns_host_set (public_id)
+      on delete cascade
+      on update cascade,
+    record_name text not null
+      constraint record_name_must_not_be_empty
+      check(length(trim(record_name)) > 0)
+      constraint record_name_must_be_less_than_254_characters
+      check(length(trim(record_name)) < 254),
+    primary key(set_id, record_name)
+  );
+
+  create trigger immutable_columns before update on dns_host_set_record_name
+    for each row execute procedure immutable_columns('set_id', 'record_name');
+
+  create table dns_host_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    primary key(host_id, set_id),
+    foreign key (catalog_id, host_id) -- fk1
+      references dns_host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (catalog_id, set_id) -- fk2
+      references dns_host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger immutable_columns before update on dns_host_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id');
+
+  create or replace function insert_dns_host_set_member()
+    returns trigger
+  as $$
+  begin
+    select dns_host_set.catalog_id
+      into new.catalog_id
+    from dns_host_set
+    where dns_host_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_dns_host_set_member before insert on dns_host_set_member
+    for each row execute procedure insert_dns_host_set_member();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('dns_host_catalog', 1),
+    ('dns_host_set', 1);
+
+  -- whx_host_dimension_source is replaced to include the dns hosts so sessions
+  -- to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'dns host'                      as host_type,
+         'None'                          as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dns host set'                  as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dns host catalog'              as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dns_host as h,
+         dns_host_catalog as c,
+         dns_host_set_member as m,
+         dns_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+commit;
+
+`),
+	},
+	"migrations/78_static_host_attribute.down.sql": {
+		name: "78_static_host_attribute.down.sql",
+		bytes: []byte(`
+begin;
+
+  alter table static_host_set
+    drop column selector;
+
+  drop table static_host_attribute;
+
+commit;
+
+`),
+	},
+	"migrations/78_static_host_attribute.up.sql": {
+		name: "78_static_host_attribute.up.sql",
+		bytes: []byte(`
+begin;
+
+-- static_host_attribute contains the attributes of a static host, which are
+-- key/value pairs the selectors of static host sets are matched against. The
+-- attributes of a host are replaced as a whole whenever they are updated.
+create table static_host_attribute (
+    host_id wt_public_id not null
+      references static_host (public_id)
+      on delete cascade
+      on update cascade,
+    key text not null
+      constraint key_must_not_be_empty
+      check(length(trim(key)) > 0)
+      constraint key_must_be_less_than_256_characters
+      check(length(key) < 256),
+    value text not null
+      constraint value_must_be_less_than_1025_characters
+      check(length(value) < 1025),
+    primary key(host_id, key)
+  );
+
+create trigger immutable_columns before update on static_host_attribute
+  for each row execute procedure immutable_columns('host_id', 'key', 'value');
+
+-- selector is the optional filter expression of a static host set. The hosts
+-- of the set's catalog whose attributes match it are members of the set in
+-- addition to the hosts added to it.
+alter table static_host_set
+  add column selector text
+    constraint selector_must_not_be_empty
+    check(length(trim(selector)) > 0);
+
+commit;
+
+`),
+	},
+	"migrations/79_auth_ldap.down.sql": {
+		name: "79_auth_ldap.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table if exists auth_ldap_account;
+  drop table if exists auth_ldap_method;
+
+  delete from oplog_ticket
+   where name in ('auth_ldap_method', 'auth_ldap_account');
+
+commit;
+
+`),
+	},
+	"migrations/79_auth_ldap.up.sql": {
+		name: "79_auth_ldap.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐
+       │  auth_method   │                 │   auth_ldap_method   │
+       ├────────────────┤                 ├──────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │
+       │                │                 │ ...                  │
+       └────────────────┘                 └──────────────────────┘
+                ┼                                     ┼
+                ┼                                     ┼
+                │                                     │
+                │ ▲fk1                                │ ▲fk1
+                │                                     │
+                ○                                     ○
+               ╱│╲                                   ╱│╲
+  ┌──────────────────────────┐          ┌──────────────────────────┐
+  │       auth_account       │          │    auth_ldap_account     │
+  ├──────────────────────────┤          ├──────────────────────────┤
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ ...                      │
+  │ iam_user_id       (fk2)  │          └──────────────────────────┘
+  └──────────────────────────┘
+
+  An auth_ldap_method is an auth_method subtype. For every row in
+  auth_ldap_method there is one row in auth_method with the same public_id and
+  scope_id.
+
+  An auth_ldap_account is an auth_account subtype. For every row in
+  auth_ldap_account there is one row in auth_account with the same public_id,
+  scope_id, and auth_method_id.
+
+  An auth_ldap_account is created the first time a login name authenticates
+  with an auth_ldap_method. The login name is unique within the
+  auth_ldap_method.
+
+*/
+
+  create table auth_ldap_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    url text not null
+      constraint url_must_not_be_empty
+      check(length(trim(url)) > 0),
+    start_tls boolean not null
+      default false,
+    certificate text
+      constraint certificate_must_not_be_empty
+      check(length(trim(certificate)) > 0),
+    bind_dn text
+      constraint bind_dn_must_not_be_empty
+      check(length(trim(bind_dn)) > 0),
+    bind_password bytea not null
+      constraint bind_password_must_not_be_empty
+      check(length(bind_password) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    user_dn text not null
+      constraint user_dn_must_not_be_empty
+      check(length(trim(user_dn)) > 0),
+    user_attr text not null
+      constraint user_attr_must_not_be_empty
+      check(length(trim(user_attr)) > 0),
+    group_dn text
+      constraint group_dn_must_not_be_empty
+      check(length(trim(group_dn)) > 0),
+    group_attr text not null
+      constraint group_attr_must_not_be_empty
+      check(length(trim(group_attr)) > 0),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_ldap_method
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_method_subtype
+  before insert on auth_ldap_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_ldap_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE(mgaffney): The scope_id type is not wt_scope_id because the domain
+    -- check is executed before the insert trigger which retrieves the scope_id
+    -- causing an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    login_name text not null
+      constraint login_name_must_be_lowercase
+      check(lower(trim(login_name)) = login_name)
+      constraint login_name_must_not_be_empty
+      check(length(trim(login_name)) > 0),
+    dn text not null
+      constraint dn_must_not_be_empty
+      check(length(trim(dn)) > 0),
+    email text,
+    full_name text,
+    member_of_groups text,
+    version wt_version,
+    foreign key (scope_id, auth_method_id)
+      references auth_ldap_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, login_name),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_ldap_account
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_account_subtype
+  before insert on auth_ldap_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  --
+  -- triggers for time columns
+  --
+
+  create trigger
+    update_time_column
+  before
+  update on auth_ldap_method
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_ldap_method
+    for each row execute procedure immutable_columns('create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_ldap_method
+    for each row execute procedure default_create_time();
+
+  create trigger
+    update_time_column
+  before
+  update on auth_ldap_account
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_ldap_account
+    for each row execute procedure immutable_columns('create_time', 'login_name');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_ldap_account
+    for each row execute procedure default_create_time();
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_ldap_method', 1),
+    ('auth_ldap_account', 1);
+
+commit;
+
+`),
+	},
+	"migrations/80_auth_password_lockout.down.sql": {
+		name: "80_auth_password_lockout.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table auth_password_account_lockout;
+
+  alter table auth_password_method
+    drop column lockout_threshold,
+    drop column lockout_duration_seconds;
+
+commit;
+
+`),
+	},
+	"migrations/80_auth_password_lockout.up.sql": {
+		name: "80_auth_password_lockout.up.sql",
+		bytes: []byte(`
+begin;
+
+-- lockout_threshold is the number of consecutive failed authentication
+-- attempts after which an account of the auth method is locked. Zero disables
+-- lockout. lockout_duration_seconds is how long an account stays locked; zero
+-- keeps an account locked until it is explicitly unlocked.
+alter table auth_password_method
+  add column lockout_threshold int
+    not null
+    default 0
+    constraint lockout_threshold_must_not_be_negative
+    check(lockout_threshold >= 0),
+  add column lockout_duration_seconds int
+    not null
+    default 0
+    constraint lockout_duration_seconds_must_not_be_negative
+    check(lockout_duration_seconds >= 0);
+
+-- auth_password_account_lockout contains the failed authentication attempts
+-- of a password account since its last successful authentication. It is kept
+-- apart from auth_password_account so recording a failed attempt does not
+-- change the version of the account. locked_until is set, and failed_attempts
+-- reset, when failed_attempts reaches the lockout_threshold of the auth
+-- method; it is 'infinity' if the account is locked until unlocked. A row is
+-- deleted when the account authenticates successfully or is unlocked.
+create table auth_password_account_lockout (
+    account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    failed_attempts int not null
+      default 0
+      constraint failed_attempts_must_not_be_negative
+      check(failed_attempts >= 0),
+    locked_until timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+
+create trigger update_time_column before update on auth_password_account_lockout
+  for each row execute procedure update_time_column();
+
+create trigger default_create_time_column before insert on auth_password_account_lockout
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_account_lockout
+  for each row execute procedure immutable_columns('account_id', 'create_time');
+
+commit;
+
+`),
+	},
+	"migrations/81_auth_password_policy.down.sql": {
+		name: "81_auth_password_policy.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table auth_password_argon2_cred_history;
+
+  drop view auth_password_current_conf;
+
+  create view auth_password_current_conf as
+      select pm.min_login_name_length, pm.min_password_length, c.*
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  alter table auth_password_method
+    drop column min_character_classes,
+    drop column password_history_count,
+    drop column max_password_age_seconds,
+    drop column disallow_login_name;
+
+commit;
+
+`),
+	},
+	"migrations/81_auth_password_policy.up.sql": {
+		name: "81_auth_password_policy.up.sql",
+		bytes: []byte(`
+begin;
+
+-- The password policy of an auth method. min_character_classes is the number
+-- of character classes (lowercase letters, uppercase letters, digits and
+-- other characters) a password must contain. password_history_count is the
+-- number of most recent passwords of an account, including its current
+-- password, which cannot be reused. max_password_age_seconds is how long a
+-- password can be used before it must be changed. disallow_login_name
+-- rejects passwords containing the login name of the account. Zero or false
+-- disables a setting.
+alter table auth_password_method
+  add column min_character_classes int
+    not null
+    default 0
+    constraint min_character_classes_must_be_between_0_and_4
+    check(min_character_classes between 0 and 4),
+  add column password_history_count int
+    not null
+    default 0
+    constraint password_history_count_must_not_be_negative
+    check(password_history_count >= 0),
+  add column max_password_age_seconds int
+    not null
+    default 0
+    constraint max_password_age_seconds_must_not_be_negative
+    check(max_password_age_seconds >= 0),
+  add column disallow_login_name boolean
+    not null
+    default false;
+
+-- The policy columns are inserted before the configuration columns so the
+-- view has to be dropped rather than replaced.
+drop view auth_password_current_conf;
+
+create view auth_password_current_conf as
+    -- Rerun this query whenever auth_password_conf_union is updated.
+    select pm.min_login_name_length, pm.min_password_length,
+           pm.min_character_classes, pm.password_history_count,
+           pm.max_password_age_seconds, pm.disallow_login_name,
+           c.*
+      from auth_password_method pm
+inner join auth_password_conf_union c
+        on pm.password_conf_id = c.password_conf_id;
+
+-- auth_password_argon2_cred_history contains the argon2 credentials an
+-- account used before its current credential. It is only written to when
+-- the password_history_count of the auth method is greater than one and is
+-- pruned to the password_history_count - 1 most recent credentials whenever
+-- the password of an account is changed or set. Each row references the
+-- argon2 configuration its derived key was created with so a new password
+-- can be compared to it.
+create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    password_conf_id wt_private_id not null
+      references auth_password_argon2_conf (private_id)
+      on delete cascade
+      on update cascade,
+    password_method_id text not null,
+    create_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+      check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+      check(length(derived_key) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0)
+  );
+
+create trigger default_create_time_column before insert on auth_password_argon2_cred_history
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_argon2_cred_history
+  for each row execute procedure immutable_columns('private_id', 'password_account_id', 'password_conf_id', 'password_method_id', 'create_time', 'derived_key');
+
+commit;
+
+`),
+	},
+	"migrations/82_auth_password_totp.down.sql": {
+		name: "82_auth_password_totp.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop table auth_password_account_recovery_code;
+  drop table auth_password_account_totp;
+
+  alter table auth_password_method
+    drop column mfa_required;
+
+commit;
+
+`),
+	},
+	"migrations/82_auth_password_totp.up.sql": {
+		name: "82_auth_password_totp.up.sql",
+		bytes: []byte(`
+begin;
+
+-- mfa_required requires every account of the auth method to authenticate
+-- with a TOTP code or a recovery code in addition to its password.
+alter table auth_password_method
+  add column mfa_required boolean
+    not null
+    default false;
+
+-- auth_password_account_totp contains the TOTP enrollments of password
+-- accounts. An account has at most one verified enrollment, which is the
+-- active second factor of the account, and at most one enrollment which has
+-- not been verified yet. Verifying an enrollment replaces the verified one so
+-- re-enrolling an account does not disable its active second factor. secret
+-- is encrypted with the database key key_id. last_used_step is the last time
+-- step a code was accepted for; codes for the same or an earlier step are
+-- rejected so a code cannot be replayed.
+create table auth_password_account_totp (
+    account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    verified boolean not null
+      default false,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+      check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    last_used_step bigint not null
+      default 0,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key(account_id, verified)
+  );
+
+create trigger update_time_column before update on auth_password_account_totp
+  for each row execute procedure update_time_column();
+
+create trigger default_create_time_column before insert on auth_password_account_totp
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_account_totp
+  for each row execute procedure immutable_columns('account_id', 'create_time');
+
+-- auth_password_account_recovery_code contains the sha256 hashes of the
+-- unused recovery codes of a password account. A recovery code can be used
+-- once in place of a TOTP code and is deleted when it is used. The recovery
+-- codes of an account are replaced whenever a TOTP enrollment is verified.
+create table auth_password_account_recovery_code (
+    account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+      check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key(account_id, code_hash)
+  );
+
+create trigger default_create_time_column before insert on auth_password_account_recovery_code
+  for each row execute procedure default_create_time();
+
+commit;
+
+`),
+	},
+	"migrations/83_auth_token_settings.down.sql": {
+		name: "83_auth_token_settings.down.sql",
+		bytes: []byte(`
+begin;
+
+  drop view auth_method_token_settings;
+
+  alter table auth_ldap_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+  alter table auth_oidc_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+  alter table auth_password_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+commit;
+
+`),
+	},
+	"migrations/83_auth_token_settings.up.sql": {
+		name: "83_auth_token_settings.up.sql",
+		bytes: []byte(`
+begin;
+
+-- The auth token settings of an auth method. max_token_duration_seconds is
+-- how long an auth token issued by the auth method is valid for after it is
+-- created. max_token_idle_seconds is how long an auth token can go unused
+-- before it is no longer valid. Zero uses the settings of the controller.
+alter table auth_password_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+alter table auth_oidc_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+alter table auth_ldap_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+-- auth_method_token_settings contains the auth token settings of every auth
+-- method regardless of its subtype.
+create view auth_method_token_settings as
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_password_method
+     union
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_oidc_method
+     union
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_ldap_method;
+
+commit;
+
+`),
+	},
+	"migrations/84_session_sessions_key.down.sql": {
+		name: "84_session_sessions_key.down.sql",
+		bytes: []byte(`
+begin;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording');
+
+alter table session
+  drop column sessions_key_id;
+
+commit;
+
+`),
+	},
+	"migrations/84_session_sessions_key.up.sql": {
+		name: "84_session_sessions_key.up.sql",
+		bytes: []byte(`
+begin;
+
+-- sessions_key_id is the id of the version of the scope's sessions key from
+-- which the session's certificate and private key were derived.  Workers
+-- derive the private key again when they look up the session, which must use
+-- the same key version even after the sessions key has been rotated.  It is
+-- null for sessions created before it was recorded.
+alter table session
+  add column sessions_key_id text
+    constraint sessions_key_id_must_not_be_empty
+    check(length(trim(sessions_key_id)) > 0);
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording', 'sessions_key_id');
+
+commit;
+
+`),
+	},
+	"migrations/85_plugin_host.down.sql": {
+		name: "85_plugin_host.down.sql",
+		bytes: []byte(`
+begin;
+
+  -- restore whx_host_dimension_source from 77_dns_host.up.sql
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'dns host'                      as host_type,
+         'None'                          as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dns host set'                  as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dns host catalog'              as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dns_host as h,
+         dns_host_catalog as c,
+         dns_host_set_member as m,
+         dns_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+  drop table plugin_host_set_member;
+  drop function insert_plugin_host_set_member;
+  drop table plugin_host_set;
+  drop table plugin_host;
+  drop table plugin_host_catalog;
+
+  delete from oplog_ticket
+   where name in ('plugin_host_catalog', 'plugin_host_set');
+
+commit;
+
+`),
+	},
+	"migrations/85_plugin_host.up.sql": {
+		name: "85_plugin_host.up.sql",
+		bytes: []byte(`
+begin;
+
+/*
+
+  ┌─────────────────┐          ┌─────────────────────┐
+  │      host       │          │     plugin_host     │
+  ├─────────────────┤          ├─────────────────────┤
+  │ public_id  (pk) │          │ public_id  (pk)     │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │┼┼─────────────────────┐
+  │                 │          │ external_id         │             ◀fk1      │
+  └─────────────────┘          │ address             │                       │
+          ╲│╱                  │ port                │                       │
+           ○                   └─────────────────────┘                       │
+           │                             ╲│╱                                 ○
+           ┼                              ○                                 ╱│╲
+           ┼                              ┼                     ┌────────────────────────┐
+  ┌─────────────────┐          ┌─────────────────────┐          │ plugin_host_set_member │
+  │  host_catalog   │          │ plugin_host_catalog │          ├────────────────────────┤
+  ├─────────────────┤          ├─────────────────────┤          │ host_id    (pk,fk1)    │
+  │ public_id (pk)  │          │ public_id (pk)      │          │ set_id     (pk,fk2)    │
+  │ scope_id  (fk)  │┼┼──────○┼│ scope_id  (fk)      │          │ catalog_id (fk1,fk2)   │
+  │                 │          │ plugin_type         │          └────────────────────────┘
+  └─────────────────┘          │ attributes          │                      ╲│╱
+           ┼                   └─────────────────────┘                       ○
+           ┼                              ┼                                  │
+           │                              ┼                                  │
+           ○                              ○                                  │
+          ╱│╲                            ╱│╲                                 │
+  ┌─────────────────┐          ┌─────────────────────┐                       │
+  │    host_set     │          │   plugin_host_set   │             ◀fk2      │
+  ├─────────────────┤          ├─────────────────────┤                       │
+  │ public_id  (pk) │          │ public_id  (pk)     │┼┼─────────────────────┘
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │
+  │                 │          │ attributes          │
+  └─────────────────┘          └─────────────────────┘
+
+  The host catalogs, host sets and hosts of every host catalog plugin are
+  stored in the plugin_host tables.  The plugin_type of a catalog is the type
+  the plugin serving it reported.  The attributes of catalogs and sets are
+  the JSON encoded attributes the plugin validated; the controller passes
+  them to the plugin when it lists the hosts of a set.  The plugin_host rows
+  and the plugin_host_set_member rows are not changed by users; they are
+  written by the controller each time the hosts of a set are listed.
+
+*/
+
+  create table plugin_host_catalog (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    plugin_type text not null
+      constraint plugin_type_must_not_be_empty
+      check(length(trim(plugin_type)) > 0),
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea,
+    foreign key (scope_id, public_id)
+      references host_catalog (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name)
+  );
+
+  create trigger update_version_column after update on plugin_host_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_host_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host_catalog
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'plugin_type', 'create_time');
+
+  create trigger insert_host_catalog_subtype before insert on plugin_host_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on plugin_host_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  create table plugin_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references plugin_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    external_id text not null
+      constraint external_id_must_not_be_empty
+      check(length(trim(external_id)) > 0),
+    name text,
+    description text,
+    address text not null
+      constraint address_must_not_be_empty
+      check(length(trim(address)) > 0),
+    port integer not null default 0
+      constraint port_must_be_0_or_a_valid_port_number
+      check(port >= 0 and port < 65536),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    unique(catalog_id, external_id),
+
+    foreign key (catalog_id, public_id)
+      references host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_time_column before update on plugin_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'external_id', 'create_time');
+
+  create trigger insert_host_subtype before insert on plugin_host
+    for each row execute procedure insert_host_subtype();
+
+  create trigger delete_host_subtype after delete on plugin_host
+    for each row execute procedure delete_host_subtype();
+
+  create table plugin_host_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references plugin_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea,
+    unique(catalog_id, name),
+    foreign key (catalog_id, public_id)
+      references host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on plugin_host_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_host_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'create_time');
+
+  create trigger insert_host_set_subtype before insert on plugin_host_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on plugin_host_set
+    for each row execute procedure delete_host_set_subtype();
+
+  create table plugin_host_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    primary key(host_id, set_id),
+    foreign key (catalog_id, host_id) -- fk1
+      references plugin_host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (catalog_id, set_id) -- fk2
+      references plugin_host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger immutable_columns before update on plugin_host_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id');
+
+  create or replace function insert_plugin_host_set_member()
+    returns trigger
+  as $$
+  begin
+    select plugin_host_set.catalog_id
+      into new.catalog_id
+    from plugin_host_set
+    where plugin_host_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_plugin_host_set_member before insert on plugin_host_set_member
+    for each row execute procedure insert_plugin_host_set_member();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('plugin_host_catalog', 1),
+    ('plugin_host_set', 1);
+
+  -- whx_host_dimension_source is replaced to include the hosts of host
+  -- catalog plugins so sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'dns host'                      as host_type,
+         'None'                          as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dns host set'                  as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dns host catalog'              as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dns_host as h,
+         dns_host_catalog as c,
+         dns_host_set_member as m,
+         dns_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         c.plugin_type || ' host'        as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         c.plugin_type || ' host set'    as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         c.plugin_type || ' host catalog' as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from plugin_host as h,
+         plugin_host_catalog as c,
+         plugin_host_set_member as m,
+         plugin_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+commit;
+
+`),
+	},
+	"migrations/86_kms_audit_key.down.sql": {
+		name: "86_kms_audit_key.down.sql",
+		bytes: []byte(`
+begin;
+
+drop table kms_audit_key;
+
+commit;
+
+`),
+	},
+	"migrations/86_kms_audit_key.up.sql": {
+		name: "86_kms_audit_key.up.sql",
+		bytes: []byte(`
+begin;
+
+-- kms_audit_key contains the key audit events are HMAC'd with.  Unlike the
+-- DEKs it has no versions and is not changed by key rotation, so values
+-- HMAC'd before and after a rotation can still be correlated, and destroying
+-- old DEK versions does not change it.  It is encrypted with a version of the
+-- scope's root key and is rewrapped under the newest root key version along
+-- with the DEK versions.  Deleting a root key version it is encrypted with is
+-- restricted, so the key can't be lost by destroying a root key version
+-- before it has been rewrapped.
+create table kms_audit_key (
+  private_id wt_private_id primary key,
+  root_key_id wt_private_id not null unique -- there can only be one audit key for a scope.
+    references kms_root_key(private_id)
+    on delete cascade
+    on update cascade,
+  root_key_version_id wt_private_id not null
+    references kms_root_key_version(private_id)
+    on delete restrict
+    on update cascade,
+  key bytea not null,
+  create_time wt_timestamp
+);
+
+-- the encrypted key and the root key version it is encrypted with change when
+-- the key is rewrapped
+create trigger
+  immutable_columns
+before
+update on kms_audit_key
+  for each row execute procedure immutable_columns('private_id', 'root_key_id', 'create_time');
+
+create trigger
+  default_create_time_column
+before
+insert on kms_audit_key
+  for each row execute procedure default_create_time();
+
+commit;
+
 `),
 	},
 }
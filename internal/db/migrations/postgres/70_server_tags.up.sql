@@ -0,0 +1,22 @@
+begin;
+
+-- server_tag contains the tags reported by workers in their status requests.
+-- A tag is a key with one or more values; each value is stored as a separate
+-- row. Tags are replaced as a whole whenever a worker reports them.
+create table server_tag (
+    server_id text not null,
+    server_type text not null,
+    key text not null
+      constraint server_tag_key_must_not_be_empty
+      check(length(trim(key)) > 0),
+    value text not null
+      constraint server_tag_value_must_not_be_empty
+      check(length(trim(value)) > 0),
+    foreign key (server_id, server_type)
+      references server(private_id, type)
+      on delete cascade
+      on update cascade,
+    primary key (server_id, server_type, key, value)
+  );
+
+commit;
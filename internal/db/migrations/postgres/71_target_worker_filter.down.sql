@@ -0,0 +1,24 @@
+begin;
+
+drop view target_all_subtypes;
+
+alter table target_tcp
+  drop column worker_filter;
+
+create view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type
+  from target_tcp;
+
+commit;
@@ -0,0 +1,29 @@
+begin;
+
+-- worker_filter is an optional boolean expression evaluated against the name
+-- and tags of each worker when authorizing a session. Only workers matching
+-- the filter are returned to the client.
+alter table target_tcp
+  add column worker_filter text
+    constraint worker_filter_must_not_be_empty
+    check(length(trim(worker_filter)) > 0);
+
+-- target_all_subtypes is a union of all target subtypes 
+create or replace view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter
+  from target_tcp;
+
+commit;
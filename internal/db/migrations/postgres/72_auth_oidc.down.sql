@@ -0,0 +1,9 @@
+begin;
+
+  drop table if exists auth_oidc_account;
+  drop table if exists auth_oidc_method;
+
+  delete from oplog_ticket
+   where name in ('auth_oidc_method', 'auth_oidc_account');
+
+commit;
@@ -0,0 +1,173 @@
+begin;
+
+/*
+
+       ┌────────────────┐                 ┌──────────────────────┐
+       │  auth_method   │                 │   auth_oidc_method   │
+       ├────────────────┤                 ├──────────────────────┤
+       │ public_id (pk) │                 │ public_id (pk,fk)    │
+       │ scope_id  (fk) │┼┼─────────────○┼│ scope_id  (fk)       │
+       │                │                 │ ...                  │
+       └────────────────┘                 └──────────────────────┘
+                ┼                                     ┼
+                ┼                                     ┼
+                │                                     │
+                │ ▲fk1                                │ ▲fk1
+                │                                     │
+                ○                                     ○
+               ╱│╲                                   ╱│╲
+  ┌──────────────────────────┐          ┌──────────────────────────┐
+  │       auth_account       │          │    auth_oidc_account     │
+  ├──────────────────────────┤          ├──────────────────────────┤
+  │ public_id         (pk)   │          │ public_id      (pk,fk2)  │
+  │ scope_id          (fk1)  │   ◀fk2   │ scope_id       (fk1,fk2) │
+  │ auth_method_id    (fk1)  │┼┼──────○┼│ auth_method_id (fk1,fk2) │
+  │ iam_user_scope_id (fk2)  │          │ ...                      │
+  │ iam_user_id       (fk2)  │          └──────────────────────────┘
+  └──────────────────────────┘
+
+  An auth_oidc_method is an auth_method subtype. For every row in
+  auth_oidc_method there is one row in auth_method with the same public_id and
+  scope_id.
+
+  An auth_oidc_account is an auth_account subtype. For every row in
+  auth_oidc_account there is one row in auth_account with the same public_id,
+  scope_id, and auth_method_id.
+
+  An auth_oidc_account is created the first time a subject authenticates with
+  an auth_oidc_method. The subject is unique within the auth_oidc_method.
+
+*/
+
+  create table auth_oidc_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    issuer text not null
+      constraint issuer_must_not_be_empty
+      check(length(trim(issuer)) > 0),
+    client_id text not null
+      constraint client_id_must_not_be_empty
+      check(length(trim(client_id)) > 0),
+    client_secret bytea not null
+      constraint client_secret_must_not_be_empty
+      check(length(client_secret) > 0),
+    -- TODO: Make key_id a foreign key once we have DEKs
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    foreign key (scope_id, public_id)
+      references auth_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name),
+    unique(scope_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_oidc_method
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_method_subtype
+  before insert on auth_oidc_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create table auth_oidc_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- NOTE(mgaffney): The scope_id type is not wt_scope_id because the domain
+    -- check is executed before the insert trigger which retrieves the scope_id
+    -- causing an insert to fail.
+    scope_id text not null,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    subject text not null
+      constraint subject_must_not_be_empty
+      check(length(trim(subject)) > 0),
+    email text,
+    full_name text,
+    version wt_version,
+    foreign key (scope_id, auth_method_id)
+      references auth_oidc_method (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (scope_id, auth_method_id, public_id)
+      references auth_account (scope_id, auth_method_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(auth_method_id, name),
+    unique(auth_method_id, subject),
+    unique(auth_method_id, public_id)
+  );
+
+  create trigger
+    update_version_column
+  after update on auth_oidc_account
+    for each row execute procedure update_version_column();
+
+  create trigger
+    insert_auth_account_subtype
+  before insert on auth_oidc_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  --
+  -- triggers for time columns
+  --
+
+  create trigger
+    update_time_column
+  before
+  update on auth_oidc_method
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_oidc_method
+    for each row execute procedure immutable_columns('create_time');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_oidc_method
+    for each row execute procedure default_create_time();
+
+  create trigger
+    update_time_column
+  before
+  update on auth_oidc_account
+    for each row execute procedure update_time_column();
+
+  create trigger
+    immutable_columns
+  before
+  update on auth_oidc_account
+    for each row execute procedure immutable_columns('create_time', 'subject');
+
+  create trigger
+    default_create_time_column
+  before
+  insert on auth_oidc_account
+    for each row execute procedure default_create_time();
+
+  -- The tickets for oplog are the subtypes not the base types because no updates
+  -- are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_oidc_method', 1),
+    ('auth_oidc_account', 1);
+
+commit;
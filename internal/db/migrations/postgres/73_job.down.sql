@@ -0,0 +1,6 @@
+begin;
+
+  drop table job;
+  drop table job_run_status_enm;
+
+commit;
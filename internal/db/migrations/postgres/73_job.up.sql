@@ -0,0 +1,65 @@
+begin;
+
+  -- job_run_status_enm contains the possible outcomes of a job run.
+  create table job_run_status_enm (
+    name text primary key
+      constraint only_predefined_job_run_statuses_allowed
+      check(name in ('succeeded', 'failed'))
+  );
+
+  insert into job_run_status_enm (name)
+  values
+    ('succeeded'),
+    ('failed');
+
+  -- job contains the background jobs run by the controller scheduler. Jobs
+  -- are registered by name when a controller starts. Before running a job a
+  -- controller must acquire its lease by setting lease_holder and
+  -- lease_expiration, which ensures only one controller runs a job at a time.
+  -- Expired leases may be taken over by any controller, so a job whose
+  -- controller went away is not stuck.
+  create table job (
+    name text primary key
+      constraint job_name_must_not_be_empty
+      check(length(trim(name)) > 0),
+    description text not null,
+    next_scheduled_run timestamp with time zone not null
+      default current_timestamp,
+    last_run_start timestamp with time zone,
+    last_run_end timestamp with time zone,
+    last_run_status text
+      references job_run_status_enm(name)
+      on delete restrict
+      on update cascade,
+    last_run_error text,
+    last_run_controller_id text,
+    lease_holder text,
+    lease_expiration timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint job_lease_holder_and_expiration_set_together
+      check(
+        (lease_holder is null and lease_expiration is null)
+        or
+        (lease_holder is not null and lease_expiration is not null)
+      )
+  );
+
+  create trigger
+    immutable_columns
+  before
+  update on job
+    for each row execute procedure immutable_columns('name', 'create_time');
+
+  create trigger
+    update_time_column
+  before update on job
+    for each row execute procedure update_time_column();
+
+  create trigger
+    default_create_time_column
+  before
+  insert on job
+    for each row execute procedure default_create_time();
+
+commit;
@@ -0,0 +1,58 @@
+begin;
+
+drop trigger immutable_columns on oplog_entry;
+create trigger
+  immutable_columns
+before
+update on oplog_entry
+  for each row execute procedure immutable_columns('id','update_time','create_time','version','aggregate_name', 'data');
+
+alter table oplog_entry
+  drop column key_id;
+
+create or replace function
+  immutable_auth_token_columns()
+  returns trigger
+as $$
+begin
+  if new.auth_account_id is distinct from old.auth_account_id then
+    raise exception 'auth_account_id is read-only';
+  end if;
+  if new.token is distinct from old.token then
+    raise exception 'token is read-only';
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+drop view kms_current_key_version;
+
+drop trigger immutable_columns on kms_token_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_token_key_version
+  for each row execute procedure immutable_columns('private_id', 'token_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_session_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_session_key_version
+  for each row execute procedure immutable_columns('private_id', 'session_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_oplog_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_oplog_key_version
+  for each row execute procedure immutable_columns('private_id', 'oplog_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+drop trigger immutable_columns on kms_database_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_database_key_version
+  for each row execute procedure immutable_columns('private_id', 'database_key_id', 'root_key_version_id', 'version', 'key', 'create_time');
+
+commit;
@@ -0,0 +1,113 @@
+begin;
+
+-- DEK versions can be rewrapped under a newer root key version, which changes
+-- the encrypted key and the root key version it references. Everything else
+-- about a key version remains immutable.
+drop trigger immutable_columns on kms_database_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_database_key_version
+  for each row execute procedure immutable_columns('private_id', 'database_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_oplog_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_oplog_key_version
+  for each row execute procedure immutable_columns('private_id', 'oplog_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_session_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_session_key_version
+  for each row execute procedure immutable_columns('private_id', 'session_key_id', 'version', 'create_time');
+
+drop trigger immutable_columns on kms_token_key_version;
+create trigger
+  immutable_columns
+before
+update on kms_token_key_version
+  for each row execute procedure immutable_columns('private_id', 'token_key_id', 'version', 'create_time');
+
+-- kms_current_key_version contains the newest key version for each scope and
+-- purpose. This is the key version new data is encrypted with, and the one
+-- existing data is rewrapped under.
+create view kms_current_key_version as
+  (select distinct on (kv.database_key_id)
+          rk.scope_id,
+          'database' as purpose,
+          kv.private_id as key_version_id
+     from kms_database_key_version kv
+     join kms_database_key k
+       on kv.database_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.database_key_id, kv.version desc)
+union all
+  (select distinct on (kv.oplog_key_id)
+          rk.scope_id,
+          'oplog' as purpose,
+          kv.private_id as key_version_id
+     from kms_oplog_key_version kv
+     join kms_oplog_key k
+       on kv.oplog_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.oplog_key_id, kv.version desc)
+union all
+  (select distinct on (kv.session_key_id)
+          rk.scope_id,
+          'sessions' as purpose,
+          kv.private_id as key_version_id
+     from kms_session_key_version kv
+     join kms_session_key k
+       on kv.session_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.session_key_id, kv.version desc)
+union all
+  (select distinct on (kv.token_key_id)
+          rk.scope_id,
+          'tokens' as purpose,
+          kv.private_id as key_version_id
+     from kms_token_key_version kv
+     join kms_token_key k
+       on kv.token_key_id = k.private_id
+     join kms_root_key rk
+       on k.root_key_id = rk.private_id
+ order by kv.token_key_id, kv.version desc);
+
+-- The encrypted token value of an auth token may only change when it is
+-- rewrapped, in which case the key id changes with it.
+create or replace function
+  immutable_auth_token_columns()
+  returns trigger
+as $$
+begin
+  if new.auth_account_id is distinct from old.auth_account_id then
+    raise exception 'auth_account_id is read-only';
+  end if;
+  if new.token is distinct from old.token and new.key_id is not distinct from old.key_id then
+    raise exception 'token is read-only';
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+-- key_id is the oplog key version the entry's data is encrypted with. It is
+-- null until the entry is first visited by a rewrap, since the key id is only
+-- recorded within the encrypted data when the entry is written.
+alter table oplog_entry
+  add column key_id text;
+
+-- Entry data can be rewrapped under a newer key version.
+drop trigger immutable_columns on oplog_entry;
+create trigger
+  immutable_columns
+before
+update on oplog_entry
+  for each row execute procedure immutable_columns('id','update_time','create_time','version','aggregate_name');
+
+commit;
@@ -0,0 +1,38 @@
+begin;
+
+drop table session_recording_chunk;
+drop table session_recording;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint');
+
+alter table session
+  drop column enable_session_recording;
+
+drop view target_all_subtypes;
+
+alter table target_tcp
+  drop column enable_session_recording;
+
+create view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter
+  from target_tcp;
+
+commit;
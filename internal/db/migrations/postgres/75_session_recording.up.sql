@@ -0,0 +1,107 @@
+begin;
+
+-- enable_session_recording determines whether the connections of sessions
+-- authorized by the target are recorded by the worker proxying them.
+alter table target_tcp
+  add column enable_session_recording boolean not null default false;
+
+-- target_all_subtypes is a union of all target subtypes 
+create or replace view target_all_subtypes
+as 
+select 
+  public_id, 
+  scope_id, 
+  name, 
+  description, 
+  default_port, 
+  session_max_seconds,
+  session_connection_limit,
+  version, 
+  create_time,
+  update_time,
+  'tcp' as type,
+  worker_filter,
+  enable_session_recording
+  from target_tcp;
+
+-- enable_session_recording is copied from the target when the session is
+-- authorized, so changing the target does not affect existing sessions.
+alter table session
+  add column enable_session_recording boolean not null default false;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording');
+
+-- session_recording contains the recording of a single session connection,
+-- uploaded by the worker that proxied the connection.  The recording is
+-- encrypted by the worker with a key derived from the session's private key,
+-- which in turn is derived from the version of the scope's sessions key
+-- identified by key_id.
+create table session_recording (
+  connection_id wt_public_id primary key
+    references session_connection (public_id)
+    on delete cascade
+    on update cascade,
+  session_id wt_public_id not null
+    references session (public_id)
+    on delete cascade
+    on update cascade,
+  key_id text not null
+    constraint key_id_must_not_be_empty
+    check(length(trim(key_id)) > 0),
+  -- complete is set once the worker has uploaded the final chunk
+  complete boolean not null default false,
+  create_time wt_timestamp,
+  update_time wt_timestamp
+);
+
+create trigger 
+  immutable_columns
+before
+update on session_recording
+  for each row execute procedure immutable_columns('connection_id', 'session_id', 'key_id', 'create_time');
+
+create trigger 
+  update_time_column 
+before update on session_recording 
+  for each row execute procedure update_time_column();
+
+create trigger 
+  default_create_time_column
+before
+insert on session_recording
+  for each row execute procedure default_create_time();
+
+-- session_recording_chunk contains the chunks of a recording in the order
+-- they were uploaded.  Chunks are opaque to the controller; concatenated in
+-- sequence order they form the recording file.
+create table session_recording_chunk (
+  connection_id wt_public_id not null
+    references session_recording (connection_id)
+    on delete cascade
+    on update cascade,
+  sequence integer not null
+    constraint sequence_must_not_be_negative
+    check(sequence >= 0),
+  data bytea not null,
+  create_time wt_timestamp,
+  primary key(connection_id, sequence)
+);
+
+create trigger 
+  immutable_columns
+before
+update on session_recording_chunk
+  for each row execute procedure immutable_columns('connection_id', 'sequence', 'data', 'create_time');
+
+create trigger 
+  default_create_time_column
+before
+insert on session_recording_chunk
+  for each row execute procedure default_create_time();
+
+commit;
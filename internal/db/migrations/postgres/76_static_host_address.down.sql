@@ -0,0 +1,8 @@
+begin;
+
+  drop table static_host_address;
+
+  alter table static_host
+    drop column port;
+
+commit;
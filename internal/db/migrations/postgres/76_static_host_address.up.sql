@@ -0,0 +1,34 @@
+begin;
+
+-- port is the optional port of a static host. When set it is used instead of
+-- the default port of the target when connecting to the host.
+alter table static_host
+  add column port integer
+    constraint port_must_be_a_valid_port_number
+    check(port > 0 and port < 65536);
+
+-- static_host_address contains the alternate addresses of a static host. They
+-- are tried in order of priority, lowest first, when connecting to the host's
+-- address fails. The alternate addresses of a host are replaced as a whole
+-- whenever they are updated.
+create table static_host_address (
+    host_id wt_public_id not null
+      references static_host (public_id)
+      on delete cascade
+      on update cascade,
+    address text not null
+      constraint address_must_be_more_than_2_characters
+      check(length(trim(address)) > 2)
+      constraint address_must_be_less_than_256_characters
+      check(length(trim(address)) < 256),
+    priority integer not null
+      constraint priority_must_be_greater_than_0
+      check(priority > 0),
+    primary key(host_id, address),
+    unique(host_id, priority)
+  );
+
+create trigger immutable_columns before update on static_host_address
+  for each row execute procedure immutable_columns('host_id', 'address', 'priority');
+
+commit;
@@ -0,0 +1,318 @@
+begin;
+
+/*
+
+  ┌─────────────────┐          ┌─────────────────────┐
+  │      host       │          │      dns_host       │
+  ├─────────────────┤          ├─────────────────────┤
+  │ public_id  (pk) │          │ public_id  (pk)     │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │┼┼─────────────────────┐
+  │                 │          │ address             │             ◀fk1      │
+  └─────────────────┘          │ port                │                       │
+          ╲│╱                  └─────────────────────┘                       │
+           ○                             ╲│╱                                 │
+           │                              ○                                  ○
+           ┼                              ┼                                 ╱│╲
+           ┼                              ┼                     ┌────────────────────────┐
+  ┌─────────────────┐          ┌─────────────────────┐          │  dns_host_set_member   │
+  │  host_catalog   │          │  dns_host_catalog   │          ├────────────────────────┤
+  ├─────────────────┤          ├─────────────────────┤          │ host_id    (pk,fk1)    │
+  │ public_id (pk)  │          │ public_id (pk)      │          │ set_id     (pk,fk2)    │
+  │ scope_id  (fk)  │┼┼──────○┼│ scope_id  (fk)      │          │ catalog_id (fk1,fk2)   │
+  │                 │          │ resolver_address    │          └────────────────────────┘
+  └─────────────────┘          └─────────────────────┘                      ╲│╱
+           ┼                              ┼                                  ○
+           ┼                              ┼                                  │
+           │                              │                                  │
+           ○                              ○                                  │
+          ╱│╲                            ╱│╲                                 │
+  ┌─────────────────┐          ┌─────────────────────┐                       │
+  │    host_set     │          │    dns_host_set     │             ◀fk2      │
+  ├─────────────────┤          ├─────────────────────┤                       │
+  │ public_id  (pk) │          │ public_id  (pk)     │┼┼─────────────────────┘
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │
+  │                 │          │                     │┼┼──────────────────────┐
+  └─────────────────┘          └─────────────────────┘                        │
+                                                                              ○
+                                                                             ╱│╲
+                                                            ┌──────────────────────────┐
+                                                            │ dns_host_set_record_name │
+                                                            ├──────────────────────────┤
+                                                            │ set_id      (pk,fk)      │
+                                                            │ record_name (pk)         │
+                                                            └──────────────────────────┘
+
+  A dns_host_set contains the DNS record names which are resolved to find the
+  hosts in the set. The dns_host rows and the dns_host_set_member rows are not
+  changed by users; they are written by the controller each time the record
+  names of a set are resolved.
+
+*/
+
+  create table dns_host_catalog (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    resolver_address text
+      constraint resolver_address_must_not_be_empty
+      check(length(trim(resolver_address)) > 0),
+    foreign key (scope_id, public_id)
+      references host_catalog (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name)
+  );
+
+  create trigger update_version_column after update on dns_host_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dns_host_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host_catalog
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger insert_host_catalog_subtype before insert on dns_host_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on dns_host_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  create table dns_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dns_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    address text not null
+      constraint address_must_not_be_empty
+      check(length(trim(address)) > 0),
+    port integer not null default 0
+      constraint port_must_be_0_or_a_valid_port_number
+      check(port >= 0 and port < 65536),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    unique(catalog_id, address, port),
+
+    foreign key (catalog_id, public_id)
+      references host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_time_column before update on dns_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'address', 'port', 'create_time');
+
+  create trigger insert_host_subtype before insert on dns_host
+    for each row execute procedure insert_host_subtype();
+
+  create trigger delete_host_subtype after delete on dns_host
+    for each row execute procedure delete_host_subtype();
+
+  create table dns_host_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references dns_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(catalog_id, name),
+    foreign key (catalog_id, public_id)
+      references host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on dns_host_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on dns_host_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on dns_host_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on dns_host_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'create_time');
+
+  create trigger insert_host_set_subtype before insert on dns_host_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on dns_host_set
+    for each row execute procedure delete_host_set_subtype();
+
+  -- dns_host_set_record_name contains the record names of a dns host set. The
+  -- record names of a set are replaced as a whole whenever they are updated.
+  create table dns_host_set_record_name (
+    set_id wt_public_id not null
+      references dns_host_set (public_id)
+      on delete cascade
+      on update cascade,
+    record_name text not null
+      constraint record_name_must_not_be_empty
+      check(length(trim(record_name)) > 0)
+      constraint record_name_must_be_less_than_254_characters
+      check(length(trim(record_name)) < 254),
+    primary key(set_id, record_name)
+  );
+
+  create trigger immutable_columns before update on dns_host_set_record_name
+    for each row execute procedure immutable_columns('set_id', 'record_name');
+
+  create table dns_host_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    primary key(host_id, set_id),
+    foreign key (catalog_id, host_id) -- fk1
+      references dns_host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (catalog_id, set_id) -- fk2
+      references dns_host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger immutable_columns before update on dns_host_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id');
+
+  create or replace function insert_dns_host_set_member()
+    returns trigger
+  as $$
+  begin
+    select dns_host_set.catalog_id
+      into new.catalog_id
+    from dns_host_set
+    where dns_host_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_dns_host_set_member before insert on dns_host_set_member
+    for each row execute procedure insert_dns_host_set_member();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('dns_host_catalog', 1),
+    ('dns_host_set', 1);
+
+  -- whx_host_dimension_source is replaced to include the dns hosts so sessions
+  -- to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'dns host'                      as host_type,
+         'None'                          as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dns host set'                  as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dns host catalog'              as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dns_host as h,
+         dns_host_catalog as c,
+         dns_host_set_member as m,
+         dns_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+  alter table static_host_set
+    drop column selector;
+
+  drop table static_host_attribute;
+
+commit;
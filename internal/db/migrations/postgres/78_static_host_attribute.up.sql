@@ -0,0 +1,33 @@
+begin;
+
+-- static_host_attribute contains the attributes of a static host, which are
+-- key/value pairs the selectors of static host sets are matched against. The
+-- attributes of a host are replaced as a whole whenever they are updated.
+create table static_host_attribute (
+    host_id wt_public_id not null
+      references static_host (public_id)
+      on delete cascade
+      on update cascade,
+    key text not null
+      constraint key_must_not_be_empty
+      check(length(trim(key)) > 0)
+      constraint key_must_be_less_than_256_characters
+      check(length(key) < 256),
+    value text not null
+      constraint value_must_be_less_than_1025_characters
+      check(length(value) < 1025),
+    primary key(host_id, key)
+  );
+
+create trigger immutable_columns before update on static_host_attribute
+  for each row execute procedure immutable_columns('host_id', 'key', 'value');
+
+-- selector is the optional filter expression of a static host set. The hosts
+-- of the set's catalog whose attributes match it are members of the set in
+-- addition to the hosts added to it.
+alter table static_host_set
+  add column selector text
+    constraint selector_must_not_be_empty
+    check(length(trim(selector)) > 0);
+
+commit;
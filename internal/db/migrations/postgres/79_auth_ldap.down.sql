@@ -0,0 +1,9 @@
+begin;
+
+  drop table if exists auth_ldap_account;
+  drop table if exists auth_ldap_method;
+
+  delete from oplog_ticket
+   where name in ('auth_ldap_method', 'auth_ldap_account');
+
+commit;
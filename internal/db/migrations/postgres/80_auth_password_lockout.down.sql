@@ -0,0 +1,9 @@
+begin;
+
+  drop table auth_password_account_lockout;
+
+  alter table auth_password_method
+    drop column lockout_threshold,
+    drop column lockout_duration_seconds;
+
+commit;
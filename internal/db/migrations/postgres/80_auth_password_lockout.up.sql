@@ -0,0 +1,49 @@
+begin;
+
+-- lockout_threshold is the number of consecutive failed authentication
+-- attempts after which an account of the auth method is locked. Zero disables
+-- lockout. lockout_duration_seconds is how long an account stays locked; zero
+-- keeps an account locked until it is explicitly unlocked.
+alter table auth_password_method
+  add column lockout_threshold int
+    not null
+    default 0
+    constraint lockout_threshold_must_not_be_negative
+    check(lockout_threshold >= 0),
+  add column lockout_duration_seconds int
+    not null
+    default 0
+    constraint lockout_duration_seconds_must_not_be_negative
+    check(lockout_duration_seconds >= 0);
+
+-- auth_password_account_lockout contains the failed authentication attempts
+-- of a password account since its last successful authentication. It is kept
+-- apart from auth_password_account so recording a failed attempt does not
+-- change the version of the account. locked_until is set, and failed_attempts
+-- reset, when failed_attempts reaches the lockout_threshold of the auth
+-- method; it is 'infinity' if the account is locked until unlocked. A row is
+-- deleted when the account authenticates successfully or is unlocked.
+create table auth_password_account_lockout (
+    account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    failed_attempts int not null
+      default 0
+      constraint failed_attempts_must_not_be_negative
+      check(failed_attempts >= 0),
+    locked_until timestamp with time zone,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+
+create trigger update_time_column before update on auth_password_account_lockout
+  for each row execute procedure update_time_column();
+
+create trigger default_create_time_column before insert on auth_password_account_lockout
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_account_lockout
+  for each row execute procedure immutable_columns('account_id', 'create_time');
+
+commit;
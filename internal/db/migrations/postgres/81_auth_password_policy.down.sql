@@ -0,0 +1,19 @@
+begin;
+
+  drop table auth_password_argon2_cred_history;
+
+  drop view auth_password_current_conf;
+
+  create view auth_password_current_conf as
+      select pm.min_login_name_length, pm.min_password_length, c.*
+        from auth_password_method pm
+  inner join auth_password_conf_union c
+          on pm.password_conf_id = c.password_conf_id;
+
+  alter table auth_password_method
+    drop column min_character_classes,
+    drop column password_history_count,
+    drop column max_password_age_seconds,
+    drop column disallow_login_name;
+
+commit;
@@ -0,0 +1,81 @@
+begin;
+
+-- The password policy of an auth method. min_character_classes is the number
+-- of character classes (lowercase letters, uppercase letters, digits and
+-- other characters) a password must contain. password_history_count is the
+-- number of most recent passwords of an account, including its current
+-- password, which cannot be reused. max_password_age_seconds is how long a
+-- password can be used before it must be changed. disallow_login_name
+-- rejects passwords containing the login name of the account. Zero or false
+-- disables a setting.
+alter table auth_password_method
+  add column min_character_classes int
+    not null
+    default 0
+    constraint min_character_classes_must_be_between_0_and_4
+    check(min_character_classes between 0 and 4),
+  add column password_history_count int
+    not null
+    default 0
+    constraint password_history_count_must_not_be_negative
+    check(password_history_count >= 0),
+  add column max_password_age_seconds int
+    not null
+    default 0
+    constraint max_password_age_seconds_must_not_be_negative
+    check(max_password_age_seconds >= 0),
+  add column disallow_login_name boolean
+    not null
+    default false;
+
+-- The policy columns are inserted before the configuration columns so the
+-- view has to be dropped rather than replaced.
+drop view auth_password_current_conf;
+
+create view auth_password_current_conf as
+    -- Rerun this query whenever auth_password_conf_union is updated.
+    select pm.min_login_name_length, pm.min_password_length,
+           pm.min_character_classes, pm.password_history_count,
+           pm.max_password_age_seconds, pm.disallow_login_name,
+           c.*
+      from auth_password_method pm
+inner join auth_password_conf_union c
+        on pm.password_conf_id = c.password_conf_id;
+
+-- auth_password_argon2_cred_history contains the argon2 credentials an
+-- account used before its current credential. It is only written to when
+-- the password_history_count of the auth method is greater than one and is
+-- pruned to the password_history_count - 1 most recent credentials whenever
+-- the password of an account is changed or set. Each row references the
+-- argon2 configuration its derived key was created with so a new password
+-- can be compared to it.
+create table auth_password_argon2_cred_history (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    password_conf_id wt_private_id not null
+      references auth_password_argon2_conf (private_id)
+      on delete cascade
+      on update cascade,
+    password_method_id text not null,
+    create_time wt_timestamp,
+    salt bytea not null
+      constraint salt_must_not_be_empty
+      check(length(salt) > 0),
+    derived_key bytea not null
+      constraint derived_key_must_not_be_empty
+      check(length(derived_key) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0)
+  );
+
+create trigger default_create_time_column before insert on auth_password_argon2_cred_history
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_argon2_cred_history
+  for each row execute procedure immutable_columns('private_id', 'password_account_id', 'password_conf_id', 'password_method_id', 'create_time', 'derived_key');
+
+commit;
@@ -0,0 +1,9 @@
+begin;
+
+  drop table auth_password_account_recovery_code;
+  drop table auth_password_account_totp;
+
+  alter table auth_password_method
+    drop column mfa_required;
+
+commit;
@@ -0,0 +1,66 @@
+begin;
+
+-- mfa_required requires every account of the auth method to authenticate
+-- with a TOTP code or a recovery code in addition to its password.
+alter table auth_password_method
+  add column mfa_required boolean
+    not null
+    default false;
+
+-- auth_password_account_totp contains the TOTP enrollments of password
+-- accounts. An account has at most one verified enrollment, which is the
+-- active second factor of the account, and at most one enrollment which has
+-- not been verified yet. Verifying an enrollment replaces the verified one so
+-- re-enrolling an account does not disable its active second factor. secret
+-- is encrypted with the database key key_id. last_used_step is the last time
+-- step a code was accepted for; codes for the same or an earlier step are
+-- rejected so a code cannot be replayed.
+create table auth_password_account_totp (
+    account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    verified boolean not null
+      default false,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+      check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+      check(length(trim(key_id)) > 0),
+    last_used_step bigint not null
+      default 0,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    primary key(account_id, verified)
+  );
+
+create trigger update_time_column before update on auth_password_account_totp
+  for each row execute procedure update_time_column();
+
+create trigger default_create_time_column before insert on auth_password_account_totp
+  for each row execute procedure default_create_time();
+
+create trigger immutable_columns before update on auth_password_account_totp
+  for each row execute procedure immutable_columns('account_id', 'create_time');
+
+-- auth_password_account_recovery_code contains the sha256 hashes of the
+-- unused recovery codes of a password account. A recovery code can be used
+-- once in place of a TOTP code and is deleted when it is used. The recovery
+-- codes of an account are replaced whenever a TOTP enrollment is verified.
+create table auth_password_account_recovery_code (
+    account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+      check(length(code_hash) > 0),
+    create_time wt_timestamp,
+    primary key(account_id, code_hash)
+  );
+
+create trigger default_create_time_column before insert on auth_password_account_recovery_code
+  for each row execute procedure default_create_time();
+
+commit;
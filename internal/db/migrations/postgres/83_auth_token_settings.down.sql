@@ -0,0 +1,17 @@
+begin;
+
+  drop view auth_method_token_settings;
+
+  alter table auth_ldap_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+  alter table auth_oidc_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+  alter table auth_password_method
+    drop column max_token_idle_seconds,
+    drop column max_token_duration_seconds;
+
+commit;
@@ -0,0 +1,55 @@
+begin;
+
+-- The auth token settings of an auth method. max_token_duration_seconds is
+-- how long an auth token issued by the auth method is valid for after it is
+-- created. max_token_idle_seconds is how long an auth token can go unused
+-- before it is no longer valid. Zero uses the settings of the controller.
+alter table auth_password_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+alter table auth_oidc_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+alter table auth_ldap_method
+  add column max_token_duration_seconds int
+    not null
+    default 0
+    constraint max_token_duration_seconds_must_not_be_negative
+    check(max_token_duration_seconds >= 0),
+  add column max_token_idle_seconds int
+    not null
+    default 0
+    constraint max_token_idle_seconds_must_not_be_negative
+    check(max_token_idle_seconds >= 0);
+
+-- auth_method_token_settings contains the auth token settings of every auth
+-- method regardless of its subtype.
+create view auth_method_token_settings as
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_password_method
+     union
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_oidc_method
+     union
+    select public_id, max_token_duration_seconds, max_token_idle_seconds
+      from auth_ldap_method;
+
+commit;
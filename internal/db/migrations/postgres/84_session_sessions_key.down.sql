@@ -0,0 +1,13 @@
+begin;
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording');
+
+alter table session
+  drop column sessions_key_id;
+
+commit;
@@ -0,0 +1,20 @@
+begin;
+
+-- sessions_key_id is the id of the version of the scope's sessions key from
+-- which the session's certificate and private key were derived.  Workers
+-- derive the private key again when they look up the session, which must use
+-- the same key version even after the sessions key has been rotated.  It is
+-- null for sessions created before it was recorded.
+alter table session
+  add column sessions_key_id text
+    constraint sessions_key_id_must_not_be_empty
+    check(length(trim(sessions_key_id)) > 0);
+
+drop trigger immutable_columns on session;
+create trigger 
+  immutable_columns
+before
+update on session
+  for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'enable_session_recording', 'sessions_key_id');
+
+commit;
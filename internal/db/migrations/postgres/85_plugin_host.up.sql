@@ -0,0 +1,347 @@
+begin;
+
+/*
+
+  ┌─────────────────┐          ┌─────────────────────┐
+  │      host       │          │     plugin_host     │
+  ├─────────────────┤          ├─────────────────────┤
+  │ public_id  (pk) │          │ public_id  (pk)     │
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │┼┼─────────────────────┐
+  │                 │          │ external_id         │             ◀fk1      │
+  └─────────────────┘          │ address             │                       │
+          ╲│╱                  │ port                │                       │
+           ○                   └─────────────────────┘                       │
+           │                             ╲│╱                                 ○
+           ┼                              ○                                 ╱│╲
+           ┼                              ┼                     ┌────────────────────────┐
+  ┌─────────────────┐          ┌─────────────────────┐          │ plugin_host_set_member │
+  │  host_catalog   │          │ plugin_host_catalog │          ├────────────────────────┤
+  ├─────────────────┤          ├─────────────────────┤          │ host_id    (pk,fk1)    │
+  │ public_id (pk)  │          │ public_id (pk)      │          │ set_id     (pk,fk2)    │
+  │ scope_id  (fk)  │┼┼──────○┼│ scope_id  (fk)      │          │ catalog_id (fk1,fk2)   │
+  │                 │          │ plugin_type         │          └────────────────────────┘
+  └─────────────────┘          │ attributes          │                      ╲│╱
+           ┼                   └─────────────────────┘                       ○
+           ┼                              ┼                                  │
+           │                              ┼                                  │
+           ○                              ○                                  │
+          ╱│╲                            ╱│╲                                 │
+  ┌─────────────────┐          ┌─────────────────────┐                       │
+  │    host_set     │          │   plugin_host_set   │             ◀fk2      │
+  ├─────────────────┤          ├─────────────────────┤                       │
+  │ public_id  (pk) │          │ public_id  (pk)     │┼┼─────────────────────┘
+  │ catalog_id (fk) │┼┼──────○┼│ catalog_id (fk)     │
+  │                 │          │ attributes          │
+  └─────────────────┘          └─────────────────────┘
+
+  The host catalogs, host sets and hosts of every host catalog plugin are
+  stored in the plugin_host tables.  The plugin_type of a catalog is the type
+  the plugin serving it reported.  The attributes of catalogs and sets are
+  the JSON encoded attributes the plugin validated; the controller passes
+  them to the plugin when it lists the hosts of a set.  The plugin_host rows
+  and the plugin_host_set_member rows are not changed by users; they are
+  written by the controller each time the hosts of a set are listed.
+
+*/
+
+  create table plugin_host_catalog (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null
+      references iam_scope (public_id)
+      on delete cascade
+      on update cascade,
+    plugin_type text not null
+      constraint plugin_type_must_not_be_empty
+      check(length(trim(plugin_type)) > 0),
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea,
+    foreign key (scope_id, public_id)
+      references host_catalog (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(scope_id, name)
+  );
+
+  create trigger update_version_column after update on plugin_host_catalog
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_host_catalog
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host_catalog
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host_catalog
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'plugin_type', 'create_time');
+
+  create trigger insert_host_catalog_subtype before insert on plugin_host_catalog
+    for each row execute procedure insert_host_catalog_subtype();
+
+  create trigger delete_host_catalog_subtype after delete on plugin_host_catalog
+    for each row execute procedure delete_host_catalog_subtype();
+
+  create table plugin_host (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references plugin_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    external_id text not null
+      constraint external_id_must_not_be_empty
+      check(length(trim(external_id)) > 0),
+    name text,
+    description text,
+    address text not null
+      constraint address_must_not_be_empty
+      check(length(trim(address)) > 0),
+    port integer not null default 0
+      constraint port_must_be_0_or_a_valid_port_number
+      check(port >= 0 and port < 65536),
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    unique(catalog_id, external_id),
+
+    foreign key (catalog_id, public_id)
+      references host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_time_column before update on plugin_host
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'external_id', 'create_time');
+
+  create trigger insert_host_subtype before insert on plugin_host
+    for each row execute procedure insert_host_subtype();
+
+  create trigger delete_host_subtype after delete on plugin_host
+    for each row execute procedure delete_host_subtype();
+
+  create table plugin_host_set (
+    public_id wt_public_id primary key,
+    catalog_id wt_public_id not null
+      references plugin_host_catalog (public_id)
+      on delete cascade
+      on update cascade,
+    name text,
+    description text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    attributes bytea,
+    unique(catalog_id, name),
+    foreign key (catalog_id, public_id)
+      references host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    unique(catalog_id, public_id)
+  );
+
+  create trigger update_version_column after update on plugin_host_set
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on plugin_host_set
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on plugin_host_set
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on plugin_host_set
+    for each row execute procedure immutable_columns('public_id', 'catalog_id', 'create_time');
+
+  create trigger insert_host_set_subtype before insert on plugin_host_set
+    for each row execute procedure insert_host_set_subtype();
+
+  create trigger delete_host_set_subtype after delete on plugin_host_set
+    for each row execute procedure delete_host_set_subtype();
+
+  create table plugin_host_set_member (
+    host_id wt_public_id not null,
+    set_id wt_public_id not null,
+    catalog_id wt_public_id not null,
+    primary key(host_id, set_id),
+    foreign key (catalog_id, host_id) -- fk1
+      references plugin_host (catalog_id, public_id)
+      on delete cascade
+      on update cascade,
+    foreign key (catalog_id, set_id) -- fk2
+      references plugin_host_set (catalog_id, public_id)
+      on delete cascade
+      on update cascade
+  );
+
+  create trigger immutable_columns before update on plugin_host_set_member
+    for each row execute procedure immutable_columns('host_id', 'set_id', 'catalog_id');
+
+  create or replace function insert_plugin_host_set_member()
+    returns trigger
+  as $$
+  begin
+    select plugin_host_set.catalog_id
+      into new.catalog_id
+    from plugin_host_set
+    where plugin_host_set.public_id = new.set_id;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_plugin_host_set_member before insert on plugin_host_set_member
+    for each row execute procedure insert_plugin_host_set_member();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('plugin_host_catalog', 1),
+    ('plugin_host_set', 1);
+
+  -- whx_host_dimension_source is replaced to include the hosts of host
+  -- catalog plugins so sessions to them are recorded in the warehouse.
+  create or replace view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'static host'                   as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'static host set'               as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'static host catalog'           as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from static_host as h,
+         static_host_catalog as c,
+         static_host_set_member as m,
+         static_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         'dns host'                      as host_type,
+         'None'                          as host_name,
+         'None'                          as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         'dns host set'                  as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         'dns host catalog'              as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from dns_host as h,
+         dns_host_catalog as c,
+         dns_host_set_member as m,
+         dns_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  union all
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         c.plugin_type || ' host'        as host_type,
+         coalesce(h.name, 'None')        as host_name,
+         coalesce(h.description, 'None') as host_description,
+         coalesce(h.address, 'Unknown')  as host_address,
+         s.public_id                     as host_set_id,
+         c.plugin_type || ' host set'    as host_set_type,
+         coalesce(s.name, 'None')        as host_set_name,
+         coalesce(s.description, 'None') as host_set_description,
+         c.public_id                     as host_catalog_id,
+         c.plugin_type || ' host catalog' as host_catalog_type,
+         coalesce(c.name, 'None')        as host_catalog_name,
+         coalesce(c.description, 'None') as host_catalog_description,
+         t.public_id                     as target_id,
+         'tcp target'                    as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as host_organization_id,
+         coalesce(o.name, 'None')        as host_organization_name,
+         coalesce(o.description, 'None') as host_organization_description
+    from plugin_host as h,
+         plugin_host_catalog as c,
+         plugin_host_set_member as m,
+         plugin_host_set as s,
+         target_host_set as ts,
+         target_tcp as t,
+         iam_scope as p,
+         iam_scope as o
+   where h.catalog_id = c.public_id
+     and h.public_id = m.host_id
+     and s.public_id = m.set_id
+     and t.public_id = ts.target_id
+     and s.public_id = ts.host_set_id
+     and p.public_id = t.scope_id
+     and p.type = 'project'
+     and o.public_id = p.parent_id
+     and o.type = 'org'
+  ;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+drop table kms_audit_key;
+
+commit;
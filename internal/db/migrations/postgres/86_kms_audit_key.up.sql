@@ -0,0 +1,39 @@
+begin;
+
+-- kms_audit_key contains the key audit events are HMAC'd with.  Unlike the
+-- DEKs it has no versions and is not changed by key rotation, so values
+-- HMAC'd before and after a rotation can still be correlated, and destroying
+-- old DEK versions does not change it.  It is encrypted with a version of the
+-- scope's root key and is rewrapped under the newest root key version along
+-- with the DEK versions.  Deleting a root key version it is encrypted with is
+-- restricted, so the key can't be lost by destroying a root key version
+-- before it has been rewrapped.
+create table kms_audit_key (
+  private_id wt_private_id primary key,
+  root_key_id wt_private_id not null unique -- there can only be one audit key for a scope.
+    references kms_root_key(private_id)
+    on delete cascade
+    on update cascade,
+  root_key_version_id wt_private_id not null
+    references kms_root_key_version(private_id)
+    on delete restrict
+    on update cascade,
+  key bytea not null,
+  create_time wt_timestamp
+);
+
+-- the encrypted key and the root key version it is encrypted with change when
+-- the key is rewrapped
+create trigger
+  immutable_columns
+before
+update on kms_audit_key
+  for each row execute procedure immutable_columns('private_id', 'root_key_id', 'create_time');
+
+create trigger
+  default_create_time_column
+before
+insert on kms_audit_key
+  for each row execute procedure default_create_time();
+
+commit;
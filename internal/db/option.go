@@ -40,6 +40,9 @@ type Options struct {
 	withWhereClause     string
 	withWhereClauseArgs []interface{}
 	withOrder           string
+
+	withPageOrder      bool
+	withStartPageAfter *PageCursor
 }
 
 type oplogOpts struct {
@@ -155,3 +158,14 @@ func WithOrder(withOrder string) Option {
 		o.withOrder = withOrder
 	}
 }
+
+// WithStartPageAfter provides an option to order the results of a search by
+// PageOrder and only return the rows after the cursor, so a list can be paged
+// through.  A nil cursor returns the rows from the start of the list.  It's
+// ignored if WithOrder is also provided.
+func WithStartPageAfter(cursor *PageCursor) Option {
+	return func(o *Options) {
+		o.withPageOrder = true
+		o.withStartPageAfter = cursor
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/stretchr/testify/assert"
@@ -175,4 +176,20 @@ func Test_getOpts(t *testing.T) {
 		testOpts.withOrder = "version desc"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts()
+		testOpts := getDefaultOptions()
+		assert.False(opts.withPageOrder)
+		assert.Equal(opts, testOpts)
+
+		opts = GetOpts(WithStartPageAfter(nil))
+		testOpts.withPageOrder = true
+		assert.Equal(opts, testOpts)
+
+		cursor := &PageCursor{CreateTime: time.Now(), PublicId: "u_1234567890"}
+		opts = GetOpts(WithStartPageAfter(cursor))
+		testOpts.withStartPageAfter = cursor
+		assert.Equal(opts, testOpts)
+	})
 }
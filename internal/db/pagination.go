@@ -0,0 +1,14 @@
+package db
+
+import "time"
+
+// PageOrder is the order in which lists of resources are returned so they can
+// be paged through: by creation time and then by public id, which is unique.
+const PageOrder = "create_time asc, public_id asc"
+
+// PageCursor is the position of a resource in a list ordered by PageOrder.  The
+// next page of the list starts with the resource after it.
+type PageCursor struct {
+	CreateTime time.Time
+	PublicId   string
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/metrics"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -878,6 +879,12 @@ func (w *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, Handler Tx
 	if w.underlying == nil {
 		return RetryInfo{}, errors.New("do underlying db is nil")
 	}
+	info, err := w.doTx(ctx, retries, backOff, Handler)
+	metrics.IncrDbTx(info.Retries, err)
+	return info, err
+}
+
+func (w *Db) doTx(ctx context.Context, retries uint, backOff Backoff, Handler TxHandler) (RetryInfo, error) {
 	info := RetryInfo{}
 	for attempts := uint(1); ; attempts++ {
 		if attempts > retries+1 {
@@ -989,6 +996,12 @@ func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where stri
 	}
 	var err error
 	db := rw.underlying.Order(opts.withOrder)
+	if opts.withOrder == "" && opts.withPageOrder {
+		db = rw.underlying.Order(PageOrder)
+		if c := opts.withStartPageAfter; c != nil {
+			db = db.Where("(create_time, public_id) > (?, ?)", c.CreateTime, c.PublicId)
+		}
+	}
 
 	// Perform limiting
 	switch {
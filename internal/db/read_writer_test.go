@@ -1883,6 +1883,38 @@ func TestDb_DeleteItems(t *testing.T) {
 	}
 }
 
+func TestDb_SearchWhere_paging(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := TestSetup(t, "postgres")
+	rw := New(conn)
+	ctx := context.Background()
+
+	var want []string
+	for i := 0; i < 5; i++ {
+		u := testUser(t, conn, "paging"+strconv.Itoa(i), "", "")
+		want = append(want, u.PublicId)
+	}
+	where := "name like ?"
+	args := []interface{}{"paging%"}
+
+	var got []string
+	var cursor *PageCursor
+	for {
+		var page []*db_test.TestUser
+		require.NoError(rw.SearchWhere(ctx, &page, where, args, WithLimit(2), WithStartPageAfter(cursor)))
+		for _, u := range page {
+			got = append(got, u.PublicId)
+		}
+		if len(page) < 2 {
+			break
+		}
+		last := page[len(page)-1]
+		cursor = &PageCursor{CreateTime: last.CreateTime.Timestamp.AsTime(), PublicId: last.PublicId}
+	}
+	assert.Equal(want, got)
+}
+
 func testUser(t *testing.T, conn *gorm.DB, name, email, phoneNumber string) *db_test.TestUser {
 	t.Helper()
 	require := require.New(t)
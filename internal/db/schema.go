@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/hashicorp/boundary/internal/db/migrations"
+)
+
+// migrationLockId is the key of the postgres advisory lock held while
+// migrating the database, so that only one process migrates it at a time.
+const migrationLockId int64 = 0x626e6479_6d677274
+
+// ErrSchemaDirty is returned when a previous migration failed part way
+// through, leaving the schema in an unknown state which must be repaired by
+// hand.
+var ErrSchemaDirty = errors.New("database schema is dirty")
+
+// ErrSchemaTooNew is returned when the database was migrated by a newer
+// binary, which includes migrations that are unknown to this one.
+var ErrSchemaTooNew = errors.New("database schema is newer than the binary")
+
+// Migration identifies a single migration embedded in the binary.
+type Migration struct {
+	Version uint   `json:"version"`
+	Name    string `json:"name"`
+}
+
+// SchemaState describes the schema version of a database relative to the
+// migrations embedded in the binary.
+type SchemaState struct {
+	// Initialized is false if no migrations have ever been run against the
+	// database.
+	Initialized bool `json:"initialized"`
+
+	// CurrentVersion is the version of the last migration that was applied.
+	CurrentVersion uint `json:"current_version"`
+
+	// Dirty is true if the last migration failed part way through.
+	Dirty bool `json:"dirty"`
+
+	// LatestVersion is the version of the newest embedded migration.
+	LatestVersion uint `json:"latest_version"`
+
+	// Pending contains the embedded migrations that have not been applied, in
+	// the order they will be applied.
+	Pending []Migration `json:"pending,omitempty"`
+}
+
+// UpToDate returns true if the database is initialized, not dirty, has no
+// pending migrations and was not migrated by a newer binary.
+func (s *SchemaState) UpToDate() bool {
+	return s.Initialized && !s.Dirty && len(s.Pending) == 0 && !s.TooNew()
+}
+
+// TooNew returns true if the database was migrated to a version greater than
+// the newest migration embedded in the binary.
+func (s *SchemaState) TooNew() bool {
+	return s.Initialized && s.CurrentVersion > s.LatestVersion
+}
+
+// GetSchemaState returns the schema state of the database, comparing the
+// version recorded by previous migrations with the migrations embedded in the
+// binary.
+func GetSchemaState(ctx context.Context, dialect string, d *sql.DB) (*SchemaState, error) {
+	if d == nil {
+		return nil, fmt.Errorf("get schema state: missing database: %w", ErrInvalidParameter)
+	}
+	src, err := migrations.NewMigrationSource(dialect)
+	if err != nil {
+		return nil, fmt.Errorf("get schema state: %w", err)
+	}
+	defer src.Close()
+
+	state := &SchemaState{}
+	var version int64
+	var dirty bool
+	err = d.QueryRowContext(ctx, "select version, dirty from schema_migrations limit 1").Scan(&version, &dirty)
+	switch {
+	case err == nil:
+		state.Initialized = true
+		state.CurrentVersion = uint(version)
+		state.Dirty = dirty
+	case err == sql.ErrNoRows, strings.Contains(err.Error(), "does not exist"):
+		// Not initialized; every migration is pending
+	default:
+		return nil, fmt.Errorf("get schema state: unable to read schema version: %w", err)
+	}
+
+	v, err := src.First()
+	for err == nil {
+		if !state.Initialized || v > state.CurrentVersion {
+			m, err := readMigration(src, v)
+			if err != nil {
+				return nil, fmt.Errorf("get schema state: %w", err)
+			}
+			state.Pending = append(state.Pending, m)
+		}
+		state.LatestVersion = v
+		v, err = src.Next(v)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("get schema state: unable to read migrations: %w", err)
+	}
+	return state, nil
+}
+
+// MigrateStore applies every pending migration to an initialized database.
+// An advisory lock is held for the duration, so concurrent calls are
+// serialized and later callers find nothing left to do. Returns the schema
+// state from before any migrations were applied; its Pending field lists the
+// migrations that were applied.
+func MigrateStore(ctx context.Context, dialect string, url string) (*SchemaState, error) {
+	d, err := sql.Open(dialect, url)
+	if err != nil {
+		return nil, fmt.Errorf("migrate store: unable to open database: %w", err)
+	}
+	defer d.Close()
+
+	conn, err := d.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate store: unable to connect to database: %w", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "select pg_advisory_lock($1)", migrationLockId); err != nil {
+		return nil, fmt.Errorf("migrate store: unable to acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), "select pg_advisory_unlock($1)", migrationLockId)
+
+	// The state is read after the lock is held so that migrations applied by
+	// whoever held it before are taken into account.
+	state, err := GetSchemaState(ctx, dialect, d)
+	if err != nil {
+		return nil, fmt.Errorf("migrate store: %w", err)
+	}
+	switch {
+	case !state.Initialized:
+		return state, fmt.Errorf("migrate store: database has not been initialized")
+	case state.Dirty:
+		return state, fmt.Errorf("migrate store: migration %d did not complete: %w", state.CurrentVersion, ErrSchemaDirty)
+	case state.TooNew():
+		return state, fmt.Errorf("migrate store: database is at version %d but the latest known migration is %d: %w", state.CurrentVersion, state.LatestVersion, ErrSchemaTooNew)
+	case len(state.Pending) == 0:
+		return state, nil
+	}
+
+	src, err := migrations.NewMigrationSource(dialect)
+	if err != nil {
+		return state, fmt.Errorf("migrate store: %w", err)
+	}
+	m, err := migrate.NewWithSourceInstance("httpfs", src, url)
+	if err != nil {
+		return state, fmt.Errorf("migrate store: unable to create migrations: %w", err)
+	}
+	defer m.Close()
+	if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return state, fmt.Errorf("migrate store: unable to run migrations: %w", err)
+	}
+	return state, nil
+}
+
+func readMigration(src source.Driver, version uint) (Migration, error) {
+	r, identifier, err := src.ReadUp(version)
+	if err != nil {
+		return Migration{}, fmt.Errorf("unable to read migration %d: %w", version, err)
+	}
+	r.Close()
+	return Migration{Version: version, Name: identifier}, nil
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/hashicorp/boundary/internal/db/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSchemaState(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	cleanup, url, _, err := StartDbInDocker("postgres")
+	require.NoError(err)
+	t.Cleanup(func() {
+		assert.NoError(cleanup())
+	})
+	d, err := sql.Open("postgres", url)
+	require.NoError(err)
+	defer d.Close()
+
+	_, err = GetSchemaState(ctx, "postgres", nil)
+	assert.Error(err)
+
+	state, err := GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	assert.False(state.Initialized)
+	assert.False(state.UpToDate())
+	require.NotEmpty(state.Pending)
+	assert.Equal(state.LatestVersion, state.Pending[len(state.Pending)-1].Version)
+
+	_, err = MigrateStore(ctx, "postgres", url)
+	assert.Error(err, "migrate should refuse an uninitialized database")
+
+	_, err = InitStore("postgres", nil, url)
+	require.NoError(err)
+	state, err = GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	assert.True(state.Initialized)
+	assert.True(state.UpToDate())
+	assert.Equal(state.LatestVersion, state.CurrentVersion)
+	assert.Empty(state.Pending)
+}
+
+func TestMigrateStore(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	cleanup, url, _, err := StartDbInDocker("postgres")
+	require.NoError(err)
+	t.Cleanup(func() {
+		assert.NoError(cleanup())
+	})
+	d, err := sql.Open("postgres", url)
+	require.NoError(err)
+	defer d.Close()
+
+	// Bring the database up to the version before the latest one, as if it
+	// had been initialized by the previous release.
+	src, err := migrations.NewMigrationSource("postgres")
+	require.NoError(err)
+	m, err := migrate.NewWithSourceInstance("httpfs", src, url)
+	require.NoError(err)
+	state, err := GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	require.True(len(state.Pending) > 1)
+	previous := state.Pending[len(state.Pending)-2].Version
+	require.NoError(m.Migrate(previous))
+	_, _ = m.Close()
+
+	state, err = GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	assert.Equal(previous, state.CurrentVersion)
+	require.Len(state.Pending, 1)
+	assert.Equal(state.LatestVersion, state.Pending[0].Version)
+
+	// Concurrent migrations are serialized by the advisory lock, so exactly
+	// one of them applies the pending migration.
+	var wg sync.WaitGroup
+	results := make([]*SchemaState, 3)
+	errs := make([]error, 3)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = MigrateStore(ctx, "postgres", url)
+		}(i)
+	}
+	wg.Wait()
+	var applied int
+	for i := range results {
+		require.NoError(errs[i])
+		applied += len(results[i].Pending)
+	}
+	assert.Equal(1, applied)
+
+	state, err = GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	assert.True(state.UpToDate())
+
+	// A dirty schema is refused
+	_, err = d.ExecContext(ctx, "update schema_migrations set dirty = true")
+	require.NoError(err)
+	_, err = MigrateStore(ctx, "postgres", url)
+	assert.True(errors.Is(err, ErrSchemaDirty))
+
+	// A database migrated by a newer binary is refused
+	_, err = d.ExecContext(ctx, "update schema_migrations set dirty = false, version = $1", state.LatestVersion+1)
+	require.NoError(err)
+	state, err = GetSchemaState(ctx, "postgres", d)
+	require.NoError(err)
+	assert.True(state.TooNew())
+	assert.False(state.UpToDate())
+	assert.Empty(state.Pending)
+	_, err = MigrateStore(ctx, "postgres", url)
+	assert.True(errors.Is(err, ErrSchemaTooNew))
+}
@@ -43,6 +43,19 @@ type ScopeServiceClient interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(ctx context.Context, in *DeleteScopeRequest, opts ...grpc.CallOption) (*DeleteScopeResponse, error)
+	// RotateKeys creates a new version of the Scope's root key and of each of
+	// the Scope's data encryption keys. New data is encrypted with the new
+	// versions, while existing data remains readable with the previous versions
+	// until it is rewrapped. If the provided Scope ID is malformed or references
+	// a non existing Scope an error is returned.
+	RotateKeys(ctx context.Context, in *RotateKeysRequest, opts ...grpc.CallOption) (*RotateKeysResponse, error)
+	// RewrapKeys re-encrypts the Scope's data encryption keys under the newest
+	// version of its root key, and schedules the re-encryption of the Scope's
+	// data under the newest version of its data encryption keys. Once this has
+	// completed, previous key versions are no longer in use. If the provided
+	// Scope ID is malformed or references a non existing Scope an error is
+	// returned.
+	RewrapKeys(ctx context.Context, in *RewrapKeysRequest, opts ...grpc.CallOption) (*RewrapKeysResponse, error)
 }
 
 type scopeServiceClient struct {
@@ -98,6 +111,24 @@ func (c *scopeServiceClient) DeleteScope(ctx context.Context, in *DeleteScopeReq
 	return out, nil
 }
 
+func (c *scopeServiceClient) RotateKeys(ctx context.Context, in *RotateKeysRequest, opts ...grpc.CallOption) (*RotateKeysResponse, error) {
+	out := new(RotateKeysResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/RotateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scopeServiceClient) RewrapKeys(ctx context.Context, in *RewrapKeysRequest, opts ...grpc.CallOption) (*RewrapKeysResponse, error) {
+	out := new(RewrapKeysResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/RewrapKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScopeServiceServer is the server API for ScopeService service.
 type ScopeServiceServer interface {
 	// GetScope returns a stored Scope if present.  The provided request
@@ -126,6 +157,19 @@ type ScopeServiceServer interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error)
+	// RotateKeys creates a new version of the Scope's root key and of each of
+	// the Scope's data encryption keys. New data is encrypted with the new
+	// versions, while existing data remains readable with the previous versions
+	// until it is rewrapped. If the provided Scope ID is malformed or references
+	// a non existing Scope an error is returned.
+	RotateKeys(context.Context, *RotateKeysRequest) (*RotateKeysResponse, error)
+	// RewrapKeys re-encrypts the Scope's data encryption keys under the newest
+	// version of its root key, and schedules the re-encryption of the Scope's
+	// data under the newest version of its data encryption keys. Once this has
+	// completed, previous key versions are no longer in use. If the provided
+	// Scope ID is malformed or references a non existing Scope an error is
+	// returned.
+	RewrapKeys(context.Context, *RewrapKeysRequest) (*RewrapKeysResponse, error)
 }
 
 // UnimplementedScopeServiceServer can be embedded to have forward compatible implementations.
@@ -147,6 +191,12 @@ func (*UnimplementedScopeServiceServer) UpdateScope(context.Context, *UpdateScop
 func (*UnimplementedScopeServiceServer) DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
+func (*UnimplementedScopeServiceServer) RotateKeys(context.Context, *RotateKeysRequest) (*RotateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKeys not implemented")
+}
+func (*UnimplementedScopeServiceServer) RewrapKeys(context.Context, *RewrapKeysRequest) (*RewrapKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewrapKeys not implemented")
+}
 
 func RegisterScopeServiceServer(s *grpc.Server, srv ScopeServiceServer) {
 	s.RegisterService(&_ScopeService_serviceDesc, srv)
@@ -242,6 +292,42 @@ func _ScopeService_DeleteScope_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_RotateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).RotateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/RotateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).RotateKeys(ctx, req.(*RotateKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScopeService_RewrapKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewrapKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).RewrapKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/RewrapKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).RewrapKeys(ctx, req.(*RewrapKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ScopeService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.api.services.v1.ScopeService",
 	HandlerType: (*ScopeServiceServer)(nil),
@@ -266,6 +352,14 @@ var _ScopeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScope",
 			Handler:    _ScopeService_DeleteScope_Handler,
 		},
+		{
+			MethodName: "RotateKeys",
+			Handler:    _ScopeService_RotateKeys_Handler,
+		},
+		{
+			MethodName: "RewrapKeys",
+			Handler:    _ScopeService_RewrapKeys_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/scope_service.proto",
@@ -65,6 +65,11 @@ type UserServiceClient interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(ctx context.Context, in *RemoveUserAccountsRequest, opts ...grpc.CallOption) (*RemoveUserAccountsResponse, error)
+	// GetUserEffectiveGrants returns the grants the provided User receives from
+	// every Role and the Scopes they apply to. If an action is provided, the
+	// grants are evaluated against it and the resource described by the
+	// request, reporting which grants apply and whether the action is allowed.
+	GetUserEffectiveGrants(ctx context.Context, in *GetUserEffectiveGrantsRequest, opts ...grpc.CallOption) (*GetUserEffectiveGrantsResponse, error)
 }
 
 type userServiceClient struct {
@@ -147,6 +152,15 @@ func (c *userServiceClient) RemoveUserAccounts(ctx context.Context, in *RemoveUs
 	return out, nil
 }
 
+func (c *userServiceClient) GetUserEffectiveGrants(ctx context.Context, in *GetUserEffectiveGrantsRequest, opts ...grpc.CallOption) (*GetUserEffectiveGrantsResponse, error) {
+	out := new(GetUserEffectiveGrantsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.UserService/GetUserEffectiveGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 type UserServiceServer interface {
 	// GetUser returns a stored User if present.  The provided request
@@ -197,6 +211,11 @@ type UserServiceServer interface {
 	// will be removed from. If the provided Account ids is not associated with the
 	// provided User, an error is returned.
 	RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error)
+	// GetUserEffectiveGrants returns the grants the provided User receives from
+	// every Role and the Scopes they apply to. If an action is provided, the
+	// grants are evaluated against it and the resource described by the
+	// request, reporting which grants apply and whether the action is allowed.
+	GetUserEffectiveGrants(context.Context, *GetUserEffectiveGrantsRequest) (*GetUserEffectiveGrantsResponse, error)
 }
 
 // UnimplementedUserServiceServer can be embedded to have forward compatible implementations.
@@ -227,6 +246,9 @@ func (*UnimplementedUserServiceServer) SetUserAccounts(context.Context, *SetUser
 func (*UnimplementedUserServiceServer) RemoveUserAccounts(context.Context, *RemoveUserAccountsRequest) (*RemoveUserAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserAccounts not implemented")
 }
+func (*UnimplementedUserServiceServer) GetUserEffectiveGrants(context.Context, *GetUserEffectiveGrantsRequest) (*GetUserEffectiveGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEffectiveGrants not implemented")
+}
 
 func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
 	s.RegisterService(&_UserService_serviceDesc, srv)
@@ -376,6 +398,24 @@ func _UserService_RemoveUserAccounts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUserEffectiveGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEffectiveGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserEffectiveGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.UserService/GetUserEffectiveGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserEffectiveGrants(ctx, req.(*GetUserEffectiveGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "controller.api.services.v1.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -412,6 +452,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUserAccounts",
 			Handler:    _UserService_RemoveUserAccounts_Handler,
 		},
+		{
+			MethodName: "GetUserEffectiveGrants",
+			Handler:    _UserService_GetUserEffectiveGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/user_service.proto",
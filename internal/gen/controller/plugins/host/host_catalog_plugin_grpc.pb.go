@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package host
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// HostCatalogPluginServiceClient is the client API for HostCatalogPluginService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HostCatalogPluginServiceClient interface {
+	// GetInfo returns the type of the plugin's host catalogs and the public
+	// id prefixes of its resources.
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// ListSetHosts returns the hosts currently in a host set.
+	ListSetHosts(ctx context.Context, in *ListSetHostsRequest, opts ...grpc.CallOption) (*ListSetHostsResponse, error)
+	// ValidateAttributes checks the attributes of a resource which is being
+	// created or updated.
+	ValidateAttributes(ctx context.Context, in *ValidateAttributesRequest, opts ...grpc.CallOption) (*ValidateAttributesResponse, error)
+}
+
+type hostCatalogPluginServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHostCatalogPluginServiceClient(cc grpc.ClientConnInterface) HostCatalogPluginServiceClient {
+	return &hostCatalogPluginServiceClient{cc}
+}
+
+func (c *hostCatalogPluginServiceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/controller.plugins.host.v1.HostCatalogPluginService/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostCatalogPluginServiceClient) ListSetHosts(ctx context.Context, in *ListSetHostsRequest, opts ...grpc.CallOption) (*ListSetHostsResponse, error) {
+	out := new(ListSetHostsResponse)
+	err := c.cc.Invoke(ctx, "/controller.plugins.host.v1.HostCatalogPluginService/ListSetHosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hostCatalogPluginServiceClient) ValidateAttributes(ctx context.Context, in *ValidateAttributesRequest, opts ...grpc.CallOption) (*ValidateAttributesResponse, error) {
+	out := new(ValidateAttributesResponse)
+	err := c.cc.Invoke(ctx, "/controller.plugins.host.v1.HostCatalogPluginService/ValidateAttributes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HostCatalogPluginServiceServer is the server API for HostCatalogPluginService service.
+type HostCatalogPluginServiceServer interface {
+	// GetInfo returns the type of the plugin's host catalogs and the public
+	// id prefixes of its resources.
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// ListSetHosts returns the hosts currently in a host set.
+	ListSetHosts(context.Context, *ListSetHostsRequest) (*ListSetHostsResponse, error)
+	// ValidateAttributes checks the attributes of a resource which is being
+	// created or updated.
+	ValidateAttributes(context.Context, *ValidateAttributesRequest) (*ValidateAttributesResponse, error)
+}
+
+// UnimplementedHostCatalogPluginServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedHostCatalogPluginServiceServer struct {
+}
+
+func (*UnimplementedHostCatalogPluginServiceServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (*UnimplementedHostCatalogPluginServiceServer) ListSetHosts(context.Context, *ListSetHostsRequest) (*ListSetHostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSetHosts not implemented")
+}
+func (*UnimplementedHostCatalogPluginServiceServer) ValidateAttributes(context.Context, *ValidateAttributesRequest) (*ValidateAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAttributes not implemented")
+}
+
+func RegisterHostCatalogPluginServiceServer(s *grpc.Server, srv HostCatalogPluginServiceServer) {
+	s.RegisterService(&_HostCatalogPluginService_serviceDesc, srv)
+}
+
+func _HostCatalogPluginService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogPluginServiceServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.plugins.host.v1.HostCatalogPluginService/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogPluginServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostCatalogPluginService_ListSetHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSetHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogPluginServiceServer).ListSetHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.plugins.host.v1.HostCatalogPluginService/ListSetHosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogPluginServiceServer).ListSetHosts(ctx, req.(*ListSetHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HostCatalogPluginService_ValidateAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HostCatalogPluginServiceServer).ValidateAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.plugins.host.v1.HostCatalogPluginService/ValidateAttributes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HostCatalogPluginServiceServer).ValidateAttributes(ctx, req.(*ValidateAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HostCatalogPluginService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "controller.plugins.host.v1.HostCatalogPluginService",
+	HandlerType: (*HostCatalogPluginServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetInfo",
+			Handler:    _HostCatalogPluginService_GetInfo_Handler,
+		},
+		{
+			MethodName: "ListSetHosts",
+			Handler:    _HostCatalogPluginService_ListSetHosts_Handler,
+		},
+		{
+			MethodName: "ValidateAttributes",
+			Handler:    _HostCatalogPluginService_ValidateAttributes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controller/plugins/host/v1/host_catalog_plugin.proto",
+}
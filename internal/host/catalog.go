@@ -0,0 +1,281 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// A Catalog is a source of host catalogs, host sets and hosts of a single
+// type. The host services create, read, update and delete the host catalogs
+// and host sets of every type through their Catalog, and the targets
+// service uses the Catalog of a host set to find the hosts a session can be
+// made to. New types of host catalogs are added by implementing Catalog and
+// registering it in a Registry.
+//
+// The attributes of the resources of a Catalog are the type specific fields
+// of the API resources, such as the address of a static host. The update
+// methods take the paths of the API fields being updated, such as "name" or
+// "attributes.address", and return an error wrapping db.ErrEmptyFieldMask
+// if none of the paths can be updated.
+type Catalog interface {
+	// Type returns the name of the type of the host catalogs, host sets
+	// and hosts of the Catalog, such as "static".
+	Type() string
+
+	// Prefixes returns the public id prefixes of the host catalogs, host
+	// sets and hosts of the Catalog.
+	Prefixes() Prefixes
+
+	// CreateCatalog creates the host catalog c in the scope c.ScopeId and
+	// returns it with its PublicId set.
+	CreateCatalog(ctx context.Context, c *HostCatalog) (*HostCatalog, error)
+
+	// LookupCatalog returns the host catalog for id. A nil HostCatalog is
+	// returned if the catalog is not found.
+	LookupCatalog(ctx context.Context, id string) (*HostCatalog, error)
+
+	// ListCatalogs returns the host catalogs in the scopes ordered by
+	// create time, starting after cursor and limited to limit.
+	ListCatalogs(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*HostCatalog, error)
+
+	// UpdateCatalog updates the fields listed in fieldPaths of the host
+	// catalog c.PublicId in the scope c.ScopeId. It returns the updated
+	// catalog and the number of catalogs updated.
+	UpdateCatalog(ctx context.Context, c *HostCatalog, version uint32, fieldPaths []string) (*HostCatalog, int, error)
+
+	// DeleteCatalog deletes the host catalog id, and its host sets and
+	// hosts, and returns the number of catalogs deleted.
+	DeleteCatalog(ctx context.Context, id string) (int, error)
+
+	// CreateSet creates the host set s in the host catalog s.CatalogId,
+	// which is in the scope scopeId, and returns it with its PublicId set.
+	CreateSet(ctx context.Context, scopeId string, s *Set) (*Set, error)
+
+	// LookupSet returns the host set for id with the ids of its hosts. The
+	// hosts of a Catalog whose hosts are discovered are the ones found the
+	// last time ListSetHosts was called for the set. A nil Set is returned
+	// if the set is not found.
+	LookupSet(ctx context.Context, id string) (*Set, error)
+
+	// ListSets returns the host sets in the host catalog catalogId ordered
+	// by create time, starting after cursor and limited to limit. The ids
+	// of the hosts of the sets are not set.
+	ListSets(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Set, error)
+
+	// UpdateSet updates the fields listed in fieldPaths of the host set
+	// s.PublicId, which is in the scope scopeId. It returns the updated set
+	// and the number of sets updated. The ids of the hosts of the returned
+	// set are only set by Catalogs which are a SetMemberWriter.
+	UpdateSet(ctx context.Context, scopeId string, s *Set, version uint32, fieldPaths []string) (*Set, int, error)
+
+	// DeleteSet deletes the host set id, which is in the scope scopeId,
+	// and returns the number of sets deleted.
+	DeleteSet(ctx context.Context, scopeId, id string) (int, error)
+
+	// ListSetHosts returns the hosts in the host set setId. Catalogs whose
+	// hosts are discovered return the hosts the set currently contains.
+	ListSetHosts(ctx context.Context, setId string) ([]*Host, error)
+
+	// LookupHost returns the host for hostId. A nil Host is returned if
+	// the host is not found.
+	LookupHost(ctx context.Context, hostId string) (*Host, error)
+
+	// ListHosts returns the hosts in the host catalog catalogId ordered by
+	// create time, starting after cursor and limited to limit.
+	ListHosts(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Host, error)
+
+	// ValidateAttributes checks the attributes of a resource of type r
+	// which is being created or updated. The returned map holds an error
+	// message for each bad field keyed by the path of the field, such as
+	// "attributes.address". It is empty if the attributes are valid.
+	ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string
+}
+
+// A HostWriter is a Catalog whose hosts are created, updated and deleted by
+// users. The hosts of a Catalog which isn't a HostWriter are discovered by
+// the Catalog and can only be read.
+type HostWriter interface {
+	Catalog
+
+	// CreateHost creates the host h in the host catalog h.CatalogId,
+	// which is in the scope scopeId, and returns it with its PublicId set.
+	CreateHost(ctx context.Context, scopeId string, h *Host) (*Host, error)
+
+	// UpdateHost updates the fields listed in fieldPaths of the host
+	// h.PublicId, which is in the scope scopeId. It returns the updated
+	// host and the number of hosts updated.
+	UpdateHost(ctx context.Context, scopeId string, h *Host, version uint32, fieldPaths []string) (*Host, int, error)
+
+	// DeleteHost deletes the host id, which is in the scope scopeId, and
+	// returns the number of hosts deleted.
+	DeleteHost(ctx context.Context, scopeId, id string) (int, error)
+}
+
+// A SetMemberWriter is a Catalog whose users add hosts to and remove hosts
+// from its host sets. Each method changes the hosts of the host set setId,
+// which is in the scope scopeId, if its version is version.
+type SetMemberWriter interface {
+	Catalog
+
+	// AddSetMembers adds the hosts hostIds to the host set.
+	AddSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error
+
+	// SetSetMembers replaces the hosts of the host set with hostIds.
+	SetSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error
+
+	// DeleteSetMembers removes the hosts hostIds from the host set.
+	DeleteSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error
+}
+
+// Prefixes are the public id prefixes of the resources of a Catalog.
+type Prefixes struct {
+	HostCatalog string
+	HostSet     string
+	Host        string
+}
+
+// A HostCatalog is a host catalog of a Catalog.
+type HostCatalog struct {
+	PublicId    string
+	ScopeId     string
+	Name        string
+	Description string
+	Attributes  *structpb.Struct
+	CreateTime  *timestamppb.Timestamp
+	UpdateTime  *timestamppb.Timestamp
+	Version     uint32
+}
+
+// A Set is a host set of a Catalog.
+type Set struct {
+	PublicId    string
+	CatalogId   string
+	Name        string
+	Description string
+	Attributes  *structpb.Struct
+	// HostIds are the ids of the hosts in the set.
+	HostIds    []string
+	CreateTime *timestamppb.Timestamp
+	UpdateTime *timestamppb.Timestamp
+	Version    uint32
+}
+
+// A Host is a host of a Catalog.
+type Host struct {
+	PublicId    string
+	CatalogId   string
+	Name        string
+	Description string
+	Address     string
+	// Port is the port sessions to the host are made to. It is 0 if the
+	// port of the target is used.
+	Port uint32
+	// AlternateAddresses are tried, in order, when a connection to Address
+	// can't be made.
+	AlternateAddresses []string
+	Attributes         *structpb.Struct
+	CreateTime         *timestamppb.Timestamp
+	UpdateTime         *timestamppb.Timestamp
+	Version            uint32
+}
+
+// A Registry holds the Catalogs of the types of host catalogs a controller
+// supports. It is safe for concurrent use.
+type Registry struct {
+	mu       sync.RWMutex
+	catalogs map[string]Catalog
+}
+
+// NewRegistry creates a Registry and registers the catalogs in it.
+func NewRegistry(catalogs ...Catalog) (*Registry, error) {
+	r := &Registry{
+		catalogs: make(map[string]Catalog),
+	}
+	for _, c := range catalogs {
+		if err := r.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds c to the registry. It returns an error if the type or any
+// of the prefixes of c are already used by a registered Catalog.
+func (r *Registry) Register(c Catalog) error {
+	if c == nil {
+		return fmt.Errorf("register: host catalog: missing catalog: %w", db.ErrInvalidParameter)
+	}
+	t := strings.ToLower(strings.TrimSpace(c.Type()))
+	if t == "" {
+		return fmt.Errorf("register: host catalog: missing type: %w", db.ErrInvalidParameter)
+	}
+	p := c.Prefixes()
+	if p.HostCatalog == "" || p.HostSet == "" || p.Host == "" {
+		return fmt.Errorf("register: host catalog %q: missing prefix: %w", t, db.ErrInvalidParameter)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.catalogs[t]; ok {
+		return fmt.Errorf("register: host catalog %q: type already registered: %w", t, db.ErrInvalidParameter)
+	}
+	for ot, o := range r.catalogs {
+		used := map[string]bool{
+			o.Prefixes().HostCatalog: true,
+			o.Prefixes().HostSet:     true,
+			o.Prefixes().Host:        true,
+		}
+		for _, pre := range []string{p.HostCatalog, p.HostSet, p.Host} {
+			if used[pre] {
+				return fmt.Errorf("register: host catalog %q: prefix %q already used by %q: %w", t, pre, ot, db.ErrInvalidParameter)
+			}
+		}
+	}
+	r.catalogs[t] = c
+	return nil
+}
+
+// Catalog returns the Catalog registered for the type t.
+func (r *Registry) Catalog(t string) (Catalog, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.catalogs[strings.ToLower(strings.TrimSpace(t))]
+	return c, ok
+}
+
+// CatalogForId returns the Catalog the host catalog, host set or host id
+// belongs to.
+func (r *Registry) CatalogForId(id string) (Catalog, bool) {
+	id = strings.TrimSpace(id)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, c := range r.catalogs {
+		p := c.Prefixes()
+		for _, pre := range []string{p.HostCatalog, p.HostSet, p.Host} {
+			if strings.HasPrefix(id, pre+"_") {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Types returns the sorted types of the registered Catalogs.
+func (r *Registry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]string, 0, len(r.catalogs))
+	for t := range r.catalogs {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
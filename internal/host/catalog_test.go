@@ -0,0 +1,200 @@
+package host
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeCatalog is a Catalog with a type and prefixes. Its other methods
+// are not implemented.
+type fakeCatalog struct {
+	Catalog
+	typ      string
+	prefixes Prefixes
+}
+
+func (c *fakeCatalog) Type() string       { return c.typ }
+func (c *fakeCatalog) Prefixes() Prefixes { return c.prefixes }
+
+func TestRegistry_Catalog(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	r, err := NewRegistry(NewStaticCatalog(nil), NewDnsCatalog(nil))
+	require.NoError(err)
+	assert.Equal([]string{"dns", "static"}, r.Types())
+
+	cmdb := &fakeCatalog{typ: "cmdb", prefixes: Prefixes{HostCatalog: "hccmdb", HostSet: "hscmdb", Host: "hcmdb"}}
+	require.NoError(r.Register(cmdb))
+	assert.Equal([]string{"cmdb", "dns", "static"}, r.Types())
+
+	c, ok := r.Catalog(" CMDB ")
+	require.True(ok)
+	assert.Equal(cmdb, c)
+	_, ok = r.Catalog("unknown")
+	assert.False(ok)
+
+	for id, want := range map[string]string{
+		static.HostCatalogPrefix + "_1234567890": "static",
+		static.HostSetPrefix + "_1234567890":     "static",
+		static.HostPrefix + "_1234567890":        "static",
+		dns.HostSetPrefix + "_1234567890":        "dns",
+		"hscmdb_1234567890":                      "cmdb",
+	} {
+		c, ok := r.CatalogForId(id)
+		require.True(ok, id)
+		assert.Equal(want, c.Type(), id)
+	}
+	for _, id := range []string{"", "hscmdb", "ttcp_1234567890"} {
+		_, ok := r.CatalogForId(id)
+		assert.False(ok, id)
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	var tests = []struct {
+		name string
+		c    Catalog
+	}{
+		{
+			name: "nil-catalog",
+		},
+		{
+			name: "missing-type",
+			c:    &fakeCatalog{prefixes: Prefixes{HostCatalog: "hcx", HostSet: "hsx", Host: "hx"}},
+		},
+		{
+			name: "missing-prefix",
+			c:    &fakeCatalog{typ: "x", prefixes: Prefixes{HostCatalog: "hcx", HostSet: "hsx"}},
+		},
+		{
+			name: "duplicate-type",
+			c:    &fakeCatalog{typ: "Static", prefixes: Prefixes{HostCatalog: "hcx", HostSet: "hsx", Host: "hx"}},
+		},
+		{
+			name: "duplicate-prefix",
+			c:    &fakeCatalog{typ: "x", prefixes: Prefixes{HostCatalog: "hcx", HostSet: static.HostSetPrefix, Host: "hx"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			r, err := NewRegistry(NewStaticCatalog(nil))
+			require.NoError(err)
+			err = r.Register(tt.c)
+			require.Error(err)
+			assert.True(errors.Is(err, db.ErrInvalidParameter))
+			assert.Equal([]string{"static"}, r.Types())
+		})
+	}
+}
+
+func TestCatalog_ValidateAttributes(t *testing.T) {
+	var tests = []struct {
+		name  string
+		c     Catalog
+		r     resource.Type
+		attrs map[string]interface{}
+		want  []string
+	}{
+		{
+			name:  "static-host-valid",
+			c:     NewStaticCatalog(nil),
+			r:     resource.Host,
+			attrs: map[string]interface{}{"address": "10.0.0.1", "port": 22, "alternate_addresses": []interface{}{"10.0.0.2"}},
+		},
+		{
+			name:  "static-host-bad-port",
+			c:     NewStaticCatalog(nil),
+			r:     resource.Host,
+			attrs: map[string]interface{}{"address": "10.0.0.1", "port": 70000},
+			want:  []string{"attributes.port"},
+		},
+		{
+			name:  "static-host-repeated-alternate",
+			c:     NewStaticCatalog(nil),
+			r:     resource.Host,
+			attrs: map[string]interface{}{"address": "10.0.0.1", "alternate_addresses": []interface{}{"10.0.0.1"}},
+			want:  []string{"attributes.alternate_addresses"},
+		},
+		{
+			name:  "static-host-unknown-attribute",
+			c:     NewStaticCatalog(nil),
+			r:     resource.Host,
+			attrs: map[string]interface{}{"unknown": "x"},
+			want:  []string{"attributes"},
+		},
+		{
+			name:  "static-host-empty-attribute-key",
+			c:     NewStaticCatalog(nil),
+			r:     resource.Host,
+			attrs: map[string]interface{}{"address": "10.0.0.1", "attributes": map[string]interface{}{" ": "x"}},
+			want:  []string{"attributes.attributes"},
+		},
+		{
+			name:  "static-set-valid-selector",
+			c:     NewStaticCatalog(nil),
+			r:     resource.HostSet,
+			attrs: map[string]interface{}{"selector": `env == "prod" and role == "db"`},
+		},
+		{
+			name:  "static-set-bad-selector",
+			c:     NewStaticCatalog(nil),
+			r:     resource.HostSet,
+			attrs: map[string]interface{}{"selector": `env ==`},
+			want:  []string{"attributes.selector"},
+		},
+		{
+			name:  "static-set-unknown-attribute",
+			c:     NewStaticCatalog(nil),
+			r:     resource.HostSet,
+			attrs: map[string]interface{}{"unknown": "x"},
+			want:  []string{"attributes"},
+		},
+		{
+			name:  "dns-catalog-valid",
+			c:     NewDnsCatalog(nil),
+			r:     resource.HostCatalog,
+			attrs: map[string]interface{}{"resolver_address": "10.0.0.53:53"},
+		},
+		{
+			name:  "dns-catalog-bad-resolver",
+			c:     NewDnsCatalog(nil),
+			r:     resource.HostCatalog,
+			attrs: map[string]interface{}{"resolver_address": "10.0.0.53"},
+			want:  []string{"attributes.resolver_address"},
+		},
+		{
+			name:  "dns-set-valid",
+			c:     NewDnsCatalog(nil),
+			r:     resource.HostSet,
+			attrs: map[string]interface{}{"record_names": []interface{}{"db.example.test"}},
+		},
+		{
+			name:  "dns-set-empty-record-name",
+			c:     NewDnsCatalog(nil),
+			r:     resource.HostSet,
+			attrs: map[string]interface{}{"record_names": []interface{}{" "}},
+			want:  []string{"attributes.record_names"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			attrs, err := structpb.NewStruct(tt.attrs)
+			require.NoError(err)
+			got := tt.c.ValidateAttributes(tt.r, attrs)
+			var fields []string
+			for k := range got {
+				fields = append(fields, k)
+			}
+			assert.ElementsMatch(tt.want, fields)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Package dns provides a host, a host catalog, and a host set suitable for
+// hosts registered in DNS.
+//
+// A host set contains a list of DNS record names. Names of the form
+// _service._proto.name are looked up as SRV records and all other names as
+// A and AAAA records. The hosts in a host set are not managed by users, they
+// are found by resolving the set's record names, which is done each time the
+// hosts in the set are needed, for example when a session is authorized. The
+// result of resolving a record name is cached for a short time so repeated
+// requests don't each query DNS. The record names are resolved with the
+// system's resolver unless the host catalog of the set has a resolver
+// address, in which case the DNS server at that address is queried.
+//
+// Every address a record name resolves to is stored as a read-only host in
+// the set's host catalog, so it has a stable id which sessions can refer to.
+// A host whose address is no longer returned for any of the catalog's host
+// sets is deleted.
+//
+// Repository
+//
+// A repository provides methods for creating, updating, retrieving, and
+// deleting host catalogs and host sets, retrieving hosts, and resolving the
+// hosts in a host set. A new repository should be created for each
+// transaction while a Resolver, which holds the cache, should be shared. For
+// example:
+//
+//  resolver := dns.NewResolver(dns.DefaultCacheTTL)
+//
+//  repo, _ := dns.NewRepository(db, db, kms, dns.WithResolver(resolver))
+//  hosts, _ := repo.ResolveSet(ctx, setId)
+package dns
@@ -0,0 +1,54 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+)
+
+// A Host is an address, and for SRV records a port, which a record name of
+// a host set in the host's catalog resolved to. Hosts are created and
+// deleted when host sets are resolved and can't be changed.
+type Host struct {
+	*store.Host
+	tableName string `gorm:"-"`
+}
+
+// newHost creates a new in memory Host for address and port in catalogId.
+func newHost(catalogId, address string, port uint32) (*Host, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("new: dns host: no catalog id: %w", db.ErrInvalidParameter)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("new: dns host: no address: %w", db.ErrInvalidParameter)
+	}
+	h := &Host{
+		Host: &store.Host{
+			CatalogId: catalogId,
+			Address:   address,
+			Port:      port,
+		},
+	}
+	return h, nil
+}
+
+// TableName returns the table name for the host.
+func (h *Host) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "dns_host"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (h *Host) SetTableName(n string) {
+	h.tableName = n
+}
+
+func allocHost() *Host {
+	return &Host{
+		Host: &store.Host{},
+	}
+}
@@ -0,0 +1,152 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// A HostSet is a collection of the hosts found by resolving its record
+// names.
+type HostSet struct {
+	*store.HostSet
+	tableName string `gorm:"-"`
+}
+
+// NewHostSet creates a new in memory HostSet assigned to catalogId.
+// Name, description, and record names are the only valid options. All
+// other options are ignored.
+func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("new: dns host set: no catalog id: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	set := &HostSet{
+		HostSet: &store.HostSet{
+			CatalogId:   catalogId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			RecordNames: opts.withRecordNames,
+		},
+	}
+	return set, nil
+}
+
+// TableName returns the table name for the host set.
+func (s *HostSet) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return "dns_host_set"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (s *HostSet) SetTableName(n string) {
+	s.tableName = n
+}
+
+func allocHostSet() *HostSet {
+	return &HostSet{
+		HostSet: &store.HostSet{},
+	}
+}
+
+func (s *HostSet) clone() *HostSet {
+	cp := proto.Clone(s.HostSet)
+	return &HostSet{
+		HostSet: cp.(*store.HostSet),
+	}
+}
+
+func (s *HostSet) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{s.PublicId},
+		"resource-type":      []string{"dns-host-set"},
+		"op-type":            []string{op.String()},
+	}
+	if s.CatalogId != "" {
+		metadata["catalog-id"] = []string{s.CatalogId}
+	}
+	return metadata
+}
+
+// A HostSetRecordName is a record name of a host set.
+type HostSetRecordName struct {
+	*store.HostSetRecordName
+	tableName string `gorm:"-"`
+}
+
+// NewHostSetRecordName creates a new in memory HostSetRecordName
+// representing name as a record name of setId.
+func NewHostSetRecordName(setId, name string, opt ...Option) (*HostSetRecordName, error) {
+	if setId == "" {
+		return nil, fmt.Errorf("new: dns host set record name: no host set id: %w", db.ErrInvalidParameter)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("new: dns host set record name: no record name: %w", db.ErrInvalidParameter)
+	}
+	n := &HostSetRecordName{
+		HostSetRecordName: &store.HostSetRecordName{
+			SetId:      setId,
+			RecordName: name,
+		},
+	}
+	return n, nil
+}
+
+// TableName returns the table name for the host set record name.
+func (n *HostSetRecordName) TableName() string {
+	if n.tableName != "" {
+		return n.tableName
+	}
+	return "dns_host_set_record_name"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (n *HostSetRecordName) SetTableName(name string) {
+	n.tableName = name
+}
+
+// A HostSetMember represents the membership of a host in a host set.
+type HostSetMember struct {
+	*store.HostSetMember
+	tableName string `gorm:"-"`
+}
+
+// newHostSetMember creates a new in memory HostSetMember representing the
+// membership of hostId in setId.
+func newHostSetMember(setId, hostId string) (*HostSetMember, error) {
+	if setId == "" {
+		return nil, fmt.Errorf("new: dns host set member: no host set id: %w", db.ErrInvalidParameter)
+	}
+	if hostId == "" {
+		return nil, fmt.Errorf("new: dns host set member: no host id: %w", db.ErrInvalidParameter)
+	}
+	m := &HostSetMember{
+		HostSetMember: &store.HostSetMember{
+			SetId:  setId,
+			HostId: hostId,
+		},
+	}
+	return m, nil
+}
+
+// TableName returns the table name for the host set member.
+func (m *HostSetMember) TableName() string {
+	if m.tableName != "" {
+		return m.tableName
+	}
+	return "dns_host_set_member"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (m *HostSetMember) SetTableName(n string) {
+	m.tableName = n
+}
@@ -0,0 +1,93 @@
+package dns
+
+import "github.com/hashicorp/boundary/internal/db"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName            string
+	withDescription     string
+	withResolverAddress string
+	withRecordNames     []string
+	withLimit           int
+	withPublicId        string
+	withStartPageAfter  *db.PageCursor
+	withResolver        *Resolver
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithResolverAddress provides an optional host and port of the DNS server
+// used to resolve the record names of a host catalog's host sets.
+func WithResolverAddress(address string) Option {
+	return func(o *options) {
+		o.withResolverAddress = address
+	}
+}
+
+// WithRecordNames provides the optional DNS record names of a host set.
+func WithRecordNames(names []string) Option {
+	return func(o *options) {
+		o.withRecordNames = names
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPublicId provides an optional public id.
+func WithPublicId(id string) Option {
+	return func(o *options) {
+		o.withPublicId = id
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
+
+// WithResolver provides the Resolver, and the cache it holds, a repository
+// uses to resolve record names. If not provided the repository uses a
+// Resolver of its own.
+func WithResolver(r *Resolver) Option {
+	return func(o *options) {
+		o.withResolver = r
+	}
+}
@@ -0,0 +1,43 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithResolverAddress", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithResolverAddress("127.0.0.1:53"))
+		testOpts := getDefaultOptions()
+		testOpts.withResolverAddress = "127.0.0.1:53"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithRecordNames", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithRecordNames([]string{"a.example.test", "_b._tcp.example.test"}))
+		testOpts := getDefaultOptions()
+		testOpts.withRecordNames = []string{"a.example.test", "_b._tcp.example.test"}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithStartPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		cursor := &db.PageCursor{CreateTime: time.Now(), PublicId: "hsdns_1234567890"}
+		opts := getOpts(WithStartPageAfter(cursor))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfter = cursor
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithResolver", func(t *testing.T) {
+		assert := assert.New(t)
+		r := NewResolver(0)
+		opts := getOpts(WithResolver(r))
+		testOpts := getDefaultOptions()
+		testOpts.withResolver = r
+		assert.Equal(opts, testOpts)
+	})
+}
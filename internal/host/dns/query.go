@@ -0,0 +1,15 @@
+package dns
+
+const (
+	// deleteOrphanedHostsQuery deletes the hosts of a catalog which are
+	// not a member of any of the catalog's host sets.
+	deleteOrphanedHostsQuery = `
+delete from dns_host
+ where catalog_id = $1
+   and public_id not in
+       ( select host_id
+           from dns_host_set_member
+          where catalog_id = $1
+       );
+`
+)
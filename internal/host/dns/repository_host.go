@@ -0,0 +1,60 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// LookupHost will look up a host in the repository. If the host is not
+// found, it will return nil, nil. All options are ignored.
+func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Option) (*Host, error) {
+	if publicId == "" {
+		return nil, fmt.Errorf("lookup: dns host: missing public id %w", db.ErrInvalidParameter)
+	}
+	h := allocHost()
+	h.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, h); err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lookup: dns host: failed %w for %s", err, publicId)
+	}
+	return h, nil
+}
+
+// ListHosts returns a slice of Hosts for the catalogId ordered by create
+// time. WithLimit and WithStartPageAfter are the only options supported.
+func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("list: dns host: missing catalog id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var hosts []*Host
+	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: dns host: %w", err)
+	}
+	return hosts, nil
+}
+
+// getHosts returns the hosts which are members of setId.
+func getHosts(ctx context.Context, reader db.Reader, setId string, limit int) ([]*Host, error) {
+	const where = `public_id in
+       ( select host_id
+           from dns_host_set_member
+          where set_id = ?
+       )`
+	var hosts []*Host
+	if err := reader.SearchWhere(ctx, &hosts, where, []interface{}{setId}, db.WithLimit(limit)); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	return hosts, nil
+}
@@ -0,0 +1,420 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// MaxRecordNameLength is the maximum length of a record name.
+const MaxRecordNameLength = 253
+
+// CreateSet inserts s into the repository and returns a new HostSet
+// containing the host set's PublicId. s is not changed. s must contain a
+// valid CatalogId. s must not contain a PublicId. The PublicId is
+// generated and assigned by this method. WithPublicId is the only valid
+// option.
+//
+// s.Name, s.Description, and s.RecordNames are optional. If s.Name is set,
+// it must be unique within s.CatalogId. The record names must not be
+// repeated.
+func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet, opt ...Option) (*HostSet, error) {
+	if s == nil {
+		return nil, fmt.Errorf("create: dns host set: %w", db.ErrInvalidParameter)
+	}
+	if s.HostSet == nil {
+		return nil, fmt.Errorf("create: dns host set: embedded HostSet: %w", db.ErrInvalidParameter)
+	}
+	if s.CatalogId == "" {
+		return nil, fmt.Errorf("create: dns host set: no catalog id: %w", db.ErrInvalidParameter)
+	}
+	if s.PublicId != "" {
+		return nil, fmt.Errorf("create: dns host set: public id not empty: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("create: dns host set: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	s = s.clone()
+	var err error
+	if s.RecordNames, err = cleanRecordNames(s.RecordNames); err != nil {
+		return nil, fmt.Errorf("create: dns host set: %w", err)
+	}
+
+	opts := getOpts(opt...)
+
+	if opts.withPublicId != "" {
+		if !strings.HasPrefix(opts.withPublicId, HostSetPrefix+"_") {
+			return nil, fmt.Errorf("create: dns host set: passed-in public ID %q has wrong prefix, should be %q: %w", opts.withPublicId, HostSetPrefix, db.ErrInvalidPublicId)
+		}
+		s.PublicId = opts.withPublicId
+	} else {
+		id, err := newHostSetId()
+		if err != nil {
+			return nil, fmt.Errorf("create: dns host set: %w", err)
+		}
+		s.PublicId = id
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, fmt.Errorf("create: dns host set: unable to get oplog wrapper: %w", err)
+	}
+
+	var newHostSet *HostSet
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			ticket, err := w.GetTicket(s)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
+			newHostSet = s.clone()
+			var setOplogMsg oplog.Message
+			if err := w.Create(ctx, newHostSet, db.NewOplogMsg(&setOplogMsg)); err != nil {
+				return err
+			}
+			msgs := []*oplog.Message{&setOplogMsg}
+			nameMsgs, err := createRecordNames(ctx, w, s.PublicId, s.RecordNames)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, nameMsgs...)
+			return w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, s.oplog(oplog.OpType_OP_TYPE_CREATE), msgs)
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, fmt.Errorf("create: dns host set: in catalog: %s: name %s already exists: %w",
+				s.CatalogId, s.Name, db.ErrNotUnique)
+		}
+		return nil, fmt.Errorf("create: dns host set: in catalog: %s: %w", s.CatalogId, err)
+	}
+	newHostSet.RecordNames = s.RecordNames
+	return newHostSet, nil
+}
+
+// UpdateSet updates the repository entry for s.PublicId with the values in
+// s for the fields listed in fieldMaskPaths. It returns a new HostSet
+// containing the updated values and a count of the number of records
+// updated. s is not changed.
+//
+// s must contain a valid PublicId. Only s.Name, s.Description, and
+// s.RecordNames can be updated. If s.Name is set to a non-empty string, it
+// must be unique within s.CatalogId. The record names of the set are
+// replaced with s.RecordNames when "RecordNames" is in fieldMaskPaths.
+//
+// An attribute of s will be set to NULL in the database if the attribute
+// in s is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet, version uint32, fieldMaskPaths []string, opt ...Option) (*HostSet, int, error) {
+	if s == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %w", db.ErrInvalidParameter)
+	}
+	if s.HostSet == nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: embedded HostSet: %w", db.ErrInvalidParameter)
+	}
+	if s.PublicId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: no version supplied: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	s = s.clone()
+
+	var setRecordNames bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold("Name", f):
+		case strings.EqualFold("Description", f):
+		case strings.EqualFold("RecordNames", f):
+			setRecordNames = true
+		default:
+			return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: field: %s: %w", f, db.ErrInvalidFieldMask)
+		}
+	}
+	dbMask, nullFields := dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			"Name":        s.Name,
+			"Description": s.Description,
+		},
+		fieldMaskPaths,
+		nil,
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 && !setRecordNames {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %w", db.ErrEmptyFieldMask)
+	}
+	if setRecordNames {
+		var err error
+		if s.RecordNames, err = cleanRecordNames(s.RecordNames); err != nil {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %w", err)
+		}
+		if len(dbMask) == 0 && len(nullFields) == 0 {
+			// Only the record names are changing, the version of the set
+			// is still incremented.
+			s.Version = version + 1
+			dbMask = []string{"Version"}
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsUpdated int
+	var returnedHostSet *HostSet
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			ticket, err := w.GetTicket(s)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
+			returnedHostSet = s.clone()
+			var setOplogMsg oplog.Message
+			rowsUpdated, err = w.Update(ctx, returnedHostSet, dbMask, nullFields,
+				db.NewOplogMsg(&setOplogMsg),
+				db.WithVersion(&version))
+			if err != nil {
+				return err
+			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+			if rowsUpdated > 1 {
+				return db.ErrMultipleRecords
+			}
+			msgs := []*oplog.Message{&setOplogMsg}
+			if setRecordNames {
+				current, err := fetchRecordNames(ctx, reader, s.PublicId)
+				if err != nil {
+					return err
+				}
+				if len(current) > 0 {
+					items := make([]interface{}, 0, len(current))
+					for _, n := range current {
+						items = append(items, n)
+					}
+					deleteMsgs := make([]*oplog.Message, 0, len(current))
+					if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&deleteMsgs)); err != nil {
+						return err
+					}
+					msgs = append(msgs, deleteMsgs...)
+				}
+				nameMsgs, err := createRecordNames(ctx, w, s.PublicId, s.RecordNames)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, nameMsgs...)
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, s.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return err
+			}
+			return setRecordNamesOf(ctx, reader, returnedHostSet)
+		},
+	)
+
+	if err != nil {
+		if db.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %s: name %s already exists: %w",
+				s.PublicId, s.Name, db.ErrNotUnique)
+		}
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %s: %w", s.PublicId, err)
+	}
+
+	return returnedHostSet, rowsUpdated, nil
+}
+
+// LookupSet will look up a host set in the repository and return the host
+// set, with its record names, and the hosts its record names resolved to
+// the last time they were resolved. If the host set is not found, it will
+// return nil, nil, nil. The WithLimit option can be used to limit the
+// number of hosts returned. All other options are ignored.
+func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Option) (*HostSet, []*Host, error) {
+	if publicId == "" {
+		return nil, nil, fmt.Errorf("lookup: dns host set: missing public id %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	s := allocHostSet()
+	s.PublicId = publicId
+
+	var hosts []*Host
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, _ db.Writer) error {
+		if err := reader.LookupByPublicId(ctx, s); err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				s = nil
+				return nil
+			}
+			return err
+		}
+		if err := setRecordNamesOf(ctx, reader, s); err != nil {
+			return err
+		}
+		var err error
+		hosts, err = getHosts(ctx, reader, s.PublicId, limit)
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("lookup: dns host set: failed %w for %s", err, publicId)
+	}
+
+	return s, hosts, nil
+}
+
+// ListSets returns a slice of HostSets, with their record names, for the
+// catalogId ordered by create time. WithLimit and WithStartPageAfter are
+// the only options supported.
+func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("list: dns host set: missing catalog id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var sets []*HostSet
+	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
+	if err != nil {
+		return nil, fmt.Errorf("list: dns host set: %w", err)
+	}
+	if err := setRecordNamesOf(ctx, r.reader, sets...); err != nil {
+		return nil, fmt.Errorf("list: dns host set: %w", err)
+	}
+	return sets, nil
+}
+
+// DeleteSet deletes the host set for the provided id from the repository
+// returning a count of the number of records deleted. All options are
+// ignored.
+func (r *Repository) DeleteSet(ctx context.Context, scopeId string, publicId string, opt ...Option) (int, error) {
+	if publicId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: dns host set: missing public id: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("delete: dns host set: no scopeId: %w", db.ErrInvalidParameter)
+	}
+	s := allocHostSet()
+	s.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: dns host set: unable to get oplog wrapper: %w", err)
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			ds := s.clone()
+			rowsDeleted, err = w.Delete(ctx, ds, db.WithOplog(oplogWrapper, s.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return db.ErrMultipleRecords
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("delete: dns host set: %s: %w", publicId, err)
+	}
+
+	return rowsDeleted, nil
+}
+
+// cleanRecordNames returns names with the spaces around each one trimmed.
+// It returns an error if a name is empty or too long, or if a name is
+// repeated.
+func cleanRecordNames(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	cleaned := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		switch {
+		case n == "":
+			return nil, fmt.Errorf("empty record name: %w", db.ErrInvalidParameter)
+		case len(n) > MaxRecordNameLength:
+			return nil, fmt.Errorf("record name %q is longer than %d characters: %w", n, MaxRecordNameLength, db.ErrInvalidParameter)
+		case seen[strings.ToLower(n)]:
+			return nil, fmt.Errorf("record name %q is repeated: %w", n, db.ErrInvalidParameter)
+		}
+		seen[strings.ToLower(n)] = true
+		cleaned = append(cleaned, n)
+	}
+	return cleaned, nil
+}
+
+// createRecordNames inserts the record names of setId using w and returns
+// the oplog messages for them.
+func createRecordNames(ctx context.Context, w db.Writer, setId string, names []string) ([]*oplog.Message, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	items := make([]interface{}, 0, len(names))
+	for _, n := range names {
+		rn, err := NewHostSetRecordName(setId, n)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, rn)
+	}
+	msgs := make([]*oplog.Message, 0, len(names))
+	if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&msgs)); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// fetchRecordNames returns the record names of the provided host sets
+// ordered by host set and name.
+func fetchRecordNames(ctx context.Context, r db.Reader, setIds ...string) ([]*HostSetRecordName, error) {
+	var names []*HostSetRecordName
+	if len(setIds) == 0 {
+		return names, nil
+	}
+	if err := r.SearchWhere(ctx, &names, "set_id in (?)", []interface{}{setIds}, db.WithOrder("set_id, record_name asc"), db.WithLimit(-1)); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// setRecordNamesOf sets the RecordNames of each of the provided host sets
+// from the repository.
+func setRecordNamesOf(ctx context.Context, r db.Reader, sets ...*HostSet) error {
+	if len(sets) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(sets))
+	for _, s := range sets {
+		ids = append(ids, s.PublicId)
+	}
+	names, err := fetchRecordNames(ctx, r, ids...)
+	if err != nil {
+		return err
+	}
+	bySet := make(map[string][]string, len(sets))
+	for _, n := range names {
+		bySet[n.SetId] = append(bySet[n.SetId], n.RecordName)
+	}
+	for _, s := range sets {
+		s.RecordNames = bySet[s.PublicId]
+	}
+	return nil
+}
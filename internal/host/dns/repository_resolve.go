@@ -0,0 +1,137 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// ResolveSet resolves the record names of the host set setId and returns
+// the hosts they resolved to. The result of resolving a record name is
+// cached by the repository's Resolver so the record names may not be
+// resolved again if they were recently.
+//
+// The hosts of the set's catalog and the members of the set are updated to
+// match the result: a host is created for each new address, the set's
+// members are replaced, and the hosts of the catalog which are no longer a
+// member of any of its sets are deleted. A host keeps its id for as long as
+// its address is found for one of the catalog's sets.
+func (r *Repository) ResolveSet(ctx context.Context, setId string, opt ...Option) ([]*Host, error) {
+	if setId == "" {
+		return nil, fmt.Errorf("resolve: dns host set: missing public id: %w", db.ErrInvalidParameter)
+	}
+	s, _, err := r.LookupSet(ctx, setId)
+	if err != nil {
+		return nil, fmt.Errorf("resolve: dns host set: %w", err)
+	}
+	if s == nil {
+		return nil, fmt.Errorf("resolve: dns host set: %s: %w", setId, db.ErrRecordNotFound)
+	}
+	c, err := r.LookupCatalog(ctx, s.CatalogId)
+	if err != nil {
+		return nil, fmt.Errorf("resolve: dns host set: %w", err)
+	}
+	if c == nil {
+		return nil, fmt.Errorf("resolve: dns host set: catalog %s: %w", s.CatalogId, db.ErrRecordNotFound)
+	}
+	addresses, err := r.resolver.Resolve(ctx, c.ResolverAddress, s.RecordNames)
+	if err != nil {
+		return nil, fmt.Errorf("resolve: dns host set: %s: %w", setId, err)
+	}
+
+	var hosts []*Host
+	for i := 0; i < db.StdRetryCnt; i++ {
+		// A host created for the same address by a concurrent resolution
+		// of another set in the catalog fails the sync, which is retried
+		// to pick up the host.
+		hosts, err = r.syncSet(ctx, s, addresses)
+		if err == nil || !db.IsUniqueError(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolve: dns host set: %s: %w", setId, err)
+	}
+	return hosts, nil
+}
+
+// syncSet updates the hosts of the catalog of s and the members of s to
+// match addresses and returns the members.
+func (r *Repository) syncSet(ctx context.Context, s *HostSet, addresses []Address) ([]*Host, error) {
+	var hosts []*Host
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			hosts = nil
+			var existing []*Host
+			if err := reader.SearchWhere(ctx, &existing, "catalog_id = ?", []interface{}{s.CatalogId}, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			byAddress := make(map[Address]*Host, len(existing))
+			for _, h := range existing {
+				byAddress[Address{Address: h.Address, Port: h.Port}] = h
+			}
+			for _, a := range addresses {
+				h, ok := byAddress[a]
+				if !ok {
+					var err error
+					if h, err = newHost(s.CatalogId, a.Address, a.Port); err != nil {
+						return err
+					}
+					if h.PublicId, err = newHostId(); err != nil {
+						return err
+					}
+					if err := w.Create(ctx, h); err != nil {
+						return err
+					}
+					byAddress[a] = h
+				}
+				hosts = append(hosts, h)
+			}
+
+			var current []*HostSetMember
+			if err := reader.SearchWhere(ctx, &current, "set_id = ?", []interface{}{s.PublicId}, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			want := make(map[string]bool, len(hosts))
+			for _, h := range hosts {
+				want[h.PublicId] = true
+			}
+			var deletes []interface{}
+			for _, m := range current {
+				if want[m.HostId] {
+					delete(want, m.HostId)
+					continue
+				}
+				deletes = append(deletes, m)
+			}
+			if len(deletes) > 0 {
+				if _, err := w.DeleteItems(ctx, deletes); err != nil {
+					return err
+				}
+			}
+			var creates []interface{}
+			for _, h := range hosts {
+				if !want[h.PublicId] {
+					continue
+				}
+				m, err := newHostSetMember(s.PublicId, h.PublicId)
+				if err != nil {
+					return err
+				}
+				creates = append(creates, m)
+			}
+			if len(creates) > 0 {
+				if err := w.CreateItems(ctx, creates); err != nil {
+					return err
+				}
+			}
+			_, err := w.Exec(ctx, deleteOrphanedHostsQuery, []interface{}{s.CatalogId})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
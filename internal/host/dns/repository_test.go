@@ -0,0 +1,153 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCatalog(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	var tests = []struct {
+		name            string
+		resolverAddress string
+		want            string
+		wantIsErr       error
+	}{
+		{
+			name: "valid-no-resolver-address",
+		},
+		{
+			name:            "valid-resolver-address",
+			resolverAddress: " 127.0.0.1:53 ",
+			want:            "127.0.0.1:53",
+		},
+		{
+			name:            "invalid-resolver-address-no-port",
+			resolverAddress: "127.0.0.1",
+			wantIsErr:       db.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			in, err := NewHostCatalog(prj.PublicId, WithResolverAddress(tt.resolverAddress))
+			require.NoError(err)
+			got, err := repo.CreateCatalog(context.Background(), in)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got.ResolverAddress)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+		})
+	}
+}
+
+func TestRepository_Sets(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	in, err := NewHostSet(cat.PublicId, WithRecordNames([]string{"a.example.test", "b.example.test"}))
+	require.NoError(err)
+	got, err := repo.CreateSet(ctx, prj.PublicId, in)
+	require.NoError(err)
+	assert.Equal([]string{"a.example.test", "b.example.test"}, got.RecordNames)
+
+	_, err = repo.CreateSet(ctx, prj.PublicId, &HostSet{HostSet: &store.HostSet{
+		CatalogId:   cat.PublicId,
+		RecordNames: []string{"a.example.test", "A.example.test"},
+	}})
+	assert.Truef(errors.Is(err, db.ErrInvalidParameter), "want err: %q got: %q", db.ErrInvalidParameter, err)
+
+	upd := got.clone()
+	upd.RecordNames = []string{"_web._tcp.example.test"}
+	updated, n, err := repo.UpdateSet(ctx, prj.PublicId, upd, got.Version, []string{"RecordNames"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal([]string{"_web._tcp.example.test"}, updated.RecordNames)
+	assert.Equal(got.Version+1, updated.Version)
+
+	found, hosts, err := repo.LookupSet(ctx, got.PublicId)
+	require.NoError(err)
+	assert.Equal(updated.RecordNames, found.RecordNames)
+	assert.Empty(hosts)
+}
+
+func TestRepository_ResolveSet(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	srv := TestDnsServer(t)
+	srv.SetHost("db1.example.test", "10.0.0.1")
+	srv.SetHost("db2.example.test", "10.0.0.2")
+	srv.SetSrv("_postgres._tcp.example.test", &net.SRV{Target: "db1.example.test", Port: 5432})
+
+	cat := TestCatalogs(t, conn, prj.PublicId, 1, WithResolverAddress(srv.Addr))[0]
+	setA := TestSets(t, conn, cat.PublicId, 1, "_postgres._tcp.example.test", "db2.example.test")[0]
+	setB := TestSets(t, conn, cat.PublicId, 1, "db2.example.test")[0]
+
+	repo, err := NewRepository(rw, rw, kms, WithResolver(NewResolver(0)))
+	require.NoError(err)
+
+	hostsA, err := repo.ResolveSet(ctx, setA.PublicId)
+	require.NoError(err)
+	require.Len(hostsA, 2)
+	got := make(map[string]uint32)
+	for _, h := range hostsA {
+		got[h.Address] = h.Port
+	}
+	assert.Equal(map[string]uint32{"10.0.0.1": 5432, "10.0.0.2": 0}, got)
+
+	hostsB, err := repo.ResolveSet(ctx, setB.PublicId)
+	require.NoError(err)
+	require.Len(hostsB, 1)
+	assert.Contains([]string{hostsA[0].PublicId, hostsA[1].PublicId}, hostsB[0].PublicId, "hosts are shared by the sets of a catalog")
+
+	_, members, err := repo.LookupSet(ctx, setA.PublicId)
+	require.NoError(err)
+	assert.Len(members, 2)
+
+	// A host no set resolves to anymore is deleted.
+	srv.SetSrv("_postgres._tcp.example.test")
+	hostsA, err = repo.ResolveSet(ctx, setA.PublicId)
+	require.NoError(err)
+	assert.Len(hostsA, 1)
+	all, err := repo.ListHosts(ctx, cat.PublicId)
+	require.NoError(err)
+	assert.Len(all, 1)
+}
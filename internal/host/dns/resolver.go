@@ -0,0 +1,167 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long a Resolver created by a repository caches the
+// addresses a record name resolved to.
+const DefaultCacheTTL = 30 * time.Second
+
+// An Address is an address a record name resolved to. Port is the port of
+// the SRV record the address was found with and is 0 for addresses found
+// with A and AAAA records.
+type Address struct {
+	Address string
+	Port    uint32
+}
+
+type cacheKey struct {
+	resolverAddress string
+	name            string
+}
+
+type cacheEntry struct {
+	addresses []Address
+	expires   time.Time
+}
+
+// A Resolver resolves record names to addresses and caches the results. It
+// is safe to use a Resolver concurrently.
+type Resolver struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	cache map[cacheKey]cacheEntry
+}
+
+// NewResolver creates a Resolver which caches the addresses a record name
+// resolved to for ttl. A ttl of 0 disables the cache.
+func NewResolver(ttl time.Duration) *Resolver {
+	return &Resolver{
+		ttl:   ttl,
+		cache: make(map[cacheKey]cacheEntry),
+	}
+}
+
+// Resolve returns the addresses names resolve to, without duplicates. Names
+// of the form _service._proto.name are looked up as SRV records and the
+// targets of the records are then looked up as A and AAAA records. All
+// other names are looked up as A and AAAA records. A name which doesn't
+// exist resolves to no addresses.
+//
+// If resolverAddress is empty the system's resolver is used, otherwise the
+// DNS server at resolverAddress, a host and port, is queried.
+func (r *Resolver) Resolve(ctx context.Context, resolverAddress string, names []string) ([]Address, error) {
+	var res *net.Resolver
+	if resolverAddress == "" {
+		res = net.DefaultResolver
+	} else {
+		res = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, resolverAddress)
+			},
+		}
+	}
+
+	var addresses []Address
+	seen := make(map[Address]bool)
+	for _, name := range names {
+		key := cacheKey{resolverAddress: resolverAddress, name: strings.ToLower(name)}
+		found, ok := r.cached(key)
+		if !ok {
+			var err error
+			found, err = lookup(ctx, res, name)
+			if err != nil {
+				return nil, fmt.Errorf("resolve: %s: %w", name, err)
+			}
+			r.store(key, found)
+		}
+		for _, a := range found {
+			if seen[a] {
+				continue
+			}
+			seen[a] = true
+			addresses = append(addresses, a)
+		}
+	}
+	return addresses, nil
+}
+
+func (r *Resolver) cached(key cacheKey) ([]Address, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	e, ok := r.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(r.cache, key)
+		return nil, false
+	}
+	return e.addresses, true
+}
+
+func (r *Resolver) store(key cacheKey, addresses []Address) {
+	if r.ttl <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[key] = cacheEntry{addresses: addresses, expires: time.Now().Add(r.ttl)}
+}
+
+// IsSrvName reports whether name is looked up as an SRV record.
+func IsSrvName(name string) bool {
+	return strings.HasPrefix(name, "_")
+}
+
+func lookup(ctx context.Context, res *net.Resolver, name string) ([]Address, error) {
+	if !IsSrvName(name) {
+		return lookupIP(ctx, res, name, 0)
+	}
+	_, srvs, err := res.LookupSRV(ctx, "", "", name)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var addresses []Address
+	for _, srv := range srvs {
+		found, err := lookupIP(ctx, res, srv.Target, uint32(srv.Port))
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, found...)
+	}
+	return addresses, nil
+}
+
+func lookupIP(ctx context.Context, res *net.Resolver, name string, port uint32) ([]Address, error) {
+	ips, err := res.LookupIPAddr(ctx, name)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	addresses := make([]Address, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, Address{Address: ip.IP.String(), Port: port})
+	}
+	return addresses, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,99 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Resolve(t *testing.T) {
+	ctx := context.Background()
+	srv := TestDnsServer(t)
+	srv.SetHost("db1.example.test", "10.0.0.1", "fd00::1")
+	srv.SetHost("db2.example.test", "10.0.0.2")
+	srv.SetSrv("_postgres._tcp.example.test",
+		&net.SRV{Target: "db1.example.test", Port: 5432},
+		&net.SRV{Target: "db2.example.test", Port: 5433},
+	)
+
+	var tests = []struct {
+		name  string
+		names []string
+		want  []Address
+	}{
+		{
+			name:  "a-record",
+			names: []string{"db2.example.test"},
+			want:  []Address{{Address: "10.0.0.2"}},
+		},
+		{
+			name:  "a-and-aaaa-records",
+			names: []string{"db1.example.test"},
+			want:  []Address{{Address: "10.0.0.1"}, {Address: "fd00::1"}},
+		},
+		{
+			name:  "srv-record",
+			names: []string{"_postgres._tcp.example.test"},
+			want: []Address{
+				{Address: "10.0.0.1", Port: 5432},
+				{Address: "fd00::1", Port: 5432},
+				{Address: "10.0.0.2", Port: 5433},
+			},
+		},
+		{
+			name:  "duplicates",
+			names: []string{"db2.example.test", "DB2.example.test"},
+			want:  []Address{{Address: "10.0.0.2"}},
+		},
+		{
+			name:  "not-found",
+			names: []string{"missing.example.test", "_missing._tcp.example.test"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			r := NewResolver(0)
+			got, err := r.Resolve(ctx, srv.Addr, tt.names)
+			require.NoError(err)
+			assert.ElementsMatch(tt.want, got)
+		})
+	}
+}
+
+func TestResolver_Cache(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	srv := TestDnsServer(t)
+	srv.SetHost("web.example.test", "10.0.0.1")
+	names := []string{"web.example.test"}
+
+	r := NewResolver(DefaultCacheTTL)
+	got, err := r.Resolve(ctx, srv.Addr, names)
+	require.NoError(err)
+	assert.Equal([]Address{{Address: "10.0.0.1"}}, got)
+	queries := srv.Queries()
+	assert.NotZero(queries)
+
+	srv.SetHost("web.example.test", "10.0.0.2")
+	got, err = r.Resolve(ctx, srv.Addr, names)
+	require.NoError(err)
+	assert.Equal([]Address{{Address: "10.0.0.1"}}, got, "cached addresses")
+	assert.Equal(queries, srv.Queries())
+
+	uncached := NewResolver(0)
+	got, err = uncached.Resolve(ctx, srv.Addr, names)
+	require.NoError(err)
+	assert.Equal([]Address{{Address: "10.0.0.2"}}, got)
+	assert.Greater(srv.Queries(), queries)
+}
+
+func TestIsSrvName(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsSrvName("_postgres._tcp.example.test"))
+	assert.False(IsSrvName("db.example.test"))
+}
@@ -0,0 +1,226 @@
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// TestCatalogs creates count number of dns host catalogs to the provided DB
+// with the provided scope id. If any errors are encountered during the
+// creation of the host catalog, the test will fail.
+func TestCatalogs(t *testing.T, conn *gorm.DB, scopeId string, count int, opt ...Option) []*HostCatalog {
+	t.Helper()
+	assert := assert.New(t)
+	var cats []*HostCatalog
+	for i := 0; i < count; i++ {
+		cat, err := NewHostCatalog(scopeId, opt...)
+		assert.NoError(err)
+		assert.NotNil(cat)
+		id, err := newHostCatalogId()
+		assert.NoError(err)
+		assert.NotEmpty(id)
+		cat.PublicId = id
+
+		w := db.New(conn)
+		err2 := w.Create(context.Background(), cat)
+		assert.NoError(err2)
+		cats = append(cats, cat)
+	}
+	return cats
+}
+
+// TestSets creates count number of dns host sets in the provided DB with
+// the provided catalog id, each with the provided record names. The catalog
+// must have been created previously. The test will fail if any errors are
+// encountered.
+func TestSets(t *testing.T, conn *gorm.DB, catalogId string, count int, recordNames ...string) []*HostSet {
+	t.Helper()
+	assert := assert.New(t)
+	var sets []*HostSet
+
+	for i := 0; i < count; i++ {
+		set, err := NewHostSet(catalogId, WithRecordNames(recordNames))
+		assert.NoError(err)
+		assert.NotNil(set)
+		id, err := newHostSetId()
+		assert.NoError(err)
+		assert.NotEmpty(id)
+		set.PublicId = id
+
+		w := db.New(conn)
+		err2 := w.Create(context.Background(), set)
+		assert.NoError(err2)
+		for _, n := range recordNames {
+			rn, err := NewHostSetRecordName(id, n)
+			assert.NoError(err)
+			assert.NoError(w.Create(context.Background(), rn))
+		}
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// A TestServer is a DNS server for tests. It answers A, AAAA, and SRV
+// queries from the records set on it and answers queries for other names
+// with NXDOMAIN.
+type TestServer struct {
+	// Addr is the host and port the server listens on.
+	Addr string
+
+	mu      sync.Mutex
+	ips     map[string][]net.IP
+	srvs    map[string][]*net.SRV
+	queries int
+}
+
+// TestDnsServer starts a TestServer on a local UDP port. The server is
+// stopped when the test completes.
+func TestDnsServer(t *testing.T) *TestServer {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+
+	s := &TestServer{
+		Addr: pc.LocalAddr().String(),
+		ips:  make(map[string][]net.IP),
+		srvs: make(map[string][]*net.SRV),
+	}
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			resp, err := s.answer(buf[:n])
+			if err != nil {
+				continue
+			}
+			_, _ = pc.WriteTo(resp, addr)
+		}
+	}()
+	return s
+}
+
+// SetHost sets the A and AAAA records of name to ips. An empty ips removes
+// the records.
+func (s *TestServer) SetHost(name string, ips ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name = canonicalName(name)
+	delete(s.ips, name)
+	for _, ip := range ips {
+		s.ips[name] = append(s.ips[name], net.ParseIP(ip))
+	}
+}
+
+// SetSrv sets the SRV records of name to srvs. An empty srvs removes the
+// records.
+func (s *TestServer) SetSrv(name string, srvs ...*net.SRV) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name = canonicalName(name)
+	delete(s.srvs, name)
+	if len(srvs) > 0 {
+		s.srvs[name] = srvs
+	}
+}
+
+// Queries returns the number of queries the server has answered.
+func (s *TestServer) Queries() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queries
+}
+
+func canonicalName(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func (s *TestServer) answer(msg []byte) ([]byte, error) {
+	var p dnsmessage.Parser
+	h, err := p.Start(msg)
+	if err != nil {
+		return nil, err
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries++
+
+	name := canonicalName(q.Name.String())
+	ips, hasIps := s.ips[name]
+	srvs, hasSrvs := s.srvs[name]
+	rh := dnsmessage.Header{
+		ID:                 h.ID,
+		Response:           true,
+		Authoritative:      true,
+		RecursionAvailable: true,
+	}
+	if !hasIps && !hasSrvs {
+		rh.RCode = dnsmessage.RCodeNameError
+	}
+	b := dnsmessage.NewBuilder(nil, rh)
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := b.Question(q); err != nil {
+		return nil, err
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+	hdr := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: 60}
+	switch q.Type {
+	case dnsmessage.TypeA:
+		for _, ip := range ips {
+			if ip4 := ip.To4(); ip4 != nil {
+				var a [4]byte
+				copy(a[:], ip4)
+				if err := b.AResource(hdr, dnsmessage.AResource{A: a}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case dnsmessage.TypeAAAA:
+		for _, ip := range ips {
+			if ip.To4() == nil {
+				var a [16]byte
+				copy(a[:], ip.To16())
+				if err := b.AAAAResource(hdr, dnsmessage.AAAAResource{AAAA: a}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case dnsmessage.TypeSRV:
+		for _, srv := range srvs {
+			target, err := dnsmessage.NewName(canonicalName(srv.Target))
+			if err != nil {
+				return nil, err
+			}
+			r := dnsmessage.SRVResource{Priority: srv.Priority, Weight: srv.Weight, Port: srv.Port, Target: target}
+			if err := b.SRVResource(hdr, r); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b.Finish()
+}
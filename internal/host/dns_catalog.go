@@ -0,0 +1,388 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pbcatalogs "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
+	pbsets "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostsets"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	dnsstore "github.com/hashicorp/boundary/internal/host/dns/store"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	dnsCatalogMask handlers.MaskManager
+	dnsSetMask     handlers.MaskManager
+)
+
+func init() {
+	var err error
+	if dnsCatalogMask, err = handlers.NewMaskManager(&dnsstore.HostCatalog{}, &pbcatalogs.HostCatalog{}, &pbcatalogs.DnsHostCatalogAttributes{}); err != nil {
+		panic(err)
+	}
+	if dnsSetMask, err = handlers.NewMaskManager(&dnsstore.HostSet{}, &pbsets.HostSet{}, &pbsets.DnsHostSetAttributes{}); err != nil {
+		panic(err)
+	}
+}
+
+// NewDnsCatalog returns the Catalog of dns host catalogs. The hosts of a
+// dns host set are the addresses its record names resolve to, which are
+// resolved each time the hosts of the set are listed.
+func NewDnsCatalog(repoFn func() (*dns.Repository, error)) Catalog {
+	return &dnsCatalog{repoFn: repoFn}
+}
+
+type dnsCatalog struct {
+	repoFn func() (*dns.Repository, error)
+}
+
+func (c *dnsCatalog) Type() string {
+	return DnsSubtype.String()
+}
+
+func (c *dnsCatalog) Prefixes() Prefixes {
+	return Prefixes{
+		HostCatalog: dns.HostCatalogPrefix,
+		HostSet:     dns.HostSetPrefix,
+		Host:        dns.HostPrefix,
+	}
+}
+
+func (c *dnsCatalog) CreateCatalog(ctx context.Context, hc *HostCatalog) (*HostCatalog, error) {
+	in, err := newDnsHostCatalog(hc)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCatalog(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return dnsHostCatalog(out)
+}
+
+func (c *dnsCatalog) LookupCatalog(ctx context.Context, id string) (*HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hc, err := repo.LookupCatalog(ctx, id)
+	if err != nil || hc == nil {
+		return nil, err
+	}
+	return dnsHostCatalog(hc)
+}
+
+func (c *dnsCatalog) ListCatalogs(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hcs, err := repo.ListCatalogs(ctx, scopeIds, dns.WithStartPageAfter(cursor), dns.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*HostCatalog, 0, len(hcs))
+	for _, hc := range hcs {
+		out, err := dnsHostCatalog(hc)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *dnsCatalog) UpdateCatalog(ctx context.Context, hc *HostCatalog, version uint32, fieldPaths []string) (*HostCatalog, int, error) {
+	dbMask := dnsCatalogMask.Translate(fieldPaths)
+	if len(dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host catalog: %w", db.ErrEmptyFieldMask)
+	}
+	in, err := newDnsHostCatalog(hc)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = hc.PublicId
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	out, rowsUpdated, err := repo.UpdateCatalog(ctx, in, version, dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := dnsHostCatalog(out)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *dnsCatalog) DeleteCatalog(ctx context.Context, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteCatalog(ctx, id)
+}
+
+func (c *dnsCatalog) CreateSet(ctx context.Context, scopeId string, s *Set) (*Set, error) {
+	in, err := newDnsSet(s)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateSet(ctx, scopeId, in)
+	if err != nil {
+		return nil, err
+	}
+	return dnsSet(out, nil)
+}
+
+// LookupSet returns the dns host set id with the hosts its record names
+// resolved to the last time the set was resolved.
+func (c *dnsCatalog) LookupSet(ctx context.Context, id string) (*Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	s, hosts, err := repo.LookupSet(ctx, id)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	return dnsSet(s, hosts)
+}
+
+func (c *dnsCatalog) ListSets(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	sets, err := repo.ListSets(ctx, catalogId, dns.WithStartPageAfter(cursor), dns.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Set, 0, len(sets))
+	for _, s := range sets {
+		out, err := dnsSet(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *dnsCatalog) UpdateSet(ctx context.Context, scopeId string, s *Set, version uint32, fieldPaths []string) (*Set, int, error) {
+	dbMask := dnsSetMask.Translate(fieldPaths)
+	if len(dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: dns host set: %w", db.ErrEmptyFieldMask)
+	}
+	in, err := newDnsSet(s)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = s.PublicId
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	out, rowsUpdated, err := repo.UpdateSet(ctx, scopeId, in, version, dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := dnsSet(out, nil)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *dnsCatalog) DeleteSet(ctx context.Context, scopeId, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteSet(ctx, scopeId, id)
+}
+
+func (c *dnsCatalog) ListSetHosts(ctx context.Context, setId string) ([]*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := repo.ResolveSet(ctx, setId)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Host, 0, len(hosts))
+	for _, h := range hosts {
+		ret = append(ret, dnsHost(h))
+	}
+	return ret, nil
+}
+
+func (c *dnsCatalog) LookupHost(ctx context.Context, hostId string) (*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	h, err := repo.LookupHost(ctx, hostId)
+	if err != nil || h == nil {
+		return nil, err
+	}
+	return dnsHost(h), nil
+}
+
+func (c *dnsCatalog) ListHosts(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := repo.ListHosts(ctx, catalogId, dns.WithStartPageAfter(cursor), dns.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Host, 0, len(hosts))
+	for _, h := range hosts {
+		ret = append(ret, dnsHost(h))
+	}
+	return ret, nil
+}
+
+// ValidateAttributes checks the resolver address of a dns host catalog and
+// the record names of a dns host set. The attributes of dns hosts are set
+// by resolving record names so they are not checked.
+func (c *dnsCatalog) ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string {
+	badFields := map[string]string{}
+	switch r {
+	case resource.HostCatalog:
+		ca := &pbcatalogs.DnsHostCatalogAttributes{}
+		if err := handlers.StructToProto(attrs, ca); err != nil {
+			badFields["attributes"] = "Attribute fields do not match the expected format."
+			break
+		}
+		if ca.GetResolverAddress() == nil {
+			break
+		}
+		addr := strings.TrimSpace(ca.GetResolverAddress().GetValue())
+		if h, p, err := net.SplitHostPort(addr); err != nil || h == "" || p == "" {
+			badFields["attributes.resolver_address"] = "This field must be a host and port, such as \"10.0.0.53:53\"."
+		}
+	case resource.HostSet:
+		sa := &pbsets.DnsHostSetAttributes{}
+		if err := handlers.StructToProto(attrs, sa); err != nil {
+			badFields["attributes"] = "Attribute fields do not match the expected format."
+			break
+		}
+		for _, n := range sa.GetRecordNames() {
+			n = strings.TrimSpace(n)
+			if n == "" || len(n) > dns.MaxRecordNameLength {
+				badFields["attributes.record_names"] = fmt.Sprintf("Record names must not be empty or longer than %d characters.", dns.MaxRecordNameLength)
+				break
+			}
+		}
+	}
+	return badFields
+}
+
+// newDnsHostCatalog converts hc into a dns host catalog.
+func newDnsHostCatalog(hc *HostCatalog) (*dns.HostCatalog, error) {
+	ca := &pbcatalogs.DnsHostCatalogAttributes{}
+	if err := handlers.StructToProto(hc.Attributes, ca); err != nil {
+		return nil, fmt.Errorf("dns host catalog attributes: %v: %w", err, db.ErrInvalidParameter)
+	}
+	opts := []dns.Option{dns.WithName(hc.Name), dns.WithDescription(hc.Description)}
+	if ca.GetResolverAddress() != nil {
+		opts = append(opts, dns.WithResolverAddress(ca.GetResolverAddress().GetValue()))
+	}
+	return dns.NewHostCatalog(hc.ScopeId, opts...)
+}
+
+// dnsHostCatalog converts a dns host catalog into a HostCatalog.
+func dnsHostCatalog(hc *dns.HostCatalog) (*HostCatalog, error) {
+	attrs := &pbcatalogs.DnsHostCatalogAttributes{}
+	if hc.GetResolverAddress() != "" {
+		attrs.ResolverAddress = wrapperspb.String(hc.GetResolverAddress())
+	}
+	st, err := handlers.ProtoToStruct(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("dns host catalog attributes: %w", err)
+	}
+	return &HostCatalog{
+		PublicId:    hc.GetPublicId(),
+		ScopeId:     hc.GetScopeId(),
+		Name:        hc.GetName(),
+		Description: hc.GetDescription(),
+		Attributes:  st,
+		CreateTime:  hc.GetCreateTime().GetTimestamp(),
+		UpdateTime:  hc.GetUpdateTime().GetTimestamp(),
+		Version:     hc.GetVersion(),
+	}, nil
+}
+
+// newDnsSet converts s into a dns host set.
+func newDnsSet(s *Set) (*dns.HostSet, error) {
+	sa := &pbsets.DnsHostSetAttributes{}
+	if err := handlers.StructToProto(s.Attributes, sa); err != nil {
+		return nil, fmt.Errorf("dns host set attributes: %v: %w", err, db.ErrInvalidParameter)
+	}
+	return dns.NewHostSet(s.CatalogId,
+		dns.WithName(s.Name),
+		dns.WithDescription(s.Description),
+		dns.WithRecordNames(sa.GetRecordNames()))
+}
+
+// dnsSet converts a dns host set, and its hosts, into a Set.
+func dnsSet(s *dns.HostSet, hosts []*dns.Host) (*Set, error) {
+	st, err := handlers.ProtoToStruct(&pbsets.DnsHostSetAttributes{RecordNames: s.GetRecordNames()})
+	if err != nil {
+		return nil, fmt.Errorf("dns host set attributes: %w", err)
+	}
+	out := &Set{
+		PublicId:    s.GetPublicId(),
+		CatalogId:   s.GetCatalogId(),
+		Name:        s.GetName(),
+		Description: s.GetDescription(),
+		Attributes:  st,
+		CreateTime:  s.GetCreateTime().GetTimestamp(),
+		UpdateTime:  s.GetUpdateTime().GetTimestamp(),
+		Version:     s.GetVersion(),
+	}
+	for _, h := range hosts {
+		out.HostIds = append(out.HostIds, h.GetPublicId())
+	}
+	return out, nil
+}
+
+// dnsHost converts a dns host into a Host.
+func dnsHost(h *dns.Host) *Host {
+	attrs := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"address": structpb.NewStringValue(h.GetAddress()),
+	}}
+	if h.GetPort() != 0 {
+		attrs.Fields["port"] = structpb.NewNumberValue(float64(h.GetPort()))
+	}
+	return &Host{
+		PublicId:   h.GetPublicId(),
+		CatalogId:  h.GetCatalogId(),
+		Address:    h.GetAddress(),
+		Port:       h.GetPort(),
+		Attributes: attrs,
+		CreateTime: h.GetCreateTime().GetTimestamp(),
+		UpdateTime: h.GetUpdateTime().GetTimestamp(),
+	}
+}
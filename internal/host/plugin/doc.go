@@ -0,0 +1,28 @@
+// Package plugin provides a host, a host catalog, and a host set for host
+// catalogs served by plugins, and adapts a plugin to a host.Catalog.
+//
+// A plugin runs in its own process and serves a Plugin over gRPC with
+// NewServer. The controller talks to it through the host.Catalog returned
+// by NewCatalog, which it registers in its host.Registry like the built in
+// catalogs. The host catalogs and host sets of a plugin are created,
+// updated, and deleted by users like those of the built in catalogs and are
+// stored by the controller along with their attributes, which the plugin
+// validates.
+//
+// The hosts in a host set are not managed by users, the plugin finds them
+// each time the hosts in the set are needed, for example when a session is
+// authorized. Every host the plugin returns is stored as a read-only host in
+// the set's host catalog, keyed by the id the plugin gave it, so it has a
+// stable id which sessions can refer to. A host which is no longer returned
+// for any of the catalog's host sets is deleted.
+//
+// Repository
+//
+// A repository provides methods for creating, updating, retrieving, and
+// deleting the host catalogs and host sets of plugins, retrieving hosts, and
+// storing the hosts the plugin found for a host set. A new repository
+// should be created for each transaction. For example:
+//
+//  repo, _ := plugin.NewRepository(db, db, kms)
+//  hosts, _ := repo.SyncSet(ctx, setId, found)
+package plugin
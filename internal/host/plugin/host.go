@@ -0,0 +1,65 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/plugin/store"
+)
+
+// A Host is a host the plugin found for a host set in the host's catalog.
+// ExternalId is the id the plugin gave the host, which is unique within the
+// catalog. Hosts are created, updated, and deleted when the hosts of host
+// sets are listed and can't be changed by users.
+type Host struct {
+	*store.Host
+	tableName string `gorm:"-"`
+}
+
+// NewHost creates a new in memory Host for the host externalId at address
+// in catalogId. Name and description are the only valid options. All other
+// options are ignored.
+func NewHost(catalogId, externalId, address string, port uint32, opt ...Option) (*Host, error) {
+	if catalogId == "" {
+		return nil, fmt.Errorf("new: plugin host: no catalog id: %w", db.ErrInvalidParameter)
+	}
+	if externalId == "" {
+		return nil, fmt.Errorf("new: plugin host: no external id: %w", db.ErrInvalidParameter)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("new: plugin host: no address: %w", db.ErrInvalidParameter)
+	}
+
+	opts := getOpts(opt...)
+	h := &Host{
+		Host: &store.Host{
+			CatalogId:   catalogId,
+			ExternalId:  externalId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Address:     address,
+			Port:        port,
+		},
+	}
+	return h, nil
+}
+
+// TableName returns the table name for the host.
+func (h *Host) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "plugin_host"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (h *Host) SetTableName(n string) {
+	h.tableName = n
+}
+
+func allocHost() *Host {
+	return &Host{
+		Host: &store.Host{},
+	}
+}
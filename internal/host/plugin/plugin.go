@@ -0,0 +1,629 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/plugins/host"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// validateTimeout bounds how long the plugin is given to check attributes
+// since host.Catalog.ValidateAttributes takes no context.
+const validateTimeout = 10 * time.Second
+
+// NewCatalog returns a host.Catalog for the host catalogs of the plugin
+// served on conn. The catalogs and sets are stored in the repositories
+// returned by repoFn; the plugin is called to check their attributes and to
+// find the hosts of a set. The type and prefixes of the plugin are fetched
+// once, when the catalog is created.
+func NewCatalog(ctx context.Context, conn grpc.ClientConnInterface, repoFn func() (*Repository, error)) (host.Catalog, error) {
+	if conn == nil {
+		return nil, fmt.Errorf("new: host catalog plugin: missing connection: %w", db.ErrInvalidParameter)
+	}
+	if repoFn == nil {
+		return nil, fmt.Errorf("new: host catalog plugin: missing repository: %w", db.ErrInvalidParameter)
+	}
+	client := pb.NewHostCatalogPluginServiceClient(conn)
+	info, err := client.GetInfo(ctx, &pb.GetInfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("new: host catalog plugin: get info: %w", err)
+	}
+	if info.GetType() == "" {
+		return nil, fmt.Errorf("new: host catalog plugin: plugin has no type: %w", db.ErrInvalidParameter)
+	}
+	prefixes := host.Prefixes{
+		HostCatalog: info.GetHostCatalogPrefix(),
+		HostSet:     info.GetHostSetPrefix(),
+		Host:        info.GetHostPrefix(),
+	}
+	if prefixes.HostCatalog == "" || prefixes.HostSet == "" || prefixes.Host == "" {
+		return nil, fmt.Errorf("new: host catalog plugin: %q is missing a public id prefix: %w", info.GetType(), db.ErrInvalidParameter)
+	}
+	return &catalog{
+		client:   client,
+		repoFn:   repoFn,
+		typ:      info.GetType(),
+		prefixes: prefixes,
+	}, nil
+}
+
+type catalog struct {
+	client   pb.HostCatalogPluginServiceClient
+	repoFn   func() (*Repository, error)
+	typ      string
+	prefixes host.Prefixes
+}
+
+func (c *catalog) Type() string {
+	return c.typ
+}
+
+func (c *catalog) Prefixes() host.Prefixes {
+	return c.prefixes
+}
+
+func (c *catalog) CreateCatalog(ctx context.Context, hc *host.HostCatalog) (*host.HostCatalog, error) {
+	attrs, err := marshalAttributes(hc.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("create: plugin host catalog: %w", err)
+	}
+	in, err := NewHostCatalog(hc.ScopeId, c.typ, WithName(hc.Name), WithDescription(hc.Description), WithAttributes(attrs))
+	if err != nil {
+		return nil, err
+	}
+	if in.PublicId, err = db.NewPublicId(c.prefixes.HostCatalog); err != nil {
+		return nil, fmt.Errorf("create: plugin host catalog: %w", err)
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCatalog(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return toHostCatalog(out)
+}
+
+func (c *catalog) LookupCatalog(ctx context.Context, id string) (*host.HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hc, err := repo.LookupCatalog(ctx, id)
+	if err != nil || hc == nil || hc.PluginType != c.typ {
+		return nil, err
+	}
+	return toHostCatalog(hc)
+}
+
+func (c *catalog) ListCatalogs(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*host.HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hcs, err := repo.ListCatalogs(ctx, c.typ, scopeIds, WithStartPageAfter(cursor), WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*host.HostCatalog, 0, len(hcs))
+	for _, hc := range hcs {
+		out, err := toHostCatalog(hc)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *catalog) UpdateCatalog(ctx context.Context, hc *host.HostCatalog, version uint32, fieldPaths []string) (*host.HostCatalog, int, error) {
+	m := translateMask(fieldPaths)
+	if len(m.dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: plugin host catalog: %w", db.ErrEmptyFieldMask)
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	var current []byte
+	if m.mergeAttributes() {
+		cur, err := repo.LookupCatalog(ctx, hc.PublicId)
+		if err != nil || cur == nil {
+			return nil, db.NoRowsAffected, err
+		}
+		current = cur.Attributes
+	}
+	attrs, err := m.attributes(current, hc.Attributes)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: plugin host catalog: %w", err)
+	}
+	in, err := NewHostCatalog(hc.ScopeId, c.typ, WithName(hc.Name), WithDescription(hc.Description), WithAttributes(attrs))
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = hc.PublicId
+	out, rowsUpdated, err := repo.UpdateCatalog(ctx, in, version, m.dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := toHostCatalog(out)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *catalog) DeleteCatalog(ctx context.Context, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteCatalog(ctx, id)
+}
+
+func (c *catalog) CreateSet(ctx context.Context, scopeId string, s *host.Set) (*host.Set, error) {
+	attrs, err := marshalAttributes(s.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("create: plugin host set: %w", err)
+	}
+	in, err := NewHostSet(s.CatalogId, WithName(s.Name), WithDescription(s.Description), WithAttributes(attrs))
+	if err != nil {
+		return nil, err
+	}
+	if in.PublicId, err = db.NewPublicId(c.prefixes.HostSet); err != nil {
+		return nil, fmt.Errorf("create: plugin host set: %w", err)
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateSet(ctx, scopeId, in)
+	if err != nil {
+		return nil, err
+	}
+	return toSet(out, nil)
+}
+
+// LookupSet returns the host set id with the hosts the plugin found for it
+// the last time the hosts of the set were listed.
+func (c *catalog) LookupSet(ctx context.Context, id string) (*host.Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	s, hosts, err := repo.LookupSet(ctx, id)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	return toSet(s, hosts)
+}
+
+func (c *catalog) ListSets(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*host.Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	sets, err := repo.ListSets(ctx, catalogId, WithStartPageAfter(cursor), WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*host.Set, 0, len(sets))
+	for _, s := range sets {
+		out, err := toSet(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *catalog) UpdateSet(ctx context.Context, scopeId string, s *host.Set, version uint32, fieldPaths []string) (*host.Set, int, error) {
+	m := translateMask(fieldPaths)
+	if len(m.dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: plugin host set: %w", db.ErrEmptyFieldMask)
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	var current []byte
+	if m.mergeAttributes() {
+		cur, _, err := repo.LookupSet(ctx, s.PublicId, WithLimit(1))
+		if err != nil || cur == nil {
+			return nil, db.NoRowsAffected, err
+		}
+		current = cur.Attributes
+	}
+	attrs, err := m.attributes(current, s.Attributes)
+	if err != nil {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: plugin host set: %w", err)
+	}
+	in, err := NewHostSet(s.CatalogId, WithName(s.Name), WithDescription(s.Description), WithAttributes(attrs))
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = s.PublicId
+	out, rowsUpdated, err := repo.UpdateSet(ctx, scopeId, in, version, m.dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := toSet(out, nil)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *catalog) DeleteSet(ctx context.Context, scopeId, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteSet(ctx, scopeId, id)
+}
+
+// ListSetHosts asks the plugin for the hosts of setId and stores them as
+// the members of the set.
+func (c *catalog) ListSetHosts(ctx context.Context, setId string) ([]*host.Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	s, _, err := repo.LookupSet(ctx, setId, WithLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %s: %w", c.typ, setId, db.ErrRecordNotFound)
+	}
+	hosts, err := c.syncSet(ctx, repo, s)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*host.Host, 0, len(hosts))
+	for _, h := range hosts {
+		ret = append(ret, toHost(h))
+	}
+	return ret, nil
+}
+
+// syncSet asks the plugin for the hosts of s, stores them as the members of
+// s, and returns the members.
+func (c *catalog) syncSet(ctx context.Context, repo *Repository, s *HostSet) ([]*Host, error) {
+	hc, err := repo.LookupCatalog(ctx, s.CatalogId)
+	if err != nil {
+		return nil, err
+	}
+	if hc == nil {
+		return nil, fmt.Errorf("list set hosts: host catalog plugin %q: catalog %s: %w", c.typ, s.CatalogId, db.ErrRecordNotFound)
+	}
+	catalogAttrs, err := unmarshalAttributes(hc.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %w", c.typ, err)
+	}
+	setAttrs, err := unmarshalAttributes(s.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %w", c.typ, err)
+	}
+	resp, err := c.client.ListSetHosts(ctx, &pb.ListSetHostsRequest{
+		Catalog: &pb.HostCatalog{Id: hc.PublicId, ScopeId: hc.ScopeId, Attributes: catalogAttrs},
+		Set:     &pb.HostSet{Id: s.PublicId, HostCatalogId: s.CatalogId, Attributes: setAttrs},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %w", c.typ, err)
+	}
+	found := make([]*Host, 0, len(resp.GetHosts()))
+	for _, ph := range resp.GetHosts() {
+		h, err := NewHost(s.CatalogId, ph.GetExternalId(), ph.GetAddress(), ph.GetPort(), WithName(ph.GetName()), WithDescription(ph.GetDescription()))
+		if err != nil {
+			return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %w", c.typ, err)
+		}
+		// The id is only used if the catalog has no host with the
+		// external id yet.
+		if h.PublicId, err = db.NewPublicId(c.prefixes.Host); err != nil {
+			return nil, fmt.Errorf("list set hosts: host catalog plugin %q: %w", c.typ, err)
+		}
+		found = append(found, h)
+	}
+	return repo.SyncSet(ctx, s.PublicId, found)
+}
+
+func (c *catalog) LookupHost(ctx context.Context, hostId string) (*host.Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	h, err := repo.LookupHost(ctx, hostId)
+	if err != nil || h == nil {
+		return nil, err
+	}
+	return toHost(h), nil
+}
+
+func (c *catalog) ListHosts(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*host.Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := repo.ListHosts(ctx, catalogId, WithStartPageAfter(cursor), WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*host.Host, 0, len(hosts))
+	for _, h := range hosts {
+		ret = append(ret, toHost(h))
+	}
+	return ret, nil
+}
+
+func (c *catalog) ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string {
+	ctx, cancel := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancel()
+	resp, err := c.client.ValidateAttributes(ctx, &pb.ValidateAttributesRequest{
+		ResourceType: r.String(),
+		Attributes:   attrs,
+	})
+	if err != nil {
+		return map[string]string{"attributes": fmt.Sprintf("The attributes could not be checked by the %q host catalog plugin: %v.", c.typ, err)}
+	}
+	badFields := map[string]string{}
+	for k, v := range resp.GetBadFields() {
+		badFields[k] = v
+	}
+	return badFields
+}
+
+// mask is an update mask of a host catalog or host set translated to the
+// fields of the storage types.
+type mask struct {
+	dbMask []string
+	// attrKeys are the keys of the "attributes.<key>" paths, which update
+	// a single attribute. A bare "attributes" path replaces all of them.
+	attrKeys []string
+	allAttrs bool
+}
+
+// translateMask translates fieldPaths, the paths of the API resource, into
+// a mask. Paths which can't be updated are dropped.
+func translateMask(fieldPaths []string) mask {
+	var m mask
+	for _, p := range fieldPaths {
+		switch lp := strings.ToLower(p); {
+		case lp == "name":
+			m.dbMask = append(m.dbMask, "Name")
+		case lp == "description":
+			m.dbMask = append(m.dbMask, "Description")
+		case lp == "attributes":
+			m.allAttrs = true
+		case strings.HasPrefix(lp, "attributes.") && len(p) > len("attributes."):
+			m.attrKeys = append(m.attrKeys, p[len("attributes."):])
+		}
+	}
+	if m.allAttrs || len(m.attrKeys) > 0 {
+		m.dbMask = append(m.dbMask, "Attributes")
+	}
+	return m
+}
+
+// mergeAttributes reports whether the attributes in the update are merged
+// into the current attributes.
+func (m mask) mergeAttributes() bool {
+	return !m.allAttrs && len(m.attrKeys) > 0
+}
+
+// attributes returns the JSON encoded attributes of the resource after the
+// update: in if all of them are replaced, or current with the keys in the
+// mask set to their value in in. A key which is missing from in or set to
+// null is removed.
+func (m mask) attributes(current []byte, in *structpb.Struct) ([]byte, error) {
+	if !m.mergeAttributes() {
+		return marshalAttributes(in)
+	}
+	attrs, err := unmarshalAttributes(current)
+	if err != nil {
+		return nil, err
+	}
+	if attrs == nil {
+		attrs = &structpb.Struct{}
+	}
+	if attrs.Fields == nil {
+		attrs.Fields = map[string]*structpb.Value{}
+	}
+	for _, k := range m.attrKeys {
+		v, ok := in.GetFields()[k]
+		if !ok || v.GetKind() == nil {
+			delete(attrs.Fields, k)
+			continue
+		}
+		if _, null := v.GetKind().(*structpb.Value_NullValue); null {
+			delete(attrs.Fields, k)
+			continue
+		}
+		attrs.Fields[k] = v
+	}
+	return marshalAttributes(attrs)
+}
+
+// marshalAttributes returns attrs encoded as JSON, or nil if there are no
+// attributes.
+func marshalAttributes(attrs *structpb.Struct) ([]byte, error) {
+	if len(attrs.GetFields()) == 0 {
+		return nil, nil
+	}
+	b, err := protojson.Marshal(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("marshal attributes: %v: %w", err, db.ErrInvalidParameter)
+	}
+	return b, nil
+}
+
+// unmarshalAttributes decodes the JSON encoded attributes b. It returns nil
+// if b is empty.
+func unmarshalAttributes(b []byte) (*structpb.Struct, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	attrs := &structpb.Struct{}
+	if err := protojson.Unmarshal(b, attrs); err != nil {
+		return nil, fmt.Errorf("unmarshal attributes: %w", err)
+	}
+	return attrs, nil
+}
+
+// toHostCatalog converts a plugin host catalog into a host.HostCatalog.
+func toHostCatalog(hc *HostCatalog) (*host.HostCatalog, error) {
+	attrs, err := unmarshalAttributes(hc.GetAttributes())
+	if err != nil {
+		return nil, fmt.Errorf("plugin host catalog: %s: %w", hc.GetPublicId(), err)
+	}
+	return &host.HostCatalog{
+		PublicId:    hc.GetPublicId(),
+		ScopeId:     hc.GetScopeId(),
+		Name:        hc.GetName(),
+		Description: hc.GetDescription(),
+		Attributes:  attrs,
+		CreateTime:  hc.GetCreateTime().GetTimestamp(),
+		UpdateTime:  hc.GetUpdateTime().GetTimestamp(),
+		Version:     hc.GetVersion(),
+	}, nil
+}
+
+// toSet converts a plugin host set, and its hosts, into a host.Set.
+func toSet(s *HostSet, hosts []*Host) (*host.Set, error) {
+	attrs, err := unmarshalAttributes(s.GetAttributes())
+	if err != nil {
+		return nil, fmt.Errorf("plugin host set: %s: %w", s.GetPublicId(), err)
+	}
+	out := &host.Set{
+		PublicId:    s.GetPublicId(),
+		CatalogId:   s.GetCatalogId(),
+		Name:        s.GetName(),
+		Description: s.GetDescription(),
+		Attributes:  attrs,
+		CreateTime:  s.GetCreateTime().GetTimestamp(),
+		UpdateTime:  s.GetUpdateTime().GetTimestamp(),
+		Version:     s.GetVersion(),
+	}
+	for _, h := range hosts {
+		out.HostIds = append(out.HostIds, h.GetPublicId())
+	}
+	return out, nil
+}
+
+// toHost converts a plugin host into a host.Host.
+func toHost(h *Host) *host.Host {
+	attrs := &structpb.Struct{Fields: map[string]*structpb.Value{
+		"address": structpb.NewStringValue(h.GetAddress()),
+	}}
+	if h.GetPort() != 0 {
+		attrs.Fields["port"] = structpb.NewNumberValue(float64(h.GetPort()))
+	}
+	return &host.Host{
+		PublicId:    h.GetPublicId(),
+		CatalogId:   h.GetCatalogId(),
+		Name:        h.GetName(),
+		Description: h.GetDescription(),
+		Address:     h.GetAddress(),
+		Port:        h.GetPort(),
+		Attributes:  attrs,
+		CreateTime:  h.GetCreateTime().GetTimestamp(),
+		UpdateTime:  h.GetUpdateTime().GetTimestamp(),
+	}
+}
+
+// A Plugin finds the hosts of the host sets of a host catalog plugin. It is
+// served to a controller with NewServer. The controller stores the plugin's
+// host catalogs and host sets and passes them, with the attributes the
+// plugin validated, when it asks for the hosts of a set.
+type Plugin interface {
+	// Type returns the type of the plugin's host catalogs, such as "cmdb".
+	Type() string
+
+	// Prefixes returns the public id prefixes of the plugin's resources.
+	Prefixes() host.Prefixes
+
+	// ListSetHosts returns the hosts currently in set, which belongs to
+	// catalog. The ExternalId of a host must be unique within the
+	// catalog and must not change.
+	ListSetHosts(ctx context.Context, catalog *host.HostCatalog, set *host.Set) ([]*ExternalHost, error)
+
+	// ValidateAttributes checks the attributes of a host catalog or host
+	// set which is being created or updated. It returns a map of the
+	// invalid attributes, keyed by "attributes.<name>", to a description
+	// of the problem.
+	ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string
+}
+
+// An ExternalHost is a host a Plugin found for a host set.
+type ExternalHost struct {
+	ExternalId  string
+	Name        string
+	Description string
+	Address     string
+	Port        uint32
+}
+
+// NewServer returns a server which serves p to a controller. It is
+// registered with pb.RegisterHostCatalogPluginServiceServer.
+func NewServer(p Plugin) pb.HostCatalogPluginServiceServer {
+	return &server{p: p}
+}
+
+type server struct {
+	pb.UnimplementedHostCatalogPluginServiceServer
+	p Plugin
+}
+
+func (s *server) GetInfo(context.Context, *pb.GetInfoRequest) (*pb.GetInfoResponse, error) {
+	p := s.p.Prefixes()
+	return &pb.GetInfoResponse{
+		Type:              s.p.Type(),
+		HostCatalogPrefix: p.HostCatalog,
+		HostSetPrefix:     p.HostSet,
+		HostPrefix:        p.Host,
+	}, nil
+}
+
+func (s *server) ListSetHosts(ctx context.Context, req *pb.ListSetHostsRequest) (*pb.ListSetHostsResponse, error) {
+	catalog := &host.HostCatalog{
+		PublicId:   req.GetCatalog().GetId(),
+		ScopeId:    req.GetCatalog().GetScopeId(),
+		Attributes: req.GetCatalog().GetAttributes(),
+	}
+	set := &host.Set{
+		PublicId:   req.GetSet().GetId(),
+		CatalogId:  req.GetSet().GetHostCatalogId(),
+		Attributes: req.GetSet().GetAttributes(),
+	}
+	hosts, err := s.p.ListSetHosts(ctx, catalog, set)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListSetHostsResponse{}
+	for _, h := range hosts {
+		resp.Hosts = append(resp.Hosts, &pb.Host{
+			ExternalId:  h.ExternalId,
+			Name:        h.Name,
+			Description: h.Description,
+			Address:     h.Address,
+			Port:        h.Port,
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) ValidateAttributes(_ context.Context, req *pb.ValidateAttributesRequest) (*pb.ValidateAttributesResponse, error) {
+	return &pb.ValidateAttributesResponse{
+		BadFields: s.p.ValidateAttributes(resource.Map[req.GetResourceType()], req.GetAttributes()),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/plugins/host"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// cmdbPlugin is an in memory Plugin whose host sets contain the hosts in
+// hosts with the role in the set's "role" attribute.
+type cmdbPlugin struct {
+	hosts map[string][]*ExternalHost
+}
+
+func (cmdbPlugin) Type() string { return "cmdb" }
+func (cmdbPlugin) Prefixes() host.Prefixes {
+	return host.Prefixes{HostCatalog: "hccmdb", HostSet: "hscmdb", Host: "hcmdb"}
+}
+func (p cmdbPlugin) ListSetHosts(_ context.Context, _ *host.HostCatalog, set *host.Set) ([]*ExternalHost, error) {
+	return p.hosts[set.Attributes.GetFields()["role"].GetStringValue()], nil
+}
+func (cmdbPlugin) ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string {
+	badFields := map[string]string{}
+	if _, ok := attrs.GetFields()["role"]; r == resource.HostSet && !ok {
+		badFields["attributes.role"] = "This field is required."
+	}
+	return badFields
+}
+
+// testConn serves s over an in memory connection and returns the
+// connection to it.
+func testConn(t *testing.T, s pb.HostCatalogPluginServiceServer) *grpc.ClientConn {
+	t.Helper()
+	l := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterHostCatalogPluginServiceServer(srv, s)
+	go srv.Serve(l)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return l.Dial()
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestCatalog(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	p := cmdbPlugin{hosts: map[string][]*ExternalHost{
+		"web": {{ExternalId: "web-1", Name: "web-1", Address: "10.0.0.1", Port: 8080}},
+	}}
+	c, err := NewCatalog(ctx, testConn(t, NewServer(p)), func() (*Repository, error) {
+		return NewRepository(rw, rw, kms)
+	})
+	require.NoError(err)
+	assert.Equal("cmdb", c.Type())
+	assert.Equal(p.Prefixes(), c.Prefixes())
+
+	catAttrs, err := structpb.NewStruct(map[string]interface{}{"url": "https://cmdb.example.test", "region": "eu"})
+	require.NoError(err)
+	hc, err := c.CreateCatalog(ctx, &host.HostCatalog{ScopeId: prj.PublicId, Name: "cmdb", Attributes: catAttrs})
+	require.NoError(err)
+	r, err := host.NewRegistry(c)
+	require.NoError(err)
+	got, ok := r.CatalogForId(hc.PublicId)
+	require.True(ok)
+	assert.Equal(c, got)
+
+	// Updating one attribute keeps the others.
+	upd, err := structpb.NewStruct(map[string]interface{}{"region": "us"})
+	require.NoError(err)
+	hc, n, err := c.UpdateCatalog(ctx, &host.HostCatalog{PublicId: hc.PublicId, ScopeId: prj.PublicId, Attributes: upd}, hc.Version, []string{"attributes.region"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("https://cmdb.example.test", hc.Attributes.GetFields()["url"].GetStringValue())
+	assert.Equal("us", hc.Attributes.GetFields()["region"].GetStringValue())
+
+	setAttrs, err := structpb.NewStruct(map[string]interface{}{"role": "web"})
+	require.NoError(err)
+	assert.Empty(c.ValidateAttributes(resource.HostSet, setAttrs))
+	assert.Equal(map[string]string{"attributes.role": "This field is required."}, c.ValidateAttributes(resource.HostSet, nil))
+	s, err := c.CreateSet(ctx, prj.PublicId, &host.Set{CatalogId: hc.PublicId, Attributes: setAttrs})
+	require.NoError(err)
+
+	hosts, err := c.ListSetHosts(ctx, s.PublicId)
+	require.NoError(err)
+	require.Len(hosts, 1)
+	assert.Equal("10.0.0.1", hosts[0].Address)
+	assert.Equal(uint32(8080), hosts[0].Port)
+
+	found, err := c.LookupSet(ctx, s.PublicId)
+	require.NoError(err)
+	assert.Equal([]string{hosts[0].PublicId}, found.HostIds, "the hosts last found are stored")
+
+	h, err := c.LookupHost(ctx, hosts[0].PublicId)
+	require.NoError(err)
+	assert.Equal("web-1", h.Name)
+
+	_, ok = c.(host.HostWriter)
+	assert.False(ok, "the hosts of a plugin can't be changed")
+}
+
+func TestNewCatalog_NoType(t *testing.T) {
+	assert := assert.New(t)
+	conn := testConn(t, &pb.UnimplementedHostCatalogPluginServiceServer{})
+	c, err := NewCatalog(context.Background(), conn, func() (*Repository, error) { return nil, nil })
+	assert.Error(err)
+	assert.Nil(c)
+}
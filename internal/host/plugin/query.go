@@ -0,0 +1,15 @@
+package plugin
+
+const (
+	// deleteOrphanedHostsQuery deletes the hosts of a catalog which are
+	// not a member of any of the catalog's host sets.
+	deleteOrphanedHostsQuery = `
+delete from plugin_host
+ where catalog_id = $1
+   and public_id not in
+       ( select host_id
+           from plugin_host_set_member
+          where catalog_id = $1
+       );
+`
+)
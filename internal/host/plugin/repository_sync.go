@@ -0,0 +1,153 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// SyncSet stores hosts as the hosts the plugin found for the host set setId
+// and returns the members of the set. The hosts are matched to the hosts of
+// the set's catalog by ExternalId: a host is created for each new
+// ExternalId, using the PublicId of the host in hosts, and the name,
+// description, address, and port of the existing hosts are updated. The
+// set's members are replaced, and the hosts of the catalog which are no
+// longer a member of any of its sets are deleted. All options are ignored.
+func (r *Repository) SyncSet(ctx context.Context, setId string, hosts []*Host, opt ...Option) ([]*Host, error) {
+	if setId == "" {
+		return nil, fmt.Errorf("sync: plugin host set: missing public id: %w", db.ErrInvalidParameter)
+	}
+	for _, h := range hosts {
+		switch {
+		case h == nil || h.Host == nil:
+			return nil, fmt.Errorf("sync: plugin host set: %s: nil host: %w", setId, db.ErrInvalidParameter)
+		case h.ExternalId == "":
+			return nil, fmt.Errorf("sync: plugin host set: %s: host with no external id: %w", setId, db.ErrInvalidParameter)
+		case h.Address == "":
+			return nil, fmt.Errorf("sync: plugin host set: %s: host %s has no address: %w", setId, h.ExternalId, db.ErrInvalidParameter)
+		}
+	}
+	s := allocHostSet()
+	s.PublicId = setId
+	if err := r.reader.LookupByPublicId(ctx, s); err != nil {
+		return nil, fmt.Errorf("sync: plugin host set: %s: %w", setId, err)
+	}
+
+	var members []*Host
+	var err error
+	for i := 0; i < db.StdRetryCnt; i++ {
+		// A host created for the same external id by a concurrent sync of
+		// another set in the catalog fails the sync, which is retried to
+		// pick up the host.
+		members, err = r.syncSet(ctx, s, hosts)
+		if err == nil || !db.IsUniqueError(err) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sync: plugin host set: %s: %w", setId, err)
+	}
+	return members, nil
+}
+
+// syncSet updates the hosts of the catalog of s and the members of s to
+// match found and returns the members.
+func (r *Repository) syncSet(ctx context.Context, s *HostSet, found []*Host) ([]*Host, error) {
+	var hosts []*Host
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			hosts = nil
+			var existing []*Host
+			if err := reader.SearchWhere(ctx, &existing, "catalog_id = ?", []interface{}{s.CatalogId}, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			byExternalId := make(map[string]*Host, len(existing))
+			for _, h := range existing {
+				byExternalId[h.ExternalId] = h
+			}
+			seen := make(map[string]bool, len(found))
+			for _, f := range found {
+				if seen[f.ExternalId] {
+					// A host the plugin returned twice is only a member once.
+					continue
+				}
+				seen[f.ExternalId] = true
+				h, ok := byExternalId[f.ExternalId]
+				switch {
+				case !ok:
+					var err error
+					if h, err = NewHost(s.CatalogId, f.ExternalId, f.Address, f.Port, WithName(f.Name), WithDescription(f.Description)); err != nil {
+						return err
+					}
+					if f.PublicId == "" {
+						return fmt.Errorf("host %s has no public id: %w", f.ExternalId, db.ErrInvalidParameter)
+					}
+					h.PublicId = f.PublicId
+					if err := w.Create(ctx, h); err != nil {
+						return err
+					}
+					byExternalId[f.ExternalId] = h
+				case h.Name != f.Name || h.Description != f.Description || h.Address != f.Address || h.Port != f.Port:
+					h.Name, h.Description, h.Address, h.Port = f.Name, f.Description, f.Address, f.Port
+					dbMask, nullFields := []string{"Address", "Port"}, []string(nil)
+					for k, v := range map[string]string{"Name": h.Name, "Description": h.Description} {
+						if v == "" {
+							nullFields = append(nullFields, k)
+							continue
+						}
+						dbMask = append(dbMask, k)
+					}
+					if _, err := w.Update(ctx, h, dbMask, nullFields); err != nil {
+						return err
+					}
+				}
+				hosts = append(hosts, h)
+			}
+
+			var current []*HostSetMember
+			if err := reader.SearchWhere(ctx, &current, "set_id = ?", []interface{}{s.PublicId}, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			want := make(map[string]bool, len(hosts))
+			for _, h := range hosts {
+				want[h.PublicId] = true
+			}
+			var deletes []interface{}
+			for _, m := range current {
+				if want[m.HostId] {
+					delete(want, m.HostId)
+					continue
+				}
+				deletes = append(deletes, m)
+			}
+			if len(deletes) > 0 {
+				if _, err := w.DeleteItems(ctx, deletes); err != nil {
+					return err
+				}
+			}
+			var creates []interface{}
+			for _, h := range hosts {
+				if !want[h.PublicId] {
+					continue
+				}
+				m, err := newHostSetMember(s.PublicId, h.PublicId)
+				if err != nil {
+					return err
+				}
+				creates = append(creates, m)
+			}
+			if len(creates) > 0 {
+				if err := w.CreateItems(ctx, creates); err != nil {
+					return err
+				}
+			}
+			_, err := w.Exec(ctx, deleteOrphanedHostsQuery, []interface{}{s.CatalogId})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCatalog(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	var tests = []struct {
+		name      string
+		publicId  string
+		wantIsErr error
+	}{
+		{
+			name:     "valid",
+			publicId: "hccmdb_1234567890",
+		},
+		{
+			name:      "no-public-id",
+			wantIsErr: db.ErrInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			repo, err := NewRepository(rw, rw, kms)
+			require.NoError(err)
+			in, err := NewHostCatalog(prj.PublicId, "cmdb", WithAttributes([]byte(`{"url":"https://cmdb.example.test"}`)))
+			require.NoError(err)
+			in.PublicId = tt.publicId
+			got, err := repo.CreateCatalog(context.Background(), in)
+			if tt.wantIsErr != nil {
+				assert.Truef(errors.Is(err, tt.wantIsErr), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal("cmdb", got.PluginType)
+			assert.Equal(in.Attributes, got.Attributes)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+			found, err := repo.ListCatalogs(context.Background(), "other", []string{prj.PublicId})
+			require.NoError(err)
+			assert.Empty(found, "catalogs of other plugins are not listed")
+		})
+	}
+}
+
+func TestRepository_SyncSet(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	cat, err := NewHostCatalog(prj.PublicId, "cmdb")
+	require.NoError(err)
+	cat.PublicId = "hccmdb_1234567890"
+	cat, err = repo.CreateCatalog(ctx, cat)
+	require.NoError(err)
+	var sets []*HostSet
+	for _, id := range []string{"hscmdb_000000000a", "hscmdb_000000000b"} {
+		s, err := NewHostSet(cat.PublicId)
+		require.NoError(err)
+		s.PublicId = id
+		s, err = repo.CreateSet(ctx, prj.PublicId, s)
+		require.NoError(err)
+		sets = append(sets, s)
+	}
+
+	found := func(externalId, publicId, address string) *Host {
+		h, err := NewHost(cat.PublicId, externalId, address, 0)
+		require.NoError(err)
+		h.PublicId = publicId
+		return h
+	}
+
+	hostsA, err := repo.SyncSet(ctx, sets[0].PublicId, []*Host{
+		found("web-1", "hcmdb_0000000001", "10.0.0.1"),
+		found("web-2", "hcmdb_0000000002", "10.0.0.2"),
+	})
+	require.NoError(err)
+	require.Len(hostsA, 2)
+
+	// A host keeps its id for as long as its external id is found.
+	hostsB, err := repo.SyncSet(ctx, sets[1].PublicId, []*Host{found("web-2", "hcmdb_0000000003", "10.0.1.2")})
+	require.NoError(err)
+	require.Len(hostsB, 1)
+	assert.Equal("hcmdb_0000000002", hostsB[0].PublicId)
+	assert.Equal("10.0.1.2", hostsB[0].Address)
+
+	_, members, err := repo.LookupSet(ctx, sets[0].PublicId)
+	require.NoError(err)
+	assert.Len(members, 2)
+
+	// A host no set has anymore is deleted.
+	hostsA, err = repo.SyncSet(ctx, sets[0].PublicId, nil)
+	require.NoError(err)
+	assert.Empty(hostsA)
+	all, err := repo.ListHosts(ctx, cat.PublicId)
+	require.NoError(err)
+	require.Len(all, 1)
+	assert.Equal("web-2", all[0].ExternalId)
+
+	n, err := repo.DeleteSet(ctx, prj.PublicId, sets[1].PublicId)
+	require.NoError(err)
+	assert.Equal(1, n)
+	all, err = repo.ListHosts(ctx, cat.PublicId)
+	require.NoError(err)
+	assert.Empty(all)
+}
@@ -6,4 +6,17 @@ var (
 	// ErrInvalidAddress results from attempting to perform an operation
 	// that sets an address on a host to an invalid value.
 	ErrInvalidAddress = errors.New("invalid address")
+
+	// ErrInvalidPort results from attempting to perform an operation
+	// that sets a port on a host to an invalid value.
+	ErrInvalidPort = errors.New("invalid port")
+
+	// ErrInvalidAttribute results from attempting to perform an operation
+	// that sets an attribute on a host to an invalid key or value.
+	ErrInvalidAttribute = errors.New("invalid attribute")
+
+	// ErrInvalidSelector results from attempting to perform an operation
+	// that sets the selector of a host set to an expression which can't be
+	// parsed.
+	ErrInvalidSelector = errors.New("invalid selector")
 )
@@ -12,17 +12,24 @@ import (
 const (
 	MinHostAddressLength = 3
 	MaxHostAddressLength = 255
+	MaxHostPort          = 65535
+
+	MaxHostAttributeKeyLength   = 255
+	MaxHostAttributeValueLength = 1024
 )
 
-// A Host contains a static address.
+// A Host contains a static address, and optionally a port and alternate
+// addresses which are tried in order when connecting to the address fails.
+// The attributes of a host are matched against the selectors of the host
+// sets in its catalog.
 type Host struct {
 	*store.Host
 	tableName string `gorm:"-"`
 }
 
 // NewHost creates a new in memory Host for address assigned to catalogId.
-// Name and description are the only valid options. All other options are
-// ignored.
+// Name, description, address, port, alternate addresses and attributes are
+// the only valid options. All other options are ignored.
 func NewHost(catalogId string, opt ...Option) (*Host, error) {
 	if catalogId == "" {
 		return nil, fmt.Errorf("new: static host: no catalog id: %w", db.ErrInvalidParameter)
@@ -31,10 +38,13 @@ func NewHost(catalogId string, opt ...Option) (*Host, error) {
 	opts := getOpts(opt...)
 	host := &Host{
 		Host: &store.Host{
-			CatalogId:   catalogId,
-			Address:     opts.withAddress,
-			Name:        opts.withName,
-			Description: opts.withDescription,
+			CatalogId:          catalogId,
+			Address:            opts.withAddress,
+			Port:               opts.withPort,
+			AlternateAddresses: opts.withAlternates,
+			Attributes:         opts.withAttributes,
+			Name:               opts.withName,
+			Description:        opts.withDescription,
 		},
 	}
 	return host, nil
@@ -0,0 +1,52 @@
+package static
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+)
+
+// A HostAddress is an alternate address of a host. The alternate addresses of
+// a host are tried in order of priority, lowest first, when connecting to the
+// host's address fails.
+type HostAddress struct {
+	*store.HostAddress
+	tableName string `gorm:"-"`
+}
+
+// NewHostAddress creates a new in memory HostAddress representing address as
+// the alternate address of hostId with the given priority.
+func NewHostAddress(hostId, address string, priority uint32, opt ...Option) (*HostAddress, error) {
+	if hostId == "" {
+		return nil, fmt.Errorf("new: static host address: no host id: %w", db.ErrInvalidParameter)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("new: static host address: no address: %w", db.ErrInvalidParameter)
+	}
+	if priority == 0 {
+		return nil, fmt.Errorf("new: static host address: no priority: %w", db.ErrInvalidParameter)
+	}
+	a := &HostAddress{
+		HostAddress: &store.HostAddress{
+			HostId:   hostId,
+			Address:  address,
+			Priority: priority,
+		},
+	}
+	return a, nil
+}
+
+// TableName returns the table name for the host address.
+func (a *HostAddress) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "static_host_address"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (a *HostAddress) SetTableName(n string) {
+	a.tableName = n
+}
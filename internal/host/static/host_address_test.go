@@ -0,0 +1,118 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostAddress_New(t *testing.T) {
+	var tests = []struct {
+		name     string
+		hostId   string
+		address  string
+		priority uint32
+		wantErr  error
+	}{
+		{
+			name:     "blank-host-id",
+			address:  "127.0.0.1",
+			priority: 1,
+			wantErr:  db.ErrInvalidParameter,
+		},
+		{
+			name:     "blank-address",
+			hostId:   "hst_1234567890",
+			priority: 1,
+			wantErr:  db.ErrInvalidParameter,
+		},
+		{
+			name:    "no-priority",
+			hostId:  "hst_1234567890",
+			address: "127.0.0.1",
+			wantErr: db.ErrInvalidParameter,
+		},
+		{
+			name:     "valid",
+			hostId:   "hst_1234567890",
+			address:  "127.0.0.1",
+			priority: 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHostAddress(tt.hostId, tt.address, tt.priority)
+			if tt.wantErr != nil {
+				assert.Truef(errors.Is(err, tt.wantErr), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.hostId, got.HostId)
+			assert.Equal(tt.address, got.Address)
+			assert.Equal(tt.priority, got.Priority)
+		})
+	}
+}
+
+func TestHostAddress_Insert(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	host := TestHosts(t, conn, cat.GetPublicId(), 1)[0]
+
+	var tests = []struct {
+		name     string
+		address  string
+		priority uint32
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			address:  "::1",
+			priority: 1,
+		},
+		{
+			name:     "invalid-duplicate-address",
+			address:  "::1",
+			priority: 2,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid-duplicate-priority",
+			address:  "localhost",
+			priority: 1,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid-address-to-short",
+			address:  "12",
+			priority: 3,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHostAddress(host.PublicId, tt.address, tt.priority)
+			require.NoError(err)
+			w := db.New(conn)
+			err2 := w.Create(context.Background(), got)
+			if tt.wantErr {
+				assert.Error(err2)
+				return
+			}
+			assert.NoError(err2)
+		})
+	}
+}
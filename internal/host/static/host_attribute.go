@@ -0,0 +1,49 @@
+package static
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+)
+
+// A HostAttribute is a key/value pair describing a host. The selectors of
+// host sets are matched against the attributes of the hosts in their
+// catalog.
+type HostAttribute struct {
+	*store.HostAttribute
+	tableName string `gorm:"-"`
+}
+
+// NewHostAttribute creates a new in memory HostAttribute representing the
+// attribute key of hostId with the given value.
+func NewHostAttribute(hostId, key, value string, opt ...Option) (*HostAttribute, error) {
+	if hostId == "" {
+		return nil, fmt.Errorf("new: static host attribute: no host id: %w", db.ErrInvalidParameter)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("new: static host attribute: no key: %w", db.ErrInvalidParameter)
+	}
+	a := &HostAttribute{
+		HostAttribute: &store.HostAttribute{
+			HostId: hostId,
+			Key:    key,
+			Value:  value,
+		},
+	}
+	return a, nil
+}
+
+// TableName returns the table name for the host attribute.
+func (a *HostAttribute) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return "static_host_attribute"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (a *HostAttribute) SetTableName(n string) {
+	a.tableName = n
+}
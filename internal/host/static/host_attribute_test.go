@@ -0,0 +1,117 @@
+package static
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostAttribute_New(t *testing.T) {
+	var tests = []struct {
+		name    string
+		hostId  string
+		key     string
+		value   string
+		wantErr error
+	}{
+		{
+			name:    "blank-host-id",
+			key:     "env",
+			value:   "prod",
+			wantErr: db.ErrInvalidParameter,
+		},
+		{
+			name:    "blank-key",
+			hostId:  "hst_1234567890",
+			value:   "prod",
+			wantErr: db.ErrInvalidParameter,
+		},
+		{
+			name:   "valid",
+			hostId: "hst_1234567890",
+			key:    "env",
+			value:  "prod",
+		},
+		{
+			name:   "valid-blank-value",
+			hostId: "hst_1234567890",
+			key:    "env",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHostAttribute(tt.hostId, tt.key, tt.value)
+			if tt.wantErr != nil {
+				assert.Truef(errors.Is(err, tt.wantErr), "want err: %q got: %q", tt.wantErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.hostId, got.HostId)
+			assert.Equal(tt.key, got.Key)
+			assert.Equal(tt.value, got.Value)
+		})
+	}
+}
+
+func TestHostAttribute_Insert(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	host := TestHosts(t, conn, cat.GetPublicId(), 1)[0]
+
+	var tests = []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			key:   "env",
+			value: "prod",
+		},
+		{
+			name:    "invalid-duplicate-key",
+			key:     "env",
+			value:   "dev",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-blank-key",
+			key:     " ",
+			wantErr: true,
+		},
+		{
+			name:    "invalid-value-too-long",
+			key:     "role",
+			value:   strings.Repeat("a", MaxHostAttributeValueLength+1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHostAttribute(host.PublicId, tt.key, tt.value)
+			require.NoError(err)
+			w := db.New(conn)
+			err2 := w.Create(context.Background(), got)
+			if tt.wantErr {
+				assert.Error(err2)
+				return
+			}
+			assert.NoError(err2)
+		})
+	}
+}
@@ -9,14 +9,16 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-// A HostSet is a collection of hosts from the set's catalog.
+// A HostSet is a collection of hosts from the set's catalog. The members of
+// a host set are the hosts added to it and, if the set has a selector, the
+// hosts in the catalog whose attributes match the selector.
 type HostSet struct {
 	*store.HostSet
 	tableName string `gorm:"-"`
 }
 
 // NewHostSet creates a new in memory HostSet assigned to catalogId.
-// Name and description are the only valid options. All other options are
+// Name, description and selector are the only valid options. All other options are
 // ignored.
 func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 	if catalogId == "" {
@@ -29,6 +31,7 @@ func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 			CatalogId:   catalogId,
 			Name:        opts.withName,
 			Description: opts.withDescription,
+			Selector:    opts.withSelector,
 		},
 	}
 	return set, nil
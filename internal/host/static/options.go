@@ -1,5 +1,7 @@
 package static
 
+import "github.com/hashicorp/boundary/internal/db"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -14,11 +16,16 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName        string
-	withDescription string
-	withLimit       int
-	withAddress     string
-	withPublicId    string
+	withName           string
+	withDescription    string
+	withLimit          int
+	withAddress        string
+	withPort           uint32
+	withAlternates     []string
+	withAttributes     map[string]string
+	withSelector       string
+	withPublicId       string
+	withStartPageAfter *db.PageCursor
 }
 
 func getDefaultOptions() options {
@@ -59,9 +66,46 @@ func WithAddress(address string) Option {
 	}
 }
 
+// WithPort provides an optional port.
+func WithPort(port uint32) Option {
+	return func(o *options) {
+		o.withPort = port
+	}
+}
+
+// WithAlternateAddresses provides optional alternate addresses, in the order
+// they are tried.
+func WithAlternateAddresses(addresses []string) Option {
+	return func(o *options) {
+		o.withAlternates = addresses
+	}
+}
+
+// WithAttributes provides optional attributes of a host.
+func WithAttributes(attributes map[string]string) Option {
+	return func(o *options) {
+		o.withAttributes = attributes
+	}
+}
+
+// WithSelector provides an optional selector of a host set.
+func WithSelector(selector string) Option {
+	return func(o *options) {
+		o.withSelector = selector
+	}
+}
+
 // WithPublicId provides an optional public id
 func WithPublicId(id string) Option {
 	return func(o *options) {
 		o.withPublicId = id
 	}
 }
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
@@ -32,6 +32,30 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withAddress = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithPort", func(t *testing.T) {
+		opts := getOpts(WithPort(22))
+		testOpts := getDefaultOptions()
+		testOpts.withPort = 22
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAlternateAddresses", func(t *testing.T) {
+		opts := getOpts(WithAlternateAddresses([]string{"test1", "test2"}))
+		testOpts := getDefaultOptions()
+		testOpts.withAlternates = []string{"test1", "test2"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAttributes", func(t *testing.T) {
+		opts := getOpts(WithAttributes(map[string]string{"env": "prod"}))
+		testOpts := getDefaultOptions()
+		testOpts.withAttributes = map[string]string{"env": "prod"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSelector", func(t *testing.T) {
+		opts := getOpts(WithSelector(`env == "prod"`))
+		testOpts := getDefaultOptions()
+		testOpts.withSelector = `env == "prod"`
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithPublicId", func(t *testing.T) {
 		opts := getOpts(WithPublicId("test"))
 		testOpts := getDefaultOptions()
@@ -17,7 +17,11 @@ import (
 // CatalogId. h must not contain a PublicId. The PublicId is generated and
 // assigned by this method. opt is ignored.
 //
-// h must contain a valid Address.
+// h must contain a valid Address. h.Port and h.AlternateAddresses are
+// optional. If h.AlternateAddresses is set, each of them must be a valid
+// address which differs from h.Address and from the others.
+//
+// h.Attributes is optional. If it is set, its keys must not be empty.
 //
 // Both h.Name and h.Description are optional. If h.Name is set, it must be
 // unique within h.CatalogId.
@@ -37,11 +41,21 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 	if scopeId == "" {
 		return nil, fmt.Errorf("create: static host: no scopeId: %w", db.ErrInvalidParameter)
 	}
+	h = h.clone()
 	h.Address = strings.TrimSpace(h.Address)
 	if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 		return nil, fmt.Errorf("create: static host: bad address: %w", ErrInvalidAddress)
 	}
-	h = h.clone()
+	if h.Port > MaxHostPort {
+		return nil, fmt.Errorf("create: static host: bad port: %w", ErrInvalidPort)
+	}
+	var err error
+	if h.AlternateAddresses, err = cleanAlternateAddresses(h.Address, h.AlternateAddresses); err != nil {
+		return nil, fmt.Errorf("create: static host: %w", err)
+	}
+	if h.Attributes, err = cleanAttributes(h.Attributes); err != nil {
+		return nil, fmt.Errorf("create: static host: %w", err)
+	}
 
 	opts := getOpts(opt...)
 
@@ -66,8 +80,27 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 	var newHost *Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) error {
+			ticket, err := w.GetTicket(h)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
 			newHost = h.clone()
-			return w.Create(ctx, newHost, db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE)))
+			var hostOplogMsg oplog.Message
+			if err := w.Create(ctx, newHost, db.NewOplogMsg(&hostOplogMsg)); err != nil {
+				return err
+			}
+			msgs := []*oplog.Message{&hostOplogMsg}
+			addrOplogMsgs, err := createHostAddresses(ctx, w, h.PublicId, h.AlternateAddresses)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, addrOplogMsgs...)
+			attrOplogMsgs, err := createHostAttributes(ctx, w, h.PublicId, h.Attributes)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, attrOplogMsgs...)
+			return w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, h.oplog(oplog.OpType_OP_TYPE_CREATE), msgs)
 		},
 	)
 
@@ -90,10 +123,12 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 // containing the updated values and a count of the number of records
 // updated. h is not changed.
 //
-// h must contain a valid PublicId. Only h.Name, h.Description, and
-// h.Address can be updated. If h.Name is set to a non-empty string, it
-// must be unique within h.CatalogId. If h.Address is set, it must contain
-// a valid address.
+// h must contain a valid PublicId. Only h.Name, h.Description, h.Address,
+// h.Port, h.AlternateAddresses and h.Attributes can be updated. If h.Name is
+// set to a non-empty string, it must be unique within h.CatalogId. If
+// h.Address is set, it must contain a valid address. The alternate addresses
+// and the attributes of the host are replaced as a whole by
+// h.AlternateAddresses and h.Attributes.
 //
 // An attribute of h will be set to NULL in the database if the attribute
 // in h is the zero value and it is included in fieldMaskPaths.
@@ -114,6 +149,8 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 		return nil, db.NoRowsAffected, fmt.Errorf("update: static host: no scopeId: %w", db.ErrInvalidParameter)
 	}
 
+	h = h.clone()
+	var setAlternates, setAttributes bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
@@ -123,6 +160,22 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 			if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 				return nil, db.NoRowsAffected, fmt.Errorf("update: static host: bad address: %w", ErrInvalidAddress)
 			}
+		case strings.EqualFold("Port", f):
+			if h.Port > MaxHostPort {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: static host: bad port: %w", ErrInvalidPort)
+			}
+		case strings.EqualFold("AlternateAddresses", f):
+			var err error
+			if h.AlternateAddresses, err = cleanAlternateAddresses("", h.AlternateAddresses); err != nil {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: static host: %w", err)
+			}
+			setAlternates = true
+		case strings.EqualFold("Attributes", f):
+			var err error
+			if h.Attributes, err = cleanAttributes(h.Attributes); err != nil {
+				return nil, db.NoRowsAffected, fmt.Errorf("update: static host: %w", err)
+			}
+			setAttributes = true
 		default:
 			return nil, db.NoRowsAffected, fmt.Errorf("update: static host: field: %s: %w", f, db.ErrInvalidFieldMask)
 		}
@@ -133,11 +186,12 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 			"Name":        h.Name,
 			"Description": h.Description,
 			"Address":     h.Address,
+			"Port":        h.Port,
 		},
 		fieldMaskPaths,
 		nil,
 	)
-	if len(dbMask) == 0 && len(nullFields) == 0 {
+	if len(dbMask) == 0 && len(nullFields) == 0 && !setAlternates && !setAttributes {
 		return nil, db.NoRowsAffected, fmt.Errorf("update: static host: %w", db.ErrEmptyFieldMask)
 	}
 
@@ -149,16 +203,84 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 	var rowsUpdated int
 	var returnedHost *Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
+			ticket, err := w.GetTicket(h)
+			if err != nil {
+				return fmt.Errorf("unable to get ticket: %w", err)
+			}
 			returnedHost = h.clone()
-			var err error
-			rowsUpdated, err = w.Update(ctx, returnedHost, dbMask, nullFields,
-				db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+			mask := dbMask
+			if len(dbMask) == 0 && len(nullFields) == 0 {
+				// Only the alternate addresses or attributes are updated,
+				// which still results in a new version of the host.
+				returnedHost.Version = version + 1
+				mask = []string{"Version"}
+			}
+			var hostOplogMsg oplog.Message
+			rowsUpdated, err = w.Update(ctx, returnedHost, mask, nullFields,
+				db.NewOplogMsg(&hostOplogMsg),
 				db.WithVersion(&version))
-			if err == nil && rowsUpdated > 1 {
+			if err != nil {
+				return err
+			}
+			switch {
+			case rowsUpdated > 1:
 				return db.ErrMultipleRecords
+			case rowsUpdated == 0:
+				return nil
 			}
-			return err
+			msgs := []*oplog.Message{&hostOplogMsg}
+			if setAlternates {
+				current, err := fetchHostAddresses(ctx, reader, h.PublicId)
+				if err != nil {
+					return err
+				}
+				if len(current) > 0 {
+					items := make([]interface{}, 0, len(current))
+					for _, a := range current {
+						items = append(items, a)
+					}
+					var addrOplogMsgs []*oplog.Message
+					if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&addrOplogMsgs)); err != nil {
+						return fmt.Errorf("unable to delete alternate addresses: %w", err)
+					}
+					msgs = append(msgs, addrOplogMsgs...)
+				}
+				addrOplogMsgs, err := createHostAddresses(ctx, w, h.PublicId, h.AlternateAddresses)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, addrOplogMsgs...)
+			}
+			if setAttributes {
+				current, err := fetchHostAttributes(ctx, reader, h.PublicId)
+				if err != nil {
+					return err
+				}
+				if len(current) > 0 {
+					items := make([]interface{}, 0, len(current))
+					for _, a := range current {
+						items = append(items, a)
+					}
+					var attrOplogMsgs []*oplog.Message
+					if _, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&attrOplogMsgs)); err != nil {
+						return fmt.Errorf("unable to delete attributes: %w", err)
+					}
+					msgs = append(msgs, attrOplogMsgs...)
+				}
+				attrOplogMsgs, err := createHostAttributes(ctx, w, h.PublicId, h.Attributes)
+				if err != nil {
+					return err
+				}
+				msgs = append(msgs, attrOplogMsgs...)
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, h.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return fmt.Errorf("unable to write oplog: %w", err)
+			}
+			if err := setAlternateAddresses(ctx, reader, returnedHost); err != nil {
+				return err
+			}
+			return setHostAttributes(ctx, reader, returnedHost)
 		},
 	)
 
@@ -190,11 +312,17 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 		}
 		return nil, fmt.Errorf("lookup: static host: failed %w for %s", err, publicId)
 	}
+	if err := setAlternateAddresses(ctx, r.reader, h); err != nil {
+		return nil, fmt.Errorf("lookup: static host: %w", err)
+	}
+	if err := setHostAttributes(ctx, r.reader, h); err != nil {
+		return nil, fmt.Errorf("lookup: static host: %w", err)
+	}
 	return h, nil
 }
 
-// ListHosts returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// ListHosts returns a slice of Hosts for the catalogId ordered by create time.
+// WithLimit and WithStartPageAfter are the only options supported.
 func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
 	if catalogId == "" {
 		return nil, fmt.Errorf("list: static host: missing catalog id: %w", db.ErrInvalidParameter)
@@ -206,10 +334,16 @@ func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Opt
 		limit = opts.withLimit
 	}
 	var hosts []*Host
-	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host: %w", err)
 	}
+	if err := setAlternateAddresses(ctx, r.reader, hosts...); err != nil {
+		return nil, fmt.Errorf("list: static host: %w", err)
+	}
+	if err := setHostAttributes(ctx, r.reader, hosts...); err != nil {
+		return nil, fmt.Errorf("list: static host: %w", err)
+	}
 	return hosts, nil
 }
 
@@ -247,3 +381,138 @@ func (r *Repository) DeleteHost(ctx context.Context, scopeId string, publicId st
 
 	return rowsDeleted, nil
 }
+
+// cleanAlternateAddresses returns the alternate addresses with the spaces
+// around them removed. Each of them must be a valid address which differs
+// from address and from the others.
+func cleanAlternateAddresses(address string, alternates []string) ([]string, error) {
+	if len(alternates) == 0 {
+		return nil, nil
+	}
+	seen := map[string]bool{address: true}
+	cleaned := make([]string, 0, len(alternates))
+	for _, a := range alternates {
+		a = strings.TrimSpace(a)
+		if len(a) < MinHostAddressLength || len(a) > MaxHostAddressLength {
+			return nil, fmt.Errorf("bad alternate address: %q: %w", a, ErrInvalidAddress)
+		}
+		if seen[a] {
+			return nil, fmt.Errorf("duplicate alternate address: %q: %w", a, ErrInvalidAddress)
+		}
+		seen[a] = true
+		cleaned = append(cleaned, a)
+	}
+	return cleaned, nil
+}
+
+// createHostAddresses writes the alternate addresses of the host, in order,
+// and returns their oplog messages.
+func createHostAddresses(ctx context.Context, w db.Writer, hostId string, alternates []string) ([]*oplog.Message, error) {
+	if len(alternates) == 0 {
+		return nil, nil
+	}
+	items := make([]interface{}, 0, len(alternates))
+	for i, a := range alternates {
+		ha, err := NewHostAddress(hostId, a, uint32(i+1))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, ha)
+	}
+	var msgs []*oplog.Message
+	if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&msgs)); err != nil {
+		return nil, fmt.Errorf("unable to create alternate addresses: %w", err)
+	}
+	return msgs, nil
+}
+
+// fetchHostAddresses returns the alternate addresses of the hosts ordered by
+// host and priority.
+func fetchHostAddresses(ctx context.Context, r db.Reader, hostIds ...string) ([]*HostAddress, error) {
+	var addrs []*HostAddress
+	if err := r.SearchWhere(ctx, &addrs, "host_id in (?)", []interface{}{hostIds}, db.WithOrder("host_id, priority asc"), db.WithLimit(-1)); err != nil {
+		return nil, fmt.Errorf("unable to fetch alternate addresses: %w", err)
+	}
+	return addrs, nil
+}
+
+// setAlternateAddresses sets the AlternateAddresses of the hosts to the ones
+// stored in the repository.
+func setAlternateAddresses(ctx context.Context, r db.Reader, hosts ...*Host) error {
+	if len(hosts) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.PublicId)
+	}
+	addrs, err := fetchHostAddresses(ctx, r, ids...)
+	if err != nil {
+		return err
+	}
+	byHost := make(map[string][]string, len(hosts))
+	for _, a := range addrs {
+		byHost[a.HostId] = append(byHost[a.HostId], a.Address)
+	}
+	for _, h := range hosts {
+		h.AlternateAddresses = byHost[h.PublicId]
+	}
+	return nil
+}
+
+// createHostAttributes writes the attributes of the host and returns their
+// oplog messages.
+func createHostAttributes(ctx context.Context, w db.Writer, hostId string, attrs map[string]string) ([]*oplog.Message, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	items := make([]interface{}, 0, len(attrs))
+	for k, v := range attrs {
+		a, err := NewHostAttribute(hostId, k, v)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, a)
+	}
+	var msgs []*oplog.Message
+	if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&msgs)); err != nil {
+		return nil, fmt.Errorf("unable to create attributes: %w", err)
+	}
+	return msgs, nil
+}
+
+// fetchHostAttributes returns the attributes of the hosts.
+func fetchHostAttributes(ctx context.Context, r db.Reader, hostIds ...string) ([]*HostAttribute, error) {
+	var attrs []*HostAttribute
+	if err := r.SearchWhere(ctx, &attrs, "host_id in (?)", []interface{}{hostIds}, db.WithLimit(-1)); err != nil {
+		return nil, fmt.Errorf("unable to fetch attributes: %w", err)
+	}
+	return attrs, nil
+}
+
+// setHostAttributes sets the Attributes of the hosts to the ones stored in
+// the repository.
+func setHostAttributes(ctx context.Context, r db.Reader, hosts ...*Host) error {
+	if len(hosts) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.PublicId)
+	}
+	attrs, err := fetchHostAttributes(ctx, r, ids...)
+	if err != nil {
+		return err
+	}
+	byHost := make(map[string]map[string]string, len(hosts))
+	for _, a := range attrs {
+		if byHost[a.HostId] == nil {
+			byHost[a.HostId] = make(map[string]string)
+		}
+		byHost[a.HostId][a.Key] = a.Value
+	}
+	for _, h := range hosts {
+		h.Attributes = byHost[h.PublicId]
+	}
+	return nil
+}
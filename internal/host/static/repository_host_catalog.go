@@ -188,9 +188,10 @@ func (r *Repository) LookupCatalog(ctx context.Context, id string, opt ...Option
 	return c, nil
 }
 
-// ListCatalogs returns a slice of HostCatalogs for the scopeId. WithLimit is the only option supported.
-func (r *Repository) ListCatalogs(ctx context.Context, scopeId string, opt ...Option) ([]*HostCatalog, error) {
-	if scopeId == "" {
+// ListCatalogs returns a slice of HostCatalogs for the scopeIds ordered by
+// create time. WithLimit and WithStartPageAfter are the only options supported.
+func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ...Option) ([]*HostCatalog, error) {
+	if len(scopeIds) == 0 {
 		return nil, fmt.Errorf("list: static host catalog: missing scope id: %w", db.ErrInvalidParameter)
 	}
 	opts := getOpts(opt...)
@@ -200,7 +201,7 @@ func (r *Repository) ListCatalogs(ctx context.Context, scopeId string, opt ...Op
 		limit = opts.withLimit
 	}
 	var hostCatalogs []*HostCatalog
-	err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id = ?", []interface{}{scopeId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host catalog: %w", err)
 	}
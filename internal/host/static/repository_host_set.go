@@ -19,6 +19,9 @@ import (
 //
 // Both s.Name and s.Description are optional. If s.Name is set, it must be
 // unique within s.CatalogId.
+//
+// s.Selector is optional. If it is set, it must be a valid selector and the
+// hosts in s.CatalogId whose attributes match it are members of the set.
 func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet, opt ...Option) (*HostSet, error) {
 	if s == nil {
 		return nil, fmt.Errorf("create: static host set: %w", db.ErrInvalidParameter)
@@ -36,6 +39,12 @@ func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet,
 		return nil, fmt.Errorf("create: static host set: no scopeId: %w", db.ErrInvalidParameter)
 	}
 	s = s.clone()
+	s.Selector = strings.TrimSpace(s.Selector)
+	if s.Selector != "" {
+		if err := ValidateSelector(s.Selector); err != nil {
+			return nil, fmt.Errorf("create: static host set: %w", err)
+		}
+	}
 
 	opts := getOpts(opt...)
 
@@ -80,9 +89,10 @@ func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet,
 // containing the updated values, the hosts assigned to the host set, and a
 // count of the number of records updated. s is not changed.
 //
-// s must contain a valid PublicId. Only s.Name and s.Description can be
-// updated. If s.Name is set to a non-empty string, it must be unique
-// within s.CatalogId.
+// s must contain a valid PublicId. Only s.Name, s.Description and
+// s.Selector can be updated. If s.Name is set to a non-empty string, it must
+// be unique within s.CatalogId. If s.Selector is set to a non-empty string,
+// it must be a valid selector.
 //
 // An attribute of s will be set to NULL in the database if the attribute
 // in s is the zero value and it is included in fieldMaskPaths.
@@ -106,10 +116,18 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 		return nil, nil, db.NoRowsAffected, fmt.Errorf("update: static host set: no scopeId: %w", db.ErrInvalidParameter)
 	}
 
+	s = s.clone()
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
+		case strings.EqualFold("Selector", f):
+			s.Selector = strings.TrimSpace(s.Selector)
+			if s.Selector != "" {
+				if err := ValidateSelector(s.Selector); err != nil {
+					return nil, nil, db.NoRowsAffected, fmt.Errorf("update: static host set: %w", err)
+				}
+			}
 		default:
 			return nil, nil, db.NoRowsAffected, fmt.Errorf("update: static host set: field: %s: %w", f, db.ErrInvalidFieldMask)
 		}
@@ -119,6 +137,7 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 		map[string]interface{}{
 			"Name":        s.Name,
 			"Description": s.Description,
+			"Selector":    s.Selector,
 		},
 		fieldMaskPaths,
 		nil,
@@ -155,7 +174,7 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 			if err != nil {
 				return err
 			}
-			hosts, err = getHosts(ctx, reader, s.PublicId, limit)
+			hosts, err = getSetMembers(ctx, reader, returnedHostSet, limit)
 			return err
 		},
 	)
@@ -172,7 +191,9 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 }
 
 // LookupSet will look up a host set in the repository and return the host
-// set and the hosts assigned to the host set. If the host set is not
+// set and its members: the hosts added to the host set and, if the host set
+// has a selector, the hosts in its catalog whose attributes match the
+// selector. If the host set is not
 // found, it will return nil, nil, nil. The WithLimit option can be used to
 // limit the number of hosts returned. All other options are ignored.
 func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Option) (*HostSet, []*Host, error) {
@@ -199,7 +220,7 @@ func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Opti
 			return err
 		}
 		var err error
-		hosts, err = getHosts(ctx, reader, s.PublicId, limit)
+		hosts, err = getSetMembers(ctx, reader, s, limit)
 		return err
 	})
 
@@ -210,8 +231,8 @@ func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Opti
 	return s, hosts, nil
 }
 
-// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
-// only option supported.
+// ListSets returns a slice of HostSets for the catalogId ordered by create
+// time. WithLimit and WithStartPageAfter are the only options supported.
 func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
 	if catalogId == "" {
 		return nil, fmt.Errorf("list: static host set: missing catalog id: %w", db.ErrInvalidParameter)
@@ -223,7 +244,7 @@ func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Opti
 		limit = opts.withLimit
 	}
 	var sets []*HostSet
-	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	if err != nil {
 		return nil, fmt.Errorf("list: static host set: %w", err)
 	}
@@ -149,6 +149,64 @@ func getHosts(ctx context.Context, reader db.Reader, setId string, limit int) ([
 	return hosts, nil
 }
 
+// getSetMembers returns the hosts added to s and, if s has a selector, the
+// hosts in the catalog of s whose attributes match the selector. The
+// alternate addresses and attributes of the hosts are set.
+func getSetMembers(ctx context.Context, reader db.Reader, s *HostSet, limit int) ([]*Host, error) {
+	if s.Selector == "" {
+		hosts, err := getHosts(ctx, reader, s.PublicId, limit)
+		if err != nil {
+			return nil, err
+		}
+		if err := setAlternateAddresses(ctx, reader, hosts...); err != nil {
+			return nil, fmt.Errorf("get hosts: %w", err)
+		}
+		if err := setHostAttributes(ctx, reader, hosts...); err != nil {
+			return nil, fmt.Errorf("get hosts: %w", err)
+		}
+		return hosts, nil
+	}
+
+	added, err := getHosts(ctx, reader, s.PublicId, unlimited)
+	if err != nil {
+		return nil, err
+	}
+	var catalogHosts []*Host
+	if err := reader.SearchWhere(ctx, &catalogHosts, "catalog_id = ?", []interface{}{s.CatalogId}, db.WithLimit(unlimited)); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	if err := setHostAttributes(ctx, reader, catalogHosts...); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	selected, err := matchHosts(s.Selector, catalogHosts)
+	if err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+
+	var hosts []*Host
+	seen := make(map[string]bool, len(added)+len(selected))
+	for _, h := range append(added, selected...) {
+		if seen[h.PublicId] {
+			continue
+		}
+		seen[h.PublicId] = true
+		hosts = append(hosts, h)
+		if limit > 0 && len(hosts) == limit {
+			break
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	if err := setAlternateAddresses(ctx, reader, hosts...); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	if err := setHostAttributes(ctx, reader, hosts...); err != nil {
+		return nil, fmt.Errorf("get hosts: %w", err)
+	}
+	return hosts, nil
+}
+
 // DeleteSetMembers deletes hostIds from setId in the repository. It
 // returns the number of hosts deleted from the set. The version must match
 // the current version of the setId in the repository.
@@ -773,6 +773,86 @@ func TestRepository_LookupSet(t *testing.T) {
 	}
 }
 
+func TestRepository_LookupSet_Selector(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	newHost := func(attrs map[string]string) *Host {
+		h, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithAttributes(attrs))
+		require.NoError(err)
+		h, err = repo.CreateHost(ctx, prj.PublicId, h)
+		require.NoError(err)
+		return h
+	}
+	proddb := newHost(map[string]string{"env": "prod", "role": "db"})
+	newHost(map[string]string{"env": "prod", "role": "web"})
+	newHost(map[string]string{"env": "dev", "role": "db"})
+	newHost(nil)
+	added := TestHosts(t, conn, catalog.PublicId, 1)[0]
+
+	bad, err := NewHostSet(catalog.PublicId, WithSelector("env =="))
+	require.NoError(err)
+	_, err = repo.CreateSet(ctx, prj.PublicId, bad)
+	assert.Truef(errors.Is(err, ErrInvalidSelector), "want err: %q got: %q", ErrInvalidSelector, err)
+
+	s, err := NewHostSet(catalog.PublicId, WithSelector(`env == "prod" and role == "db"`))
+	require.NoError(err)
+	s, err = repo.CreateSet(ctx, prj.PublicId, s)
+	require.NoError(err)
+
+	_, gotHosts, err := repo.LookupSet(ctx, s.PublicId)
+	require.NoError(err)
+	require.Len(gotHosts, 1)
+	assert.Equal(proddb.PublicId, gotHosts[0].PublicId)
+	assert.Equal(map[string]string{"env": "prod", "role": "db"}, gotHosts[0].Attributes)
+
+	// Hosts added to the set are members along with the selected hosts.
+	_, err = repo.AddSetMembers(ctx, prj.PublicId, s.PublicId, s.Version, []string{added.PublicId, proddb.PublicId})
+	require.NoError(err)
+	got, gotHosts, err := repo.LookupSet(ctx, s.PublicId)
+	require.NoError(err)
+	var ids []string
+	for _, h := range gotHosts {
+		ids = append(ids, h.PublicId)
+	}
+	assert.ElementsMatch([]string{added.PublicId, proddb.PublicId}, ids)
+
+	// Membership follows changes to the attributes of hosts.
+	updHost := proddb.clone()
+	updHost.Attributes = map[string]string{"env": "staging", "role": "db"}
+	_, _, err = repo.UpdateHost(ctx, prj.PublicId, updHost, proddb.Version, []string{"Attributes"})
+	require.NoError(err)
+	upd := got.clone()
+	upd.Selector = `role == "db"`
+	got, gotHosts, n, err := repo.UpdateSet(ctx, prj.PublicId, upd, got.Version, []string{"Selector"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(`role == "db"`, got.Selector)
+	assert.Len(gotHosts, 3)
+
+	upd = got.clone()
+	upd.Selector = ""
+	got, gotHosts, n, err = repo.UpdateSet(ctx, prj.PublicId, upd, got.Version, []string{"Selector"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Empty(got.Selector)
+	ids = nil
+	for _, h := range gotHosts {
+		ids = append(ids, h.PublicId)
+	}
+	assert.ElementsMatch([]string{added.PublicId, proddb.PublicId}, ids)
+}
+
 func TestRepository_LookupSet_Limits(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
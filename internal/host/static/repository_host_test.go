@@ -111,6 +111,69 @@ func TestRepository_CreateHost(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-with-port-and-alternate-addresses",
+			in: &Host{
+				Host: &store.Host{
+					CatalogId:          catalog.PublicId,
+					Address:            "127.0.0.1",
+					Port:               2222,
+					AlternateAddresses: []string{" ::1 ", "localhost"},
+				},
+			},
+			want: &Host{
+				Host: &store.Host{
+					CatalogId:          catalog.PublicId,
+					Address:            "127.0.0.1",
+					Port:               2222,
+					AlternateAddresses: []string{"::1", "localhost"},
+				},
+			},
+		},
+		{
+			name: "invalid-port",
+			in: &Host{
+				Host: &store.Host{
+					CatalogId: catalog.PublicId,
+					Address:   "127.0.0.1",
+					Port:      65536,
+				},
+			},
+			wantIsErr: ErrInvalidPort,
+		},
+		{
+			name: "invalid-alternate-address-to-short",
+			in: &Host{
+				Host: &store.Host{
+					CatalogId:          catalog.PublicId,
+					Address:            "127.0.0.1",
+					AlternateAddresses: []string{"12"},
+				},
+			},
+			wantIsErr: ErrInvalidAddress,
+		},
+		{
+			name: "invalid-duplicate-alternate-addresses",
+			in: &Host{
+				Host: &store.Host{
+					CatalogId:          catalog.PublicId,
+					Address:            "127.0.0.1",
+					AlternateAddresses: []string{"::1", "::1"},
+				},
+			},
+			wantIsErr: ErrInvalidAddress,
+		},
+		{
+			name: "invalid-alternate-address-same-as-address",
+			in: &Host{
+				Host: &store.Host{
+					CatalogId:          catalog.PublicId,
+					Address:            "127.0.0.1",
+					AlternateAddresses: []string{"127.0.0.1"},
+				},
+			},
+			wantIsErr: ErrInvalidAddress,
+		},
 		{
 			name: "invalid-no-address",
 			in: &Host{
@@ -162,8 +225,15 @@ func TestRepository_CreateHost(t *testing.T) {
 			assert.NotSame(tt.in, got)
 			assert.Equal(tt.want.Name, got.Name)
 			assert.Equal(tt.want.Description, got.Description)
+			assert.Equal(tt.want.Port, got.Port)
+			assert.Equal(tt.want.AlternateAddresses, got.AlternateAddresses)
 			assert.Equal(got.CreateTime, got.UpdateTime)
 			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+			found, err := repo.LookupHost(context.Background(), got.PublicId)
+			require.NoError(err)
+			assert.Equal(tt.want.Port, found.Port)
+			assert.Equal(tt.want.AlternateAddresses, found.AlternateAddresses)
 		})
 	}
 
@@ -731,6 +801,125 @@ func TestRepository_UpdateHost(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdateHost_PortAndAlternateAddresses(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	h, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithAlternateAddresses([]string{"::1"}))
+	require.NoError(err)
+	h, err = repo.CreateHost(ctx, prj.PublicId, h)
+	require.NoError(err)
+	require.Equal([]string{"::1"}, h.AlternateAddresses)
+
+	upd := h.clone()
+	upd.AlternateAddresses = []string{"localhost", "::1"}
+	got, n, err := repo.UpdateHost(ctx, prj.PublicId, upd, h.Version, []string{"AlternateAddresses"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(h.Version+1, got.Version)
+	assert.Equal([]string{"localhost", "::1"}, got.AlternateAddresses)
+	assert.NoError(db.TestVerifyOplog(t, rw, h.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+
+	upd = got.clone()
+	upd.Port = 2222
+	upd.AlternateAddresses = nil
+	got, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, got.Version, []string{"Port"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(uint32(2222), got.Port)
+	assert.Equal([]string{"localhost", "::1"}, got.AlternateAddresses, "alternate addresses not in the mask are kept")
+
+	upd = got.clone()
+	upd.Port = 0
+	upd.AlternateAddresses = nil
+	got, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, got.Version, []string{"Port", "AlternateAddresses"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Zero(got.Port)
+	assert.Empty(got.AlternateAddresses)
+
+	found, err := repo.LookupHost(ctx, h.PublicId)
+	require.NoError(err)
+	assert.Zero(found.Port)
+	assert.Empty(found.AlternateAddresses)
+
+	upd = found.clone()
+	upd.Port = 65536
+	_, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, found.Version, []string{"Port"})
+	assert.Truef(errors.Is(err, ErrInvalidPort), "want err: %q got: %q", ErrInvalidPort, err)
+	assert.Equal(db.NoRowsAffected, n)
+
+	upd.AlternateAddresses = []string{"::1", "::1"}
+	_, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, found.Version, []string{"AlternateAddresses"})
+	assert.Truef(errors.Is(err, ErrInvalidAddress), "want err: %q got: %q", ErrInvalidAddress, err)
+	assert.Equal(db.NoRowsAffected, n)
+}
+
+func TestRepository_UpdateHost_Attributes(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	h, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithAttributes(map[string]string{" env ": "prod"}))
+	require.NoError(err)
+	h, err = repo.CreateHost(ctx, prj.PublicId, h)
+	require.NoError(err)
+	require.Equal(map[string]string{"env": "prod"}, h.Attributes)
+
+	upd := h.clone()
+	upd.Attributes = map[string]string{"env": "dev", "role": "db"}
+	got, n, err := repo.UpdateHost(ctx, prj.PublicId, upd, h.Version, []string{"Attributes"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(h.Version+1, got.Version)
+	assert.Equal(map[string]string{"env": "dev", "role": "db"}, got.Attributes)
+	assert.NoError(db.TestVerifyOplog(t, rw, h.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+
+	upd = got.clone()
+	upd.Name = "db-1"
+	upd.Attributes = nil
+	got, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, got.Version, []string{"Name"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(map[string]string{"env": "dev", "role": "db"}, got.Attributes, "attributes not in the mask are kept")
+
+	upd = got.clone()
+	upd.Attributes = nil
+	got, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, got.Version, []string{"Attributes"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Empty(got.Attributes)
+
+	found, err := repo.LookupHost(ctx, h.PublicId)
+	require.NoError(err)
+	assert.Empty(found.Attributes)
+
+	upd = found.clone()
+	upd.Attributes = map[string]string{" ": "prod"}
+	_, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, found.Version, []string{"Attributes"})
+	assert.Truef(errors.Is(err, ErrInvalidAttribute), "want err: %q got: %q", ErrInvalidAttribute, err)
+	assert.Equal(db.NoRowsAffected, n)
+}
+
 func TestRepository_LookupHost(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -0,0 +1,68 @@
+package static
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr"
+)
+
+// ValidateSelector returns an error if s is not a selector which can be
+// matched against the attributes of hosts. Selectors are boolean
+// expressions in the format understood by hashicorp/go-bexpr, such as
+// `env == "prod" and role == "db"`.
+func ValidateSelector(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return fmt.Errorf("empty selector: %w", ErrInvalidSelector)
+	}
+	if _, err := bexpr.CreateEvaluator(s); err != nil {
+		return fmt.Errorf("%q: %v: %w", s, err, ErrInvalidSelector)
+	}
+	return nil
+}
+
+// matchHosts returns the hosts whose attributes match selector. A host
+// which is missing an attribute referenced by selector does not match.
+func matchHosts(selector string, hosts []*Host) ([]*Host, error) {
+	eval, err := bexpr.CreateEvaluator(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v: %w", selector, err, ErrInvalidSelector)
+	}
+	var matched []*Host
+	for _, h := range hosts {
+		attrs := h.Attributes
+		if attrs == nil {
+			attrs = map[string]string{}
+		}
+		// An error results from a selector referencing an attribute the
+		// host doesn't have, which is not a match.
+		if ok, err := eval.Evaluate(attrs); err == nil && ok {
+			matched = append(matched, h)
+		}
+	}
+	return matched, nil
+}
+
+// cleanAttributes returns the attributes with the spaces around their keys
+// removed. Keys must not be empty or longer than MaxHostAttributeKeyLength
+// and values must not be longer than MaxHostAttributeValueLength.
+func cleanAttributes(attrs map[string]string) (map[string]string, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	cleaned := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		k = strings.TrimSpace(k)
+		if k == "" || len(k) > MaxHostAttributeKeyLength {
+			return nil, fmt.Errorf("bad attribute key: %q: %w", k, ErrInvalidAttribute)
+		}
+		if len(v) > MaxHostAttributeValueLength {
+			return nil, fmt.Errorf("bad attribute value for key: %q: %w", k, ErrInvalidAttribute)
+		}
+		if _, ok := cleaned[k]; ok {
+			return nil, fmt.Errorf("duplicate attribute key: %q: %w", k, ErrInvalidAttribute)
+		}
+		cleaned[k] = v
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,135 @@
+package static
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSelector(t *testing.T) {
+	var tests = []struct {
+		name     string
+		selector string
+		wantErr  bool
+	}{
+		{
+			name:     "blank",
+			selector: " ",
+			wantErr:  true,
+		},
+		{
+			name:     "unparsable",
+			selector: `env ==`,
+			wantErr:  true,
+		},
+		{
+			name:     "valid",
+			selector: `env == "prod" and role == "db"`,
+		},
+		{
+			name:     "valid-in",
+			selector: `"db" in role`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSelector(tt.selector)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidSelector), "want err: %q got: %q", ErrInvalidSelector, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMatchHosts(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	newHost := func(id string, attrs map[string]string) *Host {
+		return &Host{Host: &store.Host{PublicId: id, Attributes: attrs}}
+	}
+	hosts := []*Host{
+		newHost("proddb", map[string]string{"env": "prod", "role": "db"}),
+		newHost("prodweb", map[string]string{"env": "prod", "role": "web"}),
+		newHost("devdb", map[string]string{"env": "dev", "role": "db"}),
+		newHost("norole", map[string]string{"env": "prod"}),
+		newHost("none", nil),
+	}
+	ids := func(hosts []*Host) []string {
+		var ids []string
+		for _, h := range hosts {
+			ids = append(ids, h.PublicId)
+		}
+		return ids
+	}
+
+	got, err := matchHosts(`env == "prod" and role == "db"`, hosts)
+	require.NoError(err)
+	assert.Equal([]string{"proddb"}, ids(got))
+
+	got, err = matchHosts(`env == "prod"`, hosts)
+	require.NoError(err)
+	assert.Equal([]string{"proddb", "prodweb", "norole"}, ids(got))
+
+	got, err = matchHosts(`role != "web"`, hosts)
+	require.NoError(err)
+	assert.Equal([]string{"proddb", "devdb"}, ids(got), "hosts without the attribute don't match")
+
+	_, err = matchHosts(`env ==`, hosts)
+	assert.Truef(errors.Is(err, ErrInvalidSelector), "want err: %q got: %q", ErrInvalidSelector, err)
+}
+
+func TestCleanAttributes(t *testing.T) {
+	var tests = []struct {
+		name    string
+		in      map[string]string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "trims-keys",
+			in:   map[string]string{" env ": "prod", "role": ""},
+			want: map[string]string{"env": "prod", "role": ""},
+		},
+		{
+			name:    "blank-key",
+			in:      map[string]string{" ": "prod"},
+			wantErr: true,
+		},
+		{
+			name:    "key-too-long",
+			in:      map[string]string{strings.Repeat("k", MaxHostAttributeKeyLength+1): "prod"},
+			wantErr: true,
+		},
+		{
+			name:    "value-too-long",
+			in:      map[string]string{"env": strings.Repeat("v", MaxHostAttributeValueLength+1)},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate-key-after-trim",
+			in:      map[string]string{"env": "prod", " env": "dev"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cleanAttributes(tt.in)
+			if tt.wantErr {
+				assert.Truef(t, errors.Is(err, ErrInvalidAttribute), "want err: %q got: %q", ErrInvalidAttribute, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,515 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pbcatalogs "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hosts"
+	pbsets "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostsets"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var (
+	staticCatalogMask handlers.MaskManager
+	staticSetMask     handlers.MaskManager
+	staticHostMask    handlers.MaskManager
+)
+
+func init() {
+	var err error
+	if staticCatalogMask, err = handlers.NewMaskManager(&store.HostCatalog{}, &pbcatalogs.HostCatalog{}); err != nil {
+		panic(err)
+	}
+	if staticSetMask, err = handlers.NewMaskManager(&store.HostSet{}, &pbsets.HostSet{}, &pbsets.StaticHostSetAttributes{}); err != nil {
+		panic(err)
+	}
+	if staticHostMask, err = handlers.NewMaskManager(&store.Host{}, &pb.Host{}, &pb.StaticHostAttributes{}); err != nil {
+		panic(err)
+	}
+}
+
+// NewStaticCatalog returns the Catalog of static host catalogs. The hosts of
+// a static host set are the hosts added to it and the hosts in its catalog
+// whose attributes match its selector.
+func NewStaticCatalog(repoFn func() (*static.Repository, error)) Catalog {
+	return &staticCatalog{repoFn: repoFn}
+}
+
+type staticCatalog struct {
+	repoFn func() (*static.Repository, error)
+}
+
+var (
+	_ HostWriter      = (*staticCatalog)(nil)
+	_ SetMemberWriter = (*staticCatalog)(nil)
+)
+
+func (c *staticCatalog) Type() string {
+	return StaticSubtype.String()
+}
+
+func (c *staticCatalog) Prefixes() Prefixes {
+	return Prefixes{
+		HostCatalog: static.HostCatalogPrefix,
+		HostSet:     static.HostSetPrefix,
+		Host:        static.HostPrefix,
+	}
+}
+
+func (c *staticCatalog) CreateCatalog(ctx context.Context, hc *HostCatalog) (*HostCatalog, error) {
+	in, err := static.NewHostCatalog(hc.ScopeId, static.WithName(hc.Name), static.WithDescription(hc.Description))
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateCatalog(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return staticHostCatalog(out), nil
+}
+
+func (c *staticCatalog) LookupCatalog(ctx context.Context, id string) (*HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hc, err := repo.LookupCatalog(ctx, id)
+	if err != nil || hc == nil {
+		return nil, err
+	}
+	return staticHostCatalog(hc), nil
+}
+
+func (c *staticCatalog) ListCatalogs(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*HostCatalog, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hcs, err := repo.ListCatalogs(ctx, scopeIds, static.WithStartPageAfter(cursor), static.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*HostCatalog, 0, len(hcs))
+	for _, hc := range hcs {
+		ret = append(ret, staticHostCatalog(hc))
+	}
+	return ret, nil
+}
+
+func (c *staticCatalog) UpdateCatalog(ctx context.Context, hc *HostCatalog, version uint32, fieldPaths []string) (*HostCatalog, int, error) {
+	dbMask := staticCatalogMask.Translate(fieldPaths)
+	if len(dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: static host catalog: %w", db.ErrEmptyFieldMask)
+	}
+	in, err := static.NewHostCatalog(hc.ScopeId, static.WithName(hc.Name), static.WithDescription(hc.Description))
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = hc.PublicId
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	out, rowsUpdated, err := repo.UpdateCatalog(ctx, in, version, dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	return staticHostCatalog(out), rowsUpdated, nil
+}
+
+func (c *staticCatalog) DeleteCatalog(ctx context.Context, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteCatalog(ctx, id)
+}
+
+func (c *staticCatalog) CreateSet(ctx context.Context, scopeId string, s *Set) (*Set, error) {
+	in, err := newStaticSet(s)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateSet(ctx, scopeId, in)
+	if err != nil {
+		return nil, err
+	}
+	return staticSet(out, nil)
+}
+
+func (c *staticCatalog) LookupSet(ctx context.Context, id string) (*Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	s, hosts, err := repo.LookupSet(ctx, id)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	return staticSet(s, hosts)
+}
+
+func (c *staticCatalog) ListSets(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Set, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	sets, err := repo.ListSets(ctx, catalogId, static.WithStartPageAfter(cursor), static.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Set, 0, len(sets))
+	for _, s := range sets {
+		out, err := staticSet(s, nil)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *staticCatalog) UpdateSet(ctx context.Context, scopeId string, s *Set, version uint32, fieldPaths []string) (*Set, int, error) {
+	dbMask := staticSetMask.Translate(fieldPaths)
+	if len(dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: static host set: %w", db.ErrEmptyFieldMask)
+	}
+	in, err := newStaticSet(s)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = s.PublicId
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	out, hosts, rowsUpdated, err := repo.UpdateSet(ctx, scopeId, in, version, dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := staticSet(out, hosts)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *staticCatalog) DeleteSet(ctx context.Context, scopeId, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteSet(ctx, scopeId, id)
+}
+
+func (c *staticCatalog) ListSetHosts(ctx context.Context, setId string) ([]*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	_, hosts, err := repo.LookupSet(ctx, setId, static.WithLimit(-1))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Host, 0, len(hosts))
+	for _, h := range hosts {
+		out, err := staticHost(h)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *staticCatalog) AddSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error {
+	repo, err := c.repoFn()
+	if err != nil {
+		return err
+	}
+	_, err = repo.AddSetMembers(ctx, scopeId, setId, version, hostIds)
+	return err
+}
+
+func (c *staticCatalog) SetSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error {
+	repo, err := c.repoFn()
+	if err != nil {
+		return err
+	}
+	_, _, err = repo.SetSetMembers(ctx, scopeId, setId, version, hostIds)
+	return err
+}
+
+func (c *staticCatalog) DeleteSetMembers(ctx context.Context, scopeId, setId string, version uint32, hostIds []string) error {
+	repo, err := c.repoFn()
+	if err != nil {
+		return err
+	}
+	_, err = repo.DeleteSetMembers(ctx, scopeId, setId, version, hostIds)
+	return err
+}
+
+func (c *staticCatalog) LookupHost(ctx context.Context, hostId string) (*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	h, err := repo.LookupHost(ctx, hostId)
+	if err != nil || h == nil {
+		return nil, err
+	}
+	return staticHost(h)
+}
+
+func (c *staticCatalog) ListHosts(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*Host, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := repo.ListHosts(ctx, catalogId, static.WithStartPageAfter(cursor), static.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Host, 0, len(hosts))
+	for _, h := range hosts {
+		out, err := staticHost(h)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, out)
+	}
+	return ret, nil
+}
+
+func (c *staticCatalog) CreateHost(ctx context.Context, scopeId string, h *Host) (*Host, error) {
+	in, err := newStaticHost(h)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateHost(ctx, scopeId, in)
+	if err != nil {
+		return nil, err
+	}
+	return staticHost(out)
+}
+
+func (c *staticCatalog) UpdateHost(ctx context.Context, scopeId string, h *Host, version uint32, fieldPaths []string) (*Host, int, error) {
+	dbMask := staticHostMask.Translate(fieldPaths)
+	if len(dbMask) == 0 {
+		return nil, db.NoRowsAffected, fmt.Errorf("update: static host: %w", db.ErrEmptyFieldMask)
+	}
+	in, err := newStaticHost(h)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	in.PublicId = h.PublicId
+	repo, err := c.repoFn()
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	out, rowsUpdated, err := repo.UpdateHost(ctx, scopeId, in, version, dbMask)
+	if err != nil || rowsUpdated == 0 {
+		return nil, rowsUpdated, err
+	}
+	ret, err := staticHost(out)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	return ret, rowsUpdated, nil
+}
+
+func (c *staticCatalog) DeleteHost(ctx context.Context, scopeId, id string) (int, error) {
+	repo, err := c.repoFn()
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	return repo.DeleteHost(ctx, scopeId, id)
+}
+
+// ValidateAttributes checks the port, alternate addresses and attributes of
+// a static host and the selector of a static host set. Static host catalogs
+// have no attributes.
+func (c *staticCatalog) ValidateAttributes(r resource.Type, attrs *structpb.Struct) map[string]string {
+	badFields := map[string]string{}
+	switch r {
+	case resource.Host:
+		validateStaticHost(attrs, badFields)
+	case resource.HostSet:
+		sa := &pbsets.StaticHostSetAttributes{}
+		if err := handlers.StructToProto(attrs, sa); err != nil {
+			badFields["attributes"] = "Attribute fields do not match the expected format."
+			break
+		}
+		if sa.GetSelector() == nil {
+			break
+		}
+		if sel := strings.TrimSpace(sa.GetSelector().GetValue()); sel != "" {
+			if err := static.ValidateSelector(sel); err != nil {
+				badFields["attributes.selector"] = fmt.Sprintf("Selector could not be parsed: %v.", err)
+			}
+		}
+	}
+	return badFields
+}
+
+// validateStaticHost checks the attributes of a static host.
+func validateStaticHost(attrs *structpb.Struct, badFields map[string]string) {
+	ha := &pb.StaticHostAttributes{}
+	if err := handlers.StructToProto(attrs, ha); err != nil {
+		badFields["attributes"] = "Attribute fields do not match the expected format."
+		return
+	}
+	if ha.GetPort() != nil && (ha.GetPort().GetValue() == 0 || ha.GetPort().GetValue() > static.MaxHostPort) {
+		badFields["attributes.port"] = fmt.Sprintf("Port must be between 1 and %d.", static.MaxHostPort)
+	}
+	seen := map[string]bool{strings.TrimSpace(ha.GetAddress().GetValue()): true}
+	for _, a := range ha.GetAlternateAddresses() {
+		a = strings.TrimSpace(a)
+		if len(a) < static.MinHostAddressLength || len(a) > static.MaxHostAddressLength {
+			badFields["attributes.alternate_addresses"] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
+			break
+		}
+		if _, _, err := net.SplitHostPort(a); err == nil {
+			badFields["attributes.alternate_addresses"] = "Addresses for static hosts do not support a port."
+			break
+		}
+		if seen[a] {
+			badFields["attributes.alternate_addresses"] = fmt.Sprintf("Address %q is repeated.", a)
+			break
+		}
+		seen[a] = true
+	}
+	for k, v := range ha.GetAttributes() {
+		k = strings.TrimSpace(k)
+		if k == "" || len(k) > static.MaxHostAttributeKeyLength {
+			badFields["attributes.attributes"] = fmt.Sprintf("Attribute keys must not be empty or longer than %d characters.", static.MaxHostAttributeKeyLength)
+			break
+		}
+		if len(v) > static.MaxHostAttributeValueLength {
+			badFields["attributes.attributes"] = fmt.Sprintf("Attribute values must not be longer than %d characters.", static.MaxHostAttributeValueLength)
+			break
+		}
+	}
+}
+
+// staticHostCatalog converts a static host catalog into a HostCatalog.
+func staticHostCatalog(hc *static.HostCatalog) *HostCatalog {
+	return &HostCatalog{
+		PublicId:    hc.GetPublicId(),
+		ScopeId:     hc.GetScopeId(),
+		Name:        hc.GetName(),
+		Description: hc.GetDescription(),
+		CreateTime:  hc.GetCreateTime().GetTimestamp(),
+		UpdateTime:  hc.GetUpdateTime().GetTimestamp(),
+		Version:     hc.GetVersion(),
+	}
+}
+
+// newStaticSet converts s into a static host set.
+func newStaticSet(s *Set) (*static.HostSet, error) {
+	sa := &pbsets.StaticHostSetAttributes{}
+	if err := handlers.StructToProto(s.Attributes, sa); err != nil {
+		return nil, fmt.Errorf("static host set attributes: %v: %w", err, db.ErrInvalidParameter)
+	}
+	opts := []static.Option{static.WithName(s.Name), static.WithDescription(s.Description)}
+	if sa.GetSelector() != nil {
+		opts = append(opts, static.WithSelector(sa.GetSelector().GetValue()))
+	}
+	return static.NewHostSet(s.CatalogId, opts...)
+}
+
+// staticSet converts a static host set, and its hosts, into a Set.
+func staticSet(s *static.HostSet, hosts []*static.Host) (*Set, error) {
+	out := &Set{
+		PublicId:    s.GetPublicId(),
+		CatalogId:   s.GetCatalogId(),
+		Name:        s.GetName(),
+		Description: s.GetDescription(),
+		CreateTime:  s.GetCreateTime().GetTimestamp(),
+		UpdateTime:  s.GetUpdateTime().GetTimestamp(),
+		Version:     s.GetVersion(),
+	}
+	for _, h := range hosts {
+		out.HostIds = append(out.HostIds, h.GetPublicId())
+	}
+	if s.GetSelector() != "" {
+		st, err := handlers.ProtoToStruct(&pbsets.StaticHostSetAttributes{Selector: wrapperspb.String(s.GetSelector())})
+		if err != nil {
+			return nil, fmt.Errorf("static host set attributes: %w", err)
+		}
+		out.Attributes = st
+	}
+	return out, nil
+}
+
+// newStaticHost converts h into a static host.
+func newStaticHost(h *Host) (*static.Host, error) {
+	ha := &pb.StaticHostAttributes{}
+	if err := handlers.StructToProto(h.Attributes, ha); err != nil {
+		return nil, fmt.Errorf("static host attributes: %v: %w", err, db.ErrInvalidParameter)
+	}
+	opts := []static.Option{static.WithName(h.Name), static.WithDescription(h.Description)}
+	if ha.GetAddress() != nil {
+		opts = append(opts, static.WithAddress(ha.GetAddress().GetValue()))
+	}
+	if ha.GetPort() != nil {
+		opts = append(opts, static.WithPort(ha.GetPort().GetValue()))
+	}
+	if len(ha.GetAlternateAddresses()) > 0 {
+		opts = append(opts, static.WithAlternateAddresses(ha.GetAlternateAddresses()))
+	}
+	if len(ha.GetAttributes()) > 0 {
+		opts = append(opts, static.WithAttributes(ha.GetAttributes()))
+	}
+	return static.NewHost(h.CatalogId, opts...)
+}
+
+// staticHost converts a static host into a Host.
+func staticHost(h *static.Host) (*Host, error) {
+	attrs := &pb.StaticHostAttributes{
+		Address:            wrapperspb.String(h.GetAddress()),
+		AlternateAddresses: h.GetAlternateAddresses(),
+		Attributes:         h.GetAttributes(),
+	}
+	if h.GetPort() != 0 {
+		attrs.Port = wrapperspb.UInt32(h.GetPort())
+	}
+	st, err := handlers.ProtoToStruct(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("static host attributes: %w", err)
+	}
+	return &Host{
+		PublicId:           h.GetPublicId(),
+		CatalogId:          h.GetCatalogId(),
+		Name:               h.GetName(),
+		Description:        h.GetDescription(),
+		Address:            h.GetAddress(),
+		Port:               h.GetPort(),
+		AlternateAddresses: h.GetAlternateAddresses(),
+		Attributes:         st,
+		CreateTime:         h.GetCreateTime().GetTimestamp(),
+		UpdateTime:         h.GetUpdateTime().GetTimestamp(),
+		Version:            h.GetVersion(),
+	}, nil
+}
@@ -0,0 +1,18 @@
+package host
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegistry returns a Registry of the static and dns catalogs which use
+// the repositories returned by staticRepoFn and dnsRepoFn.
+func TestRegistry(t *testing.T, staticRepoFn func() (*static.Repository, error), dnsRepoFn func() (*dns.Repository, error)) *Registry {
+	t.Helper()
+	r, err := NewRegistry(NewStaticCatalog(staticRepoFn), NewDnsCatalog(dnsRepoFn))
+	require.NoError(t, err)
+	return r
+}
@@ -1,6 +1,10 @@
 package iam
 
-import "io"
+import (
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -29,6 +33,7 @@ type options struct {
 	withSkipDefaultRoleCreation bool
 	withUserId                  string
 	withRandomReader            io.Reader
+	withStartPageAfter          *db.PageCursor
 }
 
 func getDefaultOptions() options {
@@ -142,3 +147,11 @@ func WithRandomReader(reader io.Reader) Option {
 		o.withRandomReader = reader
 	}
 }
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
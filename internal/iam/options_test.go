@@ -2,7 +2,9 @@ package iam
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,4 +76,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withDisassociate = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		cursor := &db.PageCursor{CreateTime: time.Now(), PublicId: "u_1234567890"}
+		opts := getOpts(WithStartPageAfter(cursor))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfter = cursor
+		assert.Equal(opts, testOpts)
+	})
 }
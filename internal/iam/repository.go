@@ -55,12 +55,24 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 // repo defaultLimit
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	opts := getOpts(opt...)
-	limit := r.defaultLimit
+	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(r.limit(opts)))
+}
+
+// listPage will return a page of a listing of resources ordered by db.PageOrder
+// and honor the WithLimit and WithStartPageAfter options.  The resources must
+// have a public id.
+func (r *Repository) listPage(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
+	opts := getOpts(opt...)
+	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(r.limit(opts)), db.WithStartPageAfter(opts.withStartPageAfter))
+}
+
+// limit returns the WithLimit option or the repo defaultLimit
+func (r *Repository) limit(opts options) int {
 	if opts.withLimit != 0 {
 		// non-zero signals an override of the default limit for the repo.
-		limit = opts.withLimit
+		return opts.withLimit
 	}
-	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit))
+	return r.defaultLimit
 }
 
 // create will create a new iam resource in the db repository with an oplog entry
@@ -166,13 +166,14 @@ func (r *Repository) DeleteGroup(ctx context.Context, withPublicId string, opt .
 	return rowsDeleted, nil
 }
 
-// ListGroups in a scope and supports WithLimit option.
-func (r *Repository) ListGroups(ctx context.Context, withScopeId string, opt ...Option) ([]*Group, error) {
-	if withScopeId == "" {
+// ListGroups in the scopes ordered by create time and supports the WithLimit
+// and WithStartPageAfter options.
+func (r *Repository) ListGroups(ctx context.Context, withScopeIds []string, opt ...Option) ([]*Group, error) {
+	if len(withScopeIds) == 0 {
 		return nil, fmt.Errorf("list groups: missing scope id %w", db.ErrInvalidParameter)
 	}
 	var grps []*Group
-	err := r.list(ctx, &grps, "scope_id = ?", []interface{}{withScopeId}, opt...)
+	err := r.listPage(ctx, &grps, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
 	if err != nil {
 		return nil, fmt.Errorf("list groups: %w", err)
 	}
@@ -630,7 +630,7 @@ func TestRepository_ListGroups(t *testing.T) {
 				testGroups = append(testGroups, TestGroup(t, conn, tt.createScopeId))
 			}
 			assert.Equal(tt.createCnt, len(testGroups))
-			got, err := repo.ListGroups(context.Background(), tt.args.withScopeId, tt.args.opt...)
+			got, err := repo.ListGroups(context.Background(), []string{tt.args.withScopeId}, tt.args.opt...)
 			if tt.wantErr {
 				require.Error(err)
 				return
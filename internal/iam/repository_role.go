@@ -177,13 +177,14 @@ func (r *Repository) DeleteRole(ctx context.Context, withPublicId string, opt ..
 	return rowsDeleted, nil
 }
 
-// ListRoles in a scope and supports WithLimit option.
-func (r *Repository) ListRoles(ctx context.Context, withScopeId string, opt ...Option) ([]*Role, error) {
-	if withScopeId == "" {
+// ListRoles in the scopes ordered by create time and supports the WithLimit and
+// WithStartPageAfter options.
+func (r *Repository) ListRoles(ctx context.Context, withScopeIds []string, opt ...Option) ([]*Role, error) {
+	if len(withScopeIds) == 0 {
 		return nil, fmt.Errorf("list roles: missing scope id %w", db.ErrInvalidParameter)
 	}
 	var roles []*Role
-	err := r.list(ctx, &roles, "scope_id = ?", []interface{}{withScopeId}, opt...)
+	err := r.listPage(ctx, &roles, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
 	if err != nil {
 		return nil, fmt.Errorf("list roles: %w", err)
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -564,3 +565,35 @@ func TestRepository_SetRoleGrants_Parameters(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_GrantsForUser(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+
+	userRole := TestRole(t, conn, proj.PublicId)
+	TestRoleGrant(t, conn, userRole.PublicId, "id=*;type=*;actions=read")
+	TestUserRole(t, conn, userRole.PublicId, user.PublicId)
+
+	group := TestGroup(t, conn, org.PublicId)
+	TestGroupMember(t, conn, group.PublicId, user.PublicId)
+	groupRole := TestRole(t, conn, org.PublicId)
+	TestRoleGrant(t, conn, groupRole.PublicId, "id=*;type=group;actions=update")
+	TestGroupRole(t, conn, groupRole.PublicId, group.PublicId)
+
+	_, err := repo.GrantsForUser(context.Background(), "")
+	assert.True(errors.Is(err, db.ErrInvalidParameter))
+
+	grants, err := repo.GrantsForUser(context.Background(), user.PublicId)
+	require.NoError(err)
+	found := map[string]perms.GrantPair{}
+	for _, g := range grants {
+		found[g.Grant] = g
+	}
+	assert.Equal(perms.GrantPair{RoleId: userRole.PublicId, ScopeId: proj.PublicId, Grant: "id=*;type=*;actions=read"}, found["id=*;type=*;actions=read"])
+	assert.Equal(perms.GrantPair{RoleId: groupRole.PublicId, ScopeId: org.PublicId, Grant: "id=*;type=group;actions=update"}, found["id=*;type=group;actions=update"])
+}
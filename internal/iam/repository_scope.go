@@ -290,7 +290,7 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 						return fmt.Errorf("unable to create in memory role grant: %w", err)
 					}
 					grants = append(grants, roleGrant)
-					roleGrant, err = NewRoleGrant(defaultRolePublicId, "id={{account.id}};actions=read,change-password")
+					roleGrant, err = NewRoleGrant(defaultRolePublicId, "id={{account.id}};actions=read,change-password,enroll-totp,verify-totp")
 					if err != nil {
 						return fmt.Errorf("unable to create in memory role grant: %w", err)
 					}
@@ -420,23 +420,40 @@ func (r *Repository) DeleteScope(ctx context.Context, withPublicId string, opt .
 	return rowsDeleted, nil
 }
 
-// ListProjects in an org and supports the WithLimit option.
+// ListScopes returns the scopes whose parent is one of the provided scopes,
+// ordered by create time.  Supports the WithLimit and WithStartPageAfter
+// options.
+func (r *Repository) ListScopes(ctx context.Context, withParentIds []string, opt ...Option) ([]*Scope, error) {
+	if len(withParentIds) == 0 {
+		return nil, fmt.Errorf("list scopes: missing parent id %w", db.ErrInvalidParameter)
+	}
+	var scopes []*Scope
+	err := r.listPage(ctx, &scopes, "parent_id in (?)", []interface{}{withParentIds}, opt...)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes: %w", err)
+	}
+	return scopes, nil
+}
+
+// ListProjects in an org ordered by create time and supports the WithLimit and
+// WithStartPageAfter options.
 func (r *Repository) ListProjects(ctx context.Context, withOrgId string, opt ...Option) ([]*Scope, error) {
 	if withOrgId == "" {
 		return nil, fmt.Errorf("list projects: missing org id %w", db.ErrInvalidParameter)
 	}
 	var projects []*Scope
-	err := r.list(ctx, &projects, "parent_id = ? and type = ?", []interface{}{withOrgId, scope.Project.String()}, opt...)
+	err := r.listPage(ctx, &projects, "parent_id = ? and type = ?", []interface{}{withOrgId, scope.Project.String()}, opt...)
 	if err != nil {
 		return nil, fmt.Errorf("list projects: %w", err)
 	}
 	return projects, nil
 }
 
-// ListOrgs and supports the WithLimit option.
+// ListOrgs ordered by create time and supports the WithLimit and
+// WithStartPageAfter options.
 func (r *Repository) ListOrgs(ctx context.Context, opt ...Option) ([]*Scope, error) {
 	var orgs []*Scope
-	err := r.list(ctx, &orgs, "parent_id = ? and type = ?", []interface{}{"global", scope.Org.String()}, opt...)
+	err := r.listPage(ctx, &orgs, "parent_id = ? and type = ?", []interface{}{"global", scope.Org.String()}, opt...)
 	if err != nil {
 		return nil, fmt.Errorf("list orgs: %w", err)
 	}
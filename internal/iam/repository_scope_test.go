@@ -120,7 +120,7 @@ func Test_Repository_Scope_Create(t *testing.T) {
 			require.NoError(err)
 			assert.True(proto.Equal(foundScope, s))
 
-			foundRoles, err := repo.ListRoles(context.Background(), foundScope.GetPublicId())
+			foundRoles, err := repo.ListRoles(context.Background(), []string{foundScope.GetPublicId()})
 			require.NoError(err)
 			numFound := 2
 			if skipCreate {
@@ -615,3 +615,33 @@ func Test_Repository_ListOrgs(t *testing.T) {
 		})
 	}
 }
+
+func Test_Repository_ListScopes(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	ctx := context.Background()
+
+	org1, proj1 := TestScopes(t, repo)
+	org2, proj2 := TestScopes(t, repo)
+
+	got, err := repo.ListScopes(ctx, []string{org1.PublicId, org2.PublicId})
+	require.NoError(err)
+	require.Len(got, 2)
+	assert.Equal(proj1.PublicId, got[0].PublicId)
+	assert.Equal(proj2.PublicId, got[1].PublicId)
+
+	got, err = repo.ListScopes(ctx, []string{org2.PublicId})
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(proj2.PublicId, got[0].PublicId)
+
+	got, err = repo.ListScopes(ctx, []string{proj1.PublicId})
+	require.NoError(err)
+	assert.Empty(got)
+
+	_, err = repo.ListScopes(ctx, nil)
+	assert.Error(err)
+}
@@ -194,13 +194,14 @@ func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, opt ..
 	return rowsDeleted, nil
 }
 
-// ListUsers in an org and supports the WithLimit option.
-func (r *Repository) ListUsers(ctx context.Context, withOrgId string, opt ...Option) ([]*User, error) {
-	if withOrgId == "" {
-		return nil, fmt.Errorf("list users: missing org id %w", db.ErrInvalidParameter)
+// ListUsers in the scopes ordered by create time and supports the WithLimit and
+// WithStartPageAfter options.
+func (r *Repository) ListUsers(ctx context.Context, withScopeIds []string, opt ...Option) ([]*User, error) {
+	if len(withScopeIds) == 0 {
+		return nil, fmt.Errorf("list users: missing scope id %w", db.ErrInvalidParameter)
 	}
 	var users []*User
-	err := r.list(ctx, &users, "scope_id = ?", []interface{}{withOrgId}, opt...)
+	err := r.listPage(ctx, &users, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
 	if err != nil {
 		return nil, fmt.Errorf("list users: %w", err)
 	}
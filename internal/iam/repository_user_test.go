@@ -530,7 +530,7 @@ func TestRepository_ListUsers(t *testing.T) {
 				testUsers = append(testUsers, TestUser(t, repo, org.PublicId))
 			}
 			assert.Equal(tt.createCnt, len(testUsers))
-			got, err := repo.ListUsers(context.Background(), tt.args.withOrgId, tt.args.opt...)
+			got, err := repo.ListUsers(context.Background(), []string{tt.args.withOrgId}, tt.args.opt...)
 			if tt.wantErr {
 				require.Error(err)
 				return
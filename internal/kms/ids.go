@@ -17,6 +17,7 @@ const (
 	TokenKeyVersionPrefix    = "ktv"
 	SessionKeyPrefix         = "ksk"
 	SessionKeyVersionPrefix  = "kskv"
+	AuditKeyPrefix           = "kak"
 )
 
 func newRootKeyId() (string, error) {
@@ -98,3 +99,11 @@ func newSessionKeyVersionId() (string, error) {
 	}
 	return id, nil
 }
+
+func newAuditKeyId() (string, error) {
+	id, err := db.NewPublicId(AuditKeyPrefix)
+	if err != nil {
+		return "", fmt.Errorf("new audit key id: %w", err)
+	}
+	return id, nil
+}
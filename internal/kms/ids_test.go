@@ -60,4 +60,9 @@ func Test_Ids(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, SessionKeyVersionPrefix+"_"))
 	})
+	t.Run("kak", func(t *testing.T) {
+		id, err := newAuditKeyId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, AuditKeyPrefix+"_"))
+	})
 }
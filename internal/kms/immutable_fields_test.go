@@ -293,15 +293,6 @@ func TestDatabaseKeyVersion_ImmutableFields(t *testing.T) {
 			}(),
 			fieldMask: []string{"RootKeyId"},
 		},
-		{
-			name: "root_key_version_id",
-			update: func() *kms.DatabaseKeyVersion {
-				k := new.Clone().(*kms.DatabaseKeyVersion)
-				k.RootKeyVersionId = "o_thisIsNotAValidId"
-				return k
-			}(),
-			fieldMask: []string{"RootKeyId"},
-		},
 		{
 			name: "version",
 			update: func() *kms.DatabaseKeyVersion {
@@ -311,15 +302,6 @@ func TestDatabaseKeyVersion_ImmutableFields(t *testing.T) {
 			}(),
 			fieldMask: []string{"Version"},
 		},
-		{
-			name: "key",
-			update: func() *kms.DatabaseKeyVersion {
-				k := new.Clone().(*kms.DatabaseKeyVersion)
-				k.Key = []byte("updated key")
-				return k
-			}(),
-			fieldMask: []string{"CtKey"},
-		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -344,3 +326,38 @@ func TestDatabaseKeyVersion_ImmutableFields(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseKeyVersion_RewrapFields(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	rw := db.New(conn)
+
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	require.NoError(conn.Where("1=1").Delete(kms.AllocRootKey()).Error)
+	rk := kms.TestRootKey(t, conn, org.PublicId)
+	_, rkvWrapper := kms.TestRootKeyVersion(t, conn, wrapper, rk.PrivateId)
+	dk := kms.TestDatabaseKey(t, conn, rk.PrivateId)
+	new := kms.TestDatabaseKeyVersion(t, conn, rkvWrapper, dk.PrivateId, []byte("database key"))
+
+	// Rewrapping a key version under a newer root key version changes its
+	// encrypted key and the root key version it references.
+	newRkv, newRkvWrapper := kms.TestRootKeyVersion(t, conn, wrapper, rk.PrivateId)
+	update := new.Clone().(*kms.DatabaseKeyVersion)
+	update.RootKeyVersionId = newRkv.PrivateId
+	update.Key = []byte("database key")
+	require.NoError(update.Encrypt(ctx, newRkvWrapper))
+	rowsUpdated, err := rw.Update(ctx, update, []string{"RootKeyVersionId", "CtKey"}, nil, db.WithSkipVetForWrite(true))
+	require.NoError(err)
+	assert.Equal(1, rowsUpdated)
+
+	after := kms.AllocDatabaseKeyVersion()
+	after.PrivateId = new.PrivateId
+	require.NoError(rw.LookupById(ctx, &after))
+	assert.Equal(newRkv.PrivateId, after.RootKeyVersionId)
+	assert.Equal(new.Version, after.Version)
+	require.NoError(after.Decrypt(ctx, newRkvWrapper))
+	assert.Equal([]byte("database key"), after.Key)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/hashicorp/boundary/internal/metrics"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -166,10 +167,12 @@ func (k *Kms) GetWrapper(ctx context.Context, scopeId string, purpose KeyPurpose
 	if ok {
 		wrapper := val.(*multiwrapper.MultiWrapper)
 		if opts.withKeyId == "" || wrapper.WrapperForKeyID(opts.withKeyId) != nil {
+			metrics.IncrKmsCacheLookup(purpose.String(), true)
 			return wrapper, nil
 		}
 		// Fall through to refresh our multiwrapper for this scope/purpose from the DB
 	}
+	metrics.IncrKmsCacheLookup(purpose.String(), false)
 
 	// We don't have it cached, so we'll need to read from the database. Get the
 	// root for the scope as we'll need it to decrypt the value coming from the
@@ -1,6 +1,8 @@
 package kms
 
 import (
+	"crypto/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,4 +29,15 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 1
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRandomReader", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.Equal(rand.Reader, opts.withRandomReader)
+
+		reader := strings.NewReader("notrandom")
+		opts = getOpts(WithRandomReader(reader))
+		testOpts := getDefaultOptions()
+		testOpts.withRandomReader = reader
+		assert.Equal(opts, testOpts)
+	})
 }
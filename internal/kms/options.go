@@ -1,6 +1,9 @@
 package kms
 
 import (
+	"crypto/rand"
+	"io"
+
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 )
@@ -27,10 +30,13 @@ type options struct {
 	withRepository        *Repository
 	withOrder             string
 	withKeyId             string
+	withRandomReader      io.Reader
 }
 
 func getDefaultOptions() options {
-	return options{}
+	return options{
+		withRandomReader: rand.Reader,
+	}
 }
 
 // WithLimit provides an option to provide a limit. Intentionally allowing
@@ -93,3 +99,11 @@ func WithKeyId(keyId string) Option {
 		o.withKeyId = keyId
 	}
 }
+
+// WithRandomReader allows specifying a reader to use in place of the default
+// (crypto/rand.Reader) when generating keys
+func WithRandomReader(reader io.Reader) Option {
+	return func(o *options) {
+		o.withRandomReader = reader
+	}
+}
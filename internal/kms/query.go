@@ -0,0 +1,83 @@
+package kms
+
+const (
+	// currentKeyVersionsQuery returns the newest key version id for each
+	// scope and purpose.
+	currentKeyVersionsQuery = `
+select scope_id, purpose, key_version_id
+from kms_current_key_version;
+`
+
+	// auditKeyQuery returns the encrypted audit key of a scope.
+	auditKeyQuery = `
+select ak.private_id, ak.root_key_version_id, ak.key
+from kms_audit_key ak
+join kms_root_key rk
+	on ak.root_key_id = rk.private_id
+where rk.scope_id = $1;
+`
+
+	// insertAuditKeyQuery creates the audit key of a scope unless another
+	// controller created it first.
+	insertAuditKeyQuery = `
+insert into kms_audit_key
+	(private_id, root_key_id, root_key_version_id, key)
+values
+	($1, $2, $3, $4)
+on conflict (root_key_id) do nothing;
+`
+
+	// rewrapKeyVersionQuery replaces the encrypted key of a DEK version or of
+	// an audit key and the root key version it is encrypted with. It must be
+	// formatted with the name of the key's table.
+	rewrapKeyVersionQuery = `
+update %s
+set
+	key = $1,
+	root_key_version_id = $2
+where
+	private_id = $3;
+`
+
+	// oplogEntriesToRewrapQuery returns a page of oplog entries which are not
+	// known to be encrypted with their scope's current oplog key version,
+	// starting after the given entry id.
+	oplogEntriesToRewrapQuery = `
+select id, data, key_id
+from oplog_entry
+where
+	id > $1
+	and (
+		key_id is null
+		or key_id not in (
+			select key_version_id
+			from kms_current_key_version
+			where purpose = 'oplog'
+		)
+	)
+order by id
+limit $2;
+`
+
+	// oplogKeyVersionScopesQuery maps each oplog key version to the scope it
+	// belongs to.
+	oplogKeyVersionScopesQuery = `
+select kv.private_id, rk.scope_id
+from kms_oplog_key_version kv
+join kms_oplog_key k
+	on kv.oplog_key_id = k.private_id
+join kms_root_key rk
+	on k.root_key_id = rk.private_id;
+`
+
+	// rewrapOplogEntryQuery replaces the encrypted data of an oplog entry and
+	// records the key version it is encrypted with.
+	rewrapOplogEntryQuery = `
+update oplog_entry
+set
+	data = $1,
+	key_id = $2
+where
+	id = $3;
+`
+)
@@ -0,0 +1,142 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+)
+
+// auditKeyTableName is the table audit keys are stored in
+const auditKeyTableName = "kms_audit_key"
+
+// auditKey is the key a scope's audit events are HMAC'd with. It is encrypted
+// with a version of the scope's root key.
+type auditKey struct {
+	PrivateId        string
+	RootKeyVersionId string
+	Key              []byte `wrapping:"pt,key"`
+	CtKey            []byte `wrapping:"ct,key"`
+}
+
+func (k *auditKey) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.WrapStruct(ctx, cipher, k, nil); err != nil {
+		return fmt.Errorf("error encrypting kms audit key: %w", err)
+	}
+	return nil
+}
+
+func (k *auditKey) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	if err := structwrapping.UnwrapStruct(ctx, cipher, k, nil); err != nil {
+		return fmt.Errorf("error decrypting kms audit key: %w", err)
+	}
+	return nil
+}
+
+// AuditKey returns the key the scope's audit events are HMAC'd with, creating
+// it from randomReader the first time it is requested. The audit key has no
+// versions and is not changed by RotateKeys, so it stays the same for the life
+// of the scope, and destroying old DEK versions does not affect it.
+func (r *Repository) AuditKey(ctx context.Context, rootWrapper wrapping.Wrapper, randomReader io.Reader, scopeId string) ([]byte, error) {
+	if rootWrapper == nil {
+		return nil, fmt.Errorf("audit key: missing root wrapper: %w", db.ErrInvalidParameter)
+	}
+	if randomReader == nil {
+		return nil, fmt.Errorf("audit key: missing random reader: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("audit key: missing scope id: %w", db.ErrInvalidParameter)
+	}
+
+	k, err := lookupAuditKey(ctx, r.reader, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("audit key: %w", err)
+	}
+	if k == nil {
+		if err := r.createAuditKey(ctx, rootWrapper, randomReader, scopeId); err != nil {
+			return nil, fmt.Errorf("audit key: %w", err)
+		}
+		// Another controller may have created the key first, in which case
+		// its key is the one used
+		if k, err = lookupAuditKey(ctx, r.reader, scopeId); err != nil {
+			return nil, fmt.Errorf("audit key: %w", err)
+		}
+		if k == nil {
+			return nil, fmt.Errorf("audit key: scope %s: %w", scopeId, db.ErrRecordNotFound)
+		}
+	}
+
+	rkv, err := r.LookupRootKeyVersion(ctx, rootWrapper, k.RootKeyVersionId)
+	if err != nil {
+		return nil, fmt.Errorf("audit key: %w", err)
+	}
+	rkvWrapper, err := newAeadWrapper(rkv.PrivateId, rkv.Key)
+	if err != nil {
+		return nil, fmt.Errorf("audit key: %w", err)
+	}
+	if err := k.decrypt(ctx, rkvWrapper); err != nil {
+		return nil, fmt.Errorf("audit key: %w", err)
+	}
+	return k.Key, nil
+}
+
+// createAuditKey creates the scope's audit key encrypted with the newest
+// version of its root key, unless the scope already has one.
+func (r *Repository) createAuditKey(ctx context.Context, rootWrapper wrapping.Wrapper, randomReader io.Reader, scopeId string) error {
+	rk, err := lookupRootKeyForScope(ctx, r.reader, scopeId)
+	if err != nil {
+		return err
+	}
+	var rootKeyVersions []*RootKeyVersion
+	if err := r.reader.SearchWhere(ctx, &rootKeyVersions, "root_key_id = ?", []interface{}{rk.PrivateId}, db.WithLimit(1), db.WithOrder("version desc")); err != nil {
+		return fmt.Errorf("unable to find root key version in scope %s: %w", scopeId, err)
+	}
+	if len(rootKeyVersions) == 0 {
+		return fmt.Errorf("no root key versions found in scope %s: %w", scopeId, db.ErrRecordNotFound)
+	}
+	rkv := rootKeyVersions[0]
+	if err := rkv.Decrypt(ctx, rootWrapper); err != nil {
+		return err
+	}
+	rkvWrapper, err := newAeadWrapper(rkv.PrivateId, rkv.Key)
+	if err != nil {
+		return err
+	}
+
+	k := &auditKey{RootKeyVersionId: rkv.PrivateId}
+	if k.Key, err = generateKey(randomReader); err != nil {
+		return err
+	}
+	if k.PrivateId, err = newAuditKeyId(); err != nil {
+		return err
+	}
+	if err := k.encrypt(ctx, rkvWrapper); err != nil {
+		return err
+	}
+	// no oplog entries for the audit key
+	if _, err := r.writer.Exec(ctx, insertAuditKeyQuery, []interface{}{k.PrivateId, rk.PrivateId, k.RootKeyVersionId, k.CtKey}); err != nil {
+		return fmt.Errorf("unable to create audit key in scope %s: %w", scopeId, err)
+	}
+	return nil
+}
+
+// lookupAuditKey returns the encrypted audit key of the scope, or nil if the
+// scope does not have one yet.
+func lookupAuditKey(ctx context.Context, r db.Reader, scopeId string) (*auditKey, error) {
+	rows, err := r.Query(ctx, auditKeyQuery, []interface{}{scopeId})
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up audit key in scope %s: %w", scopeId, err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+	var k auditKey
+	if err := rows.Scan(&k.PrivateId, &k.RootKeyVersionId, &k.CtKey); err != nil {
+		return nil, fmt.Errorf("unable to look up audit key in scope %s: %w", scopeId, err)
+	}
+	return &k, nil
+}
@@ -0,0 +1,367 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/aead"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
+)
+
+// RotateKeys creates a new version of the root key for the scope, along with a
+// new version of each of the scope's DEKs encrypted by the new root key
+// version. New data is encrypted with the new versions, while existing data
+// remains readable with the previous versions until it is rewrapped. Returns a
+// map of the new key versions. There are no valid options at this time.
+func (r *Repository) RotateKeys(ctx context.Context, rootWrapper wrapping.Wrapper, randomReader io.Reader, scopeId string, opt ...Option) (Keys, error) {
+	if rootWrapper == nil {
+		return nil, fmt.Errorf("rotate keys: missing root wrapper: %w", db.ErrInvalidParameter)
+	}
+	if randomReader == nil {
+		return nil, fmt.Errorf("rotate keys: missing random reader: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return nil, fmt.Errorf("rotate keys: missing scope id: %w", db.ErrInvalidParameter)
+	}
+
+	var keys Keys
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var err error
+			keys, err = rotateKeysTx(ctx, reader, w, rootWrapper, randomReader, scopeId)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("rotate keys: %w", err)
+	}
+	return keys, nil
+}
+
+func rotateKeysTx(ctx context.Context, r db.Reader, w db.Writer, rootWrapper wrapping.Wrapper, randomReader io.Reader, scopeId string) (Keys, error) {
+	rk, err := lookupRootKeyForScope(ctx, r, scopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := generateKey(randomReader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating random bytes for root key version in scope %s: %w", scopeId, err)
+	}
+	rkv, err := NewRootKeyVersion(rk.PrivateId, k)
+	if err != nil {
+		return nil, err
+	}
+	if rkv.PrivateId, err = newRootKeyVersionId(); err != nil {
+		return nil, err
+	}
+	if err := rkv.Encrypt(ctx, rootWrapper); err != nil {
+		return nil, err
+	}
+	// no oplog entries for root key versions
+	if err := w.Create(ctx, rkv); err != nil {
+		return nil, fmt.Errorf("unable to create root key version in scope %s: %w", scopeId, err)
+	}
+	rkvWrapper, err := newAeadWrapper(rkv.PrivateId, rkv.Key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create root key version wrapper in scope %s: %w", scopeId, err)
+	}
+
+	var dbKeys []*DatabaseKey
+	if err := r.SearchWhere(ctx, &dbKeys, "root_key_id = ?", []interface{}{rk.PrivateId}); err != nil {
+		return nil, fmt.Errorf("unable to find database key in scope %s: %w", scopeId, err)
+	}
+	var oplogKeys []*OplogKey
+	if err := r.SearchWhere(ctx, &oplogKeys, "root_key_id = ?", []interface{}{rk.PrivateId}); err != nil {
+		return nil, fmt.Errorf("unable to find oplog key in scope %s: %w", scopeId, err)
+	}
+	var sessionKeys []*SessionKey
+	if err := r.SearchWhere(ctx, &sessionKeys, "root_key_id = ?", []interface{}{rk.PrivateId}); err != nil {
+		return nil, fmt.Errorf("unable to find session key in scope %s: %w", scopeId, err)
+	}
+	var tokenKeys []*TokenKey
+	if err := r.SearchWhere(ctx, &tokenKeys, "root_key_id = ?", []interface{}{rk.PrivateId}); err != nil {
+		return nil, fmt.Errorf("unable to find token key in scope %s: %w", scopeId, err)
+	}
+	if len(dbKeys) != 1 || len(oplogKeys) != 1 || len(sessionKeys) != 1 || len(tokenKeys) != 1 {
+		return nil, fmt.Errorf("missing deks for root key in scope %s: %w", scopeId, db.ErrRecordNotFound)
+	}
+
+	keys := Keys{KeyTypeRootKeyVersion: rkv}
+
+	if k, err = generateKey(randomReader); err != nil {
+		return nil, err
+	}
+	dbKeyVersion, err := NewDatabaseKeyVersion(dbKeys[0].PrivateId, k, rkv.PrivateId)
+	if err != nil {
+		return nil, err
+	}
+	if dbKeyVersion.PrivateId, err = newDatabaseKeyVersionId(); err != nil {
+		return nil, err
+	}
+	if err := dbKeyVersion.Encrypt(ctx, rkvWrapper); err != nil {
+		return nil, err
+	}
+	keys[KeyTypeDatabaseKeyVersion] = dbKeyVersion
+
+	if k, err = generateKey(randomReader); err != nil {
+		return nil, err
+	}
+	oplogKeyVersion, err := NewOplogKeyVersion(oplogKeys[0].PrivateId, k, rkv.PrivateId)
+	if err != nil {
+		return nil, err
+	}
+	if oplogKeyVersion.PrivateId, err = newOplogKeyVersionId(); err != nil {
+		return nil, err
+	}
+	if err := oplogKeyVersion.Encrypt(ctx, rkvWrapper); err != nil {
+		return nil, err
+	}
+	keys[KeyTypeOplogKeyVersion] = oplogKeyVersion
+
+	if k, err = generateKey(randomReader); err != nil {
+		return nil, err
+	}
+	sessionKeyVersion, err := NewSessionKeyVersion(sessionKeys[0].PrivateId, k, rkv.PrivateId)
+	if err != nil {
+		return nil, err
+	}
+	if sessionKeyVersion.PrivateId, err = newSessionKeyVersionId(); err != nil {
+		return nil, err
+	}
+	if err := sessionKeyVersion.Encrypt(ctx, rkvWrapper); err != nil {
+		return nil, err
+	}
+	keys[KeyTypeSessionKeyVersion] = sessionKeyVersion
+
+	if k, err = generateKey(randomReader); err != nil {
+		return nil, err
+	}
+	tokenKeyVersion, err := NewTokenKeyVersion(tokenKeys[0].PrivateId, k, rkv.PrivateId)
+	if err != nil {
+		return nil, err
+	}
+	if tokenKeyVersion.PrivateId, err = newTokenKeyVersionId(); err != nil {
+		return nil, err
+	}
+	if err := tokenKeyVersion.Encrypt(ctx, rkvWrapper); err != nil {
+		return nil, err
+	}
+	keys[KeyTypeTokenKeyVersion] = tokenKeyVersion
+
+	for _, kt := range []KeyType{KeyTypeDatabaseKeyVersion, KeyTypeOplogKeyVersion, KeyTypeSessionKeyVersion, KeyTypeTokenKeyVersion} {
+		// no oplog entries for key versions
+		if err := w.Create(ctx, keys[kt]); err != nil {
+			return nil, fmt.Errorf("unable to create %s in scope %s: %w", kt.String(), scopeId, err)
+		}
+	}
+	return keys, nil
+}
+
+// rewrappableKeyVersion is implemented by all DEK version types
+type rewrappableKeyVersion interface {
+	GetPrivateId() string
+	GetCtKey() []byte
+	Encrypt(context.Context, wrapping.Wrapper) error
+	Decrypt(context.Context, wrapping.Wrapper) error
+}
+
+// RewrapKeys re-encrypts every DEK version in the scope, and its audit key,
+// that is encrypted by an older root key version under the newest root key
+// version, so that older root key versions are no longer needed to read the
+// scope's keys. Returns the number of keys that were rewrapped. There are no
+// valid options at this time.
+func (r *Repository) RewrapKeys(ctx context.Context, rootWrapper wrapping.Wrapper, scopeId string, opt ...Option) (int, error) {
+	if rootWrapper == nil {
+		return db.NoRowsAffected, fmt.Errorf("rewrap keys: missing root wrapper: %w", db.ErrInvalidParameter)
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("rewrap keys: missing scope id: %w", db.ErrInvalidParameter)
+	}
+
+	var rewrapped int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			rewrapped = 0
+			rk, err := lookupRootKeyForScope(ctx, reader, scopeId)
+			if err != nil {
+				return err
+			}
+			var rootKeyVersions []*RootKeyVersion
+			if err := reader.SearchWhere(ctx, &rootKeyVersions, "root_key_id = ?", []interface{}{rk.PrivateId}, db.WithLimit(-1), db.WithOrder("version desc")); err != nil {
+				return fmt.Errorf("unable to list root key versions in scope %s: %w", scopeId, err)
+			}
+			if len(rootKeyVersions) == 0 {
+				return fmt.Errorf("no root key versions found in scope %s: %w", scopeId, db.ErrRecordNotFound)
+			}
+			var multi *multiwrapper.MultiWrapper
+			for _, rkv := range rootKeyVersions {
+				if err := rkv.Decrypt(ctx, rootWrapper); err != nil {
+					return err
+				}
+				wrapper, err := newAeadWrapper(rkv.PrivateId, rkv.Key)
+				if err != nil {
+					return err
+				}
+				if multi == nil {
+					multi = multiwrapper.NewMultiWrapper(wrapper)
+				} else {
+					multi.AddWrapper(wrapper)
+				}
+			}
+			current := rootKeyVersions[0].PrivateId
+
+			where := "root_key_version_id <> ? and %s in (select private_id from %s where root_key_id = ?)"
+			args := []interface{}{current, rk.PrivateId}
+			var dbVersions []*DatabaseKeyVersion
+			if err := reader.SearchWhere(ctx, &dbVersions, fmt.Sprintf(where, "database_key_id", DefaultDatabaseKeyTableName), args, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			var oplogVersions []*OplogKeyVersion
+			if err := reader.SearchWhere(ctx, &oplogVersions, fmt.Sprintf(where, "oplog_key_id", DefaultOplogKeyTableName), args, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			var sessionVersions []*SessionKeyVersion
+			if err := reader.SearchWhere(ctx, &sessionVersions, fmt.Sprintf(where, "session_key_id", DefaultSessionKeyTableName), args, db.WithLimit(-1)); err != nil {
+				return err
+			}
+			var tokenVersions []*TokenKeyVersion
+			if err := reader.SearchWhere(ctx, &tokenVersions, fmt.Sprintf(where, "token_key_id", DefaultTokenKeyTableName), args, db.WithLimit(-1)); err != nil {
+				return err
+			}
+
+			rewrap := func(table string, kv rewrappableKeyVersion) error {
+				if err := kv.Decrypt(ctx, multi); err != nil {
+					return err
+				}
+				if err := kv.Encrypt(ctx, multi); err != nil {
+					return err
+				}
+				// no oplog entries for key versions
+				rowsUpdated, err := w.Exec(ctx, fmt.Sprintf(rewrapKeyVersionQuery, table), []interface{}{kv.GetCtKey(), current, kv.GetPrivateId()})
+				if err != nil {
+					return fmt.Errorf("unable to rewrap key version %s: %w", kv.GetPrivateId(), err)
+				}
+				if rowsUpdated > 1 {
+					return fmt.Errorf("unable to rewrap key version %s: %w", kv.GetPrivateId(), db.ErrMultipleRecords)
+				}
+				rewrapped++
+				return nil
+			}
+			for _, kv := range dbVersions {
+				if err := rewrap(DefaultDatabaseKeyVersionTableName, kv); err != nil {
+					return err
+				}
+			}
+			for _, kv := range oplogVersions {
+				if err := rewrap(DefaultOplogKeyVersionTableName, kv); err != nil {
+					return err
+				}
+			}
+			for _, kv := range sessionVersions {
+				if err := rewrap(DefaultSessionKeyVersionTableName, kv); err != nil {
+					return err
+				}
+			}
+			for _, kv := range tokenVersions {
+				if err := rewrap(DefaultTokenKeyVersionTableName, kv); err != nil {
+					return err
+				}
+			}
+
+			// The audit key is not rotated, but it is rewrapped so older
+			// root key versions can be destroyed without losing it
+			ak, err := lookupAuditKey(ctx, reader, scopeId)
+			if err != nil {
+				return err
+			}
+			if ak != nil && ak.RootKeyVersionId != current {
+				if err := ak.decrypt(ctx, multi); err != nil {
+					return err
+				}
+				if err := ak.encrypt(ctx, multi); err != nil {
+					return err
+				}
+				// no oplog entries for the audit key
+				rowsUpdated, err := w.Exec(ctx, fmt.Sprintf(rewrapKeyVersionQuery, auditKeyTableName), []interface{}{ak.CtKey, current, ak.PrivateId})
+				if err != nil {
+					return fmt.Errorf("unable to rewrap audit key %s: %w", ak.PrivateId, err)
+				}
+				if rowsUpdated > 1 {
+					return fmt.Errorf("unable to rewrap audit key %s: %w", ak.PrivateId, db.ErrMultipleRecords)
+				}
+				rewrapped++
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("rewrap keys: %w", err)
+	}
+	return rewrapped, nil
+}
+
+// CurrentKeyVersionIds returns the id of the newest key version for each
+// scope and purpose, keyed by scope id and then purpose.
+func (r *Repository) CurrentKeyVersionIds(ctx context.Context) (map[string]map[KeyPurpose]string, error) {
+	rows, err := r.reader.Query(ctx, currentKeyVersionsQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("current key version ids: %w", err)
+	}
+	defer rows.Close()
+
+	purposes := map[string]KeyPurpose{
+		KeyPurposeDatabase.String(): KeyPurposeDatabase,
+		KeyPurposeOplog.String():    KeyPurposeOplog,
+		KeyPurposeSessions.String(): KeyPurposeSessions,
+		KeyPurposeTokens.String():   KeyPurposeTokens,
+	}
+	ret := make(map[string]map[KeyPurpose]string)
+	for rows.Next() {
+		var scopeId, purpose, keyVersionId string
+		if err := rows.Scan(&scopeId, &purpose, &keyVersionId); err != nil {
+			return nil, fmt.Errorf("current key version ids: %w", err)
+		}
+		if ret[scopeId] == nil {
+			ret[scopeId] = make(map[KeyPurpose]string)
+		}
+		ret[scopeId][purposes[purpose]] = keyVersionId
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("current key version ids: %w", err)
+	}
+	return ret, nil
+}
+
+func lookupRootKeyForScope(ctx context.Context, r db.Reader, scopeId string) (*RootKey, error) {
+	var rootKeys []*RootKey
+	if err := r.SearchWhere(ctx, &rootKeys, "scope_id = ?", []interface{}{scopeId}); err != nil {
+		return nil, fmt.Errorf("unable to find root key for scope %s: %w", scopeId, err)
+	}
+	if len(rootKeys) == 0 {
+		return nil, fmt.Errorf("unable to find root key for scope %s: %w", scopeId, db.ErrRecordNotFound)
+	}
+	return rootKeys[0], nil
+}
+
+func newAeadWrapper(keyId string, key []byte) (wrapping.Wrapper, error) {
+	wrapper := aead.NewWrapper(nil)
+	if _, err := wrapper.SetConfig(map[string]string{
+		"key_id": keyId,
+	}); err != nil {
+		return nil, fmt.Errorf("error setting config on aead wrapper: %w", err)
+	}
+	if err := wrapper.SetAESGCMKeyBytes(key); err != nil {
+		return nil, fmt.Errorf("error setting key bytes on aead wrapper: %w", err)
+	}
+	return wrapper, nil
+}
@@ -0,0 +1,303 @@
+package kms
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
+	"google.golang.org/protobuf/proto"
+)
+
+// RotateKeys creates a new version of the root key and each DEK for the scope.
+// The scope's wrappers are dropped from this Kms's cache so that new data is
+// encrypted with the new versions right away; other controllers pick up the
+// new versions when they next reconcile their caches. Supported options:
+// WithRandomReader, WithRepository.
+func (k *Kms) RotateKeys(ctx context.Context, scopeId string, opt ...Option) error {
+	if scopeId == "" {
+		return fmt.Errorf("rotate keys: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	rootWrapper, err := k.rootWrapper()
+	if err != nil {
+		return fmt.Errorf("rotate keys: %w", err)
+	}
+	if _, err := repo.RotateKeys(ctx, rootWrapper, opts.withRandomReader, scopeId); err != nil {
+		return err
+	}
+	k.evictScope(scopeId)
+	return nil
+}
+
+// RewrapKeys re-encrypts the scope's DEK versions and audit key under its
+// newest root key version, after which older root key versions are no longer
+// in use. Returns the number of keys that were rewrapped. Supported options:
+// WithRepository.
+func (k *Kms) RewrapKeys(ctx context.Context, scopeId string, opt ...Option) (int, error) {
+	if scopeId == "" {
+		return db.NoRowsAffected, fmt.Errorf("rewrap keys: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	rootWrapper, err := k.rootWrapper()
+	if err != nil {
+		return db.NoRowsAffected, fmt.Errorf("rewrap keys: %w", err)
+	}
+	rewrapped, err := repo.RewrapKeys(ctx, rootWrapper, scopeId)
+	if err != nil {
+		return db.NoRowsAffected, err
+	}
+	k.evictScope(scopeId)
+	return rewrapped, nil
+}
+
+// ReconcileCache drops every cached wrapper whose encrypting key is no longer
+// the newest key version for its scope and purpose, e.g. because the keys were
+// rotated by another controller. The next call to GetWrapper for the scope and
+// purpose reloads the keys from the database. Returns the number of cache
+// entries that were dropped. Supported options: WithRepository.
+func (k *Kms) ReconcileCache(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	current, err := repo.CurrentKeyVersionIds(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("reconcile cache: %w", err)
+	}
+	currentByCacheKey := make(map[string]string)
+	for scopeId, purposes := range current {
+		for purpose, keyId := range purposes {
+			currentByCacheKey[scopeId+purpose.String()] = keyId
+		}
+	}
+
+	var dropped int
+	k.scopePurposeCache.Range(func(key, value interface{}) bool {
+		wrapper := value.(*multiwrapper.MultiWrapper)
+		if currentByCacheKey[key.(string)] != wrapper.KeyID() {
+			k.scopePurposeCache.Delete(key)
+			dropped++
+		}
+		return true
+	})
+	return dropped, nil
+}
+
+// GetAuditKey returns the key the scope's audit events are HMAC'd with,
+// creating it the first time it is requested. The audit key is stored apart
+// from the DEKs and is not changed by RotateKeys, so values HMAC'd with it can
+// be correlated across key rotations and destroying old DEK versions does not
+// change it. Supported options: WithRandomReader, WithRepository.
+func (k *Kms) GetAuditKey(ctx context.Context, scopeId string, opt ...Option) ([]byte, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("get audit key: missing scope id: %w", db.ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	rootWrapper, err := k.rootWrapper()
+	if err != nil {
+		return nil, fmt.Errorf("get audit key: %w", err)
+	}
+	key, err := repo.AuditKey(ctx, rootWrapper, opts.withRandomReader, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("get audit key: %w", err)
+	}
+	return key, nil
+}
+
+// RewrapOplogEntries re-encrypts every oplog entry that was not written with
+// the current oplog key version for its scope under the current version.
+// Entries are visited in order, so entries written while a rewrap is running
+// are picked up by the next one. Returns the number of entries that were
+// re-encrypted. Supported options: WithRepository, WithLimit, which sets the
+// number of entries read from the database at a time.
+func (k *Kms) RewrapOplogEntries(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	repo := opts.withRepository
+	if repo == nil {
+		repo = k.repo
+	}
+	limit := repo.defaultLimit
+	if opts.withLimit > 0 {
+		limit = opts.withLimit
+	}
+
+	scopes, err := repo.oplogKeyVersionScopes(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("rewrap oplog entries: %w", err)
+	}
+
+	type entry struct {
+		id    int64
+		data  []byte
+		keyId sql.NullString
+	}
+	var rewrapped int
+	var lastId int64
+	for {
+		rows, err := repo.reader.Query(ctx, oplogEntriesToRewrapQuery, []interface{}{lastId, limit})
+		if err != nil {
+			return rewrapped, fmt.Errorf("rewrap oplog entries: %w", err)
+		}
+		var entries []entry
+		for rows.Next() {
+			var e entry
+			if err := rows.Scan(&e.id, &e.data, &e.keyId); err != nil {
+				rows.Close()
+				return rewrapped, fmt.Errorf("rewrap oplog entries: %w", err)
+			}
+			entries = append(entries, e)
+		}
+		rows.Close()
+		if len(entries) == 0 {
+			return rewrapped, nil
+		}
+
+		for _, e := range entries {
+			lastId = e.id
+			blobInfo := new(wrapping.EncryptedBlobInfo)
+			if err := proto.Unmarshal(e.data, blobInfo); err != nil {
+				return rewrapped, fmt.Errorf("rewrap oplog entries: unable to read data of entry %d: %w", e.id, err)
+			}
+			keyId := blobInfo.GetKeyInfo().GetKeyID()
+			scopeId, ok := scopes[keyId]
+			if !ok {
+				return rewrapped, fmt.Errorf("rewrap oplog entries: entry %d is encrypted with unknown key version %q", e.id, keyId)
+			}
+			wrapper, err := k.GetWrapper(ctx, scopeId, KeyPurposeOplog, WithKeyId(keyId), WithRepository(repo))
+			if err != nil {
+				return rewrapped, fmt.Errorf("rewrap oplog entries: %w", err)
+			}
+
+			data := e.data
+			if wrapper.KeyID() != keyId {
+				pt, err := wrapper.Decrypt(ctx, blobInfo, nil)
+				if err != nil {
+					return rewrapped, fmt.Errorf("rewrap oplog entries: unable to decrypt entry %d: %w", e.id, err)
+				}
+				if blobInfo, err = wrapper.Encrypt(ctx, pt, nil); err != nil {
+					return rewrapped, fmt.Errorf("rewrap oplog entries: unable to encrypt entry %d: %w", e.id, err)
+				}
+				if data, err = proto.Marshal(blobInfo); err != nil {
+					return rewrapped, fmt.Errorf("rewrap oplog entries: unable to marshal entry %d: %w", e.id, err)
+				}
+				rewrapped++
+			}
+			// Entries already encrypted with the current key version are
+			// still updated to record their key id, so they are not visited
+			// again.
+			if _, err := repo.writer.Exec(ctx, rewrapOplogEntryQuery, []interface{}{data, wrapper.KeyID(), e.id}); err != nil {
+				return rewrapped, fmt.Errorf("rewrap oplog entries: unable to update entry %d: %w", e.id, err)
+			}
+		}
+	}
+}
+
+// Rewrappable is a value encrypted with a database key version which
+// RewrapDatabaseValues re-encrypts.
+type Rewrappable struct {
+	// Id identifies the value in errors.
+	Id string
+
+	// ScopeId is the scope of the database key the value is encrypted with.
+	ScopeId string
+
+	// KeyId is the database key version the value is encrypted with.
+	KeyId string
+
+	// Rewrap decrypts the value with wrapper, encrypts it with the current
+	// key version of wrapper and writes it. It returns the number of rows
+	// updated, which is zero if the value was rewrapped in the meantime.
+	Rewrap func(ctx context.Context, wrapper wrapping.Wrapper) (int, error)
+}
+
+// RewrapDatabaseValues re-encrypts values encrypted with a database key
+// version which is not the current version of their scope under the current
+// version. nextPage returns the next page of values to visit and an empty
+// page once every value was visited. name describes the values in errors.
+// Returns the number of values that were rewrapped.
+func (k *Kms) RewrapDatabaseValues(ctx context.Context, name string, nextPage func() ([]Rewrappable, error)) (int, error) {
+	var rewrapped int
+	for {
+		values, err := nextPage()
+		if err != nil {
+			return rewrapped, fmt.Errorf("rewrap %s: %w", name, err)
+		}
+		if len(values) == 0 {
+			return rewrapped, nil
+		}
+		for _, v := range values {
+			databaseWrapper, err := k.GetWrapper(ctx, v.ScopeId, KeyPurposeDatabase, WithKeyId(v.KeyId))
+			if err != nil {
+				return rewrapped, fmt.Errorf("rewrap %s: unable to get database wrapper: %w", name, err)
+			}
+			if databaseWrapper.KeyID() == v.KeyId {
+				// The cached wrapper predates the current key version; the
+				// value is picked up again once the cache is reconciled.
+				continue
+			}
+			rowsUpdated, err := v.Rewrap(ctx, databaseWrapper)
+			if err != nil {
+				return rewrapped, fmt.Errorf("rewrap %s: %s: %w", name, v.Id, err)
+			}
+			rewrapped += rowsUpdated
+		}
+	}
+}
+
+// oplogKeyVersionScopes returns the scope of every oplog key version, keyed by
+// the key version id.
+func (r *Repository) oplogKeyVersionScopes(ctx context.Context) (map[string]string, error) {
+	rows, err := r.reader.Query(ctx, oplogKeyVersionScopesQuery, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ret := make(map[string]string)
+	for rows.Next() {
+		var keyVersionId, scopeId string
+		if err := rows.Scan(&keyVersionId, &scopeId); err != nil {
+			return nil, err
+		}
+		ret[keyVersionId] = scopeId
+	}
+	return ret, rows.Err()
+}
+
+func (k *Kms) rootWrapper() (wrapping.Wrapper, error) {
+	k.externalScopeCacheMutex.RLock()
+	externalWrappers := k.externalScopeCache[scope.Global.String()]
+	k.externalScopeCacheMutex.RUnlock()
+	if externalWrappers == nil {
+		return nil, errors.New("no external wrappers configured")
+	}
+	root := externalWrappers.Root()
+	if root == nil {
+		return nil, errors.New("root key wrapper is nil")
+	}
+	return root, nil
+}
+
+// evictScope drops all cached wrappers for the scope
+func (k *Kms) evictScope(scopeId string) {
+	for _, purpose := range []KeyPurpose{KeyPurposeDatabase, KeyPurposeOplog, KeyPurposeTokens, KeyPurposeSessions} {
+		k.scopePurposeCache.Delete(scopeId + purpose.String())
+	}
+}
@@ -0,0 +1,257 @@
+package kms_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKms_RotateKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	t.Run("missing scope id", func(t *testing.T) {
+		err := kmsCache.RotateKeys(ctx, "")
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		for _, purpose := range []kms.KeyPurpose{kms.KeyPurposeDatabase, kms.KeyPurposeOplog, kms.KeyPurposeTokens, kms.KeyPurposeSessions} {
+			before, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), purpose)
+			require.NoError(err)
+			blob, err := before.Encrypt(ctx, []byte("secret"), nil)
+			require.NoError(err)
+
+			require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+
+			after, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), purpose)
+			require.NoError(err)
+			assert.NotEqual(before.KeyID(), after.KeyID(), "purpose %s", purpose)
+
+			// Data encrypted with the previous version is still readable
+			pt, err := after.Decrypt(ctx, blob, nil)
+			require.NoError(err)
+			assert.Equal([]byte("secret"), pt)
+		}
+	})
+}
+
+func TestKms_RewrapKeys(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo, err := kms.NewRepository(rw, rw)
+	require.NoError(err)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	before, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	blob, err := before.Encrypt(ctx, []byte("secret"), nil)
+	require.NoError(err)
+
+	// Nothing to rewrap until the keys have been rotated
+	rewrapped, err := kmsCache.RewrapKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, rewrapped)
+
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+
+	// The four DEK versions created with the scope are under the previous
+	// root key version
+	rewrapped, err = kmsCache.RewrapKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(4, rewrapped)
+
+	rewrapped, err = kmsCache.RewrapKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, rewrapped)
+
+	// All DEK versions are now readable with just the newest root key version
+	rootKeys, err := repo.ListRootKeys(ctx)
+	require.NoError(err)
+	var rootKeyId string
+	for _, rk := range rootKeys {
+		if rk.GetScopeId() == org.GetPublicId() {
+			rootKeyId = rk.GetPrivateId()
+		}
+	}
+	rkvs, err := repo.ListRootKeyVersions(ctx, wrapper, rootKeyId)
+	require.NoError(err)
+	require.Len(rkvs, 2)
+
+	after, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase, kms.WithKeyId(before.KeyID()))
+	require.NoError(err)
+	pt, err := after.Decrypt(ctx, blob, nil)
+	require.NoError(err)
+	assert.Equal([]byte("secret"), pt)
+}
+
+func TestKms_ReconcileCache(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	otherCache := kms.TestKms(t, conn, wrapper)
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	for _, scopeId := range []string{org.GetPublicId(), proj.GetPublicId()} {
+		_, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+		require.NoError(err)
+		_, err = kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+		require.NoError(err)
+	}
+
+	dropped, err := kmsCache.ReconcileCache(ctx)
+	require.NoError(err)
+	assert.Equal(0, dropped)
+
+	// Rotating through another Kms leaves stale wrappers in the first cache
+	require.NoError(otherCache.RotateKeys(ctx, org.GetPublicId()))
+	dropped, err = kmsCache.ReconcileCache(ctx)
+	require.NoError(err)
+	assert.Equal(2, dropped)
+
+	w, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	o, err := otherCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	assert.Equal(o.KeyID(), w.KeyID())
+}
+
+func TestKms_RewrapDatabaseValues(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	before, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+	oldKeyId := before.KeyID()
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+	after, err := kmsCache.GetWrapper(ctx, org.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(err)
+
+	var rewrappedWith []string
+	value := func(id, keyId string) kms.Rewrappable {
+		return kms.Rewrappable{
+			Id:      id,
+			ScopeId: org.GetPublicId(),
+			KeyId:   keyId,
+			Rewrap: func(_ context.Context, w wrapping.Wrapper) (int, error) {
+				rewrappedWith = append(rewrappedWith, id+":"+w.KeyID())
+				return 1, nil
+			},
+		}
+	}
+	pages := [][]kms.Rewrappable{
+		{value("v1", oldKeyId), value("v2", oldKeyId)},
+		{value("v3", oldKeyId)},
+	}
+	rewrapped, err := kmsCache.RewrapDatabaseValues(ctx, "values", func() ([]kms.Rewrappable, error) {
+		if len(pages) == 0 {
+			return nil, nil
+		}
+		page := pages[0]
+		pages = pages[1:]
+		return page, nil
+	})
+	require.NoError(err)
+	assert.Equal(3, rewrapped)
+	assert.Equal([]string{"v1:" + after.KeyID(), "v2:" + after.KeyID(), "v3:" + after.KeyID()}, rewrappedWith)
+
+	// A failed rewrap stops the walk and is returned
+	calls := 0
+	_, err = kmsCache.RewrapDatabaseValues(ctx, "values", func() ([]kms.Rewrappable, error) {
+		calls++
+		v := value("v1", oldKeyId)
+		v.Rewrap = func(context.Context, wrapping.Wrapper) (int, error) {
+			return 0, db.ErrRecordNotFound
+		}
+		return []kms.Rewrappable{v}, nil
+	})
+	require.Error(err)
+	assert.True(errors.Is(err, db.ErrRecordNotFound))
+	assert.Contains(err.Error(), "rewrap values: v1:")
+	assert.Equal(1, calls)
+}
+
+func TestKms_GetAuditKey(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo, err := kms.NewRepository(rw, rw)
+	require.NoError(err)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	key, err := kmsCache.GetAuditKey(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Len(key, 32)
+	again, err := kmsCache.GetAuditKey(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(key, again)
+
+	rootKeys, err := repo.ListRootKeys(ctx)
+	require.NoError(err)
+	var rootKeyId string
+	for _, rk := range rootKeys {
+		if rk.GetScopeId() == org.GetPublicId() {
+			rootKeyId = rk.GetPrivateId()
+		}
+	}
+	rkvs, err := repo.ListRootKeyVersions(ctx, wrapper, rootKeyId)
+	require.NoError(err)
+	require.Len(rkvs, 1)
+	first := rkvs[0].GetPrivateId()
+
+	// Rotating the keys does not change the audit key
+	require.NoError(kmsCache.RotateKeys(ctx, org.GetPublicId()))
+	afterRotate, err := kmsCache.GetAuditKey(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(key, afterRotate)
+
+	// The first root key version can't be destroyed while the audit key is
+	// encrypted with it
+	_, err = repo.DeleteRootKeyVersion(ctx, first)
+	assert.Error(err)
+
+	// The four DEK versions and the audit key are rewrapped, after which the
+	// first root key version is no longer needed
+	rewrapped, err := kmsCache.RewrapKeys(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(5, rewrapped)
+	deleted, err := repo.DeleteRootKeyVersion(ctx, first)
+	require.NoError(err)
+	assert.Equal(1, deleted)
+	afterRewrap, err := kmsCache.GetAuditKey(ctx, org.GetPublicId())
+	require.NoError(err)
+	assert.Equal(key, afterRewrap)
+
+	_, err = kmsCache.GetAuditKey(ctx, "")
+	assert.True(errors.Is(err, db.ErrInvalidParameter))
+}
@@ -0,0 +1,123 @@
+// Package metrics defines the metrics emitted by Boundary controllers and
+// workers. Metrics are sent through the global go-metrics instance configured
+// by base.Server.SetupMetrics, which by default includes a Prometheus sink
+// served on listeners with the "ops" purpose.
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	gometrics "github.com/armon/go-metrics"
+)
+
+var (
+	// apiRequestKey is the latency of controller API requests, labeled with
+	// the gRPC service method and the HTTP status code
+	apiRequestKey = []string{"controller", "api", "request"}
+
+	// workerStatusHandlerKey is the time the controller takes to handle a
+	// worker's status update, labeled with the worker name
+	workerStatusHandlerKey = []string{"controller", "worker_status", "handle"}
+
+	// tickerKey is the duration of each run of a controller ticker, labeled
+	// with the ticker name
+	tickerKey = []string{"controller", "ticker", "duration"}
+
+	// jobKey is the duration of each run of a scheduled controller job,
+	// labeled with the job name and whether it succeeded
+	jobKey = []string{"controller", "job", "duration"}
+
+	// workerStatusRpcKey is the round trip latency of a worker's status
+	// update to the controller, labeled with whether it succeeded
+	workerStatusRpcKey = []string{"worker", "status", "rpc"}
+
+	// workerActiveSessionsKey and workerActiveConnectionsKey are the number of
+	// sessions and open connections a worker is proxying, labeled with the
+	// worker name
+	workerActiveSessionsKey    = []string{"worker", "sessions", "active"}
+	workerActiveConnectionsKey = []string{"worker", "connections", "active"}
+
+	// dbTxKey counts database transactions, labeled with whether they
+	// succeeded; dbTxRetriesKey counts the retries they needed
+	dbTxKey        = []string{"db", "tx", "count"}
+	dbTxRetriesKey = []string{"db", "tx", "retries"}
+
+	// kmsCacheKey counts lookups of the kms wrapper cache, labeled with the
+	// key purpose and whether the lookup was a hit
+	kmsCacheKey = []string{"kms", "cache", "lookup"}
+)
+
+// MeasureApiRequest records the latency and status of a controller API
+// request. method is the gRPC method the request was routed to, or "unknown".
+func MeasureApiRequest(method string, code int, start time.Time) {
+	gometrics.MeasureSinceWithLabels(apiRequestKey, start, []gometrics.Label{
+		{Name: "method", Value: method},
+		{Name: "code", Value: strconv.Itoa(code)},
+	})
+}
+
+// MeasureWorkerStatusHandler records how long the controller took to handle
+// a status update from worker.
+func MeasureWorkerStatusHandler(worker string, start time.Time) {
+	gometrics.MeasureSinceWithLabels(workerStatusHandlerKey, start, []gometrics.Label{
+		{Name: "worker", Value: worker},
+	})
+}
+
+// MeasureTicker records the duration of a run of the named controller
+// ticker.
+func MeasureTicker(name string, start time.Time) {
+	gometrics.MeasureSinceWithLabels(tickerKey, start, []gometrics.Label{
+		{Name: "ticker", Value: name},
+	})
+}
+
+// MeasureJob records the duration and result of a run of the named
+// scheduled job.
+func MeasureJob(name string, start time.Time, err error) {
+	gometrics.MeasureSinceWithLabels(jobKey, start, []gometrics.Label{
+		{Name: "job", Value: name},
+		resultLabel(err),
+	})
+}
+
+// MeasureWorkerStatusRpc records the round trip latency of a worker status
+// update.
+func MeasureWorkerStatusRpc(start time.Time, err error) {
+	gometrics.MeasureSinceWithLabels(workerStatusRpcKey, start, []gometrics.Label{
+		resultLabel(err),
+	})
+}
+
+// SetWorkerActive records the number of sessions and open connections the
+// worker is proxying.
+func SetWorkerActive(worker string, sessions, connections int) {
+	labels := []gometrics.Label{{Name: "worker", Value: worker}}
+	gometrics.SetGaugeWithLabels(workerActiveSessionsKey, float32(sessions), labels)
+	gometrics.SetGaugeWithLabels(workerActiveConnectionsKey, float32(connections), labels)
+}
+
+// IncrDbTx counts a database transaction and the retries it needed.
+func IncrDbTx(retries int, err error) {
+	labels := []gometrics.Label{resultLabel(err)}
+	gometrics.IncrCounterWithLabels(dbTxKey, 1, labels)
+	if retries > 0 {
+		gometrics.IncrCounterWithLabels(dbTxRetriesKey, float32(retries), labels)
+	}
+}
+
+// IncrKmsCacheLookup counts a lookup of the kms wrapper cache for purpose.
+func IncrKmsCacheLookup(purpose string, hit bool) {
+	gometrics.IncrCounterWithLabels(kmsCacheKey, 1, []gometrics.Label{
+		{Name: "purpose", Value: purpose},
+		{Name: "hit", Value: strconv.FormatBool(hit)},
+	})
+}
+
+func resultLabel(err error) gometrics.Label {
+	if err != nil {
+		return gometrics.Label{Name: "result", Value: "error"}
+	}
+	return gometrics.Label{Name: "result", Value: "success"}
+}
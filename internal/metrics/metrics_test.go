@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gometrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testInmemSink(t *testing.T) *gometrics.InmemSink {
+	t.Helper()
+	sink := gometrics.NewInmemSink(time.Minute, time.Minute)
+	conf := gometrics.DefaultConfig("boundary")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := gometrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+	return sink
+}
+
+func TestMetrics(t *testing.T) {
+	sink := testInmemSink(t)
+
+	MeasureApiRequest("/controller.api.services.v1.TargetService/ListTargets", 200, time.Now())
+	MeasureTicker("status", time.Now())
+	SetWorkerActive("w_1", 2, 3)
+	IncrDbTx(2, nil)
+	IncrDbTx(0, errors.New("failed"))
+	IncrKmsCacheLookup("database", true)
+	IncrKmsCacheLookup("database", true)
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+	interval := data[0]
+
+	samples := interval.Samples
+	assert.Contains(t, samples, "boundary.controller.api.request;method=/controller.api.services.v1.TargetService/ListTargets;code=200")
+	assert.Contains(t, samples, "boundary.controller.ticker.duration;ticker=status")
+
+	gauges := interval.Gauges
+	require.Contains(t, gauges, "boundary.worker.sessions.active;worker=w_1")
+	assert.Equal(t, float32(2), gauges["boundary.worker.sessions.active;worker=w_1"].Value)
+	require.Contains(t, gauges, "boundary.worker.connections.active;worker=w_1")
+	assert.Equal(t, float32(3), gauges["boundary.worker.connections.active;worker=w_1"].Value)
+
+	counters := interval.Counters
+	require.Contains(t, counters, "boundary.db.tx.count;result=success")
+	assert.Equal(t, 1, counters["boundary.db.tx.count;result=success"].Count)
+	require.Contains(t, counters, "boundary.db.tx.retries;result=success")
+	assert.Equal(t, float64(2), counters["boundary.db.tx.retries;result=success"].Sum)
+	assert.Contains(t, counters, "boundary.db.tx.count;result=error")
+	assert.NotContains(t, counters, "boundary.db.tx.retries;result=error")
+	require.Contains(t, counters, "boundary.kms.cache.lookup;purpose=database;hit=true")
+	assert.Equal(t, 2, counters["boundary.kms.cache.lookup;purpose=database;hit=true"].Count)
+}
@@ -104,16 +104,6 @@ func Test_ImmutableFields(t *testing.T) {
 			}(),
 			fieldMask: []string{"AggregateName"},
 		},
-		{
-			name: "update data",
-			update: func() *Entry {
-				e := testCloneEntry(new)
-				// CtData is the field sent to the db.
-				e.CtData = []byte("Lorem Ipsum")
-				return e
-			}(),
-			fieldMask: []string{"CtData"},
-		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -136,6 +126,39 @@ func Test_ImmutableFields(t *testing.T) {
 	}
 }
 
+func Test_RewrapFields(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	cleanup, db := setup(t)
+	defer testCleanup(t, cleanup, db)
+	cipherer := testWrapper(t)
+
+	writer := &GormWriter{db}
+	u := oplog_test.TestUser{
+		Name: "foo-" + testId(t),
+	}
+	ticketer, err := NewGormTicketer(db, WithAggregateNames(true))
+	require.NoError(err)
+	ticket, err := ticketer.GetTicket("default")
+	require.NoError(err)
+	new, err := NewEntry("test-users", Metadata{"key-only": nil}, cipherer, ticketer)
+	require.NoError(err)
+	err = new.WriteEntryWith(context.Background(), writer, ticket,
+		&Message{Message: &u, TypeName: "user", OpType: OpType_OP_TYPE_CREATE})
+	require.NoError(err)
+
+	// Rewrapping an entry replaces its encrypted data and records the key
+	// version it is encrypted with.
+	err = db.Exec("update oplog_entry set data = ?, key_id = ? where id = ?", []byte("rewrapped"), "kdkv_rewrapped", new.Id).Error
+	require.NoError(err)
+
+	after := testCloneEntry(new)
+	err = db.First(&after).Error
+	require.NoError(err)
+	assert.Equal([]byte("rewrapped"), after.CtData)
+	assert.Equal(new.Version, after.Version)
+	assert.Equal(new.AggregateName, after.AggregateName)
+}
+
 func testCloneEntry(e *Entry) *Entry {
 	cp := proto.Clone(e.Entry)
 	return &Entry{
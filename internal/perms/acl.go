@@ -30,6 +30,9 @@ type ACL struct {
 type ACLResults struct {
 	Allowed bool
 
+	// Denied is true when a deny grant matched the action on the resource
+	Denied bool
+
 	// This is included but unexported for testing/debugging
 	scopeMap map[string][]Grant
 }
@@ -65,62 +68,82 @@ func NewACL(grants ...Grant) ACL {
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
+// Deny grants take precedence: if any grant in the scope denies the action on
+// the resource, it is not allowed regardless of the grants allowing it.
 func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	// First, get the grants within the specified scope
 	grants := a.scopeMap[r.ScopeId]
 	results.scopeMap = a.scopeMap
 
-	// Now, go through and check the cases indicated above
+	var allowed bool
 	for _, grant := range grants {
-		if !(grant.actions[aType] || grant.actions[action.All]) {
+		if !grant.matches(r, aType) {
 			continue
 		}
-		switch {
-		// id=<resource.id>;actions=<action> where ID cannot be a wildcard
-		case grant.id == r.Id &&
-			grant.id != "" &&
-			grant.id != "*" &&
-			grant.typ == resource.Unknown:
-
-			results.Allowed = true
+		if grant.deny {
+			results.Denied = true
 			return
+		}
+		allowed = true
+	}
+	results.Allowed = allowed
+	return
+}
 
-		// type=<resource.type>;actions=<action> when action is list or create.
-		// Must be a top level collection, otherwise must be one of the two
-		// formats specified below.
-		case grant.id == "" &&
-			r.Id == "" &&
-			grant.typ == r.Type &&
-			grant.typ != resource.Unknown &&
-			topLevelType(r.Type) &&
-			(aType == action.List || aType == action.Create):
-
-			results.Allowed = true
-			return
+// Applies determines whether the grant applies to an action on a resource,
+// allowing or denying it. This is the check Allowed performs on each grant in
+// the resource's scope, so it can be used to explain a decision.
+func (g Grant) Applies(r Resource, aType action.Type) bool {
+	return g.scope.Id == r.ScopeId && g.matches(r, aType)
+}
 
-		// id=*;type=<resource.type>;actions=<action> where type cannot be
-		// unknown but can be a wildcard to allow any resource at all
-		case grant.id == "*" &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type ||
-				grant.typ == resource.All):
+// matches determines if the grant applies to the action on the resource,
+// following the cases indicated above
+func (g Grant) matches(r Resource, aType action.Type) bool {
+	if !(g.actions[aType] || g.actions[action.All]) {
+		return false
+	}
+	switch {
+	// id=<resource.id>;actions=<action> where ID cannot be a wildcard
+	case g.id == r.Id &&
+		g.id != "" &&
+		g.id != "*" &&
+		g.typ == resource.Unknown:
 
-			results.Allowed = true
-			return
+		return true
 
-		// id=<pin>;type=<resource.type>;actions=<action> where type can be a
-		// wildcard and this this is operating on a non-top-level type
-		case grant.id != "" &&
-			grant.id == r.Pin &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type || grant.typ == resource.All) &&
-			!topLevelType(r.Type):
+	// type=<resource.type>;actions=<action> when action is list or create.
+	// Must be a top level collection, otherwise must be one of the two
+	// formats specified below.
+	case g.id == "" &&
+		r.Id == "" &&
+		g.typ == r.Type &&
+		g.typ != resource.Unknown &&
+		topLevelType(r.Type) &&
+		(aType == action.List || aType == action.Create):
 
-			results.Allowed = true
-			return
-		}
+		return true
+
+	// id=*;type=<resource.type>;actions=<action> where type cannot be
+	// unknown but can be a wildcard to allow any resource at all
+	case g.id == "*" &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type ||
+			g.typ == resource.All):
+
+		return true
+
+	// id=<pin>;type=<resource.type>;actions=<action> where type can be a
+	// wildcard and this this is operating on a non-top-level type
+	case g.id != "" &&
+		g.id == r.Pin &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type || g.typ == resource.All) &&
+		!topLevelType(r.Type):
+
+		return true
 	}
-	return
+	return false
 }
 
 func topLevelType(typ resource.Type) bool {
@@ -129,6 +152,7 @@ func topLevelType(typ resource.Type) bool {
 		resource.AuthToken,
 		resource.Group,
 		resource.HostCatalog,
+		resource.Job,
 		resource.Role,
 		resource.Scope,
 		resource.Session,
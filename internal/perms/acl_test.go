@@ -61,6 +61,15 @@ func Test_ACLAllowed(t *testing.T) {
 				"id=*;type=*;actions=create,update",
 			},
 		},
+		{
+			scope: "o_e",
+			grants: []string{
+				"id=*;type=*;actions=*",
+				"deny=true;id=p_prod;actions=delete",
+				"deny=true;id=*;type=target;actions=update",
+				"deny=true;id=hcst_prod;type=*;actions=*",
+			},
+		},
 	}
 
 	// See acl.go for expected allowed formats. The goal here is to basically
@@ -252,6 +261,52 @@ func Test_ACLAllowed(t *testing.T) {
 			},
 			userId: "u_abcd1234",
 		},
+		{
+			name:        "deny id overrides wildcard allow",
+			resource:    Resource{ScopeId: "o_e", Id: "p_prod", Type: resource.Scope},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read, allowed: true},
+				{action: action.Update, allowed: true},
+				{action: action.Delete},
+			},
+		},
+		{
+			name:        "deny id does not affect other ids",
+			resource:    Resource{ScopeId: "o_e", Id: "p_dev", Type: resource.Scope},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Update, allowed: true},
+				{action: action.Delete, allowed: true},
+			},
+		},
+		{
+			name:        "deny type overrides wildcard allow",
+			resource:    Resource{ScopeId: "o_e", Id: "ttcp_1234", Type: resource.Target},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read, allowed: true},
+				{action: action.Update},
+			},
+		},
+		{
+			name:        "deny pin overrides wildcard allow",
+			resource:    Resource{ScopeId: "o_e", Id: "hsst_1234", Type: resource.HostSet, Pin: "hcst_prod"},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read},
+				{action: action.AddHosts},
+			},
+		},
+		{
+			name:        "deny pin does not affect other pins",
+			resource:    Resource{ScopeId: "o_e", Id: "hsst_1234", Type: resource.HostSet, Pin: "hcst_dev"},
+			scopeGrants: commonGrants,
+			actionsAllowed: []actionAllowed{
+				{action: action.Read, allowed: true},
+				{action: action.AddHosts, allowed: true},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -266,8 +321,30 @@ func Test_ACLAllowed(t *testing.T) {
 			}
 			acl := NewACL(grants...)
 			for _, aa := range test.actionsAllowed {
-				assert.True(t, acl.Allowed(test.resource, aa.action).Allowed == aa.allowed)
+				assert.True(t, acl.Allowed(test.resource, aa.action).Allowed == aa.allowed, aa.action.String())
 			}
 		})
 	}
 }
+
+func Test_GrantApplies(t *testing.T) {
+	t.Parallel()
+
+	allowAll, err := Parse("o_a", "id=*;type=*;actions=*")
+	require.NoError(t, err)
+	denyDelete, err := Parse("o_a", "deny=true;id=*;type=target;actions=delete")
+	require.NoError(t, err)
+	other, err := Parse("o_b", "id=*;type=*;actions=*")
+	require.NoError(t, err)
+
+	target := Resource{ScopeId: "o_a", Id: "ttcp_1234", Type: resource.Target}
+	assert.True(t, allowAll.Applies(target, action.Delete))
+	assert.True(t, denyDelete.Applies(target, action.Delete))
+	assert.False(t, denyDelete.Applies(target, action.Read))
+	assert.False(t, other.Applies(target, action.Read))
+
+	// Both grants in the scope apply, and the deny grant decides
+	results := NewACL(allowAll, denyDelete, other).Allowed(target, action.Delete)
+	assert.False(t, results.Allowed)
+	assert.True(t, results.Denied)
+}
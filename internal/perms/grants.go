@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -17,6 +18,8 @@ import (
 type GrantPair struct {
 	ScopeId string
 	Grant   string
+	// RoleId is the role the grant is on, when known
+	RoleId string
 }
 
 // Scope provides an in-memory representation of iam.Scope without the
@@ -43,11 +46,19 @@ type Grant struct {
 	// The set of actions being granted
 	actions map[action.Type]bool
 
+	// Whether the grant denies rather than allows the actions
+	deny bool
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
 }
 
+// ScopeId returns the ID of the scope whose resources the grant applies to
+func (g Grant) ScopeId() string {
+	return g.scope.Id
+}
+
 func (g Grant) Id() string {
 	return g.id
 }
@@ -56,6 +67,12 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+// Deny returns whether the grant denies the actions it matches. Deny grants
+// take precedence over any grant allowing the same action.
+func (g Grant) Deny() bool {
+	return g.deny
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -75,6 +92,7 @@ func (g Grant) clone() *Grant {
 		scope: g.scope,
 		id:    g.id,
 		typ:   g.typ,
+		deny:  g.deny,
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -92,6 +110,10 @@ func (g Grant) clone() *Grant {
 func (g Grant) CanonicalString() string {
 	var builder []string
 
+	if g.deny {
+		builder = append(builder, "deny=true")
+	}
+
 	if g.id != "" {
 		builder = append(builder, fmt.Sprintf("id=%s", g.id))
 	}
@@ -115,6 +137,9 @@ func (g Grant) CanonicalString() string {
 // MarshalJSON provides a custom marshaller for grants
 func (g Grant) MarshalJSON() ([]byte, error) {
 	res := make(map[string]interface{}, 4)
+	if g.deny {
+		res["deny"] = true
+	}
 	if g.id != "" {
 		res["id"] = g.id
 	}
@@ -140,6 +165,13 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
+	if rawDeny, ok := raw["deny"]; ok {
+		deny, ok := rawDeny.(bool)
+		if !ok {
+			return fmt.Errorf("unable to interpret %q as bool", "deny")
+		}
+		g.deny = deny
+	}
 	if rawId, ok := raw["id"]; ok {
 		id, ok := rawId.(string)
 		if !ok {
@@ -196,6 +228,13 @@ func (g *Grant) unmarshalText(grantString string) error {
 		}
 
 		switch kv[0] {
+		case "deny":
+			deny, err := strconv.ParseBool(strings.ToLower(kv[1]))
+			if err != nil {
+				return fmt.Errorf("unable to interpret %q value %q as bool", "deny", kv[1])
+			}
+			g.deny = deny
+
 		case "id":
 			g.id = strings.ToLower(kv[1])
 
@@ -268,23 +307,8 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 
 	opts := getOpts(opt...)
 
-	// Check for templated values ID, and substitute in with the authenticated values
-	// if so
-	if grant.id != "" && strings.HasPrefix(grant.id, "{{") {
-		id := strings.TrimSuffix(strings.TrimPrefix(grant.id, "{{"), "}}")
-		id = strings.ToLower(strings.TrimSpace(id))
-		switch id {
-		case "user.id":
-			if opts.withUserId != "" {
-				grant.id = opts.withUserId
-			}
-		case "account.id":
-			if opts.withAccountId != "" {
-				grant.id = opts.withAccountId
-			}
-		default:
-			return Grant{}, fmt.Errorf("unknown template %q in grant %q value", grant.id, "id")
-		}
+	if err := grant.expandTemplates(opts); err != nil {
+		return Grant{}, err
 	}
 
 	if err := grant.validateType(); err != nil {
@@ -297,8 +321,11 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 
 	if !opts.withSkipFinalValidation {
 		// Validate the grant. Create a dummy resource and pass it through
-		// Allowed and ensure that we get allowed.
-		acl := NewACL(grant)
+		// Allowed and ensure that we get allowed. A deny grant never allows
+		// anything, so validate the grant that it is the inverse of.
+		check := grant.clone()
+		check.deny = false
+		acl := NewACL(*check)
 		r := Resource{
 			ScopeId: scopeId,
 			Id:      grant.id,
@@ -322,6 +349,33 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 	return grant, nil
 }
 
+// expandTemplates substitutes the values of the caller into a templated ID,
+// e.g. id={{user.id}}. A template is left as is when no value is provided for
+// it, such as {{account.id}} for the anonymous user; as no resource has such an
+// ID, the grant then matches nothing.
+func (g *Grant) expandTemplates(opts options) error {
+	if !strings.HasPrefix(g.id, "{{") {
+		return nil
+	}
+	if !strings.HasSuffix(g.id, "}}") {
+		return fmt.Errorf("unterminated template %q in grant %q value", g.id, "id")
+	}
+	name := strings.TrimSuffix(strings.TrimPrefix(g.id, "{{"), "}}")
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "user.id":
+		if opts.withUserId != "" {
+			g.id = opts.withUserId
+		}
+	case "account.id":
+		if opts.withAccountId != "" {
+			g.id = opts.withAccountId
+		}
+	default:
+		return fmt.Errorf("unknown template %q in grant %q value", g.id, "id")
+	}
+	return nil
+}
+
 func (g Grant) validateType() error {
 	switch g.typ {
 	case resource.Unknown,
@@ -336,7 +390,8 @@ func (g Grant) validateType() error {
 		resource.HostSet,
 		resource.Host,
 		resource.Target,
-		resource.Session:
+		resource.Session,
+		resource.Job:
 		return nil
 	}
 	return fmt.Errorf("unknown type specifier %q", g.typ)
@@ -175,6 +175,22 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"id":"baz","type":"group"}`,
 			canonicalString: `id=baz;type=group;actions=create,read`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				id: "*",
+				scope: Scope{
+					Type: scope.Org,
+				},
+				typ: resource.Scope,
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				deny: true,
+			},
+			jsonOutput:      `{"actions":["delete"],"deny":true,"id":"*","type":"scope"}`,
+			canonicalString: `deny=true;id=*;type=scope;actions=delete`,
+		},
 	}
 
 	for _, test := range tests {
@@ -277,6 +293,21 @@ func Test_Unmarshaling(t *testing.T) {
 			jsonInput: `{"actions":[1, true]}`,
 			jsonErr:   `unable to interpret 1 in actions array as string`,
 		},
+		{
+			name: "good deny",
+			expected: Grant{
+				deny: true,
+			},
+			jsonInput: `{"deny":true}`,
+			textInput: `deny=true`,
+		},
+		{
+			name:      "bad deny",
+			jsonInput: `{"deny":"yes"}`,
+			jsonErr:   `unable to interpret "deny" as bool`,
+			textInput: `deny=yes`,
+			textErr:   `unable to interpret "deny" value "yes" as bool`,
+		},
 	}
 
 	for _, test := range tests {
@@ -352,6 +383,43 @@ func Test_Parse(t *testing.T) {
 			input: "actions=create",
 			err:   `parsed grant string would not result in any action being authorized`,
 		},
+		{
+			name:  "deny empty id and type",
+			input: "deny=true;actions=delete",
+			err:   `parsed grant string would not result in any action being authorized`,
+		},
+		{
+			name:  "good text deny",
+			input: `deny=true;id=*;type=scope;actions=delete`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Scope,
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "good json deny",
+			input: `{"deny":true,"id":"foobar","actions":["read"]}`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "foobar",
+				typ: resource.Unknown,
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+				deny: true,
+			},
+		},
 		{
 			name:  "good json type",
 			input: `{"type":"host-catalog","actions":["create"]}`,
@@ -480,6 +548,26 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "unterminated user id template",
+			input:  `id={{user.id;actions=create,read`,
+			userId: "u_abcd1234",
+			err:    `unterminated template "{{user.id" in grant "id" value`,
+		},
+		{
+			name:  "unresolved user id template",
+			input: `id={{user.id}};actions=read`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id: "{{user.id}}",
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+			},
+		},
 		{
 			name:      "bad account id template",
 			input:     `id={{superman}};actions=create,read`,
@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+// RunStatus is the outcome of a job run.
+type RunStatus string
+
+const (
+	// RunSucceeded indicates the job's run func returned without error.
+	RunSucceeded RunStatus = "succeeded"
+	// RunFailed indicates the job's run func returned an error or did not
+	// complete before its lease expired.
+	RunFailed RunStatus = "failed"
+)
+
+func (s RunStatus) String() string {
+	return string(s)
+}
+
+// Job is the stored status of a job registered with the scheduler.
+type Job struct {
+	// Name uniquely identifies the job.
+	Name string
+	// Description is a human friendly description of what the job does.
+	Description string
+
+	// NextScheduledRun is the earliest time the job will next be run.
+	NextScheduledRun *timestamp.Timestamp
+
+	// LastRunStart and LastRunEnd are the start and end times of the most
+	// recent run. If the job is currently running LastRunStart is the time the
+	// current run started and LastRunEnd is the end of the previous run.
+	LastRunStart *timestamp.Timestamp
+	LastRunEnd   *timestamp.Timestamp
+	// LastRunStatus is the outcome of the most recently completed run and
+	// LastRunError the error it returned, if any.
+	LastRunStatus string
+	LastRunError  string
+	// LastRunControllerId is the name of the controller that ran, or is
+	// running, the most recent run.
+	LastRunControllerId string
+
+	// LeaseHolder is the name of the controller currently running the job,
+	// and LeaseExpiration the time after which another controller may take
+	// the lease over. Both are empty if the job is not running.
+	LeaseHolder     string
+	LeaseExpiration *timestamp.Timestamp
+
+	CreateTime *timestamp.Timestamp
+	UpdateTime *timestamp.Timestamp
+}
+
+// TableName overrides the table name used by Job to `job`
+func (j *Job) TableName() string {
+	return "job"
+}
+
+// Running returns whether a controller currently holds the lease for the
+// job.
+func (j *Job) Running() bool {
+	return j.LeaseHolder != ""
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withRunJobsInterval time.Duration
+	withLeaseDuration   time.Duration
+	withLimit           int
+	withStartPageAfter  *db.PageCursor
+}
+
+func getDefaultOptions() options {
+	return options{
+		withRunJobsInterval: defaultRunJobsInterval,
+		withLeaseDuration:   defaultLeaseDuration,
+		withLimit:           -1,
+	}
+}
+
+// WithRunJobsInterval sets how often the scheduler checks for jobs that are
+// due to run. Zero means the default is used.
+func WithRunJobsInterval(interval time.Duration) Option {
+	return func(o *options) {
+		if interval > 0 {
+			o.withRunJobsInterval = interval
+		}
+	}
+}
+
+// WithLeaseDuration sets how long a controller holds the lease for a job it
+// runs. A run that takes longer than this is canceled so another controller
+// may take the job over. Zero means the default is used.
+func WithLeaseDuration(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.withLeaseDuration = d
+		}
+	}
+}
+
+// WithLimit provides an option to provide a limit when listing jobs. If
+// WithLimit < 0, which is the default, then unlimited results are returned.
+func WithLimit(limit int) Option {
+	return func(o *options) {
+		o.withLimit = limit
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing of
+// jobs which starts after the job at the cursor.  The PublicId of the cursor
+// holds the job's name.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+const (
+	// registerJobQuery inserts a job, or updates the description of an
+	// existing job. If the job's next run is further out than its interval,
+	// e.g. because the interval was shortened, the next run is moved in.
+	registerJobQuery = `
+insert into job
+	(name, description)
+values
+	($1, $2)
+on conflict (name)
+do update set
+	description = $2,
+	next_scheduled_run = least(job.next_scheduled_run, current_timestamp + $3 * interval '1 second')
+returning *;
+`
+
+	// acquireLeaseQuery takes the lease for a job if it is due to run and no
+	// other controller holds an unexpired lease on it.
+	acquireLeaseQuery = `
+update job
+set
+	lease_holder = $2,
+	lease_expiration = current_timestamp + $3 * interval '1 second',
+	last_run_start = current_timestamp,
+	last_run_controller_id = $2
+where
+	name = $1
+	and next_scheduled_run <= current_timestamp
+	and (lease_expiration is null or lease_expiration <= current_timestamp)
+returning *;
+`
+
+	// completeRunQuery records the outcome of a run, releases the lease and
+	// schedules the next run. If a run was requested while the job was
+	// running, the requested time is kept so the job runs again right away.
+	completeRunQuery = `
+update job
+set
+	lease_holder = null,
+	lease_expiration = null,
+	last_run_end = current_timestamp,
+	last_run_status = $3,
+	last_run_error = nullif($4, ''),
+	next_scheduled_run =
+		case
+			when next_scheduled_run > last_run_start then next_scheduled_run
+			else current_timestamp + $5 * interval '1 second'
+		end
+where
+	name = $1
+	and lease_holder = $2
+returning *;
+`
+
+	// runJobNowQuery schedules a job to run as soon as a controller picks it
+	// up.
+	runJobNowQuery = `
+update job
+set
+	next_scheduled_run = current_timestamp
+where
+	name = $1
+returning *;
+`
+)
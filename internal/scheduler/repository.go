@@ -0,0 +1,172 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// Repository is the scheduler database repository
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+}
+
+// NewRepository creates a new scheduler Repository.
+func NewRepository(r db.Reader, w db.Writer) (*Repository, error) {
+	if r == nil {
+		return nil, errors.New("error creating scheduler repository with nil reader")
+	}
+	if w == nil {
+		return nil, errors.New("error creating scheduler repository with nil writer")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+	}, nil
+}
+
+// RegisterJob stores a job if it does not exist, or updates the description
+// of an existing job. A new job is scheduled to run immediately; an existing
+// job keeps its schedule unless its next run is more than interval away.
+func (r *Repository) RegisterJob(ctx context.Context, name, description string, interval time.Duration) (*Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("register job: missing name: %w", db.ErrInvalidParameter)
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("register job: interval must be positive: %w", db.ErrInvalidParameter)
+	}
+	job, err := r.updateJob(ctx, registerJobQuery, name, description, interval.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("register job: %w", err)
+	}
+	return job, nil
+}
+
+// LookupJob returns the job with the given name. If the job is not found, it
+// will return nil, nil.
+func (r *Repository) LookupJob(ctx context.Context, name string) (*Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("lookup job: missing name: %w", db.ErrInvalidParameter)
+	}
+	var jobs []*Job
+	if err := r.reader.SearchWhere(ctx, &jobs, "name = ?", []interface{}{name}, db.WithLimit(1)); err != nil {
+		return nil, fmt.Errorf("lookup job: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+// ListJobs returns the registered jobs ordered by create time and then name.
+// Supports the WithLimit and WithStartPageAfter options.
+func (r *Repository) ListJobs(ctx context.Context, opt ...Option) ([]*Job, error) {
+	opts := getOpts(opt...)
+	where, args := "", []interface{}(nil)
+	if c := opts.withStartPageAfter; c != nil {
+		where, args = "(create_time, name) > (?, ?)", []interface{}{c.CreateTime, c.PublicId}
+	}
+	var jobs []*Job
+	if err := r.reader.SearchWhere(ctx, &jobs, where, args, db.WithLimit(opts.withLimit), db.WithOrder("create_time asc, name asc")); err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// AcquireLease takes the lease for the named job on behalf of serverId, if
+// the job is due to run and no other controller holds an unexpired lease on
+// it. The lease expires after leaseDuration. If the lease could not be
+// acquired, nil is returned with no error.
+func (r *Repository) AcquireLease(ctx context.Context, name, serverId string, leaseDuration time.Duration) (*Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("acquire lease: missing name: %w", db.ErrInvalidParameter)
+	}
+	if serverId == "" {
+		return nil, fmt.Errorf("acquire lease: missing server id: %w", db.ErrInvalidParameter)
+	}
+	if leaseDuration <= 0 {
+		return nil, fmt.Errorf("acquire lease: lease duration must be positive: %w", db.ErrInvalidParameter)
+	}
+	job, err := r.updateJob(ctx, acquireLeaseQuery, name, serverId, leaseDuration.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("acquire lease: %w", err)
+	}
+	return job, nil
+}
+
+// CompleteRun records the outcome of a run of the named job held by
+// serverId, releases the lease and schedules the next run interval from now.
+// A nil runErr records a successful run. If serverId no longer holds the
+// lease, e.g. because it expired and was taken over, nil is returned with no
+// error.
+func (r *Repository) CompleteRun(ctx context.Context, name, serverId string, interval time.Duration, runErr error) (*Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("complete run: missing name: %w", db.ErrInvalidParameter)
+	}
+	if serverId == "" {
+		return nil, fmt.Errorf("complete run: missing server id: %w", db.ErrInvalidParameter)
+	}
+	if interval <= 0 {
+		return nil, fmt.Errorf("complete run: interval must be positive: %w", db.ErrInvalidParameter)
+	}
+	status, errMsg := RunSucceeded, ""
+	if runErr != nil {
+		status, errMsg = RunFailed, runErr.Error()
+	}
+	job, err := r.updateJob(ctx, completeRunQuery, name, serverId, status.String(), errMsg, interval.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("complete run: %w", err)
+	}
+	return job, nil
+}
+
+// RunJobNow schedules the named job to run as soon as a controller picks it
+// up. If the job is currently running it is run again once the current run
+// completes. If the job is not found, it will return nil, nil.
+func (r *Repository) RunJobNow(ctx context.Context, name string) (*Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("run job now: missing name: %w", db.ErrInvalidParameter)
+	}
+	job, err := r.updateJob(ctx, runJobNowQuery, name)
+	if err != nil {
+		return nil, fmt.Errorf("run job now: %w", err)
+	}
+	return job, nil
+}
+
+// updateJob executes a query returning at most one job row within a
+// transaction. It returns nil if no row was returned.
+func (r *Repository) updateJob(ctx context.Context, query string, args ...interface{}) (*Job, error) {
+	var job *Job
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, _ db.Writer) error {
+			job = nil
+			rows, err := reader.Query(ctx, query, args)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				if job != nil {
+					return errors.New("query returned more than one job")
+				}
+				job = new(Job)
+				if err := reader.ScanRows(rows, job); err != nil {
+					return fmt.Errorf("scan row failed: %w", err)
+				}
+			}
+			return rows.Err()
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return job, nil
+}
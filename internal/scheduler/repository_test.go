@@ -0,0 +1,174 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_RegisterJob(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := NewRepository(rw, rw)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("invalid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.RegisterJob(ctx, "", "description", time.Minute)
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.RegisterJob(ctx, "test_job", "description", 0)
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+	})
+
+	t.Run("new and existing", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		job, err := repo.RegisterJob(ctx, "test_job", "description", time.Hour)
+		require.NoError(err)
+		require.NotNil(job)
+		assert.Equal("test_job", job.Name)
+		assert.Equal("description", job.Description)
+		assert.False(job.Running())
+		assert.Empty(job.LastRunStatus)
+		// A new job is due right away
+		assert.False(job.NextScheduledRun.GetTimestamp().AsTime().After(time.Now()))
+
+		// Push the next run out, then make sure registering with a shorter
+		// interval pulls it back in
+		_, err = rw.Exec(ctx, "update job set next_scheduled_run = now() + interval '1 day' where name = $1", []interface{}{"test_job"})
+		require.NoError(err)
+		job, err = repo.RegisterJob(ctx, "test_job", "new description", time.Hour)
+		require.NoError(err)
+		assert.Equal("new description", job.Description)
+		assert.True(job.NextScheduledRun.GetTimestamp().AsTime().Before(time.Now().Add(time.Hour + time.Minute)))
+		assert.True(job.NextScheduledRun.GetTimestamp().AsTime().After(time.Now().Add(time.Hour - time.Minute)))
+
+		got, err := repo.LookupJob(ctx, "test_job")
+		require.NoError(err)
+		assert.Equal(job.Description, got.Description)
+
+		got, err = repo.LookupJob(ctx, "unknown_job")
+		require.NoError(err)
+		assert.Nil(got)
+	})
+}
+
+func TestRepository_ListJobs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := NewRepository(rw, rw)
+	require.NoError(err)
+	ctx := context.Background()
+
+	jobs, err := repo.ListJobs(ctx)
+	require.NoError(err)
+	assert.Empty(jobs)
+
+	for _, name := range []string{"job_b", "job_a", "job_c"} {
+		_, err := repo.RegisterJob(ctx, name, name, time.Minute)
+		require.NoError(err)
+	}
+	jobs, err = repo.ListJobs(ctx)
+	require.NoError(err)
+	require.Len(jobs, 3)
+	assert.Equal("job_b", jobs[0].Name)
+	assert.Equal("job_a", jobs[1].Name)
+	assert.Equal("job_c", jobs[2].Name)
+
+	jobs, err = repo.ListJobs(ctx, WithLimit(2))
+	require.NoError(err)
+	require.Len(jobs, 2)
+	assert.Equal("job_a", jobs[1].Name)
+
+	cursor := &db.PageCursor{CreateTime: jobs[1].CreateTime.GetTimestamp().AsTime(), PublicId: jobs[1].Name}
+	jobs, err = repo.ListJobs(ctx, WithStartPageAfter(cursor))
+	require.NoError(err)
+	require.Len(jobs, 1)
+	assert.Equal("job_c", jobs[0].Name)
+}
+
+func TestRepository_Lease(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := NewRepository(rw, rw)
+	require.NoError(err)
+	ctx := context.Background()
+
+	_, err = repo.RegisterJob(ctx, "test_job", "description", time.Hour)
+	require.NoError(err)
+
+	_, err = repo.AcquireLease(ctx, "test_job", "", time.Minute)
+	assert.True(errors.Is(err, db.ErrInvalidParameter))
+
+	// The first controller gets the lease, the second does not
+	job, err := repo.AcquireLease(ctx, "test_job", "controller_1", time.Minute)
+	require.NoError(err)
+	require.NotNil(job)
+	assert.True(job.Running())
+	assert.Equal("controller_1", job.LeaseHolder)
+	assert.Equal("controller_1", job.LastRunControllerId)
+	assert.NotNil(job.LastRunStart)
+
+	job, err = repo.AcquireLease(ctx, "test_job", "controller_2", time.Minute)
+	require.NoError(err)
+	assert.Nil(job)
+
+	// Only the holder can complete the run
+	job, err = repo.CompleteRun(ctx, "test_job", "controller_2", time.Hour, nil)
+	require.NoError(err)
+	assert.Nil(job)
+
+	job, err = repo.CompleteRun(ctx, "test_job", "controller_1", time.Hour, errors.New("failed to run"))
+	require.NoError(err)
+	require.NotNil(job)
+	assert.False(job.Running())
+	assert.Equal(RunFailed.String(), job.LastRunStatus)
+	assert.Equal("failed to run", job.LastRunError)
+	assert.NotNil(job.LastRunEnd)
+	assert.True(job.NextScheduledRun.GetTimestamp().AsTime().After(time.Now().Add(time.Hour - time.Minute)))
+
+	// Not due again for an hour
+	job, err = repo.AcquireLease(ctx, "test_job", "controller_2", time.Minute)
+	require.NoError(err)
+	assert.Nil(job)
+
+	// Until it is asked to run now
+	job, err = repo.RunJobNow(ctx, "test_job")
+	require.NoError(err)
+	require.NotNil(job)
+	job, err = repo.AcquireLease(ctx, "test_job", "controller_2", time.Minute)
+	require.NoError(err)
+	require.NotNil(job)
+	assert.Equal("controller_2", job.LeaseHolder)
+
+	// A run requested while the job is running is kept when it completes
+	_, err = repo.RunJobNow(ctx, "test_job")
+	require.NoError(err)
+	job, err = repo.CompleteRun(ctx, "test_job", "controller_2", time.Hour, nil)
+	require.NoError(err)
+	require.NotNil(job)
+	assert.Equal(RunSucceeded.String(), job.LastRunStatus)
+	assert.Empty(job.LastRunError)
+	assert.False(job.NextScheduledRun.GetTimestamp().AsTime().After(time.Now()))
+
+	// An expired lease can be taken over
+	job, err = repo.AcquireLease(ctx, "test_job", "controller_1", time.Second)
+	require.NoError(err)
+	require.NotNil(job)
+	time.Sleep(2 * time.Second)
+	job, err = repo.AcquireLease(ctx, "test_job", "controller_2", time.Minute)
+	require.NoError(err)
+	require.NotNil(job)
+	assert.Equal("controller_2", job.LeaseHolder)
+
+	job, err = repo.RunJobNow(ctx, "unknown_job")
+	require.NoError(err)
+	assert.Nil(job)
+}
@@ -0,0 +1,212 @@
+// Package scheduler runs named background jobs on an interval. Jobs are
+// registered with every controller, but each run is guarded by a lease stored
+// in the database so that only one controller runs a given job at a time. The
+// status of each job, including when it last ran and when it will next run,
+// is stored alongside the lease.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/metrics"
+	"github.com/hashicorp/go-hclog"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	defaultRunJobsInterval = time.Second
+	defaultLeaseDuration   = 5 * time.Minute
+
+	// completeRunTimeout bounds the time spent recording the outcome of a run
+	// that was interrupted by shutdown
+	completeRunTimeout = 10 * time.Second
+)
+
+// RunFunc performs the work of a job. The context is canceled if the
+// scheduler is shut down or the run outlasts its lease.
+type RunFunc func(ctx context.Context) error
+
+type registeredJob struct {
+	name        string
+	description string
+	interval    time.Duration
+	run         RunFunc
+	running     ua.Bool
+}
+
+// Scheduler runs registered jobs when they are due.
+type Scheduler struct {
+	serverId string
+	repoFn   func() (*Repository, error)
+	logger   hclog.Logger
+
+	runJobsInterval time.Duration
+	leaseDuration   time.Duration
+
+	jobsLock sync.RWMutex
+	jobs     map[string]*registeredJob
+
+	runningJobs *sync.WaitGroup
+}
+
+// New creates a Scheduler which runs jobs on behalf of the server with the
+// given id. Supports the options: WithRunJobsInterval, which sets how often
+// the scheduler checks for due jobs, and WithLeaseDuration, which sets how
+// long a run may take before another controller may take it over.
+func New(serverId string, repoFn func() (*Repository, error), logger hclog.Logger, opt ...Option) (*Scheduler, error) {
+	if serverId == "" {
+		return nil, errors.New("error creating scheduler with empty server id")
+	}
+	if repoFn == nil {
+		return nil, errors.New("error creating scheduler with nil repo factory")
+	}
+	if logger == nil {
+		return nil, errors.New("error creating scheduler with nil logger")
+	}
+	opts := getOpts(opt...)
+	return &Scheduler{
+		serverId:        serverId,
+		repoFn:          repoFn,
+		logger:          logger,
+		runJobsInterval: opts.withRunJobsInterval,
+		leaseDuration:   opts.withLeaseDuration,
+		jobs:            make(map[string]*registeredJob),
+		runningJobs:     new(sync.WaitGroup),
+	}, nil
+}
+
+// RegisterJob stores the job in the database, if it is not already there,
+// and adds it to the jobs this scheduler will run. Registering a job with a
+// name that is already registered with this scheduler is an error.
+func (s *Scheduler) RegisterJob(ctx context.Context, name, description string, interval time.Duration, run RunFunc) error {
+	if name == "" {
+		return errors.New("error registering job with empty name")
+	}
+	if interval <= 0 {
+		return fmt.Errorf("error registering job %q with non-positive interval", name)
+	}
+	if run == nil {
+		return fmt.Errorf("error registering job %q with nil run func", name)
+	}
+
+	s.jobsLock.Lock()
+	defer s.jobsLock.Unlock()
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("job %q is already registered", name)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for registering job %q: %w", name, err)
+	}
+	if _, err := repo.RegisterJob(ctx, name, description, interval); err != nil {
+		return fmt.Errorf("error registering job %q: %w", name, err)
+	}
+	s.jobs[name] = &registeredJob{
+		name:        name,
+		description: description,
+		interval:    interval,
+		run:         run,
+	}
+	return nil
+}
+
+// Start begins checking for and running due jobs until ctx is canceled.
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		timer := time.NewTimer(0)
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("scheduler shutting down")
+				timer.Stop()
+				return
+
+			case <-timer.C:
+				s.runJobs(ctx)
+				timer.Reset(s.runJobsInterval)
+			}
+		}
+	}()
+}
+
+// Wait blocks until all jobs started by this scheduler have completed.
+// Jobs are canceled when the context passed to Start is canceled.
+func (s *Scheduler) Wait() {
+	s.runningJobs.Wait()
+}
+
+func (s *Scheduler) runJobs(ctx context.Context) {
+	repo, err := s.repoFn()
+	if err != nil {
+		s.logger.Error("error fetching repository for running jobs", "error", err)
+		return
+	}
+
+	s.jobsLock.RLock()
+	jobs := make([]*registeredJob, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.jobsLock.RUnlock()
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].name < jobs[j].name })
+
+	for _, j := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if j.running.Load() {
+			continue
+		}
+		leased, err := repo.AcquireLease(ctx, j.name, s.serverId, s.leaseDuration)
+		if err != nil {
+			s.logger.Error("error acquiring job lease", "job", j.name, "error", err)
+			continue
+		}
+		if leased == nil {
+			// Not due, or another controller is running it
+			continue
+		}
+		j.running.Store(true)
+		s.runningJobs.Add(1)
+		go s.runJob(ctx, repo, j)
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context, repo *Repository, j *registeredJob) {
+	defer s.runningJobs.Done()
+	defer j.running.Store(false)
+
+	s.logger.Trace("running job", "job", j.name)
+	start := time.Now()
+	runCtx, cancel := context.WithTimeout(ctx, s.leaseDuration)
+	runErr := j.run(runCtx)
+	cancel()
+	metrics.MeasureJob(j.name, start, runErr)
+	if runErr != nil {
+		s.logger.Error("error running job", "job", j.name, "error", runErr)
+	}
+
+	completeCtx := ctx
+	if ctx.Err() != nil {
+		// We're shutting down; still release the lease so that another
+		// controller does not have to wait for it to expire
+		var completeCancel context.CancelFunc
+		completeCtx, completeCancel = context.WithTimeout(context.Background(), completeRunTimeout)
+		defer completeCancel()
+	}
+	job, err := repo.CompleteRun(completeCtx, j.name, s.serverId, j.interval, runErr)
+	switch {
+	case err != nil:
+		s.logger.Error("error completing job run", "job", j.name, "error", err)
+	case job == nil:
+		s.logger.Warn("job lease was lost before the run completed", "job", j.name)
+	default:
+		s.logger.Trace("job run completed", "job", j.name)
+	}
+}
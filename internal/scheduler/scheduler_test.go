@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ua "go.uber.org/atomic"
+)
+
+func TestScheduler_New(t *testing.T) {
+	repoFn := func() (*Repository, error) { return nil, nil }
+	logger := hclog.NewNullLogger()
+
+	_, err := New("", repoFn, logger)
+	assert.Error(t, err)
+	_, err = New("controller", nil, logger)
+	assert.Error(t, err)
+	_, err = New("controller", repoFn, nil)
+	assert.Error(t, err)
+
+	s, err := New("controller", repoFn, logger, WithRunJobsInterval(time.Minute), WithLeaseDuration(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, s.runJobsInterval)
+	assert.Equal(t, time.Hour, s.leaseDuration)
+
+	s, err = New("controller", repoFn, logger)
+	require.NoError(t, err)
+	assert.Equal(t, defaultRunJobsInterval, s.runJobsInterval)
+	assert.Equal(t, defaultLeaseDuration, s.leaseDuration)
+}
+
+func TestScheduler_RunJobs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repoFn := func() (*Repository, error) {
+		return NewRepository(rw, rw)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Two schedulers share a job; each run blocks until released so we can
+	// verify only one of them runs it at a time
+	var runs, concurrent, maxConcurrent ua.Int32
+	release := make(chan struct{})
+	run := func(ctx context.Context) error {
+		defer concurrent.Dec()
+		if c := concurrent.Inc(); c > maxConcurrent.Load() {
+			maxConcurrent.Store(c)
+		}
+		runs.Inc()
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return errors.New("run failed")
+	}
+
+	var schedulers []*Scheduler
+	for _, name := range []string{"controller_1", "controller_2"} {
+		s, err := New(name, repoFn, hclog.NewNullLogger(), WithRunJobsInterval(100*time.Millisecond))
+		require.NoError(err)
+		require.NoError(s.RegisterJob(ctx, "test_job", "description", time.Hour, run))
+		assert.Error(s.RegisterJob(ctx, "test_job", "description", time.Hour, run))
+		s.Start(ctx)
+		schedulers = append(schedulers, s)
+	}
+
+	time.Sleep(time.Second)
+	assert.Equal(int32(1), runs.Load())
+	assert.Equal(int32(1), maxConcurrent.Load())
+	close(release)
+
+	repo, err := repoFn()
+	require.NoError(err)
+	var job *Job
+	for i := 0; i < 20; i++ {
+		job, err = repo.LookupJob(ctx, "test_job")
+		require.NoError(err)
+		if job.LastRunStatus != "" {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.NotNil(job)
+	assert.False(job.Running())
+	assert.Equal(RunFailed.String(), job.LastRunStatus)
+	assert.Equal("run failed", job.LastRunError)
+
+	// Not due again for an hour, unless asked to run now
+	time.Sleep(500 * time.Millisecond)
+	assert.Equal(int32(1), runs.Load())
+	_, err = repo.RunJobNow(ctx, "test_job")
+	require.NoError(err)
+	time.Sleep(time.Second)
+	assert.Equal(int32(2), runs.Load())
+
+	cancel()
+	for _, s := range schedulers {
+		s.Wait()
+	}
+}
@@ -1,10 +1,15 @@
 package common
 
 import (
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -12,10 +17,20 @@ import (
 
 type (
 	AuthTokenRepoFactory    func() (*authtoken.Repository, error)
+	DnsRepoFactory          func() (*dns.Repository, error)
 	IamRepoFactory          func() (*iam.Repository, error)
+	LdapAuthRepoFactory     func() (*ldap.Repository, error)
+	OidcAuthRepoFactory     func() (*oidc.Repository, error)
 	PasswordAuthRepoFactory func() (*password.Repository, error)
+	PluginHostRepoFactory   func() (*plugin.Repository, error)
+	SchedulerRepoFactory    func() (*scheduler.Repository, error)
 	ServersRepoFactory      func() (*servers.Repository, error)
 	StaticRepoFactory       func() (*static.Repository, error)
 	SessionRepoFactory      func() (*session.Repository, error)
 	TargetRepoFactory       func() (*target.Repository, error)
 )
+
+// KmsRewrapDataJob is the name of the scheduler job that re-encrypts data
+// under the newest key versions. It is scheduled to run right away when a
+// scope's keys are rewrapped.
+const KmsRewrapDataJob = "kms_rewrap_data"
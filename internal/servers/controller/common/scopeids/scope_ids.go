@@ -0,0 +1,75 @@
+// Package scopeids contains the helpers used by list requests to find the
+// scopes they cover.
+package scopeids
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+)
+
+// AuthFunc verifies the request against the list action in the provided scope.
+type AuthFunc func(ctx context.Context, scopeId string) auth.VerifyResults
+
+// GetListingScopeIds returns the ids of the scopes a list request covers along
+// with the auth results for each of them, keyed by scope id.  rootResults are
+// the auth results for the scope in the request.
+//
+// When the request isn't recursive only the requested scope is covered and any
+// error in its auth results is returned.  When it is recursive the scope tree
+// under the requested scope is walked and every scope in it which authFn
+// allows listing in is covered.  The requested scope itself may then be
+// forbidden, as long as listing is allowed in at least one of the scopes
+// under it.
+func GetListingScopeIds(
+	ctx context.Context,
+	iamRepoFn common.IamRepoFactory,
+	rootResults auth.VerifyResults,
+	rootScopeId string,
+	recursive bool,
+	authFn AuthFunc) ([]string, map[string]auth.VerifyResults, error) {
+	if rootResults.Error != nil && (!recursive || !errors.Is(rootResults.Error, handlers.ForbiddenError())) {
+		return nil, nil, rootResults.Error
+	}
+
+	var scopeIds []string
+	results := make(map[string]auth.VerifyResults)
+	if rootResults.Error == nil {
+		scopeIds = append(scopeIds, rootScopeId)
+		results[rootScopeId] = rootResults
+	}
+	if !recursive {
+		return scopeIds, results, nil
+	}
+
+	repo, err := iamRepoFn()
+	if err != nil {
+		return nil, nil, err
+	}
+	// A scope is walked even when listing isn't allowed in it since listing
+	// may be allowed in the scopes under it.
+	for parentIds := []string{rootScopeId}; len(parentIds) > 0; {
+		children, err := repo.ListScopes(ctx, parentIds, iam.WithLimit(-1))
+		if err != nil {
+			return nil, nil, err
+		}
+		parentIds = nil
+		for _, c := range children {
+			parentIds = append(parentIds, c.GetPublicId())
+			res := authFn(ctx, c.GetPublicId())
+			if res.Error != nil {
+				continue
+			}
+			scopeIds = append(scopeIds, c.GetPublicId())
+			results[c.GetPublicId()] = res
+		}
+	}
+	if len(scopeIds) == 0 && rootResults.Error != nil {
+		return nil, nil, rootResults.Error
+	}
+	return scopeIds, results, nil
+}
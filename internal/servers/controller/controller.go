@@ -5,14 +5,22 @@ import (
 	"crypto/rand"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
+	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/session"
@@ -22,8 +30,13 @@ import (
 	"github.com/hashicorp/vault/sdk/helper/mlock"
 	"github.com/patrickmn/go-cache"
 	ua "go.uber.org/atomic"
+	"google.golang.org/grpc"
 )
 
+// hostCatalogPluginTimeout bounds how long the controller waits to connect
+// to a host catalog plugin when it starts.
+const hostCatalogPluginTimeout = 30 * time.Second
+
 type Controller struct {
 	conf   *Config
 	logger hclog.Logger
@@ -39,15 +52,32 @@ type Controller struct {
 
 	// Repo factory methods
 	AuthTokenRepoFn    common.AuthTokenRepoFactory
+	DnsHostRepoFn      common.DnsRepoFactory
 	IamRepoFn          common.IamRepoFactory
+	LdapAuthRepoFn     common.LdapAuthRepoFactory
+	OidcAuthRepoFn     common.OidcAuthRepoFactory
 	PasswordAuthRepoFn common.PasswordAuthRepoFactory
+	PluginHostRepoFn   common.PluginHostRepoFactory
+	SchedulerRepoFn    common.SchedulerRepoFactory
 	ServersRepoFn      common.ServersRepoFactory
 	SessionRepoFn      common.SessionRepoFactory
 	StaticHostRepoFn   common.StaticRepoFactory
 	TargetRepoFn       common.TargetRepoFactory
 
+	// HostCatalogs holds the types of host catalogs the controller supports,
+	// both the built in ones and those served by host catalog plugins
+	HostCatalogs *host.Registry
+
 	kms *kms.Kms
 
+	// scheduler runs the controller's background jobs; it is created on
+	// start
+	scheduler *scheduler.Scheduler
+
+	// eventer sends audit events to the configured sinks; it is nil if
+	// auditing is not configured
+	eventer *audit.Eventer
+
 	clusterAddress string
 }
 
@@ -113,20 +143,53 @@ func New(conf *Config) (*Controller, error) {
 	c.StaticHostRepoFn = func() (*static.Repository, error) {
 		return static.NewRepository(dbase, dbase, c.kms)
 	}
+	// The resolver is shared so the record names of dns host sets resolved
+	// by one request are cached for the requests that follow.
+	dnsResolver := dns.NewResolver(dns.DefaultCacheTTL)
+	c.DnsHostRepoFn = func() (*dns.Repository, error) {
+		return dns.NewRepository(dbase, dbase, c.kms, dns.WithResolver(dnsResolver))
+	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
-		return authtoken.NewRepository(dbase, dbase, c.kms)
+		return authtoken.NewRepository(dbase, dbase, c.kms,
+			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
+			authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration))
 	}
 	c.ServersRepoFn = func() (*servers.Repository, error) {
 		return servers.NewRepository(dbase, dbase, c.kms)
 	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
-		return password.NewRepository(dbase, dbase, c.kms)
+		return password.NewRepository(dbase, dbase, c.kms, password.WithEventer(c.eventer))
+	}
+	c.OidcAuthRepoFn = func() (*oidc.Repository, error) {
+		return oidc.NewRepository(dbase, dbase, c.kms)
+	}
+	c.LdapAuthRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(dbase, dbase, c.kms)
 	}
 	c.TargetRepoFn = func() (*target.Repository, error) {
 		return target.NewRepository(dbase, dbase, c.kms)
 	}
+	c.PluginHostRepoFn = func() (*plugin.Repository, error) {
+		return plugin.NewRepository(dbase, dbase, c.kms)
+	}
+	c.SchedulerRepoFn = func() (*scheduler.Repository, error) {
+		return scheduler.NewRepository(dbase, dbase)
+	}
 	c.SessionRepoFn = func() (*session.Repository, error) {
-		return session.NewRepository(dbase, dbase, c.kms)
+		return session.NewRepository(dbase, dbase, c.kms, session.WithEventer(c.eventer))
+	}
+
+	c.HostCatalogs, err = host.NewRegistry(
+		host.NewStaticCatalog(c.StaticHostRepoFn),
+		host.NewDnsCatalog(c.DnsHostRepoFn),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating host catalog registry: %w", err)
+	}
+	for _, p := range conf.RawConfig.Controller.HostCatalogPlugins {
+		if err := c.registerHostCatalogPlugin(p); err != nil {
+			return nil, err
+		}
 	}
 
 	c.workerAuthCache = cache.New(0, 0)
@@ -134,6 +197,32 @@ func New(conf *Config) (*Controller, error) {
 	return c, nil
 }
 
+// registerHostCatalogPlugin connects to the host catalog plugin p and adds
+// the type of host catalogs it serves to c.HostCatalogs.
+func (c *Controller) registerHostCatalogPlugin(p *config.HostCatalogPlugin) error {
+	// The connection is not encrypted, so only local plugins are dialed.
+	if err := config.ValidateHostCatalogPluginAddress(p.Address); err != nil {
+		return fmt.Errorf("error connecting to host catalog plugin %q: %w", p.Name, err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), hostCatalogPluginTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, p.Address, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return fmt.Errorf("error connecting to host catalog plugin %q: %w", p.Name, err)
+	}
+	pc, err := plugin.NewCatalog(ctx, conn, c.PluginHostRepoFn)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error creating host catalog plugin %q: %w", p.Name, err)
+	}
+	if err := c.HostCatalogs.Register(pc); err != nil {
+		conn.Close()
+		return fmt.Errorf("error registering host catalog plugin %q: %w", p.Name, err)
+	}
+	c.logger.Info("registered host catalog plugin", "name", p.Name, "type", pc.Type())
+	return nil
+}
+
 func (c *Controller) Start() error {
 	if c.started.Load() {
 		c.logger.Info("already started, skipping")
@@ -141,13 +230,18 @@ func (c *Controller) Start() error {
 	}
 	c.baseContext, c.baseCancel = context.WithCancel(context.Background())
 
+	if err := c.startEventer(); err != nil {
+		return fmt.Errorf("error starting controller audit eventer: %w", err)
+	}
 	if err := c.startListeners(); err != nil {
 		return fmt.Errorf("error starting controller listeners: %w", err)
 	}
 
 	c.startStatusTicking(c.baseContext)
-	c.startRecoveryNonceCleanupTicking(c.baseContext)
-	c.startTerminateCompletedSessionsTicking(c.baseContext)
+	c.startKmsCacheTicking(c.baseContext)
+	if err := c.startScheduler(); err != nil {
+		return fmt.Errorf("error starting controller scheduler: %w", err)
+	}
 	c.started.Store(true)
 
 	return nil
@@ -159,9 +253,13 @@ func (c *Controller) Shutdown(serversOnly bool) error {
 		return nil
 	}
 	c.baseCancel()
+	c.scheduler.Wait()
 	if err := c.stopListeners(serversOnly); err != nil {
 		return fmt.Errorf("error stopping controller listeners: %w", err)
 	}
+	if err := c.stopEventer(); err != nil {
+		return fmt.Errorf("error stopping controller audit eventer: %w", err)
+	}
 	c.clusterAddress = ""
 	c.started.Store(false)
 	return nil
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/audit"
+)
+
+// startEventer creates the audit eventer from the sinks in the events
+// configuration block. If no sinks are configured auditing is disabled and
+// the eventer is left nil.
+func (c *Controller) startEventer() error {
+	events := c.conf.RawConfig.Events
+	if events == nil || len(events.Sinks) == 0 {
+		return nil
+	}
+
+	sinks := make([]audit.Sink, 0, len(events.Sinks))
+	closeSinks := func() {
+		for _, s := range sinks {
+			_ = s.Close()
+		}
+	}
+	for _, cfg := range events.Sinks {
+		var sink audit.Sink
+		var err error
+		switch cfg.Type {
+		case "file":
+			sink, err = audit.NewFileSink(cfg.Path)
+		case "syslog":
+			var opts []audit.Option
+			if cfg.Facility != "" {
+				opts = append(opts, audit.WithSyslogFacility(cfg.Facility))
+			}
+			if cfg.Tag != "" {
+				opts = append(opts, audit.WithSyslogTag(cfg.Tag))
+			}
+			if cfg.Address != "" {
+				opts = append(opts, audit.WithSyslogAddress(cfg.Network, cfg.Address))
+			}
+			sink, err = audit.NewSyslogSink(opts...)
+		default:
+			err = fmt.Errorf("unknown sink type %q", cfg.Type)
+		}
+		if err != nil {
+			closeSinks()
+			return fmt.Errorf("error creating audit event sink: %w", err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	eventer, err := audit.NewEventer(sinks,
+		audit.WithLogger(c.logger.Named("audit")),
+		audit.WithKms(c.kms))
+	if err != nil {
+		closeSinks()
+		return fmt.Errorf("error creating audit eventer: %w", err)
+	}
+	c.eventer = eventer
+	return nil
+}
+
+// stopEventer closes the audit eventer's sinks
+func (c *Controller) stopEventer() error {
+	if c.eventer == nil {
+		return nil
+	}
+	err := c.eventer.Close()
+	c.eventer = nil
+	return err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -14,12 +15,15 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/metrics"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accounts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_sets"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/jobs"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/shared-secure-libs/configutil"
 
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
@@ -78,22 +82,23 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}),
 		runtime.WithErrorHandler(handlers.ErrorHandler(c.logger)),
 		runtime.WithForwardResponseOption(handlers.OutgoingInterceptor),
+		runtime.WithMetadata(recordRpcMethod),
 	)
-	hcs, err := host_catalogs.NewService(c.StaticHostRepoFn, c.IamRepoFn)
+	hcs, err := host_catalogs.NewService(c.IamRepoFn, c.HostCatalogs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host catalog handler service: %w", err)
 	}
 	if err := services.RegisterHostCatalogServiceHandlerServer(ctx, mux, hcs); err != nil {
 		return nil, fmt.Errorf("failed to register host catalog service handler: %w", err)
 	}
-	hss, err := host_sets.NewService(c.StaticHostRepoFn)
+	hss, err := host_sets.NewService(c.HostCatalogs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host set handler service: %w", err)
 	}
 	if err := services.RegisterHostSetServiceHandlerServer(ctx, mux, hss); err != nil {
 		return nil, fmt.Errorf("failed to register host set service handler: %w", err)
 	}
-	hs, err := hosts.NewService(c.StaticHostRepoFn)
+	hs, err := hosts.NewService(c.HostCatalogs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create host handler service: %w", err)
 	}
@@ -107,7 +112,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 	if err := services.RegisterAccountServiceHandlerServer(ctx, mux, accts); err != nil {
 		return nil, fmt.Errorf("failed to register account service handler: %w", err)
 	}
-	authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+	authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcAuthRepoFn, c.LdapAuthRepoFn, c.IamRepoFn, c.AuthTokenRepoFn, c.authenticateThrottle())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth method handler service: %w", err)
 	}
@@ -121,7 +126,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 	if err := services.RegisterAuthTokenServiceHandlerServer(ctx, mux, authtoks); err != nil {
 		return nil, fmt.Errorf("failed to register auth token service handler: %w", err)
 	}
-	os, err := scopes.NewService(c.IamRepoFn)
+	os, err := scopes.NewService(c.IamRepoFn, c.kms, c.SchedulerRepoFn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scope handler service: %w", err)
 	}
@@ -141,7 +146,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		c.IamRepoFn,
 		c.ServersRepoFn,
 		c.SessionRepoFn,
-		c.StaticHostRepoFn)
+		c.HostCatalogs)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create target handler service: %w", err)
 	}
@@ -169,10 +174,75 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 	if err := services.RegisterSessionServiceHandlerServer(ctx, mux, ss); err != nil {
 		return nil, fmt.Errorf("failed to register session service handler: %w", err)
 	}
+	if err := mux.HandlePath(http.MethodGet, "/v1/sessions/{id}:download-recording", handleDownloadRecording(c, mux, ss)); err != nil {
+		return nil, fmt.Errorf("failed to register session recording download handler: %w", err)
+	}
+	js, err := jobs.NewService(c.SchedulerRepoFn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job handler service: %w", err)
+	}
+	if err := services.RegisterJobServiceHandlerServer(ctx, mux, js); err != nil {
+		return nil, fmt.Errorf("failed to register job service handler: %w", err)
+	}
 
 	return mux, nil
 }
 
+// handleDownloadRecording streams the recording of the connection in the
+// connection_id query parameter of a session to the response.  Errors found
+// before any of the recording is written are returned like the errors of the
+// gRPC gateway.  An error after that aborts the response, so clients don't
+// mistake a truncated recording for a whole one.
+func handleDownloadRecording(c *Controller, mux *runtime.ServeMux, ss sessions.Service) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		if rm, ok := ctx.Value(requestMetricsKey).(*requestMetrics); ok {
+			rm.method = "/controller.api.services.v1.SessionService/DownloadRecording"
+		}
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		write, err := ss.DownloadRecording(ctx, pathParams["id"], r.URL.Query().Get("connection_id"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		rw := &recordingWriter{ResponseWriter: w}
+		if err := write(rw); err != nil {
+			if !rw.started {
+				runtime.HTTPError(ctx, mux, outbound, w, r, err)
+				return
+			}
+			c.logger.Error("failed to write session recording", "session_id", pathParams["id"], "error", err)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// recordingWriter sets the content type of a recording download when the
+// first byte of the recording is written.
+type recordingWriter struct {
+	http.ResponseWriter
+	started bool
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.Header().Set("Content-Type", "application/octet-stream")
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// authenticateThrottle returns the throttle for authenticate requests
+// configured in the controller's authenticate_throttle block, or the default
+// throttle if it is not configured.
+func (c *Controller) authenticateThrottle() *authmethods.Throttle {
+	t := c.conf.RawConfig.Controller.AuthenticateThrottle
+	if t == nil {
+		return authmethods.NewThrottle(authmethods.DefaultThrottleAttempts, authmethods.DefaultThrottlePeriod)
+	}
+	return authmethods.NewThrottle(t.Attempts, t.PeriodDuration)
+}
+
 func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProperties) http.Handler {
 	var maxRequestDuration time.Duration
 	var maxRequestSize int64
@@ -200,12 +270,23 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			c.logger.Trace("request received", "method", r.Method, "url", r.URL.RequestURI())
 		}
 
+		// Record the latency and status of API requests; non-API requests
+		// such as the UI are recorded under the "unknown" method
+		start := time.Now()
+		rm := &requestMetrics{method: "unknown"}
+		sr := &statusRecorder{ResponseWriter: w}
+		w = sr
+		defer func() {
+			metrics.MeasureApiRequest(rm.method, sr.code(), start)
+		}()
+
 		// Set the Cache-Control header for all responses returned
 		w.Header().Set("Cache-Control", "no-store")
 
 		// Start with the request context and our timeout
 		ctx, cancelFunc := context.WithTimeout(r.Context(), maxRequestDuration)
 		defer cancelFunc()
+		ctx = context.WithValue(ctx, requestMetricsKey, rm)
 
 		// Add a size limiter if desired
 		if maxRequestSize > 0 {
@@ -219,8 +300,19 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			DisableAuthzFailures: disableAuthzFailures,
 		}
 
+		requestId, err := uuid.GenerateUUID()
+		if err != nil {
+			c.logger.Error("unable to generate request id", "error", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		requestInfo.RequestId = requestId
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			requestInfo.ClientIp = host
+		}
+
 		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(c.logger, c.kms, r)
-		ctx = auth.NewVerifierContext(ctx, c.logger, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.kms, requestInfo)
+		ctx = auth.NewVerifierContext(ctx, c.logger, c.IamRepoFn, c.AuthTokenRepoFn, c.ServersRepoFn, c.kms, c.eventer, requestInfo)
 
 		// Set the context back on the request
 		r = r.WithContext(ctx)
@@ -30,6 +30,19 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.SetPassword,
+	action.ChangePassword,
+	action.Unlock,
+	action.EnrollTotp,
+	action.VerifyTotp,
+}
+
 // Service handles request as described by the pbs.AccountServiceServer interface.
 type Service struct {
 	repoFn common.PasswordAuthRepoFactory
@@ -54,14 +67,38 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var finalItems []*pb.Account
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			item.Scope = authResults.Scope
+			item.AuthorizedActions = authResults.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
 	}
-	return &pbs.ListAccountsResponse{Items: ul}, nil
+	return &pbs.ListAccountsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetAccount implements the interface pbs.AccountServiceServer.
@@ -78,6 +115,7 @@ func (s Service) GetAccount(ctx context.Context, req *pbs.GetAccountRequest) (*p
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetAccountResponse{Item: u}, nil
 }
 
@@ -165,6 +203,55 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: u}, nil
 }
 
+// UnlockAccount implements the interface pbs.AccountServiceServer.
+func (s Service) UnlockAccount(ctx context.Context, req *pbs.UnlockAccountRequest) (*pbs.UnlockAccountResponse, error) {
+	if err := validateUnlockAccountRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Unlock)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	u, err := s.unlockInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	u.Scope = authResults.Scope
+	return &pbs.UnlockAccountResponse{Item: u}, nil
+}
+
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	if err := validateEnrollTotpRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	uri, err := s.enrollTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.EnrollTotpResponse{ProvisioningUri: uri}, nil
+}
+
+// VerifyTotp implements the interface pbs.AccountServiceServer.
+func (s Service) VerifyTotp(ctx context.Context, req *pbs.VerifyTotpRequest) (*pbs.VerifyTotpResponse, error) {
+	if err := validateVerifyTotpRequest(req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.VerifyTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	recoveryCodes, err := s.verifyTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.VerifyTotpResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Account, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -211,6 +298,10 @@ func (s Service) createInRepo(ctx context.Context, authMethodId, scopeId string,
 	}
 	out, err := repo.CreateAccount(ctx, scopeId, a, createOpts...)
 	if err != nil {
+		if msg := passwordPolicyViolation(err); msg != "" {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"attributes.password": msg})
+		}
 		return nil, fmt.Errorf("unable to create user: %w", err)
 	}
 	if out == nil {
@@ -280,12 +371,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]*pb.Account, error) {
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, cursor *db.PageCursor, limit int) ([]*pb.Account, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAccounts(ctx, authMethodId)
+	ul, err := repo.ListAccounts(ctx, authMethodId, password.WithStartPageAfter(cursor), password.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -307,6 +398,10 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 	}
 	out, err := repo.ChangePassword(ctx, scopeId, id, currentPassword, newPassword, version)
 	if err != nil {
+		if msg := passwordPolicyViolation(err); msg != "" {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"new_password": msg})
+		}
 		switch {
 		case errors.Is(err, db.ErrRecordNotFound):
 			return nil, handlers.NotFoundErrorf("Account not found.")
@@ -316,6 +411,10 @@ func (s Service) changePasswordInRepo(ctx context.Context, scopeId, id string, v
 		case errors.Is(err, password.ErrPasswordsEqual):
 			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
 				map[string]string{"new_password": "New password equal to current password."})
+		case errors.Is(err, password.ErrAccountLocked):
+			// Respond as for a wrong current password so the lockout
+			// state of the account is not disclosed.
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Failed to change password.")
 		}
 		return nil, fmt.Errorf("unable to change password: %w", err)
 	}
@@ -332,6 +431,10 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 	}
 	out, err := repo.SetPassword(ctx, scopeId, id, pw, version)
 	if err != nil {
+		if msg := passwordPolicyViolation(err); msg != "" {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"password": msg})
+		}
 		switch {
 		case errors.Is(err, db.ErrRecordNotFound):
 			return nil, handlers.NotFoundErrorf("Account not found.")
@@ -344,6 +447,74 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 	return toProto(out)
 }
 
+// passwordPolicyViolation returns a message describing why a password was
+// rejected by the password policy of its auth method, or an empty string if
+// err is not a password policy error. ErrTooShort is not included as it is
+// also returned for login names.
+func passwordPolicyViolation(err error) string {
+	switch {
+	case errors.Is(err, password.ErrTooFewCharacterClasses):
+		return "Password does not contain enough character classes."
+	case errors.Is(err, password.ErrContainsLoginName):
+		return "Password must not contain the login name."
+	case errors.Is(err, password.ErrPasswordReused):
+		return "Password was used recently and cannot be reused."
+	default:
+		return ""
+	}
+}
+
+func (s Service) unlockInRepo(ctx context.Context, id string) (*pb.Account, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.UnlockAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, fmt.Errorf("unable to unlock account: %w", err)
+	}
+	return toProto(out)
+}
+
+func (s Service) enrollTotpInRepo(ctx context.Context, scopeId, id string) (string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return "", err
+	}
+	uri, err := repo.EnrollTotp(ctx, scopeId, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", handlers.NotFoundErrorf("Account not found.")
+		}
+		return "", fmt.Errorf("unable to enroll totp: %w", err)
+	}
+	return uri, nil
+}
+
+func (s Service) verifyTotpInRepo(ctx context.Context, scopeId, id, code string) ([]string, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.VerifyTotp(ctx, scopeId, id, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		case errors.Is(err, password.ErrTotpNotEnrolled):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "The account has no TOTP enrollment to verify.")
+		case errors.Is(err, password.ErrInvalidTotpCode):
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{"code": "Invalid TOTP code."})
+		}
+		return nil, fmt.Errorf("unable to verify totp: %w", err)
+	}
+	return out, nil
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*password.AuthMethod, auth.VerifyResults) {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -468,6 +639,15 @@ func validateListRequest(req *pbs.ListAccountsRequest) error {
 	if !handlers.ValidId(password.AuthMethodPrefix, req.GetAuthMethodId()) {
 		badFields["auth_method_id"] = "Invalid formatted identifier."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -507,3 +687,39 @@ func validateSetPasswordRequest(req *pbs.SetPasswordRequest) error {
 	}
 	return nil
 }
+
+func validateUnlockAccountRequest(req *pbs.UnlockAccountRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(password.AccountPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateEnrollTotpRequest(req *pbs.EnrollTotpRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(password.AccountPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateVerifyTotpRequest(req *pbs.VerifyTotpRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(password.AccountPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetCode() == "" {
+		badFields["code"] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
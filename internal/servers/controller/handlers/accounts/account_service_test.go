@@ -1,10 +1,12 @@
 package accounts_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
@@ -46,14 +48,15 @@ func TestGet(t *testing.T) {
 	aa := password.TestAccounts(t, conn, am.GetPublicId(), 1)[0]
 
 	wireAccount := pb.Account{
-		Id:           aa.GetPublicId(),
-		AuthMethodId: aa.GetAuthMethodId(),
-		CreatedTime:  aa.GetCreateTime().GetTimestamp(),
-		UpdatedTime:  aa.GetUpdateTime().GetTimestamp(),
-		Scope:        &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String()},
-		Version:      1,
-		Type:         "password",
-		Attributes:   &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
+		Id:                aa.GetPublicId(),
+		AuthMethodId:      aa.GetAuthMethodId(),
+		CreatedTime:       aa.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       aa.GetUpdateTime().GetTimestamp(),
+		AuthorizedActions: accounts.IdActions.Strings(),
+		Scope:             &scopepb.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String()},
+		Version:           1,
+		Type:              "password",
+		Attributes:        &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
 	}
 
 	cases := []struct {
@@ -115,28 +118,30 @@ func TestList(t *testing.T) {
 	var wantSomeAccounts []*pb.Account
 	for _, aa := range password.TestAccounts(t, conn, amSomeAccounts.GetPublicId(), 3) {
 		wantSomeAccounts = append(wantSomeAccounts, &pb.Account{
-			Id:           aa.GetPublicId(),
-			AuthMethodId: aa.GetAuthMethodId(),
-			CreatedTime:  aa.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  aa.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String()},
-			Version:      1,
-			Type:         "password",
-			Attributes:   &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
+			Id:                aa.GetPublicId(),
+			AuthMethodId:      aa.GetAuthMethodId(),
+			CreatedTime:       aa.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       aa.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: accounts.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String()},
+			Version:           1,
+			Type:              "password",
+			Attributes:        &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
 		})
 	}
 
 	var wantOtherAccounts []*pb.Account
 	for _, aa := range password.TestAccounts(t, conn, amOtherAccounts.GetPublicId(), 3) {
 		wantOtherAccounts = append(wantOtherAccounts, &pb.Account{
-			Id:           aa.GetPublicId(),
-			AuthMethodId: aa.GetAuthMethodId(),
-			CreatedTime:  aa.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  aa.GetUpdateTime().GetTimestamp(),
-			Scope:        &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String()},
-			Version:      1,
-			Type:         "password",
-			Attributes:   &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
+			Id:                aa.GetPublicId(),
+			AuthMethodId:      aa.GetAuthMethodId(),
+			CreatedTime:       aa.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       aa.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: accounts.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: scope.Org.String()},
+			Version:           1,
+			Type:              "password",
+			Attributes:        &structpb.Struct{Fields: map[string]*structpb.Value{"login_name": structpb.NewStringValue(aa.GetLoginName())}},
 		})
 	}
 
@@ -924,6 +929,76 @@ func TestSetPassword(t *testing.T) {
 	}
 }
 
+func TestSetPassword_Policy(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+
+	repo, err := repoFn()
+	require.NoError(t, err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.MinCharacterClasses = 2
+	am.PasswordHistoryCount = 1
+	am.DisallowLoginName = true
+	_, _, err = repo.UpdateAuthMethod(context.Background(), am, am.GetVersion(),
+		[]string{"MinCharacterClasses", "PasswordHistoryCount", "DisallowLoginName"})
+	require.NoError(t, err)
+	acct := password.TestAccounts(t, conn, am.GetPublicId(), 1)[0]
+	updated, err := repo.SetPassword(context.Background(), o.GetPublicId(), acct.GetPublicId(), "password-1", acct.GetVersion())
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "too few character classes",
+			password: "passwordtwo",
+			wantErr:  true,
+		},
+		{
+			name:     "contains login name",
+			password: "password-" + acct.GetLoginName(),
+			wantErr:  true,
+		},
+		{
+			name:     "reused",
+			password: "password-1",
+			wantErr:  true,
+		},
+		{
+			name:     "valid",
+			password: "password-2",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			setResp, err := tested.SetPassword(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.SetPasswordRequest{
+				Id:       acct.GetPublicId(),
+				Version:  updated.GetVersion(),
+				Password: tt.password,
+			})
+			if tt.wantErr {
+				assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+				assert.Nil(setResp)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(updated.GetVersion()+1, setResp.GetItem().GetVersion())
+		})
+	}
+}
+
 func TestChangePassword(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -1066,3 +1141,121 @@ func TestChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestUnlockAccount(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new account service.")
+
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.LockoutThreshold = 1
+	repo, err := repoFn()
+	require.NoError(t, err)
+	am, _, err = repo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"LockoutThreshold"})
+	require.NoError(t, err)
+
+	acct := password.TestAccounts(t, conn, am.GetPublicId(), 1)[0]
+	_, err = repo.SetPassword(ctx, o.GetPublicId(), acct.GetPublicId(), "thetestpassword", acct.GetVersion())
+	require.NoError(t, err)
+
+	got, err := repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), acct.GetLoginName(), "wrongpassword")
+	require.NoError(t, err)
+	require.Nil(t, got)
+	_, err = repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), acct.GetLoginName(), "thetestpassword")
+	require.True(t, errors.Is(err, password.ErrAccountLocked))
+
+	t.Run("unlock", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		resp, err := tested.UnlockAccount(ctx, &pbs.UnlockAccountRequest{Id: acct.GetPublicId()})
+		require.NoError(err)
+		assert.Equal(acct.GetPublicId(), resp.GetItem().GetId())
+
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), acct.GetLoginName(), "thetestpassword")
+		require.NoError(err)
+		assert.NotNil(got)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		assert := assert.New(t)
+		resp, err := tested.UnlockAccount(ctx, &pbs.UnlockAccountRequest{Id: "ampw_1234567890"})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		assert.Nil(resp)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		assert := assert.New(t)
+		resp, err := tested.UnlockAccount(ctx, &pbs.UnlockAccountRequest{Id: password.AccountPrefix + "_DoesntExis"})
+		assert.Error(err)
+		assert.Nil(resp)
+	})
+}
+
+func TestEnrollAndVerifyTotp(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	repoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(repoFn)
+	require.NoError(t, err, "Error when getting new account service.")
+
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccounts(t, conn, am.GetPublicId(), 1)[0]
+
+	t.Run("verify not enrolled", func(t *testing.T) {
+		assert := assert.New(t)
+		resp, err := tested.VerifyTotp(ctx, &pbs.VerifyTotpRequest{Id: acct.GetPublicId(), Code: "123456"})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+		assert.Nil(resp)
+	})
+
+	t.Run("enroll and verify", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		enrollResp, err := tested.EnrollTotp(ctx, &pbs.EnrollTotpRequest{Id: acct.GetPublicId()})
+		require.NoError(err)
+		uri := enrollResp.GetProvisioningUri()
+		assert.True(strings.HasPrefix(uri, "otpauth://totp/"), "got uri %s", uri)
+
+		code := password.TestTotpCode(t, uri, time.Now())
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		verifyResp, err := tested.VerifyTotp(ctx, &pbs.VerifyTotpRequest{Id: acct.GetPublicId(), Code: wrong})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		assert.Nil(verifyResp)
+
+		verifyResp, err = tested.VerifyTotp(ctx, &pbs.VerifyTotpRequest{Id: acct.GetPublicId(), Code: code})
+		require.NoError(err)
+		assert.Len(verifyResp.GetRecoveryCodes(), 10)
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := tested.EnrollTotp(ctx, &pbs.EnrollTotpRequest{Id: "ampw_1234567890"})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+		_, err = tested.VerifyTotp(ctx, &pbs.VerifyTotpRequest{Id: acct.GetPublicId()})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		assert := assert.New(t)
+		resp, err := tested.EnrollTotp(ctx, &pbs.EnrollTotpRequest{Id: password.AccountPrefix + "_DoesntExis"})
+		assert.Error(err)
+		assert.Nil(resp)
+	})
+}
@@ -2,11 +2,18 @@ package authmethods
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
+	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/auth/password/store"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -18,50 +25,90 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	loginNameKey = "login_name"
 	pwKey        = "password"
+	newPwKey     = "new_password"
+	totpCodeKey  = "totp_code"
+	recoveryKey  = "recovery_code"
+	codeKey      = "code"
+	stateKey     = "state"
+
+	// maxCharacterClasses is the number of character classes a password
+	// policy can require: lowercase letters, uppercase letters, digits and
+	// other characters.
+	maxCharacterClasses = 4
 )
 
 var (
-	maskManager handlers.MaskManager
+	pwMaskManager   handlers.MaskManager
+	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 )
 
 func init() {
 	var err error
-	if maskManager, err = handlers.NewMaskManager(&store.AuthMethod{}, &pb.AuthMethod{}, &pb.PasswordAuthMethodAttributes{}); err != nil {
+	if pwMaskManager, err = handlers.NewMaskManager(&store.AuthMethod{}, &pb.AuthMethod{}, &pb.PasswordAuthMethodAttributes{}); err != nil {
+		panic(err)
+	}
+	if oidcMaskManager, err = handlers.NewMaskManager(&oidcstore.AuthMethod{}, &pb.AuthMethod{}, &pb.OidcAuthMethodAttributes{}); err != nil {
+		panic(err)
+	}
+	if ldapMaskManager, err = handlers.NewMaskManager(&ldapstore.AuthMethod{}, &pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}); err != nil {
 		panic(err)
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.Authenticate,
+}
+
 // Service handles request as described by the pbs.AuthMethodServiceServer interface.
 type Service struct {
-	kms       *kms.Kms
-	pwRepoFn  common.PasswordAuthRepoFactory
-	iamRepoFn common.IamRepoFactory
-	atRepoFn  common.AuthTokenRepoFactory
+	kms        *kms.Kms
+	pwRepoFn   common.PasswordAuthRepoFactory
+	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	iamRepoFn  common.IamRepoFactory
+	atRepoFn   common.AuthTokenRepoFactory
+	throttle   *Throttle
 }
 
-// NewService returns a auth method service which handles auth method related requests to boundary.
-func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory) (Service, error) {
+// NewService returns a auth method service which handles auth method related
+// requests to boundary. Authenticate requests are limited per client IP by
+// throttle; a nil throttle allows every request.
+func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, throttle *Throttle) (Service, error) {
 	if kms == nil {
 		return Service{}, errors.New("nil kms provided")
 	}
 	if pwRepoFn == nil {
 		return Service{}, fmt.Errorf("nil password repository provided")
 	}
+	if oidcRepoFn == nil {
+		return Service{}, fmt.Errorf("nil oidc repository provided")
+	}
+	if ldapRepoFn == nil {
+		return Service{}, fmt.Errorf("nil ldap repository provided")
+	}
 	if iamRepoFn == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{kms: kms, pwRepoFn: pwRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}, nil
+	return Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, ldapRepoFn: ldapRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn, throttle: throttle}, nil
 }
 
 var _ pbs.AuthMethodServiceServer = Service{}
@@ -72,17 +119,46 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
 	}
-	return &pbs.ListAuthMethodsResponse{Items: ul}, nil
+	var finalItems []*pb.AuthMethod
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
+	}
+	return &pbs.ListAuthMethodsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetAuthMethod implements the interface pbs.AuthMethodServiceServer.
@@ -99,6 +175,7 @@ func (s Service) GetAuthMethod(ctx context.Context, req *pbs.GetAuthMethodReques
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetAuthMethodResponse{Item: u}, nil
 }
 
@@ -161,15 +238,74 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	creds := req.GetCredentials().GetFields()
-	tok, err := s.authenticateWithRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), creds[loginNameKey].GetStringValue(), creds[pwKey].GetStringValue())
+	if !s.throttle.Allow(authResults.ClientIp()) {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted, "Too many authentication attempts, try again later.")
+	}
+	tok, err := s.authenticateWithRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), req.GetCredentials().GetFields())
 	if err != nil {
 		return nil, err
 	}
 	return &pbs.AuthenticateResponse{Item: tok, TokenType: req.GetTokenType()}, nil
 }
 
+// StartAuthentication implements the interface pbs.AuthMethodServiceServer.
+func (s Service) StartAuthentication(ctx context.Context, req *pbs.StartAuthenticationRequest) (*pbs.StartAuthenticationResponse, error) {
+	if err := validateStartAuthenticationRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	// starting an authentication contacts the provider, so it is throttled
+	// like Authenticate.
+	if !s.throttle.Allow(authResults.ClientIp()) {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.ResourceExhausted, "Too many authentication attempts, try again later.")
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	u, err := repo.StartAuthentication(ctx, req.GetAuthMethodId(), req.GetRedirectUrl())
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidParameter) {
+			return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.",
+				map[string]string{"redirect_url": "Must be an http URL on a loopback address."})
+		}
+		return nil, fmt.Errorf("unable to start authentication: %w", err)
+	}
+	return &pbs.StartAuthenticationResponse{AuthUrl: u}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist.", id)
+		}
+		return toOidcAuthMethodProto(am)
+	case auth.LdapSubtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		am, err := repo.LookupAuthMethod(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if am == nil {
+			return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist.", id)
+		}
+		return toLdapAuthMethodProto(am)
+	}
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
@@ -187,12 +323,14 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.AuthMethod, er
 	return toAuthMethodProto(u)
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.AuthMethod, error) {
+// listFromRepo returns the password, OIDC and LDAP auth methods in the scope after the
+// cursor, merged in the order auth methods are listed in and limited to limit.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.AuthMethod, error) {
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthMethods(ctx, scopeId)
+	ul, err := repo.ListAuthMethods(ctx, scopeIds, password.WithStartPageAfter(cursor), password.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -204,10 +342,59 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.AuthMe
 		}
 		outUl = append(outUl, ou)
 	}
+
+	oidcRepo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	oidcl, err := oidcRepo.ListAuthMethods(ctx, scopeIds, oidc.WithStartPageAfter(cursor), oidc.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	for _, am := range oidcl {
+		ou, err := toOidcAuthMethodProto(am)
+		if err != nil {
+			return nil, err
+		}
+		outUl = append(outUl, ou)
+	}
+
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ldapl, err := ldapRepo.ListAuthMethods(ctx, scopeIds, ldap.WithStartPageAfter(cursor), ldap.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	for _, am := range ldapl {
+		ou, err := toLdapAuthMethodProto(am)
+		if err != nil {
+			return nil, err
+		}
+		outUl = append(outUl, ou)
+	}
+
+	sort.SliceStable(outUl, func(i, j int) bool {
+		ti, tj := outUl[i].GetCreatedTime().AsTime(), outUl[j].GetCreatedTime().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return outUl[i].GetId() < outUl[j].GetId()
+	})
+	if limit > 0 && len(outUl) > limit {
+		outUl = outUl[:limit]
+	}
 	return outUl, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromType(item.GetType()) {
+	case auth.OidcSubtype:
+		return s.createOidcInRepo(ctx, scopeId, item)
+	case auth.LdapSubtype:
+		return s.createLdapInRepo(ctx, scopeId, item)
+	}
 	var opts []password.Option
 	if item.GetName() != nil {
 		opts = append(opts, password.WithName(item.GetName().GetValue()))
@@ -219,6 +406,20 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
 	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
+	pwAttrs := &pb.PasswordAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), pwAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	u.LockoutThreshold = pwAttrs.GetLockoutThreshold()
+	u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
+	u.MinCharacterClasses = pwAttrs.GetMinCharacterClasses()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeSeconds = pwAttrs.GetMaxPasswordAgeSeconds()
+	u.DisallowLoginName = pwAttrs.GetDisallowLoginName()
+	u.MfaRequired = pwAttrs.GetMfaRequired()
 	repo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
@@ -234,6 +435,12 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 }
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return s.updateOidcInRepo(ctx, scopeId, id, mask, item)
+	case auth.LdapSubtype:
+		return s.updateLdapInRepo(ctx, scopeId, id, mask, item)
+	}
 	var opts []password.Option
 	if desc := item.GetDescription(); desc != nil {
 		opts = append(opts, password.WithDescription(desc.GetValue()))
@@ -245,6 +452,8 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
 	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
 
 	pwAttrs := &pb.PasswordAuthMethodAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), pwAttrs); err != nil {
@@ -257,10 +466,17 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.LockoutThreshold = pwAttrs.GetLockoutThreshold()
+	u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
+	u.MinCharacterClasses = pwAttrs.GetMinCharacterClasses()
+	u.PasswordHistoryCount = pwAttrs.GetPasswordHistoryCount()
+	u.MaxPasswordAgeSeconds = pwAttrs.GetMaxPasswordAgeSeconds()
+	u.DisallowLoginName = pwAttrs.GetDisallowLoginName()
+	u.MfaRequired = pwAttrs.GetMfaRequired()
 	version := item.GetVersion()
 
 	u.PublicId = id
-	dbMask := maskManager.Translate(mask)
+	dbMask := pwMaskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
@@ -278,12 +494,198 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	return toAuthMethodProto(out)
 }
 
-func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.pwRepoFn()
+func (s Service) createOidcInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	oidcAttrs := &pb.OidcAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), oidcAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts := []oidc.Option{
+		oidc.WithIssuer(oidcAttrs.GetIssuer()),
+		oidc.WithClientId(oidcAttrs.GetClientId()),
+		oidc.WithClientSecret(oidcAttrs.GetClientSecret()),
+	}
+	if item.GetName() != nil {
+		opts = append(opts, oidc.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, oidc.WithDescription(item.GetDescription().GetValue()))
+	}
+	u, err := oidc.NewAuthMethod(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
+	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+	}
+	return toOidcAuthMethodProto(out)
+}
+
+func (s Service) updateOidcInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	oidcAttrs := &pb.OidcAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), oidcAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts := []oidc.Option{
+		oidc.WithIssuer(oidcAttrs.GetIssuer()),
+		oidc.WithClientId(oidcAttrs.GetClientId()),
+		oidc.WithClientSecret(oidcAttrs.GetClientSecret()),
+	}
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, oidc.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, oidc.WithName(name.GetValue()))
+	}
+	u, err := oidc.NewAuthMethod(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
+	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
+	version := item.GetVersion()
+
+	u.PublicId = id
+	dbMask := oidcMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.oidcRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return toOidcAuthMethodProto(out)
+}
+
+// ldapOptions returns the options for the attributes of an LDAP auth method.
+func ldapOptions(attrs *pb.LdapAuthMethodAttributes) []ldap.Option {
+	return []ldap.Option{
+		ldap.WithUrl(attrs.GetUrl()),
+		ldap.WithStartTls(attrs.GetStartTls()),
+		ldap.WithCertificate(attrs.GetCertificate()),
+		ldap.WithBindDn(attrs.GetBindDn()),
+		ldap.WithBindPassword(attrs.GetBindPassword()),
+		ldap.WithUserDn(attrs.GetUserDn()),
+		ldap.WithUserAttr(attrs.GetUserAttr()),
+		ldap.WithGroupDn(attrs.GetGroupDn()),
+		ldap.WithGroupAttr(attrs.GetGroupAttr()),
+	}
+}
+
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	ldapAttrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), ldapAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts := ldapOptions(ldapAttrs)
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	u, err := ldap.NewAuthMethod(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for creation: %v.", err)
+	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+	}
+	return toLdapAuthMethodProto(out)
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.AuthMethod) (*pb.AuthMethod, error) {
+	ldapAttrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), ldapAttrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	opts := ldapOptions(ldapAttrs)
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, ldap.WithDescription(desc.GetValue()))
+	}
+	if name := item.GetName(); name != nil {
+		opts = append(opts, ldap.WithName(name.GetValue()))
+	}
+	u, err := ldap.NewAuthMethod(scopeId, opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build auth method for update: %v.", err)
+	}
+	u.MaxTokenDurationSeconds = item.GetMaxTokenDurationSeconds()
+	u.MaxTokenIdleSeconds = item.GetMaxTokenIdleSeconds()
+	version := item.GetVersion()
+
+	u.PublicId = id
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", id)
+	}
+	return toLdapAuthMethodProto(out)
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	var rows int
+	var err error
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		repo, iErr := s.oidcRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
+	case auth.LdapSubtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
+	default:
+		repo, iErr := s.pwRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAuthMethod(ctx, scopeId, id)
 	}
-	rows, err := repo.DeleteAuthMethod(ctx, scopeId, id)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
 			return false, nil
@@ -293,7 +695,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId string, creds map[string]*structpb.Value) (*pba.AuthToken, error) {
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
@@ -302,24 +704,112 @@ func (s Service) authenticateWithRepo(ctx context.Context, scopeId, authMethodId
 	if err != nil {
 		return nil, err
 	}
-	pwRepo, err := s.pwRepoFn()
-	if err != nil {
-		return nil, err
-	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
-	if err != nil {
-		return nil, err
-	}
-	if acct == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	var acctId string
+	userOpts := []iam.Option{iam.WithAutoVivify(true)}
+	switch auth.SubtypeFromId(authMethodId) {
+	case auth.OidcSubtype:
+		oidcRepo, err := s.oidcRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		acct, err := oidcRepo.Authenticate(ctx, authMethodId, creds[codeKey].GetStringValue(), creds[stateKey].GetStringValue())
+		if err != nil {
+			return nil, err
+		}
+		if acct == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+		}
+		acctId = acct.GetPublicId()
+		if desc := userDescription(acct.GetFullName(), acct.GetEmail()); desc != "" {
+			userOpts = append(userOpts, iam.WithDescription(desc))
+		}
+	case auth.LdapSubtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		acct, err := ldapRepo.Authenticate(ctx, authMethodId, creds[loginNameKey].GetStringValue(), creds[pwKey].GetStringValue())
+		if err != nil {
+			return nil, err
+		}
+		if acct == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+		}
+		acctId = acct.GetPublicId()
+		if desc := userDescription(acct.GetFullName(), acct.GetEmail()); desc != "" {
+			userOpts = append(userOpts, iam.WithDescription(desc))
+		}
+	default:
+		pwRepo, err := s.pwRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		pw, newPw := creds[pwKey].GetStringValue(), creds[newPwKey].GetStringValue()
+		var pwOpts []password.Option
+		if code := creds[totpCodeKey].GetStringValue(); code != "" {
+			pwOpts = append(pwOpts, password.WithTotpCode(code))
+		}
+		if code := creds[recoveryKey].GetStringValue(); code != "" {
+			pwOpts = append(pwOpts, password.WithRecoveryCode(code))
+		}
+		acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, creds[loginNameKey].GetStringValue(), pw, pwOpts...)
+		expired := errors.Is(err, password.ErrPasswordExpired)
+		if err != nil && !expired {
+			switch {
+			case errors.Is(err, password.ErrAccountLocked):
+				// Respond as for a wrong password so the lockout state
+				// of an account is not disclosed.
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+			case errors.Is(err, password.ErrSecondFactorRequired):
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+					"A second factor is required and must be provided in credentials.%s or credentials.%s.", totpCodeKey, recoveryKey)
+			case errors.Is(err, password.ErrTotpNotEnrolled):
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+					"The auth method requires a second factor but the account is not enrolled for TOTP.")
+			}
+			return nil, err
+		}
+		if acct == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+		}
+		switch {
+		case newPw != "":
+			acct, err = pwRepo.ChangePassword(ctx, scopeId, acct.GetPublicId(), pw, newPw, acct.GetVersion())
+			if err != nil {
+				var msg string
+				switch {
+				case errors.Is(err, password.ErrTooShort):
+					msg = "Password is too short."
+				case errors.Is(err, password.ErrTooFewCharacterClasses):
+					msg = "Password does not contain enough character classes."
+				case errors.Is(err, password.ErrContainsLoginName):
+					msg = "Password must not contain the login name."
+				case errors.Is(err, password.ErrPasswordReused):
+					msg = "Password was used recently and cannot be reused."
+				case errors.Is(err, password.ErrPasswordsEqual):
+					msg = "New password equal to current password."
+				default:
+					return nil, err
+				}
+				return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+					map[string]string{"credentials." + newPwKey: msg})
+			}
+			if acct == nil {
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+			}
+		case expired:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+				"The password has expired and must be changed by providing credentials.%s.", newPwKey)
+		}
+		acctId = acct.GetPublicId()
 	}
 
-	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId(), iam.WithAutoVivify(true))
+	u, err := iamRepo.LookupUserWithLogin(ctx, acctId, userOpts...)
 	if err != nil {
 		return nil, err
 	}
-	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	tok, err := atRepo.CreateAuthToken(ctx, u, acctId)
 	if err != nil {
 		return nil, err
 	}
@@ -371,15 +861,50 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		repo, err := s.pwRepoFn()
-		if err != nil {
-			res.Error = err
-			return res
-		}
-		authMeth, err := repo.LookupAuthMethod(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
+		var authMeth interface{ GetScopeId() string }
+		switch auth.SubtypeFromId(id) {
+		case auth.OidcSubtype:
+			repo, err := s.oidcRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am != nil {
+				authMeth = am
+			}
+		case auth.LdapSubtype:
+			repo, err := s.ldapRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am != nil {
+				authMeth = am
+			}
+		default:
+			repo, err := s.pwRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am != nil {
+				authMeth = am
+			}
 		}
 		if authMeth == nil {
 			res.Error = handlers.NotFoundError()
@@ -394,12 +919,14 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 
 func toAuthMethodProto(in *password.AuthMethod) (*pb.AuthMethod, error) {
 	out := pb.AuthMethod{
-		Id:          in.GetPublicId(),
-		ScopeId:     in.GetScopeId(),
-		CreatedTime: in.GetCreateTime().GetTimestamp(),
-		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
-		Version:     in.GetVersion(),
-		Type:        auth.PasswordSubtype.String(),
+		Id:                      in.GetPublicId(),
+		ScopeId:                 in.GetScopeId(),
+		CreatedTime:             in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:             in.GetUpdateTime().GetTimestamp(),
+		Version:                 in.GetVersion(),
+		Type:                    auth.PasswordSubtype.String(),
+		MaxTokenDurationSeconds: in.GetMaxTokenDurationSeconds(),
+		MaxTokenIdleSeconds:     in.GetMaxTokenIdleSeconds(),
 	}
 	if in.GetDescription() != "" {
 		out.Description = wrapperspb.String(in.GetDescription())
@@ -408,8 +935,15 @@ func toAuthMethodProto(in *password.AuthMethod) (*pb.AuthMethod, error) {
 		out.Name = wrapperspb.String(in.GetName())
 	}
 	st, err := handlers.ProtoToStruct(&pb.PasswordAuthMethodAttributes{
-		MinLoginNameLength: in.GetMinLoginNameLength(),
-		MinPasswordLength:  in.GetMinPasswordLength(),
+		MinLoginNameLength:     in.GetMinLoginNameLength(),
+		MinPasswordLength:      in.GetMinPasswordLength(),
+		LockoutThreshold:       in.GetLockoutThreshold(),
+		LockoutDurationSeconds: in.GetLockoutDurationSeconds(),
+		MinCharacterClasses:    in.GetMinCharacterClasses(),
+		PasswordHistoryCount:   in.GetPasswordHistoryCount(),
+		MaxPasswordAgeSeconds:  in.GetMaxPasswordAgeSeconds(),
+		DisallowLoginName:      in.GetDisallowLoginName(),
+		MfaRequired:            in.GetMfaRequired(),
 	})
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building password attribute struct: %v", err)
@@ -418,6 +952,81 @@ func toAuthMethodProto(in *password.AuthMethod) (*pb.AuthMethod, error) {
 	return &out, nil
 }
 
+func toOidcAuthMethodProto(in *oidc.AuthMethod) (*pb.AuthMethod, error) {
+	out := pb.AuthMethod{
+		Id:                      in.GetPublicId(),
+		ScopeId:                 in.GetScopeId(),
+		CreatedTime:             in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:             in.GetUpdateTime().GetTimestamp(),
+		Version:                 in.GetVersion(),
+		Type:                    auth.OidcSubtype.String(),
+		MaxTokenDurationSeconds: in.GetMaxTokenDurationSeconds(),
+		MaxTokenIdleSeconds:     in.GetMaxTokenIdleSeconds(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	st, err := handlers.ProtoToStruct(&pb.OidcAuthMethodAttributes{
+		Issuer:   in.GetIssuer(),
+		ClientId: in.GetClientId(),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
+func toLdapAuthMethodProto(in *ldap.AuthMethod) (*pb.AuthMethod, error) {
+	out := pb.AuthMethod{
+		Id:                      in.GetPublicId(),
+		ScopeId:                 in.GetScopeId(),
+		CreatedTime:             in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:             in.GetUpdateTime().GetTimestamp(),
+		Version:                 in.GetVersion(),
+		Type:                    auth.LdapSubtype.String(),
+		MaxTokenDurationSeconds: in.GetMaxTokenDurationSeconds(),
+		MaxTokenIdleSeconds:     in.GetMaxTokenIdleSeconds(),
+	}
+	if in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	st, err := handlers.ProtoToStruct(&pb.LdapAuthMethodAttributes{
+		Url:         in.GetUrl(),
+		StartTls:    in.GetStartTls(),
+		Certificate: in.GetCertificate(),
+		BindDn:      in.GetBindDn(),
+		UserDn:      in.GetUserDn(),
+		UserAttr:    in.GetUserAttr(),
+		GroupDn:     in.GetGroupDn(),
+		GroupAttr:   in.GetGroupAttr(),
+	})
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+	}
+	out.Attributes = st
+	return &out, nil
+}
+
+// userDescription returns the description given to a user created the
+// first time an account with fullName and email authenticates.
+func userDescription(fullName, email string) string {
+	switch {
+	case fullName != "" && email != "":
+		return fmt.Sprintf("%s <%s>", fullName, email)
+	case fullName != "":
+		return fullName
+	default:
+		return email
+	}
+}
+
 func toAuthTokenProto(t *authtoken.AuthToken) *pba.AuthToken {
 	return &pba.AuthToken{
 		Id:                      t.GetPublicId(),
@@ -434,11 +1043,11 @@ func toAuthTokenProto(t *authtoken.AuthToken) *pba.AuthToken {
 
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
-//  * The path passed in is correctly formatted
-//  * All required parameters are set
-//  * There are no conflicting parameters provided
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetAuthMethodRequest) error {
-	return handlers.ValidateGetRequest(password.AuthMethodPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateGetRequest(authMethodPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateCreateRequest(req *pbs.CreateAuthMethodRequest) error {
@@ -453,16 +1062,53 @@ func validateCreateRequest(req *pbs.CreateAuthMethodRequest) error {
 			pwAttrs := &pb.PasswordAuthMethodAttributes{}
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), pwAttrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if pwAttrs.GetMinCharacterClasses() > maxCharacterClasses {
+				badFields["attributes.min_character_classes"] = fmt.Sprintf("This field must be between 0 and %d.", maxCharacterClasses)
+			}
+		case auth.OidcSubtype:
+			oidcAttrs := &pb.OidcAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), oidcAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if !validIssuer(oidcAttrs.GetIssuer()) {
+				badFields["attributes.issuer"] = "This is a required field and must be an http or https URL."
+			}
+			if oidcAttrs.GetClientId() == "" {
+				badFields["attributes.client_id"] = "This is a required field."
+			}
+			if oidcAttrs.GetClientSecret() == "" {
+				badFields["attributes.client_secret"] = "This is a required field."
+			}
+		case auth.LdapSubtype:
+			ldapAttrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), ldapAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if !validLdapUrl(ldapAttrs.GetUrl()) {
+				badFields["attributes.url"] = "This is a required field and must be an ldap or ldaps URL."
+			}
+			if ldapAttrs.GetUserDn() == "" {
+				badFields["attributes.user_dn"] = "This is a required field."
+			}
+			if ldapAttrs.GetBindDn() != "" && ldapAttrs.GetBindPassword() == "" {
+				badFields["attributes.bind_password"] = "This field is required when bind_dn is set."
+			}
+			if ldapAttrs.GetCertificate() != "" && !validCertificate(ldapAttrs.GetCertificate()) {
+				badFields["attributes.certificate"] = "This field must be a PEM encoded certificate."
 			}
 		default:
-			badFields["type"] = fmt.Sprintf("This is a required field and must be %q.", auth.PasswordSubtype.String())
+			badFields["type"] = fmt.Sprintf("This is a required field and must be %q, %q or %q.", auth.PasswordSubtype.String(), auth.OidcSubtype.String(), auth.LdapSubtype.String())
 		}
 		return badFields
 	})
 }
 
 func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
-	return handlers.ValidateUpdateRequest(password.AuthMethodPrefix, req, req.GetItem(), func() map[string]string {
+	return handlers.ValidateUpdateRequest(authMethodPrefix(req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		switch auth.SubtypeFromId(req.GetId()) {
 		case auth.PasswordSubtype:
@@ -472,6 +1118,46 @@ func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
 			pwAttrs := &pb.PasswordAuthMethodAttributes{}
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), pwAttrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if pwAttrs.GetMinCharacterClasses() > maxCharacterClasses {
+				badFields["attributes.min_character_classes"] = fmt.Sprintf("This field must be between 0 and %d.", maxCharacterClasses)
+			}
+		case auth.OidcSubtype:
+			if req.GetItem().GetType() != "" && auth.SubtypeFromType(req.GetItem().GetType()) != auth.OidcSubtype {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			oidcAttrs := &pb.OidcAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), oidcAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.issuer") && !validIssuer(oidcAttrs.GetIssuer()) {
+				badFields["attributes.issuer"] = "This field must be an http or https URL."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.client_id") && oidcAttrs.GetClientId() == "" {
+				badFields["attributes.client_id"] = "This field cannot be unset."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.client_secret") && oidcAttrs.GetClientSecret() == "" {
+				badFields["attributes.client_secret"] = "This field cannot be unset."
+			}
+		case auth.LdapSubtype:
+			if req.GetItem().GetType() != "" && auth.SubtypeFromType(req.GetItem().GetType()) != auth.LdapSubtype {
+				badFields["type"] = "Cannot modify the resource type."
+			}
+			ldapAttrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), ldapAttrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.url") && !validLdapUrl(ldapAttrs.GetUrl()) {
+				badFields["attributes.url"] = "This field must be an ldap or ldaps URL."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.user_dn") && ldapAttrs.GetUserDn() == "" {
+				badFields["attributes.user_dn"] = "This field cannot be unset."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.certificate") && ldapAttrs.GetCertificate() != "" && !validCertificate(ldapAttrs.GetCertificate()) {
+				badFields["attributes.certificate"] = "This field must be a PEM encoded certificate."
 			}
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
@@ -481,7 +1167,7 @@ func validateUpdateRequest(req *pbs.UpdateAuthMethodRequest) error {
 }
 
 func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
-	return handlers.ValidateDeleteRequest(password.AuthMethodPrefix, req, handlers.NoopValidatorFn)
+	return handlers.ValidateDeleteRequest(authMethodPrefix(req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
@@ -490,6 +1176,15 @@ func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "This field must be 'global' or a valid org scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -500,19 +1195,24 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	badFields := make(map[string]string)
 	if strings.TrimSpace(req.GetAuthMethodId()) == "" {
 		badFields["auth_method_id"] = "This is a required field."
-	} else if !handlers.ValidId(password.AuthMethodPrefix, req.GetAuthMethodId()) {
+	} else if !handlers.ValidId(authMethodPrefix(req.GetAuthMethodId()), req.GetAuthMethodId()) {
 		badFields["auth_method_id"] = "Invalid formatted identifier."
 	}
-	// TODO: Update this when we enable different auth method types.
 	if req.GetCredentials() == nil {
 		badFields["credentials"] = "This is a required field."
 	}
 	creds := req.GetCredentials().GetFields()
-	if _, ok := creds[loginNameKey]; !ok {
-		badFields["credentials.login_name"] = "This is a required field."
+	var requiredCreds []string
+	switch auth.SubtypeFromId(req.GetAuthMethodId()) {
+	case auth.OidcSubtype:
+		requiredCreds = []string{codeKey, stateKey}
+	default:
+		requiredCreds = []string{loginNameKey, pwKey}
 	}
-	if _, ok := creds[pwKey]; !ok {
-		badFields["credentials.password"] = "This is a required field."
+	for _, k := range requiredCreds {
+		if _, ok := creds[k]; !ok {
+			badFields["credentials."+k] = "This is a required field."
+		}
 	}
 	tType := strings.ToLower(strings.TrimSpace(req.GetTokenType()))
 	if tType != "" && tType != "token" && tType != "cookie" {
@@ -523,3 +1223,45 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	}
 	return nil
 }
+
+func validateStartAuthenticationRequest(req *pbs.StartAuthenticationRequest) error {
+	badFields := make(map[string]string)
+	if strings.TrimSpace(req.GetAuthMethodId()) == "" {
+		badFields["auth_method_id"] = "This is a required field."
+	} else if !handlers.ValidId(oidc.AuthMethodPrefix, req.GetAuthMethodId()) {
+		badFields["auth_method_id"] = "Invalid formatted identifier."
+	}
+	if strings.TrimSpace(req.GetRedirectUrl()) == "" {
+		badFields["redirect_url"] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+// authMethodPrefix returns the public id prefix of the auth method subtype
+// id belongs to.  The password prefix is returned for unknown subtypes.
+func authMethodPrefix(id string) string {
+	switch auth.SubtypeFromId(id) {
+	case auth.OidcSubtype:
+		return oidc.AuthMethodPrefix
+	case auth.LdapSubtype:
+		return ldap.AuthMethodPrefix
+	}
+	return password.AuthMethodPrefix
+}
+
+func validIssuer(issuer string) bool {
+	u, err := url.Parse(issuer)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func validLdapUrl(u string) bool {
+	pu, err := url.Parse(u)
+	return err == nil && (pu.Scheme == "ldap" || pu.Scheme == "ldaps") && pu.Host != ""
+}
+
+func validCertificate(cert string) bool {
+	return x509.NewCertPool().AppendCertsFromPEM([]byte(cert))
+}
@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
@@ -47,6 +50,12 @@ func TestGet(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -65,7 +74,8 @@ func TestGet(t *testing.T) {
 			"min_password_length":   structpb.NewNumberValue(8),
 			"min_login_name_length": structpb.NewNumberValue(3),
 		}},
-		Version: 1,
+		Version:           1,
+		AuthorizedActions: authmethods.IdActions.Strings(),
 		Scope: &scopepb.ScopeInfo{
 			Id:   o.GetPublicId(),
 			Type: o.GetType(),
@@ -111,7 +121,7 @@ func TestGet(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.GetAuthMethod(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
@@ -135,6 +145,12 @@ func TestList(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -147,13 +163,14 @@ func TestList(t *testing.T) {
 	var wantSomeAuthMethods []*pb.AuthMethod
 	for _, am := range password.TestAuthMethods(t, conn, oWithAuthMethods.GetPublicId(), 3) {
 		wantSomeAuthMethods = append(wantSomeAuthMethods, &pb.AuthMethod{
-			Id:          am.GetPublicId(),
-			ScopeId:     oWithAuthMethods.GetPublicId(),
-			CreatedTime: am.GetCreateTime().GetTimestamp(),
-			UpdatedTime: am.GetUpdateTime().GetTimestamp(),
-			Scope:       &scopepb.ScopeInfo{Id: oWithAuthMethods.GetPublicId(), Type: scope.Org.String()},
-			Version:     1,
-			Type:        "password",
+			Id:                am.GetPublicId(),
+			ScopeId:           oWithAuthMethods.GetPublicId(),
+			CreatedTime:       am.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       am.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: authmethods.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: oWithAuthMethods.GetPublicId(), Type: scope.Org.String()},
+			Version:           1,
+			Type:              "password",
 			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 				"min_password_length":   structpb.NewNumberValue(8),
 				"min_login_name_length": structpb.NewNumberValue(3),
@@ -164,13 +181,14 @@ func TestList(t *testing.T) {
 	var wantOtherAuthMethods []*pb.AuthMethod
 	for _, aa := range password.TestAuthMethods(t, conn, oWithOtherAuthMethods.GetPublicId(), 3) {
 		wantOtherAuthMethods = append(wantOtherAuthMethods, &pb.AuthMethod{
-			Id:          aa.GetPublicId(),
-			ScopeId:     oWithOtherAuthMethods.GetPublicId(),
-			CreatedTime: aa.GetCreateTime().GetTimestamp(),
-			UpdatedTime: aa.GetUpdateTime().GetTimestamp(),
-			Scope:       &scopepb.ScopeInfo{Id: oWithOtherAuthMethods.GetPublicId(), Type: scope.Org.String()},
-			Version:     1,
-			Type:        "password",
+			Id:                aa.GetPublicId(),
+			ScopeId:           oWithOtherAuthMethods.GetPublicId(),
+			CreatedTime:       aa.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       aa.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: authmethods.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: oWithOtherAuthMethods.GetPublicId(), Type: scope.Org.String()},
+			Version:           1,
+			Type:              "password",
 			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 				"min_password_length":   structpb.NewNumberValue(8),
 				"min_login_name_length": structpb.NewNumberValue(3),
@@ -208,7 +226,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.ListAuthMethods(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListAuthMethodsRequest{ScopeId: tc.scopeId})
@@ -232,6 +250,12 @@ func TestDelete(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -240,7 +264,7 @@ func TestDelete(t *testing.T) {
 	o, _ := iam.TestScopes(t, iamRepo)
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	cases := []struct {
@@ -296,6 +320,12 @@ func TestDelete_twice(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -304,7 +334,7 @@ func TestDelete_twice(t *testing.T) {
 	o, _ := iam.TestScopes(t, iamRepo)
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 	require.NoError(err, "Error when getting new auth_method service.")
 
 	req := &pbs.DeleteAuthMethodRequest{
@@ -328,6 +358,12 @@ func TestCreate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -398,6 +434,113 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create an AuthMethod with lockout",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Name:    &wrapperspb.StringValue{Value: "lockout"},
+				Type:    "password",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"lockout_threshold":        structpb.NewNumberValue(5),
+					"lockout_duration_seconds": structpb.NewNumberValue(600),
+				}},
+			}},
+			res: &pbs.CreateAuthMethodResponse{
+				Uri: fmt.Sprintf("auth-methods/%s_", password.AuthMethodPrefix),
+				Item: &pb.AuthMethod{
+					Id:          defaultAm.GetPublicId(),
+					ScopeId:     o.GetPublicId(),
+					CreatedTime: defaultAm.GetCreateTime().GetTimestamp(),
+					UpdatedTime: defaultAm.GetUpdateTime().GetTimestamp(),
+					Name:        &wrapperspb.StringValue{Value: "lockout"},
+					Scope:       &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()},
+					Version:     1,
+					Type:        "password",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"min_password_length":      structpb.NewNumberValue(8),
+						"min_login_name_length":    structpb.NewNumberValue(3),
+						"lockout_threshold":        structpb.NewNumberValue(5),
+						"lockout_duration_seconds": structpb.NewNumberValue(600),
+					}},
+				},
+			},
+		},
+		{
+			name: "Create an AuthMethod with a password policy",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Name:    &wrapperspb.StringValue{Value: "policy"},
+				Type:    "password",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"min_character_classes":    structpb.NewNumberValue(3),
+					"password_history_count":   structpb.NewNumberValue(5),
+					"max_password_age_seconds": structpb.NewNumberValue(7776000),
+					"disallow_login_name":      structpb.NewBoolValue(true),
+				}},
+			}},
+			res: &pbs.CreateAuthMethodResponse{
+				Uri: fmt.Sprintf("auth-methods/%s_", password.AuthMethodPrefix),
+				Item: &pb.AuthMethod{
+					Id:          defaultAm.GetPublicId(),
+					ScopeId:     o.GetPublicId(),
+					CreatedTime: defaultAm.GetCreateTime().GetTimestamp(),
+					UpdatedTime: defaultAm.GetUpdateTime().GetTimestamp(),
+					Name:        &wrapperspb.StringValue{Value: "policy"},
+					Scope:       &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()},
+					Version:     1,
+					Type:        "password",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"min_password_length":      structpb.NewNumberValue(8),
+						"min_login_name_length":    structpb.NewNumberValue(3),
+						"min_character_classes":    structpb.NewNumberValue(3),
+						"password_history_count":   structpb.NewNumberValue(5),
+						"max_password_age_seconds": structpb.NewNumberValue(7776000),
+						"disallow_login_name":      structpb.NewBoolValue(true),
+					}},
+				},
+			},
+		},
+		{
+			name: "Create an AuthMethod with auth token settings",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId:                 o.GetPublicId(),
+				Name:                    &wrapperspb.StringValue{Value: "token settings"},
+				Type:                    "password",
+				MaxTokenDurationSeconds: 28800,
+				MaxTokenIdleSeconds:     900,
+			}},
+			res: &pbs.CreateAuthMethodResponse{
+				Uri: fmt.Sprintf("auth-methods/%s_", password.AuthMethodPrefix),
+				Item: &pb.AuthMethod{
+					Id:                      defaultAm.GetPublicId(),
+					ScopeId:                 o.GetPublicId(),
+					CreatedTime:             defaultAm.GetCreateTime().GetTimestamp(),
+					UpdatedTime:             defaultAm.GetUpdateTime().GetTimestamp(),
+					Name:                    &wrapperspb.StringValue{Value: "token settings"},
+					Scope:                   &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()},
+					Version:                 1,
+					Type:                    "password",
+					MaxTokenDurationSeconds: 28800,
+					MaxTokenIdleSeconds:     900,
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"min_password_length":   structpb.NewNumberValue(8),
+						"min_login_name_length": structpb.NewNumberValue(3),
+					}},
+				},
+			},
+		},
+		{
+			name: "Too many character classes",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    "password",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"min_character_classes": structpb.NewNumberValue(5),
+				}},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Can't specify Id",
 			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
@@ -447,6 +590,119 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Create a valid OIDC AuthMethod",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Name:    &wrapperspb.StringValue{Value: "oidc name"},
+				Type:    "oidc",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"issuer":        structpb.NewStringValue("https://example.com"),
+					"client_id":     structpb.NewStringValue("client"),
+					"client_secret": structpb.NewStringValue("secret"),
+				}},
+			}},
+			res: &pbs.CreateAuthMethodResponse{
+				Uri: fmt.Sprintf("auth-methods/%s_", oidc.AuthMethodPrefix),
+				Item: &pb.AuthMethod{
+					ScopeId: o.GetPublicId(),
+					Name:    &wrapperspb.StringValue{Value: "oidc name"},
+					Scope:   &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()},
+					Version: 1,
+					Type:    "oidc",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"issuer":    structpb.NewStringValue("https://example.com"),
+						"client_id": structpb.NewStringValue("client"),
+					}},
+				},
+			},
+		},
+		{
+			name: "OIDC AuthMethod requires a client secret",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    "oidc",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"issuer":    structpb.NewStringValue("https://example.com"),
+					"client_id": structpb.NewStringValue("client"),
+				}},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "OIDC AuthMethod issuer must be a URL",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    "oidc",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"issuer":        structpb.NewStringValue("example.com"),
+					"client_id":     structpb.NewStringValue("client"),
+					"client_secret": structpb.NewStringValue("secret"),
+				}},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid LDAP AuthMethod",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Name:    &wrapperspb.StringValue{Value: "ldap name"},
+				Type:    "ldap",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"url":           structpb.NewStringValue("ldaps://ldap.example.com"),
+					"bind_dn":       structpb.NewStringValue("cn=admin,dc=example,dc=com"),
+					"bind_password": structpb.NewStringValue("secret"),
+					"user_dn":       structpb.NewStringValue("ou=users,dc=example,dc=com"),
+					"user_attr":     structpb.NewStringValue("sAMAccountName"),
+				}},
+			}},
+			res: &pbs.CreateAuthMethodResponse{
+				Uri: fmt.Sprintf("auth-methods/%s_", ldap.AuthMethodPrefix),
+				Item: &pb.AuthMethod{
+					ScopeId: o.GetPublicId(),
+					Name:    &wrapperspb.StringValue{Value: "ldap name"},
+					Scope:   &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()},
+					Version: 1,
+					Type:    "ldap",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"url":        structpb.NewStringValue("ldaps://ldap.example.com"),
+						"bind_dn":    structpb.NewStringValue("cn=admin,dc=example,dc=com"),
+						"user_dn":    structpb.NewStringValue("ou=users,dc=example,dc=com"),
+						"user_attr":  structpb.NewStringValue("sAMAccountName"),
+						"group_attr": structpb.NewStringValue("member"),
+					}},
+				},
+			},
+		},
+		{
+			name: "LDAP AuthMethod url must be an ldap URL",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    "ldap",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"url":     structpb.NewStringValue("https://ldap.example.com"),
+					"user_dn": structpb.NewStringValue("ou=users,dc=example,dc=com"),
+				}},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "LDAP AuthMethod requires a bind password with a bind dn",
+			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
+				ScopeId: o.GetPublicId(),
+				Type:    "ldap",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"url":     structpb.NewStringValue("ldaps://ldap.example.com"),
+					"bind_dn": structpb.NewStringValue("cn=admin,dc=example,dc=com"),
+					"user_dn": structpb.NewStringValue("ou=users,dc=example,dc=com"),
+				}},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Attributes must be valid for type",
 			req: &pbs.CreateAuthMethodRequest{Item: &pb.AuthMethod{
@@ -467,7 +723,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 			require.NoError(err, "Error when getting new auth_method service.")
 
 			got, gErr := s.CreateAuthMethod(auth.DisabledAuthTestContext(auth.WithScopeId(tc.req.GetItem().GetScopeId())), tc.req)
@@ -480,7 +736,7 @@ func TestCreate(t *testing.T) {
 			}
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.Uri)
-				assert.True(strings.HasPrefix(got.GetItem().GetId(), password.AuthMethodPrefix+"_"))
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), strings.TrimPrefix(tc.res.Uri, "auth-methods/")))
 				gotCreateTime, err := ptypes.Timestamp(got.GetItem().GetCreatedTime())
 				require.NoError(err, "Error converting proto to timestamp.")
 				gotUpdateTime, err := ptypes.Timestamp(got.GetItem().GetUpdatedTime())
@@ -510,13 +766,19 @@ func TestUpdate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType()}
@@ -902,6 +1164,12 @@ func TestAuthenticate(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -1028,7 +1296,7 @@ func TestAuthenticate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 			require.NoError(err)
 
 			resp, err := s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), tc.request)
@@ -1066,6 +1334,12 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -1085,7 +1359,7 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	iamUser, err := iamRepo.LookupUserWithLogin(context.Background(), acct.GetPublicId(), iam.WithAutoVivify(true))
 	require.NoError(err)
 
-	s, err := authmethods.NewService(kms, pwRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
 	require.NoError(err)
 	resp, err := s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.AuthenticateRequest{
 		AuthMethodId: am.GetPublicId(),
@@ -1108,3 +1382,397 @@ func TestAuthenticate_AuthAccountConnectedToIamUser(t *testing.T) {
 	assert.NotEmpty(aToken.GetToken())
 	assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
 }
+
+func TestAuthenticate_Lockout(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.LockoutThreshold = 2
+	am, _, err = pwRepo.UpdateAuthMethod(context.Background(), am, am.GetVersion(), []string{"LockoutThreshold"})
+	require.NoError(err)
+
+	acct, err := password.NewAccount(am.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(err)
+	_, err = pwRepo.CreateAccount(context.Background(), o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(err)
+
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
+	require.NoError(err)
+	authenticate := func(pw string) (*pbs.AuthenticateResponse, error) {
+		return s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			TokenType:    "token",
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue(testLoginName),
+				"password":   structpb.NewStringValue(pw),
+			}},
+		})
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := authenticate("wrong")
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "got error %v", err)
+	}
+	// The account is locked so even the correct password fails, with the
+	// same error as a wrong password.
+	resp, err := authenticate(testPassword)
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "got error %v", err)
+	assert.Nil(resp)
+}
+
+func TestAuthenticate_PasswordExpired(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.MaxPasswordAgeSeconds = 1
+	am.PasswordHistoryCount = 2
+	am, _, err = pwRepo.UpdateAuthMethod(context.Background(), am, am.GetVersion(), []string{"MaxPasswordAgeSeconds", "PasswordHistoryCount"})
+	require.NoError(err)
+
+	acct, err := password.NewAccount(am.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(err)
+	_, err = pwRepo.CreateAccount(context.Background(), o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(err)
+	time.Sleep(1100 * time.Millisecond)
+
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
+	require.NoError(err)
+	authenticate := func(creds map[string]*structpb.Value) (*pbs.AuthenticateResponse, error) {
+		creds["login_name"] = structpb.NewStringValue(testLoginName)
+		return s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			TokenType:    "token",
+			Credentials:  &structpb.Struct{Fields: creds},
+		})
+	}
+
+	resp, err := authenticate(map[string]*structpb.Value{
+		"password": structpb.NewStringValue(testPassword),
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+	assert.Nil(resp)
+
+	// The new password must satisfy the password policy
+	resp, err = authenticate(map[string]*structpb.Value{
+		"password":     structpb.NewStringValue(testPassword),
+		"new_password": structpb.NewStringValue(testPassword),
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v", err)
+	assert.Nil(resp)
+
+	resp, err = authenticate(map[string]*structpb.Value{
+		"password":     structpb.NewStringValue(testPassword),
+		"new_password": structpb.NewStringValue("anewtestpassword"),
+	})
+	require.NoError(err)
+	assert.NotEmpty(resp.GetItem().GetToken())
+
+	resp, err = authenticate(map[string]*structpb.Value{
+		"password": structpb.NewStringValue("anewtestpassword"),
+	})
+	require.NoError(err)
+	assert.NotEmpty(resp.GetItem().GetToken())
+}
+
+func TestAuthenticate_Totp(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(err)
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	am.MfaRequired = true
+	am, _, err = pwRepo.UpdateAuthMethod(ctx, am, am.GetVersion(), []string{"MfaRequired"})
+	require.NoError(err)
+
+	acct, err := password.NewAccount(am.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(err)
+	acct, err = pwRepo.CreateAccount(ctx, o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(err)
+
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
+	require.NoError(err)
+	authenticate := func(creds map[string]*structpb.Value) (*pbs.AuthenticateResponse, error) {
+		creds["login_name"] = structpb.NewStringValue(testLoginName)
+		creds["password"] = structpb.NewStringValue(testPassword)
+		return s.Authenticate(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			TokenType:    "token",
+			Credentials:  &structpb.Struct{Fields: creds},
+		})
+	}
+
+	// The account is not enrolled
+	resp, err := authenticate(map[string]*structpb.Value{})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+	assert.Nil(resp)
+
+	uri, err := pwRepo.EnrollTotp(ctx, o.GetPublicId(), acct.GetPublicId())
+	require.NoError(err)
+	recoveryCodes, err := pwRepo.VerifyTotp(ctx, o.GetPublicId(), acct.GetPublicId(), password.TestTotpCode(t, uri, time.Now()))
+	require.NoError(err)
+
+	// A second factor is required
+	resp, err = authenticate(map[string]*structpb.Value{})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "got error %v", err)
+	assert.Nil(resp)
+
+	resp, err = authenticate(map[string]*structpb.Value{
+		"recovery_code": structpb.NewStringValue("aaaa-aaaa-aaaa-aaaa"),
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "got error %v", err)
+	assert.Nil(resp)
+
+	resp, err = authenticate(map[string]*structpb.Value{
+		"totp_code": structpb.NewStringValue(password.TestTotpCode(t, uri, time.Now().Add(30*time.Second))),
+	})
+	require.NoError(err)
+	assert.NotEmpty(resp.GetItem().GetToken())
+
+	resp, err = authenticate(map[string]*structpb.Value{
+		"recovery_code": structpb.NewStringValue(recoveryCodes[0]),
+	})
+	require.NoError(err)
+	assert.NotEmpty(resp.GetItem().GetToken())
+}
+
+func TestAuthenticate_Oidc(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kmsCache)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), o.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	p := oidc.NewTestProvider(t)
+	am := oidc.TestAuthMethod(t, conn, databaseWrapper, o.GetPublicId(), p)
+
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p.SetUser("carol", "carol@example.com", "Carol")
+		start, err := s.StartAuthentication(ctx, &pbs.StartAuthenticationRequest{
+			AuthMethodId: am.GetPublicId(),
+			RedirectUrl:  "http://127.0.0.1:9876/callback",
+		})
+		require.NoError(err)
+		code, state := p.Login(t, start.GetAuthUrl())
+
+		resp, err := s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"code":  structpb.NewStringValue(code),
+				"state": structpb.NewStringValue(state),
+			}},
+		})
+		require.NoError(err)
+		aToken := resp.GetItem()
+		assert.Equal(am.GetPublicId(), aToken.GetAuthMethodId())
+		assert.True(strings.HasPrefix(aToken.GetAccountId(), oidc.AccountPrefix))
+		assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
+
+		iamRepo, err := iamRepoFn()
+		require.NoError(err)
+		u, err := iamRepo.LookupUserWithLogin(context.Background(), aToken.GetAccountId())
+		require.NoError(err)
+		assert.Equal(aToken.GetUserId(), u.GetPublicId())
+		assert.Equal("Carol <carol@example.com>", u.GetDescription())
+	})
+	t.Run("non-loopback-redirect", func(t *testing.T) {
+		_, err := s.StartAuthentication(ctx, &pbs.StartAuthenticationRequest{
+			AuthMethodId: am.GetPublicId(),
+			RedirectUrl:  "http://example.com/callback",
+		})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got %v", err)
+	})
+	t.Run("missing-credentials", func(t *testing.T) {
+		_, err := s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue(testLoginName),
+				"password":   structpb.NewStringValue(testPassword),
+			}},
+		})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got %v", err)
+	})
+	t.Run("bad-code", func(t *testing.T) {
+		start, err := s.StartAuthentication(ctx, &pbs.StartAuthenticationRequest{
+			AuthMethodId: am.GetPublicId(),
+			RedirectUrl:  "http://127.0.0.1:9876/callback",
+		})
+		require.NoError(t, err)
+		_, state := p.Login(t, start.GetAuthUrl())
+		_, err = s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"code":  structpb.NewStringValue("not-a-code"),
+				"state": structpb.NewStringValue(state),
+			}},
+		})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "got %v", err)
+	})
+}
+
+func TestAuthenticate_Ldap(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kmsCache)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(rw, rw, kmsCache)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(rw, rw, kmsCache)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kmsCache)
+	}
+
+	databaseWrapper, err := kmsCache.GetWrapper(context.Background(), o.GetPublicId(), kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	ls := ldap.NewTestServer(t)
+	ls.AddUser("dave", "dave-password", "dave@example.com", "Dave")
+	am := ldap.TestAuthMethod(t, conn, databaseWrapper, o.GetPublicId(), ls, ldap.WithStartTls(true))
+
+	s, err := authmethods.NewService(kmsCache, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn, nil)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId()))
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		resp, err := s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue("dave"),
+				"password":   structpb.NewStringValue("dave-password"),
+			}},
+		})
+		require.NoError(err)
+		aToken := resp.GetItem()
+		assert.Equal(am.GetPublicId(), aToken.GetAuthMethodId())
+		assert.True(strings.HasPrefix(aToken.GetAccountId(), ldap.AccountPrefix))
+		assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
+
+		iamRepo, err := iamRepoFn()
+		require.NoError(err)
+		u, err := iamRepo.LookupUserWithLogin(context.Background(), aToken.GetAccountId())
+		require.NoError(err)
+		assert.Equal(aToken.GetUserId(), u.GetPublicId())
+		assert.Equal("Dave <dave@example.com>", u.GetDescription())
+	})
+	t.Run("wrong-password", func(t *testing.T) {
+		_, err := s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue("dave"),
+				"password":   structpb.NewStringValue("wrong-password"),
+			}},
+		})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "got %v", err)
+	})
+	t.Run("missing-credentials", func(t *testing.T) {
+		_, err := s.Authenticate(ctx, &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			Credentials: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"code":  structpb.NewStringValue("code"),
+				"state": structpb.NewStringValue("state"),
+			}},
+		})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got %v", err)
+	})
+}
@@ -0,0 +1,86 @@
+package authmethods
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultThrottleAttempts is the number of authenticate requests a
+	// client IP may make per DefaultThrottlePeriod if not configured.
+	DefaultThrottleAttempts = 20
+
+	// DefaultThrottlePeriod is the period authenticate requests are counted
+	// in if not configured.
+	DefaultThrottlePeriod = time.Minute
+)
+
+// A Throttle limits the number of authenticate requests each client IP can
+// make in a period. A nil *Throttle allows every request.
+type Throttle struct {
+	attempts int
+	period   time.Duration
+
+	l         sync.Mutex
+	windows   map[string]*throttleWindow
+	lastPrune time.Time
+
+	// now is replaced in tests
+	now func() time.Time
+}
+
+type throttleWindow struct {
+	start    time.Time
+	attempts int
+}
+
+// NewThrottle returns a Throttle which allows attempts authenticate requests
+// per client IP in each period. A zero attempts or period is replaced by
+// DefaultThrottleAttempts or DefaultThrottlePeriod. A negative attempts
+// disables throttling and returns nil.
+func NewThrottle(attempts int, period time.Duration) *Throttle {
+	if attempts < 0 {
+		return nil
+	}
+	if attempts == 0 {
+		attempts = DefaultThrottleAttempts
+	}
+	if period <= 0 {
+		period = DefaultThrottlePeriod
+	}
+	return &Throttle{
+		attempts: attempts,
+		period:   period,
+		windows:  make(map[string]*throttleWindow),
+		now:      time.Now,
+	}
+}
+
+// Allow records an authenticate request from clientIp and reports whether
+// the client is within its limit. Requests without a client IP are always
+// allowed.
+func (t *Throttle) Allow(clientIp string) bool {
+	if t == nil || clientIp == "" {
+		return true
+	}
+	t.l.Lock()
+	defer t.l.Unlock()
+
+	now := t.now()
+	if now.Sub(t.lastPrune) >= t.period {
+		for ip, w := range t.windows {
+			if now.Sub(w.start) >= t.period {
+				delete(t.windows, ip)
+			}
+		}
+		t.lastPrune = now
+	}
+
+	w, ok := t.windows[clientIp]
+	if !ok || now.Sub(w.start) >= t.period {
+		w = &throttleWindow{start: now}
+		t.windows[clientIp] = w
+	}
+	w.attempts++
+	return w.attempts <= t.attempts
+}
@@ -0,0 +1,51 @@
+package authmethods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottle(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	now := time.Now()
+	th := NewThrottle(2, time.Minute)
+	require.NotNil(th)
+	th.now = func() time.Time { return now }
+
+	assert.True(th.Allow("10.0.0.1"))
+	assert.True(th.Allow("10.0.0.1"))
+	assert.False(th.Allow("10.0.0.1"))
+
+	// Other clients and requests without a client IP are not affected
+	assert.True(th.Allow("10.0.0.2"))
+	assert.True(th.Allow(""))
+	assert.True(th.Allow(""))
+	assert.True(th.Allow(""))
+
+	// The count starts over in the next period
+	now = now.Add(time.Minute)
+	assert.True(th.Allow("10.0.0.1"))
+	assert.True(th.Allow("10.0.0.1"))
+	assert.False(th.Allow("10.0.0.1"))
+
+	// Expired windows are pruned
+	now = now.Add(2 * time.Minute)
+	assert.True(th.Allow("10.0.0.3"))
+	assert.Len(th.windows, 1)
+}
+
+func TestNewThrottle(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(NewThrottle(-1, time.Minute))
+	var disabled *Throttle
+	assert.True(disabled.Allow("10.0.0.1"))
+
+	th := NewThrottle(0, 0)
+	assert.Equal(DefaultThrottleAttempts, th.attempts)
+	assert.Equal(DefaultThrottlePeriod, th.period)
+}
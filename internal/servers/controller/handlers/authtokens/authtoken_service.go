@@ -11,12 +11,20 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/authtokens"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Delete,
+}
+
 // Service handles request as described by the pbs.AuthTokenServiceServer interface.
 type Service struct {
 	repoFn    common.AuthTokenRepoFactory
@@ -42,17 +50,46 @@ func (s Service) ListAuthTokens(ctx context.Context, req *pbs.ListAuthTokensRequ
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, req.GetScopeId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
 	}
-	return &pbs.ListAuthTokensResponse{Items: ul}, nil
+	var finalItems []*pb.AuthToken
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
+	}
+	return &pbs.ListAuthTokensResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetAuthToken implements the interface pbs.AuthTokenServiceServer.
@@ -69,6 +106,7 @@ func (s Service) GetAuthToken(ctx context.Context, req *pbs.GetAuthTokenRequest)
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetAuthTokenResponse{Item: u}, nil
 }
 
@@ -121,13 +159,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, orgId string) ([]*pb.AuthToken, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthTokens(ctx, orgId)
+	ul, err := repo.ListAuthTokens(ctx, scopeIds, authtoken.WithStartPageAfter(cursor), authtoken.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +254,15 @@ func validateListRequest(req *pbs.ListAuthTokensRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "This field must be 'global' or a valid org scope id."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
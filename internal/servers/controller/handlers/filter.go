@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-bexpr"
+	"google.golang.org/protobuf/proto"
+)
+
+// Filter is a compiled filter expression used to select the items returned by
+// a list request.
+type Filter struct {
+	eval *bexpr.Evaluator
+}
+
+// NewFilter compiles the provided filter expression.  The expression is in the
+// format understood by hashicorp/go-bexpr.  An empty expression results in a
+// Filter which matches every item.
+func NewFilter(f string) (*Filter, error) {
+	if f == "" {
+		return &Filter{}, nil
+	}
+	eval, err := bexpr.CreateEvaluator(f)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build filter: %w", err)
+	}
+	return &Filter{eval: eval}, nil
+}
+
+// Match returns whether the item matches the filter.  The expression is
+// evaluated against the JSON form of the item, as returned by the API, which is
+// available under "/item"; for instance "/item/name" selects the item's name.
+// Items for which the expression can't be evaluated, such as those missing a
+// field the expression references, don't match.
+func (f *Filter) Match(item proto.Message) bool {
+	if f.eval == nil {
+		return true
+	}
+	st, err := ProtoToStruct(item)
+	if err != nil {
+		return false
+	}
+	ok, err := f.eval.Evaluate(map[string]interface{}{"item": st.AsMap()})
+	return ok && err == nil
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"testing"
+
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNewFilter(t *testing.T) {
+	_, err := NewFilter(`"/item/name" == "web"`)
+	assert.NoError(t, err)
+
+	_, err = NewFilter(`"//name/" == "web"`)
+	assert.Error(t, err)
+
+	_, err = NewFilter(`"/item/name" ==`)
+	assert.Error(t, err)
+}
+
+func TestFilter_Match(t *testing.T) {
+	item := &pb.Target{
+		Id:                "ttcp_1234567890",
+		ScopeId:           "p_1234567890",
+		Name:              &wrapperspb.StringValue{Value: "web"},
+		Type:              "tcp",
+		Version:           3,
+		AuthorizedActions: []string{"read", "authorize-session"},
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"default_port": structpb.NewNumberValue(22),
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{name: "empty", filter: "", want: true},
+		{name: "field", filter: `"/item/name" == "web"`, want: true},
+		{name: "field mismatch", filter: `"/item/name" == "db"`, want: false},
+		{name: "and", filter: `"/item/id" == "ttcp_1234567890" and "/item/scope_id" == "p_1234567890"`, want: true},
+		{name: "and mismatch", filter: `"/item/id" == "ttcp_1234567890" and "/item/scope_id" == "p_other"`, want: false},
+		{name: "or", filter: `"/item/name" == "db" or "/item/type" == "tcp"`, want: true},
+		{name: "number", filter: `"/item/version" == 3`, want: true},
+		{name: "nested", filter: `"/item/attributes/default_port" == 22`, want: true},
+		{name: "missing field", filter: `"/item/description" == "web"`, want: false},
+		{name: "missing field negated", filter: `not ("/item/description" == "web")`, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, f.Match(item))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -32,6 +33,17 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.AddMembers,
+	action.SetMembers,
+	action.RemoveMembers,
+}
+
 // Service handles request as described by the pbs.GroupServiceServer interface.
 type Service struct {
 	repoFn common.IamRepoFactory
@@ -53,17 +65,46 @@ func (s Service) ListGroups(ctx context.Context, req *pbs.ListGroupsRequest) (*p
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.repoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
-	gl, err := s.listFromRepo(ctx, req.GetScopeId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range gl {
-		item.Scope = authResults.Scope
+	var finalItems []*pb.Group
+	for pager.More() {
+		gl, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range gl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(gl))
 	}
-	return &pbs.ListGroupsResponse{Items: gl}, nil
+	return &pbs.ListGroupsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetGroups implements the interface pbs.GroupServiceServer.
@@ -80,6 +121,7 @@ func (s Service) GetGroup(ctx context.Context, req *pbs.GetGroupRequest) (*pbs.G
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetGroupResponse{Item: u}, nil
 }
 
@@ -275,12 +317,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Group, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.Group, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	gl, err := repo.ListGroups(ctx, scopeId)
+	gl, err := repo.ListGroups(ctx, scopeIds, iam.WithStartPageAfter(cursor), iam.WithLimit(limit))
 	if err != nil {
 		return nil, fmt.Errorf("unable to list groups: %w", err)
 	}
@@ -450,6 +492,15 @@ func validateListRequest(req *pbs.ListGroupsRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Incorrectly formatted identifier."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
@@ -91,15 +91,16 @@ func TestGet(t *testing.T) {
 	}
 
 	wantOrgGroup := &pb.Group{
-		Id:          og.GetPublicId(),
-		ScopeId:     og.GetScopeId(),
-		Scope:       &scopes.ScopeInfo{Id: og.GetScopeId(), Type: scope.Org.String()},
-		Name:        &wrapperspb.StringValue{Value: og.GetName()},
-		Description: &wrapperspb.StringValue{Value: og.GetDescription()},
-		CreatedTime: og.CreateTime.GetTimestamp(),
-		UpdatedTime: og.UpdateTime.GetTimestamp(),
-		Version:     1,
-		MemberIds:   []string{u.GetPublicId()},
+		Id:                og.GetPublicId(),
+		ScopeId:           og.GetScopeId(),
+		AuthorizedActions: groups.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: og.GetScopeId(), Type: scope.Org.String()},
+		Name:              &wrapperspb.StringValue{Value: og.GetName()},
+		Description:       &wrapperspb.StringValue{Value: og.GetDescription()},
+		CreatedTime:       og.CreateTime.GetTimestamp(),
+		UpdatedTime:       og.UpdateTime.GetTimestamp(),
+		Version:           1,
+		MemberIds:         []string{u.GetPublicId()},
 		Members: []*pb.Member{
 			{
 				Id:      u.GetPublicId(),
@@ -109,15 +110,16 @@ func TestGet(t *testing.T) {
 	}
 
 	wantProjGroup := &pb.Group{
-		Id:          pg.GetPublicId(),
-		ScopeId:     pg.GetScopeId(),
-		Scope:       &scopes.ScopeInfo{Id: pg.GetScopeId(), Type: scope.Project.String()},
-		Name:        &wrapperspb.StringValue{Value: pg.GetName()},
-		Description: &wrapperspb.StringValue{Value: pg.GetDescription()},
-		CreatedTime: pg.CreateTime.GetTimestamp(),
-		UpdatedTime: pg.UpdateTime.GetTimestamp(),
-		Version:     1,
-		MemberIds:   []string{u.GetPublicId()},
+		Id:                pg.GetPublicId(),
+		ScopeId:           pg.GetScopeId(),
+		AuthorizedActions: groups.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: pg.GetScopeId(), Type: scope.Project.String()},
+		Name:              &wrapperspb.StringValue{Value: pg.GetName()},
+		Description:       &wrapperspb.StringValue{Value: pg.GetDescription()},
+		CreatedTime:       pg.CreateTime.GetTimestamp(),
+		UpdatedTime:       pg.UpdateTime.GetTimestamp(),
+		Version:           1,
+		MemberIds:         []string{u.GetPublicId()},
 		Members: []*pb.Member{
 			{
 				Id:      u.GetPublicId(),
@@ -218,21 +220,23 @@ func TestList(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		og := iam.TestGroup(t, conn, oWithGroups.GetPublicId())
 		wantOrgGroups = append(wantOrgGroups, &pb.Group{
-			Id:          og.GetPublicId(),
-			ScopeId:     og.GetScopeId(),
-			Scope:       &scopes.ScopeInfo{Id: oWithGroups.GetPublicId(), Type: scope.Org.String()},
-			CreatedTime: og.GetCreateTime().GetTimestamp(),
-			UpdatedTime: og.GetUpdateTime().GetTimestamp(),
-			Version:     1,
+			Id:                og.GetPublicId(),
+			ScopeId:           og.GetScopeId(),
+			AuthorizedActions: groups.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: oWithGroups.GetPublicId(), Type: scope.Org.String()},
+			CreatedTime:       og.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       og.GetUpdateTime().GetTimestamp(),
+			Version:           1,
 		})
 		pg := iam.TestGroup(t, conn, pWithGroups.GetPublicId())
 		wantProjGroups = append(wantProjGroups, &pb.Group{
-			Id:          pg.GetPublicId(),
-			ScopeId:     pg.GetScopeId(),
-			Scope:       &scopes.ScopeInfo{Id: pWithGroups.GetPublicId(), Type: scope.Project.String()},
-			CreatedTime: pg.GetCreateTime().GetTimestamp(),
-			UpdatedTime: pg.GetUpdateTime().GetTimestamp(),
-			Version:     1,
+			Id:                pg.GetPublicId(),
+			ScopeId:           pg.GetScopeId(),
+			AuthorizedActions: groups.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: pWithGroups.GetPublicId(), Type: scope.Project.String()},
+			CreatedTime:       pg.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       pg.GetUpdateTime().GetTimestamp(),
+			Version:           1,
 		})
 	}
 
@@ -2,51 +2,54 @@ package host_catalogs
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/static"
-	"github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var (
-	maskManager handlers.MaskManager
-)
-
-func init() {
-	var err error
-	if maskManager, err = handlers.NewMaskManager(&store.HostCatalog{}, &pb.HostCatalog{}); err != nil {
-		panic(err)
-	}
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
 	iamRepoFn    common.IamRepoFactory
+	hostCatalogs *host.Registry
 }
 
 var _ pbs.HostCatalogServiceServer = Service{}
 
-// NewService returns a host catalog Service which handles host catalog related requests to boundary and uses the provided
-// repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
-	if repoFn == nil {
-		return Service{}, fmt.Errorf("nil static repository provided")
-	}
+// NewService returns a host catalog Service which handles host catalog related requests to boundary and uses the
+// host catalog registry to reach the catalogs of each type.
+func NewService(iamRepoFn common.IamRepoFactory, hostCatalogs *host.Registry) (Service, error) {
 	if iamRepoFn == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{staticRepoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+	if hostCatalogs == nil {
+		return Service{}, fmt.Errorf("nil host catalog registry provided")
+	}
+	return Service{iamRepoFn: iamRepoFn, hostCatalogs: hostCatalogs}, nil
 }
 
 func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogsRequest) (*pbs.ListHostCatalogsResponse, error) {
@@ -54,22 +57,51 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.HostCatalog
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
 	}
-	return &pbs.ListHostCatalogsResponse{Items: ul}, nil
+	return &pbs.ListHostCatalogsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetHostCatalog implements the interface pbs.HostCatalogServiceServer.
 func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequest) (*pbs.GetHostCatalogResponse, error) {
-	if err := validateGetRequest(req); err != nil {
+	if err := validateGetRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Read)
@@ -81,12 +113,13 @@ func (s Service) GetHostCatalog(ctx context.Context, req *pbs.GetHostCatalogRequ
 		return nil, err
 	}
 	hc.Scope = authResults.Scope
+	hc.AuthorizedActions = authResults.FetchActionSetForId(hc.Id, IdActions).Strings()
 	return &pbs.GetHostCatalogResponse{Item: hc}, nil
 }
 
 // CreateHostCatalog implements the interface pbs.HostCatalogServiceServer.
 func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatalogRequest) (*pbs.CreateHostCatalogResponse, error) {
-	if err := validateCreateRequest(req); err != nil {
+	if err := validateCreateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
@@ -106,7 +139,7 @@ func (s Service) CreateHostCatalog(ctx context.Context, req *pbs.CreateHostCatal
 
 // UpdateHostCatalog implements the interface pbs.HostCatalogServiceServer.
 func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatalogRequest) (*pbs.UpdateHostCatalogResponse, error) {
-	if err := validateUpdateRequest(req); err != nil {
+	if err := validateUpdateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Update)
@@ -123,7 +156,7 @@ func (s Service) UpdateHostCatalog(ctx context.Context, req *pbs.UpdateHostCatal
 
 // DeleteHostCatalog implements the interface pbs.HostCatalogServiceServer.
 func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatalogRequest) (*pbs.DeleteHostCatalogResponse, error) {
-	if err := validateDeleteRequest(req); err != nil {
+	if err := validateDeleteRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.Delete)
@@ -138,106 +171,111 @@ func (s Service) DeleteHostCatalog(ctx context.Context, req *pbs.DeleteHostCatal
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostCatalog, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist.", id)
 	}
-	hc, err := repo.LookupCatalog(ctx, id)
+	hc, err := c.LookupCatalog(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if hc == nil {
 		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist.", id)
 	}
-	return toProto(hc), nil
+	return toProto(c.Type(), hc), nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.HostCatalog, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	ul, err := repo.ListCatalogs(ctx, scopeId)
-	if err != nil {
-		return nil, err
-	}
+// listFromRepo returns the host catalogs of every type in the scopes after
+// the cursor, merged in the order host catalogs are listed in and limited to
+// limit.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.HostCatalog, error) {
 	var outUl []*pb.HostCatalog
-	for _, u := range ul {
-		outUl = append(outUl, toProto(u))
+	for _, t := range s.hostCatalogs.Types() {
+		c, ok := s.hostCatalogs.Catalog(t)
+		if !ok {
+			continue
+		}
+		ul, err := c.ListCatalogs(ctx, scopeIds, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range ul {
+			outUl = append(outUl, toProto(c.Type(), u))
+		}
+	}
+
+	sort.SliceStable(outUl, func(i, j int) bool {
+		ti, tj := outUl[i].GetCreatedTime().AsTime(), outUl[j].GetCreatedTime().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return outUl[i].GetId() < outUl[j].GetId()
+	})
+	if limit > 0 && len(outUl) > limit {
+		outUl = outUl[:limit]
 	}
 	return outUl, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, projId string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
-	var opts []static.Option
-	if item.GetName() != nil {
-		opts = append(opts, static.WithName(item.GetName().GetValue()))
+	c, ok := s.hostCatalogs.Catalog(item.GetType())
+	if !ok {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host catalog for creation: unknown type %q.", item.GetType())
 	}
-	if item.GetDescription() != nil {
-		opts = append(opts, static.WithDescription(item.GetDescription().GetValue()))
-	}
-	h, err := static.NewHostCatalog(projId, opts...)
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host catalog for creation: %v.", err)
-	}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	out, err := repo.CreateCatalog(ctx, h)
+	out, err := c.CreateCatalog(ctx, fromProto(projId, "", item))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create host catalog: %w", err)
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host catalog but no error returned from repository.")
 	}
-	return toProto(out), nil
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.HostCatalog) (*pb.HostCatalog, error) {
-	var opts []static.Option
-	if desc := item.GetDescription(); desc != nil {
-		opts = append(opts, static.WithDescription(desc.GetValue()))
-	}
-	if name := item.GetName(); name != nil {
-		opts = append(opts, static.WithName(name.GetValue()))
-	}
-	version := item.GetVersion()
-	h, err := static.NewHostCatalog(projId, opts...)
-	if err != nil {
-		return nil, fmt.Errorf("unable to build host catalog for update: %w", err)
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", id)
 	}
-	h.PublicId = id
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
+	out, rowsUpdated, err := c.UpdateCatalog(ctx, fromProto(projId, id, item), item.GetVersion(), mask)
+	if errors.Is(err, db.ErrEmptyFieldMask) {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	out, rowsUpdated, err := repo.UpdateCatalog(ctx, h, version, dbMask)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update host catalog: %w", err)
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Host Catalog %q doesn't exist or incorrect version provided.", id)
 	}
-	return toProto(out), nil
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return false, err
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return false, nil
 	}
-	rows, err := repo.DeleteCatalog(ctx, id)
+	rows, err := c.DeleteCatalog(ctx, id)
 	if err != nil {
-		return false, fmt.Errorf("unable to delete host: %w", err)
+		return false, fmt.Errorf("unable to delete host catalog: %w", err)
 	}
 	return rows > 0, nil
 }
 
+// lookupCatalogScopeId returns the scope id of the host catalog id, or an
+// empty string if the catalog doesn't exist.
+func (s Service) lookupCatalogScopeId(ctx context.Context, id string) (string, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return "", nil
+	}
+	cat, err := c.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
+	}
+	return cat.ScopeId, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -261,45 +299,52 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		repo, err := s.staticRepoFn()
+		scopeId, err := s.lookupCatalogScopeId(ctx, id)
 		if err != nil {
 			res.Error = err
 			return res
 		}
-		cat, err := repo.LookupCatalog(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
-		}
-		if cat == nil {
+		if scopeId == "" {
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-		parentId = cat.GetScopeId()
+		parentId = scopeId
 		opts = append(opts, auth.WithId(id))
 	}
 	opts = append(opts, auth.WithScopeId(parentId))
 	return auth.Verify(ctx, opts...)
 }
 
-func toProto(in *static.HostCatalog) *pb.HostCatalog {
+func toProto(typ string, in *host.HostCatalog) *pb.HostCatalog {
 	out := pb.HostCatalog{
-		Id:          in.GetPublicId(),
-		ScopeId:     in.GetScopeId(),
-		CreatedTime: in.GetCreateTime().GetTimestamp(),
-		UpdatedTime: in.GetUpdateTime().GetTimestamp(),
-		Version:     in.GetVersion(),
-		Type:        host.StaticSubtype.String(),
+		Id:          in.PublicId,
+		ScopeId:     in.ScopeId,
+		CreatedTime: in.CreateTime,
+		UpdatedTime: in.UpdateTime,
+		Version:     in.Version,
+		Type:        typ,
+		Attributes:  in.Attributes,
 	}
-	if in.GetDescription() != "" {
-		out.Description = &wrapperspb.StringValue{Value: in.GetDescription()}
+	if in.Description != "" {
+		out.Description = &wrapperspb.StringValue{Value: in.Description}
 	}
-	if in.GetName() != "" {
-		out.Name = &wrapperspb.StringValue{Value: in.GetName()}
+	if in.Name != "" {
+		out.Name = &wrapperspb.StringValue{Value: in.Name}
 	}
 	return &out
 }
 
+// fromProto returns the host catalog id in projId with the values of item.
+func fromProto(projId, id string, item *pb.HostCatalog) *host.HostCatalog {
+	return &host.HostCatalog{
+		PublicId:    id,
+		ScopeId:     projId,
+		Name:        item.GetName().GetValue(),
+		Description: item.GetDescription().GetValue(),
+		Attributes:  item.GetAttributes(),
+	}
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -307,49 +352,88 @@ func toProto(in *static.HostCatalog) *pb.HostCatalog {
 //  * There are no conflicting parameters provided
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
-func validateGetRequest(req *pbs.GetHostCatalogRequest) error {
-	return handlers.ValidateGetRequest(static.HostCatalogPrefix, req, handlers.NoopValidatorFn)
+func validateGetRequest(req *pbs.GetHostCatalogRequest, catalogs *host.Registry) error {
+	return handlers.ValidateGetRequest(hostCatalogPrefix(catalogs, req.GetId()), req, handlers.NoopValidatorFn)
 }
 
-func validateCreateRequest(req *pbs.CreateHostCatalogRequest) error {
+func validateCreateRequest(req *pbs.CreateHostCatalogRequest, catalogs *host.Registry) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
 		if !handlers.ValidId(scope.Project.Prefix(), req.GetItem().GetScopeId()) {
 			badFields["scope_id"] = "This field must be a valid project scope id."
 		}
-		switch host.SubtypeFromType(req.GetItem().GetType()) {
-		case host.StaticSubtype:
-		default:
-			badFields["type"] = fmt.Sprintf("This is a required field and must be %q.", host.StaticSubtype.String())
+		if c, ok := catalogs.Catalog(req.GetItem().GetType()); ok {
+			validateAttributes(c, req.GetItem().GetAttributes(), badFields)
+		} else {
+			badFields["type"] = fmt.Sprintf("This is a required field and must be one of %s.", quotedTypes(catalogs))
 		}
 		return badFields
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateHostCatalogRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostCatalogPrefix, req, req.GetItem(), func() map[string]string {
+func validateUpdateRequest(req *pbs.UpdateHostCatalogRequest, catalogs *host.Registry) error {
+	return handlers.ValidateUpdateRequest(hostCatalogPrefix(catalogs, req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		switch host.SubtypeFromId(req.GetId()) {
-		case host.StaticSubtype:
-			if req.GetItem().GetType() != "" && host.SubtypeFromType(req.GetItem().GetType()) != host.StaticSubtype {
+		if c, ok := catalogs.CatalogForId(req.GetId()); ok {
+			if t := req.GetItem().GetType(); t != "" && !strings.EqualFold(strings.TrimSpace(t), c.Type()) {
 				badFields["type"] = "Cannot modify resource type."
 			}
+			validateAttributes(c, req.GetItem().GetAttributes(), badFields)
 		}
 		return badFields
 	})
 }
 
-func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest) error {
-	return handlers.ValidateDeleteRequest(static.HostCatalogPrefix, req, handlers.NoopValidatorFn)
+func validateDeleteRequest(req *pbs.DeleteHostCatalogRequest, catalogs *host.Registry) error {
+	return handlers.ValidateDeleteRequest(hostCatalogPrefix(catalogs, req.GetId()), req, handlers.NoopValidatorFn)
 }
 
 func validateListRequest(req *pbs.ListHostCatalogsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
-		badFields["scope_id"] = "This field must be a valid project scope id."
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+	default:
+		badFields["scope_id"] = "This field must be a valid project scope id, or 'global' or a valid org scope id when listing recursively."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
+
+// validateAttributes adds to badFields the problems the catalog c finds
+// with the attributes of a host catalog.
+func validateAttributes(c host.Catalog, attrs *structpb.Struct, badFields map[string]string) {
+	for k, v := range c.ValidateAttributes(resource.HostCatalog, attrs) {
+		badFields[k] = v
+	}
+}
+
+// quotedTypes returns the registered host catalog types, quoted and
+// separated by commas.
+func quotedTypes(catalogs *host.Registry) string {
+	types := catalogs.Types()
+	for i, t := range types {
+		types[i] = strconv.Quote(t)
+	}
+	return strings.Join(types, ", ")
+}
+
+// hostCatalogPrefix returns the public id prefix of the host catalog type
+// id belongs to.  The static prefix is returned for unknown types.
+func hostCatalogPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().HostCatalog
+	}
+	return static.HostCatalogPrefix
+}
@@ -15,6 +15,8 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/hostcatalogs"
 	scopepb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -26,12 +28,13 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Scope, common.StaticRepoFactory, common.IamRepoFactory) {
+func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Scope, common.StaticRepoFactory, common.DnsRepoFactory, common.IamRepoFactory) {
 	t.Helper()
 	require := require.New(t)
 	conn, _ := db.TestSetup(t, "postgres")
@@ -44,6 +47,9 @@ func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Sc
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.TestRepo(t, conn, wrapper), nil
 	}
@@ -55,25 +61,26 @@ func createDefaultHostCatalogAndRepo(t *testing.T) (*static.HostCatalog, *iam.Sc
 	hcRes, err := repo.CreateCatalog(context.Background(), hc)
 	require.NoError(err, "Couldn't persist new catalog.")
 
-	return hcRes, pRes, repoFn, iamRepoFn
+	return hcRes, pRes, repoFn, dnsRepoFn, iamRepoFn
 }
 
 func TestGet(t *testing.T) {
 	t.Parallel()
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dnsRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 	toMerge := &pbs.GetHostCatalogRequest{
 		Id: hc.GetPublicId(),
 	}
 
 	pHostCatalog := &pb.HostCatalog{
-		Id:          hc.GetPublicId(),
-		ScopeId:     hc.GetScopeId(),
-		Scope:       &scopepb.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
-		Name:        &wrappers.StringValue{Value: hc.GetName()},
-		Description: &wrappers.StringValue{Value: hc.GetDescription()},
-		CreatedTime: hc.CreateTime.GetTimestamp(),
-		UpdatedTime: hc.UpdateTime.GetTimestamp(),
-		Type:        "static",
+		Id:                hc.GetPublicId(),
+		ScopeId:           hc.GetScopeId(),
+		AuthorizedActions: host_catalogs.IdActions.Strings(),
+		Scope:             &scopepb.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+		Name:              &wrappers.StringValue{Value: hc.GetName()},
+		Description:       &wrappers.StringValue{Value: hc.GetDescription()},
+		CreatedTime:       hc.CreateTime.GetTimestamp(),
+		UpdatedTime:       hc.UpdateTime.GetTimestamp(),
+		Type:              "static",
 	}
 
 	cases := []struct {
@@ -112,7 +119,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, iamRepoFn)
+			s, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repo, dnsRepoFn))
 			require.NoError(err, "Couldn't create a new host catalog service.")
 
 			got, gErr := s.GetHostCatalog(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -140,6 +147,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	_, pNoCatalogs := iam.TestScopes(t, iamRepo)
@@ -149,26 +159,43 @@ func TestList(t *testing.T) {
 	var wantSomeCatalogs []*pb.HostCatalog
 	for _, hc := range static.TestCatalogs(t, conn, pWithCatalogs.GetPublicId(), 3) {
 		wantSomeCatalogs = append(wantSomeCatalogs, &pb.HostCatalog{
-			Id:          hc.GetPublicId(),
-			ScopeId:     hc.GetScopeId(),
-			CreatedTime: hc.GetCreateTime().GetTimestamp(),
-			UpdatedTime: hc.GetUpdateTime().GetTimestamp(),
-			Scope:       &scopepb.ScopeInfo{Id: pWithCatalogs.GetPublicId(), Type: scope.Project.String()},
-			Version:     1,
-			Type:        "static",
+			Id:                hc.GetPublicId(),
+			ScopeId:           hc.GetScopeId(),
+			CreatedTime:       hc.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       hc.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: host_catalogs.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: pWithCatalogs.GetPublicId(), Type: scope.Project.String()},
+			Version:           1,
+			Type:              "static",
 		})
 	}
 
 	var wantOtherCatalogs []*pb.HostCatalog
 	for _, hc := range static.TestCatalogs(t, conn, pWithOtherCatalogs.GetPublicId(), 3) {
 		wantOtherCatalogs = append(wantOtherCatalogs, &pb.HostCatalog{
-			Id:          hc.GetPublicId(),
-			ScopeId:     hc.GetScopeId(),
-			CreatedTime: hc.GetCreateTime().GetTimestamp(),
-			UpdatedTime: hc.GetUpdateTime().GetTimestamp(),
-			Scope:       &scopepb.ScopeInfo{Id: pWithOtherCatalogs.GetPublicId(), Type: scope.Project.String()},
-			Version:     1,
-			Type:        "static",
+			Id:                hc.GetPublicId(),
+			ScopeId:           hc.GetScopeId(),
+			CreatedTime:       hc.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       hc.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: host_catalogs.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: pWithOtherCatalogs.GetPublicId(), Type: scope.Project.String()},
+			Version:           1,
+			Type:              "static",
+		})
+	}
+	for _, hc := range dns.TestCatalogs(t, conn, pWithOtherCatalogs.GetPublicId(), 1, dns.WithResolverAddress("127.0.0.1:53")) {
+		wantOtherCatalogs = append(wantOtherCatalogs, &pb.HostCatalog{
+			Id:                hc.GetPublicId(),
+			ScopeId:           hc.GetScopeId(),
+			CreatedTime:       hc.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       hc.GetUpdateTime().GetTimestamp(),
+			AuthorizedActions: host_catalogs.IdActions.Strings(),
+			Scope:             &scopepb.ScopeInfo{Id: pWithOtherCatalogs.GetPublicId(), Type: scope.Project.String()},
+			Version:           1,
+			Type:              "dns",
+			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"resolver_address": structpb.NewStringValue("127.0.0.1:53"),
+			}},
 		})
 	}
 
@@ -207,7 +234,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_catalogs.NewService(repoFn, iamRepoFn)
+			s, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Couldn't create new auth_method service.")
 
 			got, gErr := s.ListHostCatalogs(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListHostCatalogsRequest{ScopeId: tc.scopeId})
@@ -222,9 +249,9 @@ func TestList(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	t.Parallel()
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dnsRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 
-	s, err := host_catalogs.NewService(repo, iamRepoFn)
+	s, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repo, dnsRepoFn))
 	require.NoError(t, err, "Couldn't create a new host catalog service.")
 
 	cases := []struct {
@@ -275,9 +302,9 @@ func TestDelete(t *testing.T) {
 func TestDelete_twice(t *testing.T) {
 	t.Parallel()
 	assert, require := assert.New(t), require.New(t)
-	hc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	hc, proj, repo, dnsRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 
-	s, err := host_catalogs.NewService(repo, iamRepoFn)
+	s, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repo, dnsRepoFn))
 	require.NoError(err, "Couldn't create a new host catalog service.")
 	req := &pbs.DeleteHostCatalogRequest{
 		Id: hc.GetPublicId(),
@@ -292,7 +319,7 @@ func TestDelete_twice(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	t.Parallel()
-	defaultHc, proj, repo, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	defaultHc, proj, repo, dnsRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
 	defaultHcCreated, err := ptypes.Timestamp(defaultHc.GetCreateTime().GetTimestamp())
 	require.NoError(t, err, "Error converting proto to timestamp.")
 	toMerge := &pbs.CreateHostCatalogRequest{}
@@ -322,6 +349,40 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid dns HostCatalog",
+			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId: proj.GetPublicId(),
+				Name:    &wrappers.StringValue{Value: "dns name"},
+				Type:    "dns",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"resolver_address": structpb.NewStringValue("127.0.0.1:53"),
+				}},
+			}},
+			res: &pbs.CreateHostCatalogResponse{
+				Uri: fmt.Sprintf("host-catalogs/%s_", dns.HostCatalogPrefix),
+				Item: &pb.HostCatalog{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopepb.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:    &wrappers.StringValue{Value: "dns name"},
+					Type:    "dns",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"resolver_address": structpb.NewStringValue("127.0.0.1:53"),
+					}},
+				},
+			},
+		},
+		{
+			name: "Cant create dns with resolver address without port",
+			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
+				ScopeId: proj.GetPublicId(),
+				Type:    "dns",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"resolver_address": structpb.NewStringValue("127.0.0.1"),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Cant create in org",
 			req: &pbs.CreateHostCatalogRequest{Item: &pb.HostCatalog{
@@ -390,7 +451,7 @@ func TestCreate(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.CreateHostCatalogRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_catalogs.NewService(repo, iamRepoFn)
+			s, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repo, dnsRepoFn))
 			require.NoError(err, "Failed to create a new host catalog service.")
 
 			got, gErr := s.CreateHostCatalog(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -400,7 +461,7 @@ func TestCreate(t *testing.T) {
 			}
 			if got != nil {
 				assert.Contains(got.GetUri(), tc.res.GetUri())
-				assert.True(strings.HasPrefix(got.GetItem().GetId(), static.HostCatalogPrefix))
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), strings.TrimPrefix(tc.res.GetUri(), "host-catalogs/")))
 				gotCreateTime, err := ptypes.Timestamp(got.GetItem().GetCreatedTime())
 				require.NoError(err, "Error converting proto to timestamp.")
 				gotUpdateTime, err := ptypes.Timestamp(got.GetItem().GetUpdatedTime())
@@ -424,8 +485,8 @@ func TestCreate(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	t.Parallel()
-	hc, proj, repoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
-	tested, err := host_catalogs.NewService(repoFn, iamRepoFn)
+	hc, proj, repoFn, dnsRepoFn, iamRepoFn := createDefaultHostCatalogAndRepo(t)
+	tested, err := host_catalogs.NewService(iamRepoFn, host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	var version uint32 = 1
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
@@ -11,63 +12,95 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/static"
-	"github.com/hashicorp/boundary/internal/host/static/store"
-	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/sdk/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var (
-	maskManager handlers.MaskManager
-)
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.AddHosts,
+	action.SetHosts,
+	action.RemoveHosts,
+}
 
-func init() {
-	var err error
-	if maskManager, err = handlers.NewMaskManager(&store.HostSet{}, &pb.HostSet{}); err != nil {
-		panic(err)
-	}
+// DiscoveredIdActions contains the set of actions that can be performed on
+// individual host sets whose hosts are found by their host catalog, such as
+// dns host sets. Their hosts can't be added, set, or removed.
+var DiscoveredIdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
+	hostCatalogs *host.Registry
 }
 
 var _ pbs.HostSetServiceServer = Service{}
 
-// NewService returns a host set Service which handles host set related requests to boundary and uses the provided
-// repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory) (Service, error) {
-	if repoFn == nil {
-		return Service{}, fmt.Errorf("nil static repository provided")
+// NewService returns a host set Service which handles host set related requests to boundary and uses the host
+// catalog types in hostCatalogs for storage and retrieval.
+func NewService(hostCatalogs *host.Registry) (Service, error) {
+	if hostCatalogs == nil {
+		return Service{}, fmt.Errorf("nil host catalog registry provided")
 	}
-	return Service{staticRepoFn: repoFn}, nil
+	return Service{hostCatalogs: hostCatalogs}, nil
 }
 
 func (s Service) ListHostSets(ctx context.Context, req *pbs.ListHostSetsRequest) (*pbs.ListHostSetsResponse, error) {
-	if err := validateListRequest(req); err != nil {
+	if err := validateListRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range hl {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.HostSet
+	for pager.More() {
+		hl, err := s.listFromRepo(ctx, req.GetHostCatalogId(), pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range hl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			item.Scope = authResults.Scope
+			item.AuthorizedActions = authResults.FetchActionSetForId(item.Id, s.idActions(item.Id)).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(hl))
 	}
-	return &pbs.ListHostSetsResponse{Items: hl}, nil
+	return &pbs.ListHostSetsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetHostSet implements the interface pbs.HostSetServiceServer.
 func (s Service) GetHostSet(ctx context.Context, req *pbs.GetHostSetRequest) (*pbs.GetHostSetResponse, error) {
-	if err := validateGetRequest(req); err != nil {
+	if err := validateGetRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
@@ -79,19 +112,20 @@ func (s Service) GetHostSet(ctx context.Context, req *pbs.GetHostSetRequest) (*p
 		return nil, err
 	}
 	hc.Scope = authResults.Scope
+	hc.AuthorizedActions = authResults.FetchActionSetForId(hc.Id, s.idActions(hc.Id)).Strings()
 	return &pbs.GetHostSetResponse{Item: hc}, nil
 }
 
 // CreateHostSet implements the interface pbs.HostSetServiceServer.
 func (s Service) CreateHostSet(ctx context.Context, req *pbs.CreateHostSetRequest) (*pbs.CreateHostSetResponse, error) {
-	if err := validateCreateRequest(req); err != nil {
+	if err := validateCreateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	cat, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetHostCatalogId(), action.Create)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	h, err := s.createInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetItem())
+	h, err := s.createInRepo(ctx, authResults.Scope.GetId(), cat, req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -104,14 +138,14 @@ func (s Service) CreateHostSet(ctx context.Context, req *pbs.CreateHostSetReques
 
 // UpdateHostSet implements the interface pbs.HostSetServiceServer.
 func (s Service) UpdateHostSet(ctx context.Context, req *pbs.UpdateHostSetRequest) (*pbs.UpdateHostSetResponse, error) {
-	if err := validateUpdateRequest(req); err != nil {
+	if err := validateUpdateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	cat, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +155,7 @@ func (s Service) UpdateHostSet(ctx context.Context, req *pbs.UpdateHostSetReques
 
 // DeleteHostSet implements the interface pbs.HostSetServiceServer.
 func (s Service) DeleteHostSet(ctx context.Context, req *pbs.DeleteHostSetRequest) (*pbs.DeleteHostSetResponse, error) {
-	if err := validateDeleteRequest(req); err != nil {
+	if err := validateDeleteRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Delete)
@@ -137,10 +171,10 @@ func (s Service) DeleteHostSet(ctx context.Context, req *pbs.DeleteHostSetReques
 
 // AddHostSetHosts implements the interface pbs.HostSetServiceServer.
 func (s Service) AddHostSetHosts(ctx context.Context, req *pbs.AddHostSetHostsRequest) (*pbs.AddHostSetHostsResponse, error) {
-	if err := validateAddRequest(req); err != nil {
+	if err := validateAddRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.AddHostSets)
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.AddHosts)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
@@ -154,10 +188,10 @@ func (s Service) AddHostSetHosts(ctx context.Context, req *pbs.AddHostSetHostsRe
 
 // SetHostSetHosts implements the interface pbs.HostSetServiceServer.
 func (s Service) SetHostSetHosts(ctx context.Context, req *pbs.SetHostSetHostsRequest) (*pbs.SetHostSetHostsResponse, error) {
-	if err := validateSetRequest(req); err != nil {
+	if err := validateSetRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.SetHostSets)
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.SetHosts)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
@@ -171,10 +205,10 @@ func (s Service) SetHostSetHosts(ctx context.Context, req *pbs.SetHostSetHostsRe
 
 // RemoveHostSetHosts implements the interface pbs.HostSetServiceServer.
 func (s Service) RemoveHostSetHosts(ctx context.Context, req *pbs.RemoveHostSetHostsRequest) (*pbs.RemoveHostSetHostsResponse, error) {
-	if err := validateRemoveRequest(req); err != nil {
+	if err := validateRemoveRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveHostSets)
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveHosts)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
@@ -187,37 +221,27 @@ func (s Service) RemoveHostSetHosts(ctx context.Context, req *pbs.RemoveHostSetH
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.HostSet, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", id)
 	}
-	h, m, err := repo.LookupSet(ctx, id)
+	h, err := c.LookupSet(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if h == nil {
 		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", id)
 	}
-	return toProto(h, m), nil
+	withCurrentHosts(ctx, c, h)
+	return toProto(c.Type(), h), nil
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, item *pb.HostSet) (*pb.HostSet, error) {
-	var opts []static.Option
-	if item.GetName() != nil {
-		opts = append(opts, static.WithName(item.GetName().GetValue()))
-	}
-	if item.GetDescription() != nil {
-		opts = append(opts, static.WithDescription(item.GetDescription().GetValue()))
+	c, ok := s.hostCatalogs.CatalogForId(catalogId)
+	if !ok {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host set for creation: unknown host catalog %q.", catalogId)
 	}
-	h, err := static.NewHostSet(catalogId, opts...)
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host set for creation: %v.", err)
-	}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	out, err := repo.CreateSet(ctx, scopeId, h)
+	out, err := c.CreateSet(ctx, scopeId, fromProto(catalogId, "", item))
 	if err != nil {
 		if db.IsUniqueError(err) || errors.Is(err, db.ErrNotUnique) {
 			// Push this error through so the error interceptor can interpret it correctly.
@@ -228,136 +252,158 @@ func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, it
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host set but no error returned from repository.")
 	}
-	return toProto(out, nil), nil
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId, id string, mask []string, item *pb.HostSet) (*pb.HostSet, error) {
-	var opts []static.Option
-	if desc := item.GetDescription(); desc != nil {
-		opts = append(opts, static.WithDescription(desc.GetValue()))
-	}
-	if name := item.GetName(); name != nil {
-		opts = append(opts, static.WithName(name.GetValue()))
-	}
-	h, err := static.NewHostSet(catalogId, opts...)
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host set for update: %v.", err)
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist or incorrect version provided.", id)
 	}
-	h.PublicId = id
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
+	out, rowsUpdated, err := c.UpdateSet(ctx, scopeId, fromProto(catalogId, id, item), item.GetVersion(), mask)
+	if errors.Is(err, db.ErrEmptyFieldMask) {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	out, m, rowsUpdated, err := repo.UpdateSet(ctx, scopeId, h, item.GetVersion(), dbMask)
 	if err != nil {
+		if db.IsUniqueError(err) || errors.Is(err, db.ErrNotUnique) {
+			// Push this error through so the error interceptor can interpret it correctly.
+			return nil, err
+		}
 		return nil, fmt.Errorf("unable to update host set: %w.", err)
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist or incorrect version provided.", id)
 	}
-	return toProto(out, m), nil
+	withCurrentHosts(ctx, c, out)
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return false, err
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return false, nil
 	}
-	rows, err := repo.DeleteSet(ctx, scopeId, id)
+	rows, err := c.DeleteSet(ctx, scopeId, id)
 	if err != nil {
-		return false, fmt.Errorf("unable to delete host: %w", err)
+		return false, fmt.Errorf("unable to delete host set: %w", err)
 	}
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]*pb.HostSet, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
+func (s Service) listFromRepo(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*pb.HostSet, error) {
+	c, ok := s.hostCatalogs.CatalogForId(catalogId)
+	if !ok {
+		return nil, nil
 	}
-	hl, err := repo.ListSets(ctx, catalogId)
+	hl, err := c.ListSets(ctx, catalogId, cursor, limit)
 	if err != nil {
 		return nil, err
 	}
 	var outH []*pb.HostSet
 	for _, h := range hl {
-		outH = append(outH, toProto(h, nil))
+		outH = append(outH, toProto(c.Type(), h))
 	}
 	return outH, nil
 }
 
 func (s Service) addInRepo(ctx context.Context, scopeId, setId string, hostIds []string, version uint32) (*pb.HostSet, error) {
-	repo, err := s.staticRepoFn()
+	c, err := s.memberWriter(setId)
 	if err != nil {
 		return nil, err
 	}
-	_, err = repo.AddSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
+	err = c.AddSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add hosts to host set: %v.", err)
 	}
-	out, m, err := repo.LookupSet(ctx, setId)
+	out, err := c.LookupSet(ctx, setId)
 	if err != nil {
 		return nil, fmt.Errorf("unable to look up host set after adding hosts: %w", err)
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup host set after adding hosts to it.")
 	}
-	return toProto(out, m), nil
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) setInRepo(ctx context.Context, scopeId, setId string, hostIds []string, version uint32) (*pb.HostSet, error) {
-	repo, err := s.staticRepoFn()
+	c, err := s.memberWriter(setId)
 	if err != nil {
 		return nil, err
 	}
-	_, _, err = repo.SetSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
+	err = c.SetSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set hosts in host set: %v.", err)
 	}
 
-	out, m, err := repo.LookupSet(ctx, setId)
+	out, err := c.LookupSet(ctx, setId)
 	if err != nil {
 		return nil, fmt.Errorf("unable to look up host set after setting hosts: %w", err)
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup host set after setting hosts for it.")
 	}
-	return toProto(out, m), nil
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) removeInRepo(ctx context.Context, scopeId, setId string, hostIds []string, version uint32) (*pb.HostSet, error) {
-	repo, err := s.staticRepoFn()
+	c, err := s.memberWriter(setId)
 	if err != nil {
 		return nil, err
 	}
-	_, err = repo.DeleteSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
+	err = c.DeleteSetMembers(ctx, scopeId, setId, version, strutil.RemoveDuplicates(hostIds, false))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to remove hosts from host set: %v.", err)
 	}
-	out, m, err := repo.LookupSet(ctx, setId)
+	out, err := c.LookupSet(ctx, setId)
 	if err != nil {
 		return nil, fmt.Errorf("unable to look up host set: %w", err)
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to lookup host set after removing hosts from it.")
 	}
-	return toProto(out, m), nil
+	return toProto(c.Type(), out), nil
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*static.HostCatalog, auth.VerifyResults) {
-	res := auth.VerifyResults{}
-	repo, err := s.staticRepoFn()
+// memberWriter returns the catalog of the host set setId if users can
+// change the hosts of its sets.
+func (s Service) memberWriter(setId string) (host.SetMemberWriter, error) {
+	c, ok := s.hostCatalogs.CatalogForId(setId)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host Set %q doesn't exist.", setId)
+	}
+	w, ok := c.(host.SetMemberWriter)
+	if !ok {
+		return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{"id": hostsReadOnly})
+	}
+	return w, nil
+}
+
+// withCurrentHosts sets the hosts of the host set h to the hosts its
+// catalog currently finds for it, if the catalog discovers the hosts of its
+// sets. The hosts the catalog found the last time are kept if it can't find
+// them now.
+func withCurrentHosts(ctx context.Context, c host.Catalog, h *host.Set) {
+	if _, ok := c.(host.SetMemberWriter); ok {
+		return
+	}
+	hosts, err := c.ListSetHosts(ctx, h.PublicId)
 	if err != nil {
-		res.Error = err
-		return nil, res
+		return
+	}
+	h.HostIds = nil
+	for _, m := range hosts {
+		h.HostIds = append(h.HostIds, m.PublicId)
 	}
+}
+
+// parentAndAuthResult returns the id of the host catalog the host set id
+// belongs to, or id itself for the actions on a catalog's host sets, along
+// with the auth results for the action.
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
+	res := auth.VerifyResults{}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.HostSet), auth.WithAction(a)}
@@ -365,53 +411,103 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 	case action.List, action.Create:
 		parentId = id
 	default:
-		set, _, err := repo.LookupSet(ctx, id)
+		catalogId, err := s.lookupSetCatalogId(ctx, id)
 		if err != nil {
 			res.Error = err
-			return nil, res
+			return "", res
 		}
-		if set == nil {
+		if catalogId == "" {
 			res.Error = handlers.NotFoundError()
-			return nil, res
+			return "", res
 		}
-		parentId = set.GetCatalogId()
+		parentId = catalogId
 		opts = append(opts, auth.WithId(id))
 	}
 
-	cat, err := repo.LookupCatalog(ctx, parentId)
+	scopeId, err := s.lookupCatalogScopeId(ctx, parentId)
 	if err != nil {
 		res.Error = err
-		return nil, res
+		return "", res
 	}
-	if cat == nil {
+	if scopeId == "" {
 		res.Error = handlers.NotFoundError()
-		return nil, res
+		return "", res
 	}
-	opts = append(opts, auth.WithScopeId(cat.GetScopeId()), auth.WithPin(parentId))
-	return cat, auth.Verify(ctx, opts...)
+	opts = append(opts, auth.WithScopeId(scopeId), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
 }
 
-func toProto(in *static.HostSet, hs []*static.Host) *pb.HostSet {
-	out := pb.HostSet{
-		Id:            in.GetPublicId(),
-		HostCatalogId: in.GetCatalogId(),
-		Type:          host.StaticSubtype.String(),
-		CreatedTime:   in.GetCreateTime().GetTimestamp(),
-		UpdatedTime:   in.GetUpdateTime().GetTimestamp(),
-		Version:       in.GetVersion(),
+// lookupSetCatalogId returns the catalog id of the host set id, or an empty
+// string if the set doesn't exist.
+func (s Service) lookupSetCatalogId(ctx context.Context, id string) (string, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return "", nil
+	}
+	set, err := c.LookupSet(ctx, id)
+	if err != nil || set == nil {
+		return "", err
+	}
+	return set.CatalogId, nil
+}
+
+// lookupCatalogScopeId returns the scope id of the host catalog id, or an
+// empty string if the catalog doesn't exist.
+func (s Service) lookupCatalogScopeId(ctx context.Context, id string) (string, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return "", nil
 	}
-	if in.GetDescription() != "" {
-		out.Description = wrapperspb.String(in.GetDescription())
+	cat, err := c.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
 	}
-	if in.GetName() != "" {
-		out.Name = wrapperspb.String(in.GetName())
+	return cat.ScopeId, nil
+}
+
+func toProto(typ string, in *host.Set) *pb.HostSet {
+	out := pb.HostSet{
+		Id:            in.PublicId,
+		HostCatalogId: in.CatalogId,
+		Type:          typ,
+		CreatedTime:   in.CreateTime,
+		UpdatedTime:   in.UpdateTime,
+		Version:       in.Version,
+		HostIds:       in.HostIds,
+		Attributes:    in.Attributes,
 	}
-	for _, h := range hs {
-		out.HostIds = append(out.HostIds, h.GetPublicId())
+	if in.Description != "" {
+		out.Description = wrapperspb.String(in.Description)
+	}
+	if in.Name != "" {
+		out.Name = wrapperspb.String(in.Name)
 	}
 	return &out
 }
 
+// fromProto returns the host set id in the host catalog catalogId with the
+// fields of item.
+func fromProto(catalogId, id string, item *pb.HostSet) *host.Set {
+	return &host.Set{
+		PublicId:    id,
+		CatalogId:   catalogId,
+		Name:        item.GetName().GetValue(),
+		Description: item.GetDescription().GetValue(),
+		Attributes:  item.GetAttributes(),
+	}
+}
+
+// idActions returns the set of actions that can be performed on the host
+// set id.
+func (s Service) idActions(id string) action.ActionSet {
+	if c, ok := s.hostCatalogs.CatalogForId(id); ok {
+		if _, ok := c.(host.SetMemberWriter); !ok {
+			return DiscoveredIdActions
+		}
+	}
+	return IdActions
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -419,116 +515,154 @@ func toProto(in *static.HostSet, hs []*static.Host) *pb.HostSet {
 //  * There are no conflicting parameters provided
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
-func validateGetRequest(req *pbs.GetHostSetRequest) error {
-	return handlers.ValidateGetRequest(static.HostSetPrefix, req, handlers.NoopValidatorFn)
+func validateGetRequest(req *pbs.GetHostSetRequest, catalogs *host.Registry) error {
+	return handlers.ValidateGetRequest(hostSetPrefix(catalogs, req.GetId()), req, handlers.NoopValidatorFn)
 }
 
-func validateCreateRequest(req *pbs.CreateHostSetRequest) error {
+func validateCreateRequest(req *pbs.CreateHostSetRequest, catalogs *host.Registry) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		if !handlers.ValidId(static.HostCatalogPrefix, req.GetItem().GetHostCatalogId()) {
+		if !handlers.ValidId(hostCatalogPrefix(catalogs, req.GetItem().GetHostCatalogId()), req.GetItem().GetHostCatalogId()) {
 			badFields["host_catalog_id"] = "The field is incorrectly formatted."
 		}
-		switch host.SubtypeFromId(req.GetItem().GetHostCatalogId()) {
-		case host.StaticSubtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
+		if c, ok := catalogs.CatalogForId(req.GetItem().GetHostCatalogId()); ok {
+			if req.GetItem().GetType() != "" && !strings.EqualFold(req.GetItem().GetType(), c.Type()) {
 				badFields["type"] = "Doesn't match the parent resource's type."
 			}
+			validateAttributes(c, req.GetItem().GetAttributes(), badFields)
 		}
 		return badFields
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateHostSetRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostSetPrefix, req, req.GetItem(), func() map[string]string {
+func validateUpdateRequest(req *pbs.UpdateHostSetRequest, catalogs *host.Registry) error {
+	return handlers.ValidateUpdateRequest(hostSetPrefix(catalogs, req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		switch host.SubtypeFromId(req.GetId()) {
-		case host.StaticSubtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
+		if c, ok := catalogs.CatalogForId(req.GetId()); ok {
+			if req.GetItem().GetType() != "" && !strings.EqualFold(req.GetItem().GetType(), c.Type()) {
 				badFields["type"] = "Cannot modify the resource type."
 			}
+			validateAttributes(c, req.GetItem().GetAttributes(), badFields)
 		}
 		return badFields
 	})
 }
 
-func validateDeleteRequest(req *pbs.DeleteHostSetRequest) error {
-	return handlers.ValidateDeleteRequest(static.HostSetPrefix, req, handlers.NoopValidatorFn)
+func validateDeleteRequest(req *pbs.DeleteHostSetRequest, catalogs *host.Registry) error {
+	return handlers.ValidateDeleteRequest(hostSetPrefix(catalogs, req.GetId()), req, handlers.NoopValidatorFn)
 }
 
-func validateListRequest(req *pbs.ListHostSetsRequest) error {
+func validateListRequest(req *pbs.ListHostSetsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostCatalogPrefix, req.GetHostCatalogId()) {
+	if !handlers.ValidId(hostCatalogPrefix(catalogs, req.GetHostCatalogId()), req.GetHostCatalogId()) {
 		badFields["host_catalog_id"] = "The field is incorrectly formatted."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
 
-func validateAddRequest(req *pbs.AddHostSetHostsRequest) error {
+func validateAddRequest(req *pbs.AddHostSetHostsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
-		badFields["id"] = "Incorrectly formatted identifier."
-	}
 	if req.GetVersion() == 0 {
 		badFields["version"] = "Required field."
 	}
 	if len(req.GetHostIds()) == 0 {
 		badFields["host_ids"] = "Must be non-empty."
 	}
-	for _, id := range req.GetHostIds() {
-		if !handlers.ValidId(static.HostPrefix, id) {
-			badFields["host_ids"] = "Incorrectly formatted host identifier."
-			break
-		}
-	}
+	validateMembers(catalogs, req.GetId(), req.GetHostIds(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
-func validateSetRequest(req *pbs.SetHostSetHostsRequest) error {
+func validateSetRequest(req *pbs.SetHostSetHostsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
-		badFields["id"] = "Incorrectly formatted identifier."
-	}
 	if req.GetVersion() == 0 {
 		badFields["version"] = "Required field."
 	}
-	for _, id := range req.GetHostIds() {
-		if !handlers.ValidId(static.HostPrefix, id) {
-			badFields["host_ids"] = "Incorrectly formatted host identifier."
-			break
-		}
-	}
+	validateMembers(catalogs, req.GetId(), req.GetHostIds(), badFields)
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
-func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest) error {
+func validateRemoveRequest(req *pbs.RemoveHostSetHostsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostSetPrefix, req.GetId()) {
-		badFields["id"] = "Incorrectly formatted identifier."
-	}
 	if req.GetVersion() == 0 {
 		badFields["version"] = "Required field."
 	}
 	if len(req.GetHostIds()) == 0 {
 		badFields["host_ids"] = "Must be non-empty."
 	}
-	for _, id := range req.GetHostIds() {
-		if !handlers.ValidId(static.HostPrefix, id) {
+	validateMembers(catalogs, req.GetId(), req.GetHostIds(), badFields)
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+// validateMembers adds to badFields the problems with the host set id and
+// the host ids of a request which changes the hosts of the set.
+func validateMembers(catalogs *host.Registry, id string, hostIds []string, badFields map[string]string) {
+	hostPrefix := static.HostPrefix
+	if c, ok := catalogs.CatalogForId(id); ok {
+		if _, ok := c.(host.SetMemberWriter); !ok {
+			badFields["id"] = hostsReadOnly
+			return
+		}
+		hostPrefix = c.Prefixes().Host
+	}
+	if !handlers.ValidId(hostSetPrefix(catalogs, id), id) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	for _, id := range hostIds {
+		if !handlers.ValidId(hostPrefix, id) {
 			badFields["host_ids"] = "Incorrectly formatted host identifier."
 			break
 		}
 	}
-	if len(badFields) > 0 {
-		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+}
+
+// hostsReadOnly is the error for a request which changes the hosts of a
+// host set whose hosts are discovered by its host catalog.
+const hostsReadOnly = "The hosts of this host set are found by its host catalog and can't be changed."
+
+// validateAttributes adds to badFields the problems the catalog c finds
+// with the attributes of a host set.
+func validateAttributes(c host.Catalog, attrs *structpb.Struct, badFields map[string]string) {
+	for k, v := range c.ValidateAttributes(resource.HostSet, attrs) {
+		badFields[k] = v
 	}
-	return nil
+}
+
+// hostSetPrefix returns the public id prefix of the host sets of the host
+// catalog type id belongs to.  The static prefix is returned for unknown
+// types.
+func hostSetPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().HostSet
+	}
+	return static.HostSetPrefix
+}
+
+// hostCatalogPrefix returns the public id prefix of the host catalog type
+// id belongs to.  The static prefix is returned for unknown types.
+func hostCatalogPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().HostCatalog
+	}
+	return static.HostCatalogPrefix
 }
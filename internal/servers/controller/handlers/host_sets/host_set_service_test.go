@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -29,6 +30,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestGet(t *testing.T) {
@@ -43,6 +45,9 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -53,13 +58,14 @@ func TestGet(t *testing.T) {
 	toMerge := &pbs.GetHostSetRequest{}
 
 	pHost := &pb.HostSet{
-		HostCatalogId: hc.GetPublicId(),
-		Id:            hs.GetPublicId(),
-		CreatedTime:   hs.CreateTime.GetTimestamp(),
-		UpdatedTime:   hs.UpdateTime.GetTimestamp(),
-		Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
-		Type:          "static",
-		HostIds:       hIds,
+		HostCatalogId:     hc.GetPublicId(),
+		Id:                hs.GetPublicId(),
+		CreatedTime:       hs.CreateTime.GetTimestamp(),
+		UpdatedTime:       hs.UpdateTime.GetTimestamp(),
+		AuthorizedActions: host_sets.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+		Type:              "static",
+		HostIds:           hIds,
 	}
 
 	cases := []struct {
@@ -98,7 +104,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), req)
@@ -126,19 +132,23 @@ func TestList(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
 	var wantHs []*pb.HostSet
 	for _, h := range static.TestSets(t, conn, hc.GetPublicId(), 10) {
 		wantHs = append(wantHs, &pb.HostSet{
-			Id:            h.GetPublicId(),
-			HostCatalogId: h.GetCatalogId(),
-			Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
-			CreatedTime:   h.GetCreateTime().GetTimestamp(),
-			UpdatedTime:   h.GetUpdateTime().GetTimestamp(),
-			Version:       h.GetVersion(),
-			Type:          host.StaticSubtype.String(),
+			Id:                h.GetPublicId(),
+			HostCatalogId:     h.GetCatalogId(),
+			AuthorizedActions: host_sets.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+			CreatedTime:       h.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       h.GetUpdateTime().GetTimestamp(),
+			Version:           h.GetVersion(),
+			Type:              host.StaticSubtype.String(),
 		})
 	}
 
@@ -162,7 +172,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			got, gErr := s.ListHostSets(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), &pbs.ListHostSetsRequest{HostCatalogId: tc.hostCatalogId})
@@ -187,10 +197,13 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn)
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -259,10 +272,13 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn)
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostSetRequest{
 		Id: h.GetPublicId(),
@@ -287,6 +303,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
 	defaultHcCreated, err := ptypes.Timestamp(hc.GetCreateTime().GetTimestamp())
@@ -317,6 +336,40 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid Host Set with a selector",
+			req: &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+				HostCatalogId: hc.GetPublicId(),
+				Name:          &wrappers.StringValue{Value: "selector name"},
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"selector": structpb.NewStringValue(`env == "prod"`),
+				}},
+			}},
+			res: &pbs.CreateHostSetResponse{
+				Uri: fmt.Sprintf("host-sets/%s_", static.HostSetPrefix),
+				Item: &pb.HostSet{
+					HostCatalogId: hc.GetPublicId(),
+					Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:          &wrappers.StringValue{Value: "selector name"},
+					Type:          "static",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"selector": structpb.NewStringValue(`env == "prod"`),
+					}},
+				},
+			},
+		},
+		{
+			name: "Create with an invalid selector",
+			req: &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"selector": structpb.NewStringValue(`env ==`),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with unknown type",
 			req: &pbs.CreateHostSetRequest{Item: &pb.HostSet{
@@ -377,7 +430,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn)
+			s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), tc.req)
@@ -421,6 +474,9 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -451,7 +507,7 @@ func TestUpdate(t *testing.T) {
 		Id: hs.GetPublicId(),
 	}
 
-	tested, err := host_sets.NewService(repoFn)
+	tested, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -776,7 +832,10 @@ func TestAddHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -888,7 +947,10 @@ func TestSetHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -996,7 +1058,10 @@ func TestRemoveHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	s, err := host_sets.NewService(repoFn)
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -1110,3 +1175,56 @@ func TestRemoveHostSetHosts(t *testing.T) {
 		})
 	}
 }
+
+func TestDnsHostSet(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms, dns.WithResolver(dns.NewResolver(0)))
+	}
+	srv := dns.TestDnsServer(t)
+	srv.SetHost("web.example.test", "10.0.0.1", "10.0.0.2")
+
+	hc := dns.TestCatalogs(t, conn, proj.GetPublicId(), 1, dns.WithResolverAddress(srv.Addr))[0]
+	hs := dns.TestSets(t, conn, hc.GetPublicId(), 1, "web.example.test")[0]
+
+	s, err := host_sets.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
+	require.NoError(err, "Couldn't create a new host set service.")
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	got, err := s.GetHostSet(ctx, &pbs.GetHostSetRequest{Id: hs.GetPublicId()})
+	require.NoError(err)
+	assert.Equal("dns", got.GetItem().GetType())
+	assert.Len(got.GetItem().GetHostIds(), 2, "the members are resolved on read")
+	assert.Equal(host_sets.DiscoveredIdActions.Strings(), got.GetItem().GetAuthorizedActions())
+	assert.Equal([]interface{}{"web.example.test"}, got.GetItem().GetAttributes().AsMap()["record_names"])
+
+	created, err := s.CreateHostSet(ctx, &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+		HostCatalogId: hc.GetPublicId(),
+		Name:          &wrappers.StringValue{Value: "srv"},
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"record_names": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewStringValue("_web._tcp.example.test"),
+			}}),
+		}},
+	}})
+	require.NoError(err)
+	assert.True(strings.HasPrefix(created.GetItem().GetId(), dns.HostSetPrefix+"_"))
+	assert.Equal("dns", created.GetItem().GetType())
+
+	_, err = s.AddHostSetHosts(ctx, &pbs.AddHostSetHostsRequest{
+		Id:      hs.GetPublicId(),
+		Version: hs.GetVersion(),
+		HostIds: []string{dns.HostPrefix + "_1234567890"},
+	})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "hosts can't be added to a dns host set")
+}
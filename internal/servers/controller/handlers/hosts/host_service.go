@@ -13,62 +13,90 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
 	"github.com/hashicorp/boundary/internal/host/static"
-	"github.com/hashicorp/boundary/internal/host/static/store"
-	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var (
-	maskManager handlers.MaskManager
-)
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+}
 
-func init() {
-	var err error
-	if maskManager, err = handlers.NewMaskManager(&store.Host{}, &pb.Host{}, &pb.StaticHostAttributes{}); err != nil {
-		panic(err)
-	}
+// DiscoveredIdActions contains the set of actions that can be performed on
+// individual hosts found by their host catalog, such as dns hosts. They are
+// created and deleted when the hosts of their catalog's host sets are found
+// so they can only be read.
+var DiscoveredIdActions = action.ActionSet{
+	action.Read,
 }
 
 type Service struct {
-	staticRepoFn common.StaticRepoFactory
+	hostCatalogs *host.Registry
 }
 
 var _ pbs.HostServiceServer = Service{}
 
-// NewService returns a host Service which handles host related requests to boundary and uses the provided
-// repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory) (Service, error) {
-	if repoFn == nil {
-		return Service{}, fmt.Errorf("nil static repository provided")
+// NewService returns a host Service which handles host related requests to boundary and uses the host catalog
+// types in hostCatalogs for storage and retrieval.
+func NewService(hostCatalogs *host.Registry) (Service, error) {
+	if hostCatalogs == nil {
+		return Service{}, fmt.Errorf("nil host catalog registry provided")
 	}
-	return Service{staticRepoFn: repoFn}, nil
+	return Service{hostCatalogs: hostCatalogs}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
-	if err := validateListRequest(req); err != nil {
+	if err := validateListRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hl, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range hl {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
 	}
-	return &pbs.ListHostsResponse{Items: hl}, nil
+	var finalItems []*pb.Host
+	for pager.More() {
+		hl, err := s.listFromRepo(ctx, req.GetHostCatalogId(), pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range hl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			item.Scope = authResults.Scope
+			item.AuthorizedActions = authResults.FetchActionSetForId(item.Id, s.idActions(item.Id)).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(hl))
+	}
+	return &pbs.ListHostsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetHost implements the interface pbs.HostServiceServer.
 func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.GetHostResponse, error) {
-	if err := validateGetRequest(req); err != nil {
+	if err := validateGetRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Read)
@@ -80,12 +108,13 @@ func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.Get
 		return nil, err
 	}
 	hc.Scope = authResults.Scope
+	hc.AuthorizedActions = authResults.FetchActionSetForId(hc.Id, s.idActions(hc.Id)).Strings()
 	return &pbs.GetHostResponse{Item: hc}, nil
 }
 
 // CreateHost implements the interface pbs.HostServiceServer.
 func (s Service) CreateHost(ctx context.Context, req *pbs.CreateHostRequest) (*pbs.CreateHostResponse, error) {
-	if err := validateCreateRequest(req); err != nil {
+	if err := validateCreateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetItem().GetHostCatalogId(), action.Create)
@@ -105,14 +134,14 @@ func (s Service) CreateHost(ctx context.Context, req *pbs.CreateHostRequest) (*p
 
 // UpdateHost implements the interface pbs.HostServiceServer.
 func (s Service) UpdateHost(ctx context.Context, req *pbs.UpdateHostRequest) (*pbs.UpdateHostResponse, error) {
-	if err := validateUpdateRequest(req); err != nil {
+	if err := validateUpdateRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	cat, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Update)
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat.GetPublicId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	hc, err := s.updateInRepo(ctx, authResults.Scope.GetId(), cat, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +151,7 @@ func (s Service) UpdateHost(ctx context.Context, req *pbs.UpdateHostRequest) (*p
 
 // DeleteHost implements the interface pbs.HostServiceServer.
 func (s Service) DeleteHost(ctx context.Context, req *pbs.DeleteHostRequest) (*pbs.DeleteHostResponse, error) {
-	if err := validateDeleteRequest(req); err != nil {
+	if err := validateDeleteRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Delete)
@@ -137,45 +166,26 @@ func (s Service) DeleteHost(ctx context.Context, req *pbs.DeleteHostRequest) (*p
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Host, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Host %q doesn't exist.", id)
 	}
-	h, err := repo.LookupHost(ctx, id)
+	h, err := c.LookupHost(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if h == nil {
 		return nil, handlers.NotFoundErrorf("Host %q doesn't exist.", id)
 	}
-	return toProto(h, nil)
+	return toProto(c.Type(), h), nil
 }
 
 func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, item *pb.Host) (*pb.Host, error) {
-	ha := &pb.StaticHostAttributes{}
-	if err := handlers.StructToProto(item.GetAttributes(), ha); err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Failed converting attributes to subtype proto: %s", err)
-	}
-	var opts []static.Option
-	if ha.GetAddress() != nil {
-		opts = append(opts, static.WithAddress(ha.GetAddress().GetValue()))
-	}
-	if item.GetName() != nil {
-		opts = append(opts, static.WithName(item.GetName().GetValue()))
-	}
-	if item.GetDescription() != nil {
-		opts = append(opts, static.WithDescription(item.GetDescription().GetValue()))
-	}
-	h, err := static.NewHost(catalogId, opts...)
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host for creation: %v.", err)
-	}
-
-	repo, err := s.staticRepoFn()
+	c, err := s.hostWriter(catalogId)
 	if err != nil {
 		return nil, err
 	}
-	out, err := repo.CreateHost(ctx, scopeId, h)
+	out, err := c.CreateHost(ctx, scopeId, fromProto(catalogId, "", item))
 	if err != nil {
 		if db.IsUniqueError(err) || errors.Is(err, db.ErrNotUnique) {
 			// Push this error through so the error interceptor can interpret it correctly.
@@ -186,86 +196,74 @@ func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, it
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create host but no error returned from repository.")
 	}
-	return toProto(out, nil)
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId, id string, mask []string, item *pb.Host) (*pb.Host, error) {
-	ha := &pb.StaticHostAttributes{}
-	if err := handlers.StructToProto(item.GetAttributes(), ha); err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Failed converting attributes to subtype proto: %s", err)
-	}
-	var opts []static.Option
-	if desc := item.GetDescription(); desc != nil {
-		opts = append(opts, static.WithDescription(desc.GetValue()))
-	}
-	if name := item.GetName(); name != nil {
-		opts = append(opts, static.WithName(name.GetValue()))
-	}
-	if addr := ha.GetAddress(); addr != nil {
-		opts = append(opts, static.WithAddress(addr.GetValue()))
-	}
-	h, err := static.NewHost(catalogId, opts...)
+	c, err := s.hostWriter(id)
 	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build host for update: %v.", err)
+		return nil, err
 	}
-	h.PublicId = id
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
+	out, rowsUpdated, err := c.UpdateHost(ctx, scopeId, fromProto(catalogId, id, item), item.GetVersion(), mask)
+	if errors.Is(err, db.ErrEmptyFieldMask) {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
-	}
-	out, rowsUpdated, err := repo.UpdateHost(ctx, scopeId, h, item.GetVersion(), dbMask)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update host: %w", err)
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Host %q doesn't exist or incorrect version provided.", id)
 	}
-	return toProto(out, nil)
+	return toProto(c.Type(), out), nil
 }
 
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
-	repo, err := s.staticRepoFn()
+	c, err := s.hostWriter(id)
 	if err != nil {
 		return false, err
 	}
-	rows, err := repo.DeleteHost(ctx, scopeId, id)
+	rows, err := c.DeleteHost(ctx, scopeId, id)
 	if err != nil {
 		return false, fmt.Errorf("unable to delete host: %w", err)
 	}
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]*pb.Host, error) {
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		return nil, err
+func (s Service) listFromRepo(ctx context.Context, catalogId string, cursor *db.PageCursor, limit int) ([]*pb.Host, error) {
+	c, ok := s.hostCatalogs.CatalogForId(catalogId)
+	if !ok {
+		return nil, nil
 	}
-	hl, err := repo.ListHosts(ctx, catalogId)
+	hl, err := c.ListHosts(ctx, catalogId, cursor, limit)
 	if err != nil {
 		return nil, err
 	}
 	var outHl []*pb.Host
 	for _, h := range hl {
-		p, err := toProto(h, nil)
-		if err != nil {
-			return nil, err
-		}
-		outHl = append(outHl, p)
+		outHl = append(outHl, toProto(c.Type(), h))
 	}
 	return outHl, nil
 }
 
-func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (*static.HostCatalog, auth.VerifyResults) {
+// hostWriter returns the catalog of the host catalog or host id if users
+// can change the hosts of its catalogs.
+func (s Service) hostWriter(id string) (host.HostWriter, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", id)
+	}
+	w, ok := c.(host.HostWriter)
+	if !ok {
+		return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", map[string]string{"id": hostsReadOnly})
+	}
+	return w, nil
+}
+
+// parentAndAuthResult returns the id of the host catalog the host id belongs
+// to, or id itself for the actions on a catalog's hosts, along with the auth
+// results for the action.
+func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (string, auth.VerifyResults) {
 	res := auth.VerifyResults{}
-	repo, err := s.staticRepoFn()
-	if err != nil {
-		res.Error = err
-		return nil, res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.Host), auth.WithAction(a)}
@@ -273,56 +271,99 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 	case action.List, action.Create:
 		parentId = id
 	default:
-		h, err := repo.LookupHost(ctx, id)
+		catalogId, err := s.lookupHostCatalogId(ctx, id)
 		if err != nil {
 			res.Error = err
-			return nil, res
+			return "", res
 		}
-		if h == nil {
+		if catalogId == "" {
 			res.Error = handlers.NotFoundError()
-			return nil, res
+			return "", res
 		}
-		parentId = h.GetCatalogId()
+		parentId = catalogId
 		opts = append(opts, auth.WithId(id))
 	}
 
-	cat, err := repo.LookupCatalog(ctx, parentId)
+	scopeId, err := s.lookupCatalogScopeId(ctx, parentId)
 	if err != nil {
 		res.Error = err
-		return nil, res
+		return "", res
 	}
-	if cat == nil {
+	if scopeId == "" {
 		res.Error = handlers.NotFoundError()
-		return nil, res
+		return "", res
 	}
-	opts = append(opts, auth.WithScopeId(cat.GetScopeId()), auth.WithPin(parentId))
-	return cat, auth.Verify(ctx, opts...)
+	opts = append(opts, auth.WithScopeId(scopeId), auth.WithPin(parentId))
+	return parentId, auth.Verify(ctx, opts...)
 }
 
-func toProto(in *static.Host, members []*static.HostSet) (*pb.Host, error) {
+// lookupHostCatalogId returns the catalog id of the host id, or an empty
+// string if the host doesn't exist.
+func (s Service) lookupHostCatalogId(ctx context.Context, id string) (string, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return "", nil
+	}
+	h, err := c.LookupHost(ctx, id)
+	if err != nil || h == nil {
+		return "", err
+	}
+	return h.CatalogId, nil
+}
+
+// lookupCatalogScopeId returns the scope id of the host catalog id, or an
+// empty string if the catalog doesn't exist.
+func (s Service) lookupCatalogScopeId(ctx context.Context, id string) (string, error) {
+	c, ok := s.hostCatalogs.CatalogForId(id)
+	if !ok {
+		return "", nil
+	}
+	cat, err := c.LookupCatalog(ctx, id)
+	if err != nil || cat == nil {
+		return "", err
+	}
+	return cat.ScopeId, nil
+}
+
+func toProto(typ string, in *host.Host) *pb.Host {
 	out := pb.Host{
-		Id:            in.GetPublicId(),
-		HostCatalogId: in.GetCatalogId(),
-		Type:          host.StaticSubtype.String(),
-		CreatedTime:   in.GetCreateTime().GetTimestamp(),
-		UpdatedTime:   in.GetUpdateTime().GetTimestamp(),
-		Version:       in.GetVersion(),
+		Id:            in.PublicId,
+		HostCatalogId: in.CatalogId,
+		Type:          typ,
+		CreatedTime:   in.CreateTime,
+		UpdatedTime:   in.UpdateTime,
+		Version:       in.Version,
+		Attributes:    in.Attributes,
 	}
-	if in.GetDescription() != "" {
-		out.Description = wrapperspb.String(in.GetDescription())
+	if in.Description != "" {
+		out.Description = wrapperspb.String(in.Description)
 	}
-	if in.GetName() != "" {
-		out.Name = wrapperspb.String(in.GetName())
+	if in.Name != "" {
+		out.Name = wrapperspb.String(in.Name)
 	}
-	for _, m := range members {
-		out.HostSetIds = append(out.HostSetIds, m.GetPublicId())
+	return &out
+}
+
+// fromProto returns the host id in the host catalog catalogId with the
+// fields of item.
+func fromProto(catalogId, id string, item *pb.Host) *host.Host {
+	return &host.Host{
+		PublicId:    id,
+		CatalogId:   catalogId,
+		Name:        item.GetName().GetValue(),
+		Description: item.GetDescription().GetValue(),
+		Attributes:  item.GetAttributes(),
 	}
-	st, err := handlers.ProtoToStruct(&pb.StaticHostAttributes{Address: wrapperspb.String(in.GetAddress())})
-	if err != nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert static attribute to struct: %s", err)
+}
+
+// idActions returns the set of actions that can be performed on the host id.
+func (s Service) idActions(id string) action.ActionSet {
+	if c, ok := s.hostCatalogs.CatalogForId(id); ok {
+		if _, ok := c.(host.HostWriter); !ok {
+			return DiscoveredIdActions
+		}
 	}
-	out.Attributes = st
-	return &out, nil
+	return IdActions
 }
 
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
@@ -332,28 +373,34 @@ func toProto(in *static.Host, members []*static.HostSet) (*pb.Host, error) {
 //  * There are no conflicting parameters provided
 //  * The type asserted by the ID and/or field is known
 //  * If relevant, the type derived from the id prefix matches what is claimed by the type field
-func validateGetRequest(req *pbs.GetHostRequest) error {
-	return handlers.ValidateGetRequest(static.HostPrefix, req, func() map[string]string {
+func validateGetRequest(req *pbs.GetHostRequest, catalogs *host.Registry) error {
+	return handlers.ValidateGetRequest(hostPrefix(catalogs, req.GetId()), req, func() map[string]string {
 		badFields := map[string]string{}
-		ct := host.SubtypeFromId(req.GetId())
-		if ct == host.UnknownSubtype {
+		if _, ok := catalogs.CatalogForId(req.GetId()); !ok {
 			badFields["id"] = "Improperly formatted identifier used."
 		}
 		return badFields
 	})
 }
 
-func validateCreateRequest(req *pbs.CreateHostRequest) error {
+func validateCreateRequest(req *pbs.CreateHostRequest, catalogs *host.Registry) error {
 	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		if !handlers.ValidId(static.HostCatalogPrefix, req.GetItem().GetHostCatalogId()) {
+		if !handlers.ValidId(hostCatalogPrefix(catalogs, req.GetItem().GetHostCatalogId()), req.GetItem().GetHostCatalogId()) {
 			badFields["host_catalog_id"] = "The field is incorrectly formatted."
 		}
-		switch host.SubtypeFromId(req.GetItem().GetHostCatalogId()) {
-		case host.StaticSubtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
-				badFields["type"] = "Doesn't match the parent resource's type."
-			}
+		c, ok := catalogs.CatalogForId(req.GetItem().GetHostCatalogId())
+		if !ok {
+			return badFields
+		}
+		if _, ok := c.(host.HostWriter); !ok {
+			badFields["host_catalog_id"] = hostsReadOnly
+			return badFields
+		}
+		if req.GetItem().GetType() != "" && !strings.EqualFold(req.GetItem().GetType(), c.Type()) {
+			badFields["type"] = "Doesn't match the parent resource's type."
+		}
+		if host.SubtypeFromId(req.GetItem().GetHostCatalogId()) == host.StaticSubtype {
 			attrs := &pb.StaticHostAttributes{}
 			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
 				badFields["attributes"] = "Attribute fields do not match the expected format."
@@ -373,49 +420,103 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 				badFields["attributes.address"] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
 		}
+		validateAttributes(c, req.GetItem().GetAttributes(), badFields)
 		return badFields
 	})
 }
 
-func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
-	return handlers.ValidateUpdateRequest(static.HostPrefix, req, req.GetItem(), func() map[string]string {
+func validateUpdateRequest(req *pbs.UpdateHostRequest, catalogs *host.Registry) error {
+	return handlers.ValidateUpdateRequest(hostPrefix(catalogs, req.GetId()), req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
-		switch host.SubtypeFromId(req.GetId()) {
-		case host.StaticSubtype:
-			if req.GetItem().GetType() != "" && req.GetItem().GetType() != host.StaticSubtype.String() {
-				badFields["type"] = "Cannot modify the resource type."
-
-				attrs := &pb.StaticHostAttributes{}
-				if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
-					badFields["attributes"] = "Attribute fields do not match the expected format."
-				}
+		c, ok := catalogs.CatalogForId(req.GetId())
+		if !ok {
+			badFields["id"] = "Improperly formatted identifier used."
+			return badFields
+		}
+		if _, ok := c.(host.HostWriter); !ok {
+			badFields["id"] = hostsReadOnly
+			return badFields
+		}
+		if req.GetItem().GetType() != "" && !strings.EqualFold(req.GetItem().GetType(), c.Type()) {
+			badFields["type"] = "Cannot modify the resource type."
+		}
+		if host.SubtypeFromId(req.GetId()) == host.StaticSubtype {
+			attrs := &pb.StaticHostAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields["attributes"] = "Attribute fields do not match the expected format."
+			}
 
-				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.address") {
-					if attrs.GetAddress() == nil ||
-						len(strings.TrimSpace(attrs.GetAddress().GetValue())) < static.MinHostAddressLength ||
-						len(strings.TrimSpace(attrs.GetAddress().GetValue())) > static.MaxHostAddressLength {
-						badFields["attributes.address"] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
-					}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.address") {
+				if attrs.GetAddress() == nil ||
+					len(strings.TrimSpace(attrs.GetAddress().GetValue())) < static.MinHostAddressLength ||
+					len(strings.TrimSpace(attrs.GetAddress().GetValue())) > static.MaxHostAddressLength {
+					badFields["attributes.address"] = fmt.Sprintf("Address length must be between %d and %d characters.", static.MinHostAddressLength, static.MaxHostAddressLength)
 				}
 			}
-		default:
-			badFields["id"] = "Improperly formatted identifier used."
 		}
+		validateAttributes(c, req.GetItem().GetAttributes(), badFields)
 		return badFields
 	})
 }
 
-func validateDeleteRequest(req *pbs.DeleteHostRequest) error {
-	return handlers.ValidateDeleteRequest(static.HostPrefix, req, handlers.NoopValidatorFn)
+// validateAttributes adds to badFields the problems the catalog c finds
+// with the attributes of a host.
+func validateAttributes(c host.Catalog, attrs *structpb.Struct, badFields map[string]string) {
+	for k, v := range c.ValidateAttributes(resource.Host, attrs) {
+		badFields[k] = v
+	}
+}
+
+func validateDeleteRequest(req *pbs.DeleteHostRequest, catalogs *host.Registry) error {
+	return handlers.ValidateDeleteRequest(hostPrefix(catalogs, req.GetId()), req, func() map[string]string {
+		badFields := map[string]string{}
+		if c, ok := catalogs.CatalogForId(req.GetId()); ok {
+			if _, ok := c.(host.HostWriter); !ok {
+				badFields["id"] = hostsReadOnly
+			}
+		}
+		return badFields
+	})
 }
 
-func validateListRequest(req *pbs.ListHostsRequest) error {
+func validateListRequest(req *pbs.ListHostsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(static.HostCatalogPrefix, req.GetHostCatalogId()) {
+	if !handlers.ValidId(hostCatalogPrefix(catalogs, req.GetHostCatalogId()), req.GetHostCatalogId()) {
 		badFields["host_catalog_id"] = "The field is incorrectly formatted."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
+
+// hostsReadOnly is the error for a request which changes a host whose host
+// catalog finds its hosts, such as a dns host catalog.
+const hostsReadOnly = "The hosts of this host catalog are found by the catalog and can't be changed."
+
+// hostPrefix returns the public id prefix of the hosts of the host catalog
+// type id belongs to.  The static prefix is returned for unknown types.
+func hostPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().Host
+	}
+	return static.HostPrefix
+}
+
+// hostCatalogPrefix returns the public id prefix of the host catalog type
+// id belongs to.  The static prefix is returned for unknown types.
+func hostCatalogPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().HostCatalog
+	}
+	return static.HostCatalogPrefix
+}
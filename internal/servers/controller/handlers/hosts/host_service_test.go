@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -43,16 +44,20 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
 	pHost := &pb.Host{
-		HostCatalogId: hc.GetPublicId(),
-		Id:            h.GetPublicId(),
-		CreatedTime:   h.CreateTime.GetTimestamp(),
-		UpdatedTime:   h.UpdateTime.GetTimestamp(),
-		Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
-		Type:          "static",
+		HostCatalogId:     hc.GetPublicId(),
+		Id:                h.GetPublicId(),
+		CreatedTime:       h.CreateTime.GetTimestamp(),
+		UpdatedTime:       h.UpdateTime.GetTimestamp(),
+		AuthorizedActions: hosts.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+		Type:              "static",
 		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 			"address": structpb.NewStringValue(h.GetAddress()),
 		}},
@@ -91,7 +96,7 @@ func TestGet(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn)
+			s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), tc.req)
@@ -119,19 +124,23 @@ func TestList(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
 	var wantHs []*pb.Host
 	for _, h := range static.TestHosts(t, conn, hc.GetPublicId(), 10) {
 		wantHs = append(wantHs, &pb.Host{
-			Id:            h.GetPublicId(),
-			HostCatalogId: h.GetCatalogId(),
-			Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
-			CreatedTime:   h.GetCreateTime().GetTimestamp(),
-			UpdatedTime:   h.GetUpdateTime().GetTimestamp(),
-			Version:       h.GetVersion(),
-			Type:          host.StaticSubtype.String(), Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			Id:                h.GetPublicId(),
+			HostCatalogId:     h.GetCatalogId(),
+			AuthorizedActions: hosts.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+			CreatedTime:       h.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       h.GetUpdateTime().GetTimestamp(),
+			Version:           h.GetVersion(),
+			Type:              host.StaticSubtype.String(), Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 				"address": structpb.NewStringValue(h.GetAddress()),
 			}},
 		})
@@ -157,7 +166,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn)
+			s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Couldn't create new host set service.")
 
 			got, gErr := s.ListHosts(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), &pbs.ListHostsRequest{HostCatalogId: tc.hostCatalogId})
@@ -182,10 +191,13 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(repoFn)
+	s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -246,10 +258,13 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(repoFn)
+	s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostRequest{
 		Id: h.GetPublicId(),
@@ -274,6 +289,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
 	defaultHcCreated, err := ptypes.Timestamp(hc.GetCreateTime().GetTimestamp())
@@ -310,6 +328,120 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a valid Host with port and alternate addresses",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"port":    structpb.NewNumberValue(2222),
+					"alternate_addresses": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+						structpb.NewStringValue("::1"),
+						structpb.NewStringValue("host.example.com"),
+					}}),
+				}},
+			}},
+			res: &pbs.CreateHostResponse{
+				Uri: fmt.Sprintf("hosts/%s_", static.HostPrefix),
+				Item: &pb.Host{
+					HostCatalogId: hc.GetPublicId(),
+					Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Type:          "static",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"address": structpb.NewStringValue("10.0.0.1"),
+						"port":    structpb.NewNumberValue(2222),
+						"alternate_addresses": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+							structpb.NewStringValue("::1"),
+							structpb.NewStringValue("host.example.com"),
+						}}),
+					}},
+				},
+			},
+		},
+		{
+			name: "Create a valid Host with attributes",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"attributes": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"env":  structpb.NewStringValue("prod"),
+						"role": structpb.NewStringValue("db"),
+					}}),
+				}},
+			}},
+			res: &pbs.CreateHostResponse{
+				Uri: fmt.Sprintf("hosts/%s_", static.HostPrefix),
+				Item: &pb.Host{
+					HostCatalogId: hc.GetPublicId(),
+					Scope:         &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Type:          "static",
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"address": structpb.NewStringValue("10.0.0.1"),
+						"attributes": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+							"env":  structpb.NewStringValue("prod"),
+							"role": structpb.NewStringValue("db"),
+						}}),
+					}},
+				},
+			},
+		},
+		{
+			name: "Create with empty attribute key",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"attributes": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						" ": structpb.NewStringValue("prod"),
+					}}),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with invalid port",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"port":    structpb.NewNumberValue(0),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with alternate address with a port",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"alternate_addresses": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+						structpb.NewStringValue("10.0.0.2:22"),
+					}}),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with repeated alternate address",
+			req: &pbs.CreateHostRequest{Item: &pb.Host{
+				HostCatalogId: hc.GetPublicId(),
+				Type:          "static",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"address": structpb.NewStringValue("10.0.0.1"),
+					"alternate_addresses": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+						structpb.NewStringValue("10.0.0.1"),
+					}}),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with empty address",
 			req: &pbs.CreateHostRequest{Item: &pb.Host{
@@ -398,7 +530,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn)
+			s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHost(auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId())), tc.req)
@@ -442,6 +574,9 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -467,7 +602,7 @@ func TestUpdate(t *testing.T) {
 		Id: h.GetPublicId(),
 	}
 
-	tested, err := hosts.NewService(repoFn)
+	tested, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -721,6 +856,21 @@ func TestUpdate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Cant set port out of range",
+			req: &pbs.UpdateHostRequest{
+				Id: hc.GetPublicId(),
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"attributes.port"},
+				},
+				Item: &pb.Host{
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"port": structpb.NewNumberValue(70000),
+					}},
+				}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Cant set address to empty string",
 			req: &pbs.UpdateHostRequest{
@@ -821,3 +971,67 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestDnsHosts(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	dnsRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms, dns.WithResolver(dns.NewResolver(0)))
+	}
+	srv := dns.TestDnsServer(t)
+	srv.SetHost("web.example.test", "10.0.0.1")
+
+	hc := dns.TestCatalogs(t, conn, proj.GetPublicId(), 1, dns.WithResolverAddress(srv.Addr))[0]
+	hs := dns.TestSets(t, conn, hc.GetPublicId(), 1, "web.example.test")[0]
+	dnsRepo, err := dnsRepoFn()
+	require.NoError(err)
+	resolved, err := dnsRepo.ResolveSet(ctx, hs.GetPublicId())
+	require.NoError(err)
+	require.Len(resolved, 1)
+	h := resolved[0]
+
+	s, err := hosts.NewService(host.TestRegistry(t, repoFn, dnsRepoFn))
+	require.NoError(err, "Couldn't create a new host service.")
+	authCtx := auth.DisabledAuthTestContext(auth.WithScopeId(proj.GetPublicId()))
+
+	want := &pb.Host{
+		HostCatalogId:     hc.GetPublicId(),
+		Id:                h.GetPublicId(),
+		CreatedTime:       h.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       h.GetUpdateTime().GetTimestamp(),
+		AuthorizedActions: hosts.DiscoveredIdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+		Type:              "dns",
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"address": structpb.NewStringValue("10.0.0.1"),
+		}},
+	}
+	got, err := s.GetHost(authCtx, &pbs.GetHostRequest{Id: h.GetPublicId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(got.GetItem(), want, protocmp.Transform()))
+
+	list, err := s.ListHosts(authCtx, &pbs.ListHostsRequest{HostCatalogId: hc.GetPublicId()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(list.GetItems(), []*pb.Host{want}, protocmp.Transform()))
+
+	_, err = s.DeleteHost(authCtx, &pbs.DeleteHostRequest{Id: h.GetPublicId()})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "dns hosts can't be deleted")
+
+	_, err = s.CreateHost(authCtx, &pbs.CreateHostRequest{Item: &pb.Host{
+		HostCatalogId: hc.GetPublicId(),
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"address": structpb.NewStringValue("10.0.0.2"),
+		}},
+	}})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "dns hosts can't be created")
+}
@@ -0,0 +1,258 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/jobs"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// Job names are lowercase words separated by underscores
+var reValidJobName = regexp.MustCompile("^[a-z0-9]+(_[a-z0-9]+)*$")
+
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Run,
+}
+
+// Service handles request as described by the pbs.JobServiceServer interface.
+type Service struct {
+	repoFn common.SchedulerRepoFactory
+}
+
+// NewService returns a job service which handles job related requests to boundary.
+func NewService(repoFn common.SchedulerRepoFactory) (Service, error) {
+	if repoFn == nil {
+		return Service{}, fmt.Errorf("nil scheduler repository provided")
+	}
+	return Service{repoFn: repoFn}, nil
+}
+
+var _ pbs.JobServiceServer = Service{}
+
+// GetJob implements the interface pbs.JobServiceServer.
+func (s Service) GetJob(ctx context.Context, req *pbs.GetJobRequest) (*pbs.GetJobResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	j, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	j.Scope = authResults.Scope
+	j.AuthorizedActions = authResults.FetchActionSetForId(j.Id, IdActions).Strings()
+	return &pbs.GetJobResponse{Item: j}, nil
+}
+
+// ListJobs implements the interface pbs.JobServiceServer.
+func (s Service) ListJobs(ctx context.Context, req *pbs.ListJobsRequest) (*pbs.ListJobsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.Job
+	for pager.More() {
+		jl, err := s.listFromRepo(ctx, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range jl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			item.Scope = authResults.Scope
+			item.AuthorizedActions = authResults.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(jl))
+	}
+	return &pbs.ListJobsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
+}
+
+// RunJob implements the interface pbs.JobServiceServer.
+func (s Service) RunJob(ctx context.Context, req *pbs.RunJobRequest) (*pbs.RunJobResponse, error) {
+	if err := validateRunRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Run)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	j, err := s.runInRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	j.Scope = authResults.Scope
+	return &pbs.RunJobResponse{Item: j}, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, name string) (*pb.Job, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	j, err := repo.LookupJob(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if j == nil {
+		return nil, handlers.NotFoundErrorf("Job %q doesn't exist.", name)
+	}
+	return toProto(j), nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, cursor *db.PageCursor, limit int) ([]*pb.Job, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	jl, err := repo.ListJobs(ctx, scheduler.WithStartPageAfter(cursor), scheduler.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	var outJl []*pb.Job
+	for _, j := range jl {
+		outJl = append(outJl, toProto(j))
+	}
+	return outJl, nil
+}
+
+func (s Service) runInRepo(ctx context.Context, name string) (*pb.Job, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	j, err := repo.RunJobNow(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule job: %w", err)
+	}
+	if j == nil {
+		return nil, handlers.NotFoundErrorf("Job %q doesn't exist.", name)
+	}
+	return toProto(j), nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	// Jobs are not owned by any scope other than global
+	opts := []auth.Option{auth.WithType(resource.Job), auth.WithAction(a), auth.WithScopeId(scope.Global.String())}
+	switch a {
+	case action.List:
+	case action.Read, action.Run:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		j, err := repo.LookupJob(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if j == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithId(id))
+	default:
+		res.Error = errors.New("unsupported action")
+		return res
+	}
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(in *scheduler.Job) *pb.Job {
+	return &pb.Job{
+		Id:                  in.Name,
+		Description:         in.Description,
+		NextScheduledRun:    in.NextScheduledRun.GetTimestamp(),
+		LastRunStart:        in.LastRunStart.GetTimestamp(),
+		LastRunEnd:          in.LastRunEnd.GetTimestamp(),
+		LastRunStatus:       in.LastRunStatus,
+		LastRunError:        in.LastRunError,
+		LastRunControllerId: in.LastRunControllerId,
+		Running:             in.Running(),
+		CreatedTime:         in.CreateTime.GetTimestamp(),
+		UpdatedTime:         in.UpdateTime.GetTimestamp(),
+	}
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetJobRequest) error {
+	return validateJobName(req.GetId())
+}
+
+func validateListRequest(req *pbs.ListJobsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() {
+		badFields["scope_id"] = "Jobs only exist in the global scope."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateRunRequest(req *pbs.RunJobRequest) error {
+	return validateJobName(req.GetId())
+}
+
+func validateJobName(name string) error {
+	badFields := map[string]string{}
+	if !reValidJobName.MatchString(name) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/jobs"
+	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/jobs"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func testService(t *testing.T) (jobs.Service, *scheduler.Repository) {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	repo, err := scheduler.NewRepository(rw, rw)
+	require.NoError(t, err)
+	s, err := jobs.NewService(func() (*scheduler.Repository, error) {
+		return repo, nil
+	})
+	require.NoError(t, err)
+	return s, repo
+}
+
+func toWire(j *scheduler.Job) *pb.Job {
+	return &pb.Job{
+		Id:               j.Name,
+		Scope:            &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String()},
+		Description:      j.Description,
+		NextScheduledRun: j.NextScheduledRun.GetTimestamp(),
+		CreatedTime:      j.CreateTime.GetTimestamp(),
+		UpdatedTime:      j.UpdateTime.GetTimestamp(),
+	}
+}
+
+func TestGet(t *testing.T) {
+	s, repo := testService(t)
+	j, err := repo.RegisterJob(context.Background(), "test_job", "A test job", time.Minute)
+	require.NoError(t, err)
+	wantJob := toWire(j)
+	wantJob.AuthorizedActions = jobs.IdActions.Strings()
+
+	cases := []struct {
+		name string
+		req  *pbs.GetJobRequest
+		res  *pbs.GetJobResponse
+		err  error
+	}{
+		{
+			name: "Get a job",
+			req:  &pbs.GetJobRequest{Id: "test_job"},
+			res:  &pbs.GetJobResponse{Item: wantJob},
+		},
+		{
+			name: "Get a non existant job",
+			req:  &pbs.GetJobRequest{Id: "unknown_job"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Invalid name",
+			req:  &pbs.GetJobRequest{Id: "Test Job"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetJob(auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String())), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetJob(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetJob(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	s, repo := testService(t)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String()))
+
+	got, err := s.ListJobs(ctx, &pbs.ListJobsRequest{ScopeId: scope.Global.String()})
+	require.NoError(err)
+	assert.Empty(got.GetItems())
+
+	var want []*pb.Job
+	for _, name := range []string{"job_a", "job_b"} {
+		j, err := repo.RegisterJob(context.Background(), name, name, time.Minute)
+		require.NoError(err)
+		w := toWire(j)
+		w.AuthorizedActions = jobs.IdActions.Strings()
+		want = append(want, w)
+	}
+	got, err = s.ListJobs(ctx, &pbs.ListJobsRequest{ScopeId: scope.Global.String()})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(got, &pbs.ListJobsResponse{Items: want}, protocmp.Transform()))
+
+	_, err = s.ListJobs(ctx, &pbs.ListJobsRequest{ScopeId: "o_1234567890"})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+}
+
+func TestRun(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	s, repo := testService(t)
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String()))
+
+	_, err := repo.RegisterJob(context.Background(), "test_job", "A test job", time.Minute)
+	require.NoError(err)
+	_, err = repo.AcquireLease(context.Background(), "test_job", "controller", time.Minute)
+	require.NoError(err)
+	_, err = repo.CompleteRun(context.Background(), "test_job", "controller", time.Hour, nil)
+	require.NoError(err)
+
+	got, err := s.RunJob(ctx, &pbs.RunJobRequest{Id: "test_job"})
+	require.NoError(err)
+	assert.Equal("test_job", got.GetItem().GetId())
+	assert.Equal(scheduler.RunSucceeded.String(), got.GetItem().GetLastRunStatus())
+	assert.False(got.GetItem().GetNextScheduledRun().AsTime().After(time.Now()))
+
+	_, err = s.RunJob(ctx, &pbs.RunJobRequest{Id: "unknown_job"})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+}
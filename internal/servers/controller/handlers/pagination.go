@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxPageSize is the largest number of items returned in a page of a list.  It
+// is also the size of the page when a list request doesn't specify one.
+const MaxPageSize = 1000
+
+// PageItem is an item of a list which can be paged through.  Lists are ordered
+// by the items' created time and then by their id.
+type PageItem interface {
+	GetId() string
+	GetCreatedTime() *timestamppb.Timestamp
+}
+
+// pageToken is the content of the opaque token which is returned with a page of
+// a list and is used to request the next page.
+type pageToken struct {
+	CreateTime time.Time `json:"t"`
+	Id         string    `json:"id"`
+}
+
+// Pager collects the items of a page of a list.  The items are fetched from
+// the repository in batches, each one starting after the last item fetched,
+// until either the page is full or the list is exhausted.  Since not every
+// item fetched ends up in the page, for instance because it doesn't match the
+// list's filter, the page token returned with the page refers to the last
+// item fetched rather than the last item added.
+type Pager struct {
+	size   int
+	count  int
+	done   bool
+	cursor *db.PageCursor
+}
+
+// NewPager returns a Pager for a page of the provided size which starts after
+// the item referred to by the token.  A size of 0 results in a page of
+// MaxPageSize items and an empty token in the first page of the list.
+func NewPager(size uint32, token string) (*Pager, error) {
+	if size > MaxPageSize {
+		return nil, fmt.Errorf("page size must not be greater than %d", MaxPageSize)
+	}
+	if size == 0 {
+		size = MaxPageSize
+	}
+	p := &Pager{size: int(size)}
+	if token == "" {
+		return p, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't decode page token: %w", err)
+	}
+	var t pageToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, fmt.Errorf("couldn't decode page token: %w", err)
+	}
+	if t.Id == "" {
+		return nil, fmt.Errorf("page token is missing an id")
+	}
+	p.cursor = &db.PageCursor{CreateTime: t.CreateTime, PublicId: t.Id}
+	return p, nil
+}
+
+// More returns whether more items are needed to fill the page and the list may
+// contain them.
+func (p *Pager) More() bool {
+	return !p.done && p.count < p.size
+}
+
+// Cursor returns the cursor the next batch of items should start after.  It is
+// nil when starting with the first item of the list.
+func (p *Pager) Cursor() *db.PageCursor {
+	return p.cursor
+}
+
+// Limit returns the number of items to fetch in a batch.
+func (p *Pager) Limit() int {
+	return p.size
+}
+
+// Fetched advances the cursor past the item.  It must be called, in order, for
+// each item of a batch that is considered for the page.
+func (p *Pager) Fetched(item PageItem) {
+	p.cursor = &db.PageCursor{
+		CreateTime: item.GetCreatedTime().AsTime(),
+		PublicId:   item.GetId(),
+	}
+}
+
+// Added records that an item was added to the page.
+func (p *Pager) Added() {
+	p.count++
+}
+
+// BatchDone records that a batch of n items was fetched.  A batch which is
+// shorter than the limit means the end of the list was reached.
+func (p *Pager) BatchDone(n int) {
+	if n < p.Limit() {
+		p.done = true
+	}
+}
+
+// NextPageToken returns the token to request the page after this one.  It is
+// empty when the end of the list was reached before the page was full.
+func (p *Pager) NextPageToken() string {
+	if p.count < p.size || p.cursor == nil {
+		return ""
+	}
+	b, err := json.Marshal(pageToken{CreateTime: p.cursor.CreateTime, Id: p.cursor.PublicId})
+	if err != nil {
+		// The token only contains a time and a string so this can't happen.
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
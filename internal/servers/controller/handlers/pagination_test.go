@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewPager(t *testing.T) {
+	p, err := NewPager(0, "")
+	require.NoError(t, err)
+	assert.Equal(t, MaxPageSize, p.Limit())
+	assert.Nil(t, p.Cursor())
+
+	_, err = NewPager(MaxPageSize+1, "")
+	assert.Error(t, err)
+
+	_, err = NewPager(10, "not a token")
+	assert.Error(t, err)
+
+	_, err = NewPager(10, "e30")
+	assert.Error(t, err, "a token without an id is invalid")
+}
+
+func TestPager(t *testing.T) {
+	start := time.Now().Truncate(time.Second).UTC()
+	var items []*pb.User
+	for i := 0; i < 7; i++ {
+		items = append(items, &pb.User{
+			Id:          fmt.Sprintf("u_%010d", i),
+			CreatedTime: timestamppb.New(start.Add(time.Duration(i) * time.Second)),
+		})
+	}
+	// list mimics a repository returning a batch of items after the cursor.
+	list := func(p *Pager) []*pb.User {
+		var out []*pb.User
+		for _, item := range items {
+			if c := p.Cursor(); c != nil && item.Id <= c.PublicId {
+				continue
+			}
+			if len(out) == p.Limit() {
+				break
+			}
+			out = append(out, item)
+		}
+		return out
+	}
+	// page collects a page skipping the items with an odd id, as a filter would.
+	page := func(size uint32, token string) ([]string, string) {
+		p, err := NewPager(size, token)
+		require.NoError(t, err)
+		var ids []string
+		for p.More() {
+			batch := list(p)
+			for _, item := range batch {
+				if !p.More() {
+					break
+				}
+				p.Fetched(item)
+				if item.Id[len(item.Id)-1]%2 == 1 {
+					continue
+				}
+				ids = append(ids, item.Id)
+				p.Added()
+			}
+			p.BatchDone(len(batch))
+		}
+		return ids, p.NextPageToken()
+	}
+
+	ids, token := page(2, "")
+	assert.Equal(t, []string{"u_0000000000", "u_0000000002"}, ids)
+	require.NotEmpty(t, token)
+
+	ids, token = page(2, token)
+	assert.Equal(t, []string{"u_0000000004", "u_0000000006"}, ids)
+	require.NotEmpty(t, token)
+
+	ids, token = page(2, token)
+	assert.Empty(t, ids)
+	assert.Empty(t, token)
+
+	ids, token = page(0, "")
+	assert.Equal(t, []string{"u_0000000000", "u_0000000002", "u_0000000004", "u_0000000006"}, ids)
+	assert.Empty(t, token)
+}
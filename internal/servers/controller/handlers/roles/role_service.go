@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -33,6 +34,20 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.AddPrincipals,
+	action.SetPrincipals,
+	action.RemovePrincipals,
+	action.AddGrants,
+	action.SetGrants,
+	action.RemoveGrants,
+}
+
 // Service handles request as described by the pbs.RoleServiceServer interface.
 type Service struct {
 	repoFn common.IamRepoFactory
@@ -54,17 +69,46 @@ func (s Service) ListRoles(ctx context.Context, req *pbs.ListRolesRequest) (*pbs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.repoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
-	gl, err := s.listFromRepo(ctx, req.GetScopeId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range gl {
-		item.Scope = authResults.Scope
+	var finalItems []*pb.Role
+	for pager.More() {
+		gl, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range gl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(gl))
 	}
-	return &pbs.ListRolesResponse{Items: gl}, nil
+	return &pbs.ListRolesResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetRoles implements the interface pbs.RoleServiceServer.
@@ -81,6 +125,7 @@ func (s Service) GetRole(ctx context.Context, req *pbs.GetRoleRequest) (*pbs.Get
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetRoleResponse{Item: u}, nil
 }
 
@@ -334,12 +379,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Role, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.Role, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	rl, err := repo.ListRoles(ctx, scopeId)
+	rl, err := repo.ListRoles(ctx, scopeIds, iam.WithStartPageAfter(cursor), iam.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -557,6 +602,7 @@ func toProto(in *iam.Role, principals []iam.PrincipalRole, grants []*iam.RoleGra
 					Id:      parsed.Id(),
 					Type:    parsed.Type().String(),
 					Actions: actions,
+					Deny:    parsed.Deny(),
 				},
 			})
 		}
@@ -637,6 +683,15 @@ func validateListRequest(req *pbs.ListRolesRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Improperly formatted field."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
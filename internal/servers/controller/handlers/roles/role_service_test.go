@@ -77,27 +77,29 @@ func TestGet(t *testing.T) {
 	}
 
 	wantOrgRole := &pb.Role{
-		Id:           or.GetPublicId(),
-		ScopeId:      or.GetScopeId(),
-		Scope:        &scopes.ScopeInfo{Id: or.GetScopeId(), Type: scope.Org.String()},
-		Name:         &wrapperspb.StringValue{Value: or.GetName()},
-		Description:  &wrapperspb.StringValue{Value: or.GetDescription()},
-		GrantScopeId: &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
-		CreatedTime:  or.CreateTime.GetTimestamp(),
-		UpdatedTime:  or.UpdateTime.GetTimestamp(),
-		Version:      or.GetVersion(),
+		Id:                or.GetPublicId(),
+		ScopeId:           or.GetScopeId(),
+		AuthorizedActions: roles.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: or.GetScopeId(), Type: scope.Org.String()},
+		Name:              &wrapperspb.StringValue{Value: or.GetName()},
+		Description:       &wrapperspb.StringValue{Value: or.GetDescription()},
+		GrantScopeId:      &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
+		CreatedTime:       or.CreateTime.GetTimestamp(),
+		UpdatedTime:       or.UpdateTime.GetTimestamp(),
+		Version:           or.GetVersion(),
 	}
 
 	wantProjRole := &pb.Role{
-		Id:           pr.GetPublicId(),
-		ScopeId:      pr.GetScopeId(),
-		Scope:        &scopes.ScopeInfo{Id: pr.GetScopeId(), Type: scope.Project.String()},
-		Name:         &wrapperspb.StringValue{Value: pr.GetName()},
-		Description:  &wrapperspb.StringValue{Value: pr.GetDescription()},
-		GrantScopeId: &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
-		CreatedTime:  pr.CreateTime.GetTimestamp(),
-		UpdatedTime:  pr.UpdateTime.GetTimestamp(),
-		Version:      pr.GetVersion(),
+		Id:                pr.GetPublicId(),
+		ScopeId:           pr.GetScopeId(),
+		AuthorizedActions: roles.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: pr.GetScopeId(), Type: scope.Project.String()},
+		Name:              &wrapperspb.StringValue{Value: pr.GetName()},
+		Description:       &wrapperspb.StringValue{Value: pr.GetDescription()},
+		GrantScopeId:      &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
+		CreatedTime:       pr.CreateTime.GetTimestamp(),
+		UpdatedTime:       pr.UpdateTime.GetTimestamp(),
+		Version:           pr.GetVersion(),
 	}
 
 	cases := []struct {
@@ -192,23 +194,25 @@ func TestList(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		or := iam.TestRole(t, conn, oWithRoles.GetPublicId())
 		wantOrgRoles = append(wantOrgRoles, &pb.Role{
-			Id:           or.GetPublicId(),
-			ScopeId:      or.GetScopeId(),
-			Scope:        &scopes.ScopeInfo{Id: or.GetScopeId(), Type: scope.Org.String()},
-			CreatedTime:  or.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  or.GetUpdateTime().GetTimestamp(),
-			GrantScopeId: &wrapperspb.StringValue{Value: or.GetGrantScopeId()},
-			Version:      or.GetVersion(),
+			Id:                or.GetPublicId(),
+			ScopeId:           or.GetScopeId(),
+			AuthorizedActions: roles.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: or.GetScopeId(), Type: scope.Org.String()},
+			CreatedTime:       or.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       or.GetUpdateTime().GetTimestamp(),
+			GrantScopeId:      &wrapperspb.StringValue{Value: or.GetGrantScopeId()},
+			Version:           or.GetVersion(),
 		})
 		pr := iam.TestRole(t, conn, pWithRoles.GetPublicId())
 		wantProjRoles = append(wantProjRoles, &pb.Role{
-			Id:           pr.GetPublicId(),
-			ScopeId:      pr.GetScopeId(),
-			Scope:        &scopes.ScopeInfo{Id: pr.GetScopeId(), Type: scope.Project.String()},
-			CreatedTime:  pr.GetCreateTime().GetTimestamp(),
-			UpdatedTime:  pr.GetUpdateTime().GetTimestamp(),
-			GrantScopeId: &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
-			Version:      pr.GetVersion(),
+			Id:                pr.GetPublicId(),
+			ScopeId:           pr.GetScopeId(),
+			AuthorizedActions: roles.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: pr.GetScopeId(), Type: scope.Project.String()},
+			CreatedTime:       pr.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       pr.GetUpdateTime().GetTimestamp(),
+			GrantScopeId:      &wrapperspb.StringValue{Value: pr.GetGrantScopeId()},
+			Version:           pr.GetVersion(),
 		})
 	}
 
@@ -7,12 +7,15 @@ import (
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -32,17 +35,35 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.RotateKeys,
+	action.RewrapKeys,
+}
+
 // Service handles requests as described by the pbs.ScopeServiceServer interface.
 type Service struct {
-	repoFn common.IamRepoFactory
+	repoFn          common.IamRepoFactory
+	kms             *kms.Kms
+	schedulerRepoFn common.SchedulerRepoFactory
 }
 
 // NewService returns a project service which handles project related requests to boundary.
-func NewService(repo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.IamRepoFactory, kmsCache *kms.Kms, schedulerRepoFn common.SchedulerRepoFactory) (Service, error) {
 	if repo == nil {
 		return Service{}, fmt.Errorf("nil iam repository provided")
 	}
-	return Service{repoFn: repo}, nil
+	if kmsCache == nil {
+		return Service{}, fmt.Errorf("nil kms provided")
+	}
+	if schedulerRepoFn == nil {
+		return Service{}, fmt.Errorf("nil scheduler repository provided")
+	}
+	return Service{repoFn: repo, kms: kmsCache, schedulerRepoFn: schedulerRepoFn}, nil
 }
 
 var _ pbs.ScopeServiceServer = Service{}
@@ -56,19 +77,46 @@ func (s Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*p
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.repoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
 	}
-	pl, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-
-	for _, item := range pl {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
 	}
-
-	return &pbs.ListScopesResponse{Items: pl}, nil
+	var finalItems []*pb.Scope
+	for pager.More() {
+		pl, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range pl {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(pl))
+	}
+	return &pbs.ListScopesResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetScopes implements the interface pbs.ScopeServiceServer.
@@ -85,6 +133,7 @@ func (s Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.G
 		return nil, err
 	}
 	p.Scope = authResults.Scope
+	p.AuthorizedActions = authResults.FetchActionSetForId(p.Id, IdActions).Strings()
 	return &pbs.GetScopeResponse{Item: p}, nil
 }
 
@@ -138,6 +187,55 @@ func (s Service) DeleteScope(ctx context.Context, req *pbs.DeleteScopeRequest) (
 	return &pbs.DeleteScopeResponse{}, nil
 }
 
+// RotateKeys implements the interface pbs.ScopeServiceServer.
+func (s Service) RotateKeys(ctx context.Context, req *pbs.RotateKeysRequest) (*pbs.RotateKeysResponse, error) {
+	if err := validateRotateKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RotateKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.kms.RotateKeys(ctx, req.GetId()); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to rotate keys for scope: %v.", err)
+	}
+	p, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	p.Scope = authResults.Scope
+	return &pbs.RotateKeysResponse{Item: p}, nil
+}
+
+// RewrapKeys implements the interface pbs.ScopeServiceServer. The scope's keys
+// are rewrapped before returning; the data encrypted with them is re-encrypted
+// by a background job which is scheduled to run right away.
+func (s Service) RewrapKeys(ctx context.Context, req *pbs.RewrapKeysRequest) (*pbs.RewrapKeysResponse, error) {
+	if err := validateRewrapKeysRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RewrapKeys)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if _, err := s.kms.RewrapKeys(ctx, req.GetId()); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to rewrap keys for scope: %v.", err)
+	}
+	schedulerRepo, err := s.schedulerRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := schedulerRepo.RunJobNow(ctx, common.KmsRewrapDataJob); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to schedule rewrapping of data: %v.", err)
+	}
+	p, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	p.Scope = authResults.Scope
+	return &pbs.RewrapKeysResponse{Item: p}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -244,29 +342,24 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId string) (bool, erro
 }
 
 func SortScopes(scps []*pb.Scope) {
-	// We stable sort here even though the database may not return things in
-	// sorted order, still nice to have them as consistent as possible.
+	// Sort in the order scopes are listed in, which is by created time and then
+	// by id.
 	sort.SliceStable(scps, func(i, j int) bool {
+		ti, tj := scps[i].GetCreatedTime().AsTime(), scps[j].GetCreatedTime().AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
 		return scps[i].GetId() < scps[j].GetId()
 	})
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Scope, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
 
-	var scps []*iam.Scope
-	switch {
-	case scopeId == "global":
-		scps, err = repo.ListOrgs(ctx)
-	case strings.HasPrefix(scopeId, scope.Org.Prefix()):
-		scps, err = repo.ListProjects(ctx, scopeId)
-	default:
-		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
-			map[string]string{"scope_id": "This field must be 'global' or a valid org scope id."})
-	}
+	scps, err := repo.ListScopes(ctx, scopeIds, iam.WithStartPageAfter(cursor), iam.WithLimit(limit))
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to list scopes: %v", err)
 	}
@@ -275,7 +368,6 @@ func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Scope,
 	for _, scp := range scps {
 		outPl = append(outPl, ToProto(scp))
 	}
-	SortScopes(outPl)
 	return outPl, nil
 }
 
@@ -476,11 +568,49 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateRotateKeysRequest(req *pbs.RotateKeysRequest) error {
+	return validateKeysScopeId(req.GetId())
+}
+
+func validateRewrapKeysRequest(req *pbs.RewrapKeysRequest) error {
+	return validateKeysScopeId(req.GetId())
+}
+
+func validateKeysScopeId(id string) error {
+	badFields := map[string]string{}
+	switch {
+	case id == "global":
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		if !handlers.ValidId(scope.Org.Prefix(), id) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		if !handlers.ValidId(scope.Project.Prefix(), id) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	default:
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(scope.Org.Prefix(), req.GetScopeId()) {
 		badFields["scope_id"] = "Must be 'global' or a valid org scope id when listing."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -15,9 +16,14 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/jinzhu/gorm"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -28,7 +34,20 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error)) {
+func testKmsAndScheduler(t *testing.T, conn *gorm.DB, wrap wrapping.Wrapper) (*kms.Kms, func() (*scheduler.Repository, error)) {
+	t.Helper()
+	kmsCache := kms.TestKms(t, conn, wrap)
+	rw := db.New(conn)
+	schedulerRepo, err := scheduler.NewRepository(rw, rw)
+	require.NoError(t, err)
+	_, err = schedulerRepo.RegisterJob(context.Background(), common.KmsRewrapDataJob, "rewrap data", time.Hour)
+	require.NoError(t, err)
+	return kmsCache, func() (*scheduler.Repository, error) {
+		return schedulerRepo, nil
+	}
+}
+
+func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error), *kms.Kms, func() (*scheduler.Repository, error)) {
 	t.Helper()
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -36,6 +55,7 @@ func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	kmsCache, schedulerRepoFn := testKmsAndScheduler(t, conn, wrap)
 
 	oRes, pRes := iam.TestScopes(t, iamRepo)
 
@@ -52,37 +72,39 @@ func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*
 	require.NoError(t, err)
 	pRes, _, err = repo.UpdateScope(context.Background(), pRes, 1, []string{"Name", "Description"})
 	require.NoError(t, err)
-	return oRes, pRes, repoFn
+	return oRes, pRes, repoFn, kmsCache, schedulerRepoFn
 }
 
 func TestGet(t *testing.T) {
-	org, proj, repo := createDefaultScopesAndRepo(t)
+	org, proj, repo, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
 	toMerge := &pbs.GetScopeRequest{
 		Id: proj.GetPublicId(),
 	}
 
 	oScope := &pb.Scope{
-		Id:          org.GetPublicId(),
-		ScopeId:     org.GetParentId(),
-		Scope:       &pb.ScopeInfo{Id: "global", Type: scope.Global.String()},
-		Name:        &wrapperspb.StringValue{Value: org.GetName()},
-		Description: &wrapperspb.StringValue{Value: org.GetDescription()},
-		CreatedTime: org.CreateTime.GetTimestamp(),
-		UpdatedTime: org.UpdateTime.GetTimestamp(),
-		Version:     2,
-		Type:        scope.Org.String(),
+		Id:                org.GetPublicId(),
+		ScopeId:           org.GetParentId(),
+		AuthorizedActions: scopes.IdActions.Strings(),
+		Scope:             &pb.ScopeInfo{Id: "global", Type: scope.Global.String()},
+		Name:              &wrapperspb.StringValue{Value: org.GetName()},
+		Description:       &wrapperspb.StringValue{Value: org.GetDescription()},
+		CreatedTime:       org.CreateTime.GetTimestamp(),
+		UpdatedTime:       org.UpdateTime.GetTimestamp(),
+		Version:           2,
+		Type:              scope.Org.String(),
 	}
 
 	pScope := &pb.Scope{
-		Id:          proj.GetPublicId(),
-		ScopeId:     proj.GetParentId(),
-		Scope:       &pb.ScopeInfo{Id: oScope.Id, Type: scope.Org.String()},
-		Name:        &wrapperspb.StringValue{Value: proj.GetName()},
-		Description: &wrapperspb.StringValue{Value: proj.GetDescription()},
-		CreatedTime: proj.CreateTime.GetTimestamp(),
-		UpdatedTime: proj.UpdateTime.GetTimestamp(),
-		Version:     2,
-		Type:        scope.Project.String(),
+		Id:                proj.GetPublicId(),
+		ScopeId:           proj.GetParentId(),
+		AuthorizedActions: scopes.IdActions.Strings(),
+		Scope:             &pb.ScopeInfo{Id: oScope.Id, Type: scope.Org.String()},
+		Name:              &wrapperspb.StringValue{Value: proj.GetName()},
+		Description:       &wrapperspb.StringValue{Value: proj.GetDescription()},
+		CreatedTime:       proj.CreateTime.GetTimestamp(),
+		UpdatedTime:       proj.UpdateTime.GetTimestamp(),
+		Version:           2,
+		Type:              scope.Project.String(),
 	}
 
 	cases := []struct {
@@ -140,7 +162,7 @@ func TestGet(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetScopeRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := scopes.NewService(repo)
+			s, err := scopes.NewService(repo, kmsCache, schedulerRepoFn)
 			require.NoError(err, "Couldn't create new project service.")
 
 			got, gErr := s.GetScope(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), req)
@@ -160,6 +182,7 @@ func TestList(t *testing.T) {
 	repoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
+	kmsCache, schedulerRepoFn := testKmsAndScheduler(t, conn, wrap)
 	repo, err := repoFn()
 	require.NoError(t, err)
 
@@ -208,7 +231,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache, schedulerRepoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			got, gErr := s.ListScopes(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
@@ -227,13 +250,14 @@ func TestList(t *testing.T) {
 		o, err := repo.CreateScope(context.Background(), newO, "")
 		require.NoError(t, err)
 		wantOrgs = append(wantOrgs, &pb.Scope{
-			Id:          o.GetPublicId(),
-			ScopeId:     globalScope.GetId(),
-			Scope:       globalScope,
-			CreatedTime: o.GetCreateTime().GetTimestamp(),
-			UpdatedTime: o.GetUpdateTime().GetTimestamp(),
-			Version:     1,
-			Type:        scope.Org.String(),
+			Id:                o.GetPublicId(),
+			ScopeId:           globalScope.GetId(),
+			AuthorizedActions: scopes.IdActions.Strings(),
+			Scope:             globalScope,
+			CreatedTime:       o.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       o.GetUpdateTime().GetTimestamp(),
+			Version:           1,
+			Type:              scope.Org.String(),
 		})
 	}
 	wantOrgs = append(wantOrgs, initialOrgs...)
@@ -246,13 +270,14 @@ func TestList(t *testing.T) {
 		p, err := repo.CreateScope(context.Background(), newP, "")
 		require.NoError(t, err)
 		wantProjects = append(wantProjects, &pb.Scope{
-			Id:          p.GetPublicId(),
-			ScopeId:     oWithProjects.GetPublicId(),
-			Scope:       &pb.ScopeInfo{Id: oWithProjects.GetPublicId(), Type: scope.Org.String()},
-			CreatedTime: p.GetCreateTime().GetTimestamp(),
-			UpdatedTime: p.GetUpdateTime().GetTimestamp(),
-			Version:     1,
-			Type:        scope.Project.String(),
+			Id:                p.GetPublicId(),
+			ScopeId:           oWithProjects.GetPublicId(),
+			AuthorizedActions: scopes.IdActions.Strings(),
+			Scope:             &pb.ScopeInfo{Id: oWithProjects.GetPublicId(), Type: scope.Org.String()},
+			CreatedTime:       p.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       p.GetUpdateTime().GetTimestamp(),
+			Version:           1,
+			Type:              scope.Project.String(),
 		})
 	}
 	scopes.SortScopes(wantProjects)
@@ -280,7 +305,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := scopes.NewService(repoFn)
+			s, err := scopes.NewService(repoFn, kmsCache, schedulerRepoFn)
 			require.NoError(err, "Couldn't create new role service.")
 
 			got, gErr := s.ListScopes(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
@@ -294,9 +319,9 @@ func TestList(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
-	org, proj, repo := createDefaultScopesAndRepo(t)
+	org, proj, repo, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repo)
+	s, err := scopes.NewService(repo, kmsCache, schedulerRepoFn)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	cases := []struct {
@@ -370,9 +395,9 @@ func TestDelete(t *testing.T) {
 
 func TestDelete_twice(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	org, proj, repo := createDefaultScopesAndRepo(t)
+	org, proj, repo, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
 
-	s, err := scopes.NewService(repo)
+	s, err := scopes.NewService(repo, kmsCache, schedulerRepoFn)
 	require.NoError(err, "Error when getting new scopes service")
 	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(org.GetPublicId()))
 	req := &pbs.DeleteScopeRequest{
@@ -397,7 +422,7 @@ func TestDelete_twice(t *testing.T) {
 
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
-	defaultOrg, defaultProj, repoFn := createDefaultScopesAndRepo(t)
+	defaultOrg, defaultProj, repoFn, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
 	defaultProjCreated, err := ptypes.Timestamp(defaultProj.GetCreateTime().GetTimestamp())
 	require.NoError(t, err, "Error converting proto to timestamp.")
 	toMerge := &pbs.CreateScopeRequest{}
@@ -573,7 +598,7 @@ func TestCreate(t *testing.T) {
 				req := proto.Clone(toMerge).(*pbs.CreateScopeRequest)
 				proto.Merge(req, tc.req)
 
-				s, err := scopes.NewService(repoFn)
+				s, err := scopes.NewService(repoFn, kmsCache, schedulerRepoFn)
 				require.NoError(err, "Error when getting new project service.")
 
 				if name != "" {
@@ -613,7 +638,7 @@ func TestCreate(t *testing.T) {
 					if withUserId {
 						repo, err := repoFn()
 						require.NoError(err)
-						roles, err := repo.ListRoles(ctx, got.GetItem().GetId())
+						roles, err := repo.ListRoles(ctx, []string{got.GetItem().GetId()})
 						require.NoError(err)
 						switch tc.scopeId {
 						case defaultOrg.PublicId:
@@ -647,8 +672,8 @@ func TestCreate(t *testing.T) {
 }
 
 func TestUpdate(t *testing.T) {
-	org, proj, repoFn := createDefaultScopesAndRepo(t)
-	tested, err := scopes.NewService(repoFn)
+	org, proj, repoFn, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
+	tested, err := scopes.NewService(repoFn, kmsCache, schedulerRepoFn)
 	require.NoError(t, err, "Error when getting new project service.")
 
 	var orgVersion uint32 = 2
@@ -1014,3 +1039,92 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateKeys(t *testing.T) {
+	org, proj, repo, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
+	s, err := scopes.NewService(repo, kmsCache, schedulerRepoFn)
+	require.NoError(t, err, "Error when getting new scopes service.")
+
+	cases := []struct {
+		name    string
+		scopeId string
+		req     *pbs.RotateKeysRequest
+		err     error
+	}{
+		{
+			name:    "Rotate Global Keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateKeysRequest{Id: scope.Global.String()},
+		},
+		{
+			name:    "Rotate Org Keys",
+			scopeId: scope.Global.String(),
+			req:     &pbs.RotateKeysRequest{Id: org.GetPublicId()},
+		},
+		{
+			name:    "Rotate Project Keys",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.RotateKeysRequest{Id: proj.GetPublicId()},
+		},
+		{
+			name:    "Rotate Non Existing Scope",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.RotateKeysRequest{Id: scope.Project.Prefix() + "_DoesntExis"},
+			err:     handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:    "Bad Scope Id",
+			scopeId: org.GetPublicId(),
+			req:     &pbs.RotateKeysRequest{Id: "bad_id"},
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RotateKeys(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RotateKeys(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(tc.req.GetId(), got.GetItem().GetId())
+
+			wrapper, err := kmsCache.GetWrapper(context.Background(), tc.req.GetId(), kms.KeyPurposeDatabase)
+			require.NoError(err)
+			dropped, err := kmsCache.ReconcileCache(context.Background())
+			require.NoError(err)
+			assert.Equal(0, dropped, "newly loaded wrappers should use the rotated keys")
+			assert.NotEmpty(wrapper.KeyID())
+		})
+	}
+}
+
+func TestRewrapKeys(t *testing.T) {
+	org, _, repo, kmsCache, schedulerRepoFn := createDefaultScopesAndRepo(t)
+	s, err := scopes.NewService(repo, kmsCache, schedulerRepoFn)
+	require.NoError(t, err, "Error when getting new scopes service.")
+	ctx := auth.DisabledAuthTestContext(auth.WithScopeId(scope.Global.String()))
+
+	_, err = s.RotateKeys(ctx, &pbs.RotateKeysRequest{Id: org.GetPublicId()})
+	require.NoError(t, err)
+
+	schedulerRepo, err := schedulerRepoFn()
+	require.NoError(t, err)
+	before, err := schedulerRepo.LookupJob(context.Background(), common.KmsRewrapDataJob)
+	require.NoError(t, err)
+
+	got, err := s.RewrapKeys(ctx, &pbs.RewrapKeysRequest{Id: org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, org.GetPublicId(), got.GetItem().GetId())
+
+	after, err := schedulerRepo.LookupJob(context.Background(), common.KmsRewrapDataJob)
+	require.NoError(t, err)
+	assert.True(t, after.NextScheduledRun.GetTimestamp().AsTime().Before(before.NextScheduledRun.GetTimestamp().AsTime()),
+		"rewrapping keys should schedule the data rewrap job to run right away")
+
+	_, err = s.RewrapKeys(ctx, &pbs.RewrapKeysRequest{Id: "bad_id"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)))
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/sessions"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -18,6 +20,14 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Cancel,
+	action.DownloadRecording,
+}
+
 // Service handles request as described by the pbs.SessionServiceServer interface.
 type Service struct {
 	repoFn    common.SessionRepoFactory
@@ -51,6 +61,7 @@ func (s Service) GetSession(ctx context.Context, req *pbs.GetSessionRequest) (*p
 		return nil, err
 	}
 	ses.Scope = authResults.Scope
+	ses.AuthorizedActions = authResults.FetchActionSetForId(ses.Id, IdActions).Strings()
 	return &pbs.GetSessionResponse{Item: ses}, nil
 }
 
@@ -60,17 +71,46 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
-	seslist, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range seslist {
-		item.Scope = authResults.Scope
+	var finalItems []*pb.Session
+	for pager.More() {
+		seslist, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range seslist {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(seslist))
 	}
-	return &pbs.ListSessionsResponse{Items: seslist}, nil
+	return &pbs.ListSessionsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // CancelSession implements the interface pbs.SessionServiceServer.
@@ -90,6 +130,52 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: ses}, nil
 }
 
+// ListRecordings implements the interface pbs.SessionServiceServer.
+func (s Service) ListRecordings(ctx context.Context, req *pbs.ListRecordingsRequest) (*pbs.ListRecordingsResponse, error) {
+	if err := validateListRecordingsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DownloadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	recs, err := s.recordingsFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ListRecordingsResponse{Items: recs}, nil
+}
+
+// DownloadRecording authorizes downloading the recording of the connection
+// connectionId of the Session id and returns a function which writes the
+// decrypted recording to w as it is read from the database.  Recordings can
+// be too large to hold in memory, so they are served by an HTTP handler
+// rather than through the gRPC gateway.  The errors returned before the
+// recording is written are api errors.
+func (s Service) DownloadRecording(ctx context.Context, id, connectionId string) (func(w io.Writer) error, error) {
+	if err := validateDownloadRecordingRequest(id, connectionId); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, id, action.DownloadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	rec, err := repo.LookupRecording(ctx, id, connectionId)
+	if err != nil {
+		return nil, err
+	}
+	if rec == nil {
+		return nil, handlers.NotFoundErrorf("Connection %q of Session %q wasn't recorded.", connectionId, id)
+	}
+	return func(w io.Writer) error {
+		return repo.ReadRecording(ctx, rec, w)
+	}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -108,12 +194,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*pb.Session, error
 	return toProto(sess), nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Session, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	seslist, err := repo.ListSessions(ctx, session.WithScopeId(scopeId))
+	seslist, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds), session.WithStartPageAfter(cursor), session.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -136,6 +222,25 @@ func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*
 	return toProto(out), nil
 }
 
+func (s Service) recordingsFromRepo(ctx context.Context, id string) ([]*pb.SessionRecording, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	recs, err := repo.ListRecordings(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*pb.SessionRecording, 0, len(recs))
+	for _, r := range recs {
+		out = append(out, &pb.SessionRecording{
+			ConnectionId: r.ConnectionId,
+			Complete:     r.Complete,
+		})
+	}
+	return out, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 
@@ -158,7 +263,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.Cancel:
+	case action.Read, action.Cancel, action.DownloadRecording:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -232,8 +337,20 @@ func validateGetRequest(req *pbs.GetSessionRequest) error {
 
 func validateListRequest(req *pbs.ListSessionsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
-		badFields["scope_id"] = "This field is required to have a properly formatted project scope id."
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+	default:
+		badFields["scope_id"] = "This field is required to have a properly formatted project scope id, or 'global' or a valid org scope id when listing recursively."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
@@ -254,3 +371,28 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateListRecordingsRequest(req *pbs.ListRecordingsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(session.SessionPrefix, req.GetId()) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateDownloadRecordingRequest(id, connectionId string) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(session.SessionPrefix, id) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(session.ConnectionPrefix, connectionId) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
@@ -1,7 +1,10 @@
 package sessions_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,9 +18,11 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/stretchr/testify/assert"
@@ -64,23 +69,24 @@ func TestGetSession(t *testing.T) {
 	})
 
 	wireSess := &pb.Session{
-		Id:             sess.GetPublicId(),
-		ScopeId:        p.GetPublicId(),
-		AuthTokenId:    at.GetPublicId(),
-		Endpoint:       sess.Endpoint,
-		UserId:         at.GetIamUserId(),
-		TargetId:       sess.TargetId,
-		HostSetId:      sess.HostSetId,
-		HostId:         sess.HostId,
-		Version:        sess.Version,
-		Status:         session.StatusPending.String(),
-		UpdatedTime:    sess.UpdateTime.GetTimestamp(),
-		CreatedTime:    sess.CreateTime.GetTimestamp(),
-		ExpirationTime: sess.ExpirationTime.GetTimestamp(),
-		Scope:          &scopes.ScopeInfo{Id: p.GetPublicId(), Type: scope.Project.String()},
-		States:         []*pb.SessionState{{Status: session.StatusPending.String(), StartTime: sess.CreateTime.GetTimestamp()}},
-		Certificate:    sess.Certificate,
-		Type:           target.TcpSubType.String(),
+		Id:                sess.GetPublicId(),
+		ScopeId:           p.GetPublicId(),
+		AuthTokenId:       at.GetPublicId(),
+		Endpoint:          sess.Endpoint,
+		UserId:            at.GetIamUserId(),
+		TargetId:          sess.TargetId,
+		HostSetId:         sess.HostSetId,
+		HostId:            sess.HostId,
+		Version:           sess.Version,
+		Status:            session.StatusPending.String(),
+		UpdatedTime:       sess.UpdateTime.GetTimestamp(),
+		CreatedTime:       sess.CreateTime.GetTimestamp(),
+		ExpirationTime:    sess.ExpirationTime.GetTimestamp(),
+		AuthorizedActions: sessions.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: p.GetPublicId(), Type: scope.Project.String()},
+		States:            []*pb.SessionState{{Status: session.StatusPending.String(), StartTime: sess.CreateTime.GetTimestamp()}},
+		Certificate:       sess.Certificate,
+		Type:              target.TcpSubType.String(),
 	}
 
 	cases := []struct {
@@ -180,23 +186,24 @@ func TestList(t *testing.T) {
 		status, states := convertStates(sess.States)
 
 		wantSession = append(wantSession, &pb.Session{
-			Id:             sess.GetPublicId(),
-			ScopeId:        pWithSessions.GetPublicId(),
-			AuthTokenId:    at.GetPublicId(),
-			UserId:         at.GetIamUserId(),
-			TargetId:       sess.TargetId,
-			Endpoint:       sess.Endpoint,
-			HostSetId:      sess.HostSetId,
-			HostId:         sess.HostId,
-			Version:        sess.Version,
-			UpdatedTime:    sess.UpdateTime.GetTimestamp(),
-			CreatedTime:    sess.CreateTime.GetTimestamp(),
-			ExpirationTime: sess.ExpirationTime.GetTimestamp(),
-			Scope:          &scopes.ScopeInfo{Id: pWithSessions.GetPublicId(), Type: scope.Project.String()},
-			Status:         status,
-			States:         states,
-			Certificate:    sess.Certificate,
-			Type:           target.TcpSubType.String(),
+			Id:                sess.GetPublicId(),
+			ScopeId:           pWithSessions.GetPublicId(),
+			AuthTokenId:       at.GetPublicId(),
+			UserId:            at.GetIamUserId(),
+			TargetId:          sess.TargetId,
+			Endpoint:          sess.Endpoint,
+			HostSetId:         sess.HostSetId,
+			HostId:            sess.HostId,
+			Version:           sess.Version,
+			UpdatedTime:       sess.UpdateTime.GetTimestamp(),
+			CreatedTime:       sess.CreateTime.GetTimestamp(),
+			ExpirationTime:    sess.ExpirationTime.GetTimestamp(),
+			AuthorizedActions: sessions.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: pWithSessions.GetPublicId(), Type: scope.Project.String()},
+			Status:            status,
+			States:            states,
+			Certificate:       sess.Certificate,
+			Type:              target.TcpSubType.String(),
 		})
 	}
 
@@ -216,6 +223,26 @@ func TestList(t *testing.T) {
 			req:  &pbs.ListSessionsRequest{ScopeId: pNoSessions.GetPublicId()},
 			res:  &pbs.ListSessionsResponse{},
 		},
+		{
+			name: "Filter To One Session",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: fmt.Sprintf(`"/item/id"==%q`, wantSession[1].GetId())},
+			res:  &pbs.ListSessionsResponse{Items: wantSession[1:2]},
+		},
+		{
+			name: "Filter On Status And User",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: fmt.Sprintf(`"/item/status"=="pending" and "/item/user_id"==%q`, uId)},
+			res:  &pbs.ListSessionsResponse{Items: wantSession},
+		},
+		{
+			name: "Filter To No Sessions",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: `"/item/user_id"=="u_doesntmatch"`},
+			res:  &pbs.ListSessionsResponse{},
+		},
+		{
+			name: "Filter Bad Format",
+			req:  &pbs.ListSessionsRequest{ScopeId: pWithSessions.GetPublicId(), Filter: `"//id/"=="bad"`},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -398,3 +425,184 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+// testRecordedSession creates a Session whose Target enables session
+// recording, with one connection whose recording was uploaded by a worker.
+func testRecordedSession(t *testing.T) (common.SessionRepoFactory, common.IamRepoFactory, *session.Session, *session.Connection) {
+	t.Helper()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+	uId := at.GetIamUserId()
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := target.TestTcpTarget(t, conn, p.GetPublicId(), "test", target.WithHostSets([]string{hs.GetPublicId()}), target.WithEnableSessionRecording(true))
+
+	sess := session.TestSession(t, conn, wrap, session.ComposedOf{
+		UserId:                 uId,
+		HostId:                 h.GetPublicId(),
+		TargetId:               tar.GetPublicId(),
+		HostSetId:              hs.GetPublicId(),
+		AuthTokenId:            at.GetPublicId(),
+		ScopeId:                p.GetPublicId(),
+		Endpoint:               "tcp://127.0.0.1:22",
+		EnableSessionRecording: true,
+	})
+	sc := session.TestConnection(t, conn, sess.GetPublicId(), "127.0.0.1", 22, "127.0.0.1", 2222)
+
+	// Upload a recording encrypted as the worker does
+	sessionsWrapper, err := kmsCache.GetWrapper(ctx, p.GetPublicId(), kms.KeyPurposeSessions)
+	require.NoError(t, err)
+	_, privKey, err := session.DeriveED25519Key(sessionsWrapper, uId, sess.GetPublicId())
+	require.NoError(t, err)
+	key, err := recording.DeriveKey(privKey, sc.GetPublicId())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := recording.NewWriter(&buf, recording.Header{SessionId: sess.GetPublicId(), ConnectionId: sc.GetPublicId(), KeyId: sessionsWrapper.KeyID()}, key)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteFrame(recording.EndpointToClient, []byte("$ ")))
+	require.NoError(t, w.Close())
+	require.NoError(t, sessRepo.AddRecordingChunk(ctx, session.RecordingChunk{
+		SessionId:    sess.GetPublicId(),
+		ConnectionId: sc.GetPublicId(),
+		KeyId:        sessionsWrapper.KeyID(),
+		Data:         buf.Bytes(),
+		Final:        true,
+	}))
+
+	return sessRepoFn, iamRepoFn, sess, sc
+}
+
+func TestListRecordings(t *testing.T) {
+	sessRepoFn, iamRepoFn, sess, sc := testRecordedSession(t)
+
+	cases := []struct {
+		name    string
+		scopeId string
+		req     *pbs.ListRecordingsRequest
+		res     *pbs.ListRecordingsResponse
+		err     error
+	}{
+		{
+			name:    "List the recordings",
+			scopeId: sess.ScopeId,
+			req:     &pbs.ListRecordingsRequest{Id: sess.GetPublicId()},
+			res: &pbs.ListRecordingsResponse{Items: []*pb.SessionRecording{
+				{ConnectionId: sc.GetPublicId(), Complete: true},
+			}},
+		},
+		{
+			name: "List from a non existing Session",
+			req:  &pbs.ListRecordingsRequest{Id: session.SessionPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.ListRecordingsRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new session service.")
+
+			got, gErr := s.ListRecordings(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListRecordings(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListRecordings(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestDownloadRecording(t *testing.T) {
+	sessRepoFn, iamRepoFn, sess, sc := testRecordedSession(t)
+
+	cases := []struct {
+		name         string
+		scopeId      string
+		id           string
+		connectionId string
+		err          error
+	}{
+		{
+			name:         "Download a recording",
+			scopeId:      sess.ScopeId,
+			id:           sess.GetPublicId(),
+			connectionId: sc.GetPublicId(),
+		},
+		{
+			name:         "Download from a non existing Session",
+			id:           session.SessionPrefix + "_DoesntExis",
+			connectionId: sc.GetPublicId(),
+			err:          handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:         "Download a connection which wasn't recorded",
+			scopeId:      sess.ScopeId,
+			id:           sess.GetPublicId(),
+			connectionId: session.ConnectionPrefix + "_DoesntExis",
+			err:          handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:         "Wrong id prefix",
+			id:           "j_1234567890",
+			connectionId: sc.GetPublicId(),
+			err:          handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing connection id",
+			id:   sess.GetPublicId(),
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			require.NoError(err, "Couldn't create new session service.")
+
+			write, gErr := s.DownloadRecording(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), tc.id, tc.connectionId)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DownloadRecording(%q, %q) got error %v, wanted %v", tc.id, tc.connectionId, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			var out bytes.Buffer
+			require.NoError(write(&out))
+
+			r, err := recording.NewReader(&out, nil)
+			require.NoError(err)
+			f, err := r.Next()
+			require.NoError(err)
+			assert.Equal("$ ", string(f.Data))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package targets
+
+import (
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterWorkers(t *testing.T) {
+	worker := func(name string, tags map[string][]string) *servers.Server {
+		w := &servers.Server{Name: name, Tags: make(map[string]*servers.TagValues)}
+		for k, v := range tags {
+			w.Tags[k] = &servers.TagValues{Values: v}
+		}
+		return w
+	}
+	workers := []*servers.Server{
+		worker("w1", map[string][]string{"region": {"us-east-1"}, "type": {"prod", "pci"}}),
+		worker("w2", map[string][]string{"region": {"us-west-2"}, "type": {"dev"}}),
+		worker("w3", nil),
+	}
+
+	var tests = []struct {
+		name    string
+		filter  string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "name",
+			filter: `"/name" == "w2"`,
+			want:   []string{"w2"},
+		},
+		{
+			name:   "tag-value",
+			filter: `"pci" in "/tags/type"`,
+			want:   []string{"w1"},
+		},
+		{
+			name:   "name-or-tag",
+			filter: `"/name" == "w3" or "/tags/region" contains "us-west-2"`,
+			want:   []string{"w2", "w3"},
+		},
+		{
+			name:   "missing-tag",
+			filter: `"/tags/zone" contains "a"`,
+		},
+		{
+			name:   "negated-missing-tag",
+			filter: `not ("dev" in "/tags/type")`,
+			want:   []string{"w1", "w3"},
+		},
+		{
+			name:    "bad-filter",
+			filter:  `"/name" ==`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := filterWorkers(tt.filter, workers)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			var names []string
+			for _, w := range got {
+				names = append(names, w.GetName())
+			}
+			assert.Equal(tt.want, names)
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/boundary/internal/auth"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
@@ -26,6 +30,8 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/strutil"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
 	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -44,14 +50,26 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.AddHostSets,
+	action.SetHostSets,
+	action.RemoveHostSets,
+	action.AuthorizeSession,
+}
+
 // Service handles request as described by the pbs.TargetServiceServer interface.
 type Service struct {
-	repoFn           common.TargetRepoFactory
-	iamRepoFn        common.IamRepoFactory
-	serversRepoFn    common.ServersRepoFactory
-	sessionRepoFn    common.SessionRepoFactory
-	staticHostRepoFn common.StaticRepoFactory
-	kmsCache         *kms.Kms
+	repoFn        common.TargetRepoFactory
+	iamRepoFn     common.IamRepoFactory
+	serversRepoFn common.ServersRepoFactory
+	sessionRepoFn common.SessionRepoFactory
+	hostCatalogs  *host.Registry
+	kmsCache      *kms.Kms
 }
 
 // NewService returns a target service which handles target related requests to boundary.
@@ -61,7 +79,7 @@ func NewService(
 	iamRepoFn common.IamRepoFactory,
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
-	staticHostRepoFn common.StaticRepoFactory) (Service, error) {
+	hostCatalogs *host.Registry) (Service, error) {
 	if repoFn == nil {
 		return Service{}, fmt.Errorf("nil target repository provided")
 	}
@@ -74,16 +92,16 @@ func NewService(
 	if sessionRepoFn == nil {
 		return Service{}, fmt.Errorf("nil session repository provided")
 	}
-	if staticHostRepoFn == nil {
-		return Service{}, fmt.Errorf("nil static host repository provided")
+	if hostCatalogs == nil {
+		return Service{}, fmt.Errorf("nil host catalog registry provided")
 	}
 	return Service{
-		repoFn:           repoFn,
-		iamRepoFn:        iamRepoFn,
-		serversRepoFn:    serversRepoFn,
-		sessionRepoFn:    sessionRepoFn,
-		staticHostRepoFn: staticHostRepoFn,
-		kmsCache:         kmsCache,
+		repoFn:        repoFn,
+		iamRepoFn:     iamRepoFn,
+		serversRepoFn: serversRepoFn,
+		sessionRepoFn: sessionRepoFn,
+		hostCatalogs:  hostCatalogs,
+		kmsCache:      kmsCache,
 	}, nil
 }
 
@@ -95,17 +113,46 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.iamRepoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
+	}
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, authResults.Scope.GetId())
+	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	var finalItems []*pb.Target
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
 	}
-	return &pbs.ListTargetsResponse{Items: ul}, nil
+	return &pbs.ListTargetsResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetTargets implements the interface pbs.TargetServiceServer.
@@ -122,6 +169,7 @@ func (s Service) GetTarget(ctx context.Context, req *pbs.GetTargetRequest) (*pbs
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetTargetResponse{Item: u}, nil
 }
 
@@ -177,7 +225,7 @@ func (s Service) DeleteTarget(ctx context.Context, req *pbs.DeleteTargetRequest)
 
 // AddTargetHostSets implements the interface pbs.TargetServiceServer.
 func (s Service) AddTargetHostSets(ctx context.Context, req *pbs.AddTargetHostSetsRequest) (*pbs.AddTargetHostSetsResponse, error) {
-	if err := validateAddRequest(req); err != nil {
+	if err := validateAddRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.AddHostSets)
@@ -194,7 +242,7 @@ func (s Service) AddTargetHostSets(ctx context.Context, req *pbs.AddTargetHostSe
 
 // SetTargetHostSets implements the interface pbs.TargetServiceServer.
 func (s Service) SetTargetHostSets(ctx context.Context, req *pbs.SetTargetHostSetsRequest) (*pbs.SetTargetHostSetsResponse, error) {
-	if err := validateSetRequest(req); err != nil {
+	if err := validateSetRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.SetHostSets)
@@ -211,7 +259,7 @@ func (s Service) SetTargetHostSets(ctx context.Context, req *pbs.SetTargetHostSe
 
 // RemoveTargetHostSets implements the interface pbs.TargetServiceServer.
 func (s Service) RemoveTargetHostSets(ctx context.Context, req *pbs.RemoveTargetHostSetsRequest) (*pbs.RemoveTargetHostSetsResponse, error) {
-	if err := validateRemoveRequest(req); err != nil {
+	if err := validateRemoveRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.RemoveHostSets)
@@ -227,7 +275,7 @@ func (s Service) RemoveTargetHostSets(ctx context.Context, req *pbs.RemoveTarget
 }
 
 func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSessionRequest) (*pbs.AuthorizeSessionResponse, error) {
-	if err := validateAuthorizeSessionRequest(req); err != nil {
+	if err := validateAuthorizeSessionRequest(req, s.hostCatalogs); err != nil {
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetId(), action.AuthorizeSession)
@@ -287,29 +335,28 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	var chosenId *compoundHost
 	requestedId := req.GetHostId()
-	staticHostRepo, err := s.staticHostRepoFn()
-	if err != nil {
-		return nil, err
-	}
-
 	hostIds := make([]compoundHost, 0, len(hostSets)*10)
 
 HostSetIterationLoop:
 	for _, tSet := range hostSets {
 		hsId := tSet.PublicId
-		switch host.SubtypeFromId(hsId) {
-		case host.StaticSubtype:
-			_, hosts, err := staticHostRepo.LookupSet(ctx, hsId)
-			if err != nil {
-				return nil, err
-			}
-			for _, host := range hosts {
-				compoundId := compoundHost{hostSetId: hsId, hostId: host.PublicId}
-				hostIds = append(hostIds, compoundId)
-				if host.PublicId == requestedId {
-					chosenId = &compoundId
-					break HostSetIterationLoop
-				}
+		catalog, ok := s.hostCatalogs.CatalogForId(hsId)
+		if !ok {
+			return nil, fmt.Errorf("no host catalog supports host set %q", hsId)
+		}
+		// Catalogs which discover their hosts, such as dns, find the
+		// hosts of the set now so the session is made with one of the
+		// hosts the set currently contains.
+		hosts, err := catalog.ListSetHosts(ctx, hsId)
+		if err != nil {
+			return nil, err
+		}
+		for _, host := range hosts {
+			compoundId := compoundHost{hostSetId: hsId, hostId: host.PublicId}
+			hostIds = append(hostIds, compoundId)
+			if host.PublicId == requestedId {
+				chosenId = &compoundId
+				break HostSetIterationLoop
 			}
 		}
 	}
@@ -333,37 +380,71 @@ HostSetIterationLoop:
 	endpointUrl := &url.URL{
 		Scheme: t.GetType(),
 	}
-	defaultPort := t.GetDefaultPort()
-	var endpointHost string
-	switch host.SubtypeFromId(chosenId.hostId) {
-	case host.StaticSubtype:
-		h, err := staticHostRepo.LookupHost(ctx, chosenId.hostId)
+	port := t.GetDefaultPort()
+	catalog, ok := s.hostCatalogs.CatalogForId(chosenId.hostId)
+	if !ok {
+		return nil, fmt.Errorf("no host catalog supports host %q", chosenId.hostId)
+	}
+	h, err := catalog.LookupHost(ctx, chosenId.hostId)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up host: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("host %q was removed while authorizing the session", chosenId.hostId)
+	}
+	endpointHost := h.Address
+	if endpointHost == "" {
+		return nil, errors.New("host had empty address")
+	}
+	// A port set on the host, such as the port of an SRV record, takes
+	// precedence over the target's default port.
+	if h.Port != 0 {
+		port = h.Port
+	}
+	alternateHosts := h.AlternateAddresses
+	endpointUrl.Host = joinHostPort(endpointHost, port)
+	if len(alternateHosts) > 0 {
+		// The worker tries the alternate addresses, in order, when it can't
+		// connect to the endpoint's host.
+		q := url.Values{}
+		for _, a := range alternateHosts {
+			q.Add("alternate", joinHostPort(a, port))
+		}
+		endpointUrl.RawQuery = q.Encode()
+	}
+
+	// Get workers and filter down to ones that can service this request
+	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.GetWorkerFilter()) > 0 {
+		servers, err = filterWorkers(t.GetWorkerFilter(), servers)
 		if err != nil {
-			return nil, fmt.Errorf("error looking up host: %w", err)
+			return nil, err
 		}
-		endpointHost = h.Address
-		if endpointHost == "" {
-			return nil, errors.New("host had empty address")
+		if len(servers) == 0 {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "No workers are available to handle this session, or all have been filtered.")
 		}
 	}
-	if defaultPort != 0 {
-		endpointUrl.Host = fmt.Sprintf("%s:%d", endpointHost, defaultPort)
-	} else {
-		endpointUrl.Host = endpointHost
+	var workers []*pb.WorkerInfo
+	for _, v := range servers {
+		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
 	}
 
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:          authResults.UserId,
-		HostId:          chosenId.hostId,
-		TargetId:        t.GetPublicId(),
-		HostSetId:       chosenId.hostSetId,
-		AuthTokenId:     authResults.AuthTokenId,
-		ScopeId:         authResults.Scope.Id,
-		Endpoint:        endpointUrl.String(),
-		ExpirationTime:  &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit: t.GetSessionConnectionLimit(),
+		UserId:                 authResults.UserId,
+		HostId:                 chosenId.hostId,
+		TargetId:               t.GetPublicId(),
+		HostSetId:              chosenId.hostSetId,
+		AuthTokenId:            authResults.AuthTokenId,
+		ScopeId:                authResults.Scope.Id,
+		Endpoint:               endpointUrl.String(),
+		ExpirationTime:         &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:        t.GetSessionConnectionLimit(),
+		EnableSessionRecording: t.GetEnableSessionRecording(),
 	}
 
 	sess, err := session.New(sessionComposition)
@@ -379,15 +460,6 @@ HostSetIterationLoop:
 		return nil, err
 	}
 
-	var workers []*pb.WorkerInfo
-	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
-	if err != nil {
-		return nil, err
-	}
-	for _, v := range servers {
-		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
-	}
-
 	sad := &pb.SessionAuthorizationData{
 		SessionId:       sess.PublicId,
 		TargetId:        t.GetPublicId(),
@@ -449,6 +521,9 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target,
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if item.GetWorkerFilter() != nil {
+		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
+	}
 	tcpAttrs := &pb.TcpTargetAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
@@ -456,6 +531,9 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (*pb.Target,
 	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
 	}
+	if tcpAttrs.GetEnableSessionRecording() != nil {
+		opts = append(opts, target.WithEnableSessionRecording(tcpAttrs.GetEnableSessionRecording().GetValue()))
+	}
 	u, err := target.NewTcpTarget(item.GetScopeId(), opts...)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build target for creation: %v.", err)
@@ -488,6 +566,9 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if filter := item.GetWorkerFilter(); filter != nil {
+		opts = append(opts, target.WithWorkerFilter(filter.GetValue()))
+	}
 	tcpAttrs := &pb.TcpTargetAttributes{}
 	if err := handlers.StructToProto(item.GetAttributes(), tcpAttrs); err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "Provided attributes don't match expected format.")
@@ -495,6 +576,9 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if tcpAttrs.GetDefaultPort().GetValue() != 0 {
 		opts = append(opts, target.WithDefaultPort(tcpAttrs.GetDefaultPort().GetValue()))
 	}
+	if tcpAttrs.GetEnableSessionRecording() != nil {
+		opts = append(opts, target.WithEnableSessionRecording(tcpAttrs.GetEnableSessionRecording().GetValue()))
+	}
 	version := item.GetVersion()
 	u, err := target.NewTcpTarget(scopeId, opts...)
 	if err != nil {
@@ -534,12 +618,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeId string) ([]*pb.Target, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx, target.WithScopeId(scopeId))
+	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds), target.WithStartPageAfter(cursor), target.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -672,10 +756,16 @@ func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
 	if in.GetName() != "" {
 		out.Name = wrapperspb.String(in.GetName())
 	}
+	if in.GetWorkerFilter() != "" {
+		out.WorkerFilter = wrapperspb.String(in.GetWorkerFilter())
+	}
 	attrs := &pb.TcpTargetAttributes{}
 	if in.GetDefaultPort() > 0 {
 		attrs.DefaultPort = &wrappers.UInt32Value{Value: in.GetDefaultPort()}
 	}
+	if in.GetEnableSessionRecording() {
+		attrs.EnableSessionRecording = wrapperspb.Bool(true)
+	}
 	st, err := handlers.ProtoToStruct(attrs)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building password attribute struct: %v", err)
@@ -691,6 +781,36 @@ func toProto(in target.Target, m []*target.TargetSet) (*pb.Target, error) {
 	return &out, nil
 }
 
+// filterWorkers returns the workers whose name and tags satisfy the given
+// filter expression. The filter is evaluated against data of the form:
+//
+//	{"name": "<worker name>", "tags": {"<key>": ["<value>", ...]}}
+func filterWorkers(filter string, workers []*servers.Server) ([]*servers.Server, error) {
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build worker filter: %v.", err)
+	}
+	ret := make([]*servers.Server, 0, len(workers))
+	for _, w := range workers {
+		tags := make(map[string][]string, len(w.GetTags()))
+		for k, v := range w.GetTags() {
+			tags[k] = v.GetValues()
+		}
+		filterInput := map[string]interface{}{
+			"name": w.GetName(),
+			"tags": tags,
+		}
+		ok, err := eval.Evaluate(filterInput)
+		if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Worker filter expression evaluation resulted in error: %v.", err)
+		}
+		if ok {
+			ret = append(ret, w)
+		}
+	}
+	return ret, nil
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -721,6 +841,11 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields["session_max_seconds"] = "This must be greater than zero."
 		}
+		if filter := req.GetItem().GetWorkerFilter(); filter != nil {
+			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+				badFields["worker_filter"] = "Unable to successfully parse filter expression."
+			}
+		}
 		switch target.SubtypeFromType(req.GetItem().GetType()) {
 		case target.TcpSubType:
 			tcpAttrs := &pb.TcpTargetAttributes{}
@@ -760,6 +885,11 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields["session_max_seconds"] = "This must be greater than zero."
 		}
+		if filter := req.GetItem().GetWorkerFilter(); filter != nil {
+			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
+				badFields["worker_filter"] = "Unable to successfully parse filter expression."
+			}
+		}
 		switch target.SubtypeFromId(req.GetItem().GetType()) {
 		case target.TcpSubType:
 			if req.GetItem().GetType() != "" && target.SubtypeFromType(req.GetItem().GetType()) != target.TcpSubType {
@@ -783,8 +913,20 @@ func validateDeleteRequest(req *pbs.DeleteTargetRequest) error {
 
 func validateListRequest(req *pbs.ListTargetsRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()) {
-		badFields["scope_id"] = "This field is required to have a properly formatted project scope id."
+	switch {
+	case handlers.ValidId(scope.Project.Prefix(), req.GetScopeId()):
+	case req.GetRecursive() && (req.GetScopeId() == scope.Global.String() || handlers.ValidId(scope.Org.Prefix(), req.GetScopeId())):
+	default:
+		badFields["scope_id"] = "This field is required to have a properly formatted project scope id, or 'global' or a valid org scope id when listing recursively."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
 	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
@@ -792,7 +934,7 @@ func validateListRequest(req *pbs.ListTargetsRequest) error {
 	return nil
 }
 
-func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
+func validateAddRequest(req *pbs.AddTargetHostSetsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
@@ -804,7 +946,7 @@ func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
 		badFields["host_set_ids"] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(hostSetPrefix(catalogs, id), id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -815,7 +957,7 @@ func validateAddRequest(req *pbs.AddTargetHostSetsRequest) error {
 	return nil
 }
 
-func validateSetRequest(req *pbs.SetTargetHostSetsRequest) error {
+func validateSetRequest(req *pbs.SetTargetHostSetsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
@@ -824,7 +966,7 @@ func validateSetRequest(req *pbs.SetTargetHostSetsRequest) error {
 		badFields["version"] = "Required field."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(hostSetPrefix(catalogs, id), id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -835,7 +977,7 @@ func validateSetRequest(req *pbs.SetTargetHostSetsRequest) error {
 	return nil
 }
 
-func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
+func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
@@ -847,7 +989,7 @@ func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 		badFields["host_set_ids"] = "Must be non-empty."
 	}
 	for _, id := range req.GetHostSetIds() {
-		if !handlers.ValidId(static.HostSetPrefix, id) {
+		if !handlers.ValidId(hostSetPrefix(catalogs, id), id) {
 			badFields["host_set_ids"] = fmt.Sprintf("Incorrectly formatted host set identifier %q.", id)
 			break
 		}
@@ -858,15 +1000,14 @@ func validateRemoveRequest(req *pbs.RemoveTargetHostSetsRequest) error {
 	return nil
 }
 
-func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
+func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest, catalogs *host.Registry) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(target.TcpTargetPrefix, req.GetId()) {
 		badFields["id"] = "Incorrectly formatted identifier."
 	}
 	if req.GetHostId() != "" {
-		switch host.SubtypeFromId(req.GetHostId()) {
-		case host.StaticSubtype:
-		default:
+		c, ok := catalogs.CatalogForId(req.GetHostId())
+		if !ok || !handlers.ValidId(c.Prefixes().Host, req.GetHostId()) {
 			badFields["host_id"] = "Incorrectly formatted identifier."
 		}
 	}
@@ -875,3 +1016,25 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	}
 	return nil
 }
+
+// joinHostPort combines the address and port into a url host.  The port is
+// left out if it is 0 and IPv6 addresses are enclosed in brackets.
+func joinHostPort(address string, port uint32) string {
+	if port != 0 {
+		return net.JoinHostPort(address, strconv.FormatUint(uint64(port), 10))
+	}
+	if strings.Contains(address, ":") {
+		return "[" + address + "]"
+	}
+	return address
+}
+
+// hostSetPrefix returns the public id prefix of the host sets of the host
+// catalog type id belongs to.  The static prefix is returned for unknown
+// types.
+func hostSetPrefix(catalogs *host.Registry, id string) string {
+	if c, ok := catalogs.CatalogForId(id); ok {
+		return c.Prefixes().HostSet
+	}
+	return static.HostSetPrefix
+}
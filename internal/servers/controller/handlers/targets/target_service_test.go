@@ -14,6 +14,8 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/dns"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -53,7 +55,10 @@ func testService(t *testing.T, conn *gorm.DB, kms *kms.Kms, wrapper wrapping.Wra
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
-	return targets.NewService(kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, staticHostRepoFn)
+	dnsHostRepoFn := func() (*dns.Repository, error) {
+		return dns.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, host.TestRegistry(t, staticHostRepoFn, dnsHostRepoFn))
 }
 
 func TestGet(t *testing.T) {
@@ -75,6 +80,7 @@ func TestGet(t *testing.T) {
 		Name:                   wrapperspb.String("test"),
 		CreatedTime:            tar.CreateTime.GetTimestamp(),
 		UpdatedTime:            tar.UpdateTime.GetTimestamp(),
+		AuthorizedActions:      targets.IdActions.Strings(),
 		Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
 		Type:                   target.TcpTargetType.String(),
 		HostSetIds:             []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
@@ -143,7 +149,7 @@ func TestList(t *testing.T) {
 	kms := kms.TestKms(t, conn, wrapper)
 
 	_, projNoTar := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
-	_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	org, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hss := static.TestSets(t, conn, hc.GetPublicId(), 2)
 
@@ -155,6 +161,7 @@ func TestList(t *testing.T) {
 			Id:                     tar.GetPublicId(),
 			ScopeId:                proj.GetPublicId(),
 			Name:                   wrapperspb.String(name),
+			AuthorizedActions:      targets.IdActions.Strings(),
 			Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
 			CreatedTime:            tar.GetCreateTime().GetTimestamp(),
 			UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
@@ -167,10 +174,11 @@ func TestList(t *testing.T) {
 	}
 
 	cases := []struct {
-		name    string
-		scopeId string
-		res     *pbs.ListTargetsResponse
-		err     error
+		name      string
+		scopeId   string
+		recursive bool
+		res       *pbs.ListTargetsResponse
+		err       error
 	}{
 		{
 			name:    "List Many Host Sets",
@@ -182,6 +190,17 @@ func TestList(t *testing.T) {
 			scopeId: projNoTar.GetPublicId(),
 			res:     &pbs.ListTargetsResponse{},
 		},
+		{
+			name:      "List Recursively From Org",
+			scopeId:   org.GetPublicId(),
+			recursive: true,
+			res:       &pbs.ListTargetsResponse{Items: wantTars},
+		},
+		{
+			name:    "List Org Without Recursive",
+			scopeId: org.GetPublicId(),
+			err:     handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -189,7 +208,11 @@ func TestList(t *testing.T) {
 			s, err := testService(t, conn, kms, wrapper)
 			require.NoError(err, "Couldn't create new host set service.")
 
-			got, gErr := s.ListTargets(auth.DisabledAuthTestContext(auth.WithScopeId(tc.scopeId)), &pbs.ListTargetsRequest{ScopeId: tc.scopeId})
+			var opts []auth.Option
+			if !tc.recursive {
+				opts = append(opts, auth.WithScopeId(tc.scopeId))
+			}
+			got, gErr := s.ListTargets(auth.DisabledAuthTestContext(opts...), &pbs.ListTargetsRequest{ScopeId: tc.scopeId, Recursive: tc.recursive})
 			if tc.err != nil {
 				require.Error(gErr)
 				assert.True(errors.Is(gErr, tc.err), "ListTargets(%q) got error %v, wanted %v", tc.scopeId, gErr, tc.err)
@@ -321,6 +344,63 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Create a target with a worker filter",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:      proj.GetPublicId(),
+				Name:         wrapperspb.String("filtered"),
+				Type:         target.TcpTargetType.String(),
+				WorkerFilter: wrapperspb.String(`"/tags/type" contains "web"`),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", target.TcpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId:                proj.GetPublicId(),
+					Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:                   wrapperspb.String("filtered"),
+					Type:                   target.TcpTargetType.String(),
+					Attributes:             &structpb.Struct{Fields: map[string]*structpb.Value{}},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(1),
+					WorkerFilter:           wrapperspb.String(`"/tags/type" contains "web"`),
+				},
+			},
+		},
+		{
+			name: "Create a target with session recording",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("recorded"),
+				Type:    target.TcpTargetType.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"enable_session_recording": structpb.NewBoolValue(true),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", target.TcpTargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String()},
+					Name:    wrapperspb.String("recorded"),
+					Type:    target.TcpTargetType.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"enable_session_recording": structpb.NewBoolValue(true),
+					}},
+					SessionMaxSeconds:      wrapperspb.UInt32(28800),
+					SessionConnectionLimit: wrapperspb.Int32(1),
+				},
+			},
+		},
+		{
+			name: "Create with an unparseable worker filter",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:      proj.GetPublicId(),
+				Name:         wrapperspb.String("name"),
+				Type:         target.TcpTargetType.String(),
+				WorkerFilter: wrapperspb.String(`"/tags/type" is contains`),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with default port 0",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -810,6 +890,8 @@ func TestAddTargetHostSets(t *testing.T) {
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	hs := static.TestSets(t, conn, hc.GetPublicId(), 2)
+	dnsHc := dns.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	dnsHs := dns.TestSets(t, conn, dnsHc.GetPublicId(), 1, "web.example.test")[0]
 
 	addCases := []struct {
 		name           string
@@ -835,6 +917,12 @@ func TestAddTargetHostSets(t *testing.T) {
 			addHostSets:    []string{hs[1].GetPublicId(), hs[1].GetPublicId()},
 			resultHostSets: []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
 		},
+		{
+			name:           "Add dns set on populated target",
+			tar:            target.TestTcpTarget(t, conn, proj.GetPublicId(), "dns", target.WithHostSets([]string{hs[0].GetPublicId()})),
+			addHostSets:    []string{dnsHs.GetPublicId()},
+			resultHostSets: []string{hs[0].GetPublicId(), dnsHs.GetPublicId()},
+		},
 	}
 
 	for _, tc := range addCases {
@@ -12,7 +12,9 @@ import (
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -33,6 +35,18 @@ func init() {
 	}
 }
 
+// IdActions contains the set of actions that can be performed on
+// individual resources
+var IdActions = action.ActionSet{
+	action.Read,
+	action.Update,
+	action.Delete,
+	action.AddAccounts,
+	action.SetAccounts,
+	action.RemoveAccounts,
+	action.EffectiveGrants,
+}
+
 // Service handles request as described by the pbs.UserServiceServer interface.
 type Service struct {
 	repoFn common.IamRepoFactory
@@ -54,17 +68,46 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 		return nil, err
 	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
+	scopeIds, scopeResults, err := scopeids.GetListingScopeIds(ctx, s.repoFn, authResults, req.GetScopeId(), req.GetRecursive(),
+		func(ctx context.Context, scopeId string) auth.VerifyResults {
+			return s.authResult(ctx, scopeId, action.List)
+		})
+	if err != nil {
+		return nil, err
 	}
-	ul, err := s.listFromRepo(ctx, req.GetScopeId())
+	pager, err := handlers.NewPager(req.GetPageSize(), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	for _, item := range ul {
-		item.Scope = authResults.Scope
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
 	}
-	return &pbs.ListUsersResponse{Items: ul}, nil
+	var finalItems []*pb.User
+	for pager.More() {
+		ul, err := s.listFromRepo(ctx, scopeIds, pager.Cursor(), pager.Limit())
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range ul {
+			if !pager.More() {
+				break
+			}
+			pager.Fetched(item)
+			res := scopeResults[item.GetScopeId()]
+			item.Scope = res.Scope
+			item.AuthorizedActions = res.FetchActionSetForId(item.Id, IdActions).Strings()
+			if len(item.AuthorizedActions) == 0 {
+				continue
+			}
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				pager.Added()
+			}
+		}
+		pager.BatchDone(len(ul))
+	}
+	return &pbs.ListUsersResponse{Items: finalItems, NextPageToken: pager.NextPageToken()}, nil
 }
 
 // GetUsers implements the interface pbs.UserServiceServer.
@@ -81,6 +124,7 @@ func (s Service) GetUser(ctx context.Context, req *pbs.GetUserRequest) (*pbs.Get
 		return nil, err
 	}
 	u.Scope = authResults.Scope
+	u.AuthorizedActions = authResults.FetchActionSetForId(u.Id, IdActions).Strings()
 	return &pbs.GetUserResponse{Item: u}, nil
 }
 
@@ -185,6 +229,18 @@ func (s Service) RemoveUserAccounts(ctx context.Context, req *pbs.RemoveUserAcco
 	return &pbs.RemoveUserAccountsResponse{Item: u}, nil
 }
 
+// GetUserEffectiveGrants implements the interface pbs.UserServiceServer.
+func (s Service) GetUserEffectiveGrants(ctx context.Context, req *pbs.GetUserEffectiveGrantsRequest) (*pbs.GetUserEffectiveGrantsResponse, error) {
+	if err := validateGetUserEffectiveGrantsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.EffectiveGrants)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	return s.effectiveGrantsFromRepo(ctx, req)
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*pb.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -276,12 +332,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, orgId string) ([]*pb.User, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, cursor *db.PageCursor, limit int) ([]*pb.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListUsers(ctx, orgId)
+	ul, err := repo.ListUsers(ctx, scopeIds, iam.WithStartPageAfter(cursor), iam.WithLimit(limit))
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +405,61 @@ func (s Service) removeInRepo(ctx context.Context, userId string, accountIds []s
 	return toProto(out, accts), nil
 }
 
+// effectiveGrantsFromRepo parses the user's grants as the auth check does and,
+// when an action is requested, evaluates them with the same ACL.  Account
+// templates are left unexpanded since the account depends on the token used.
+func (s Service) effectiveGrantsFromRepo(ctx context.Context, req *pbs.GetUserEffectiveGrantsRequest) (*pbs.GetUserEffectiveGrantsResponse, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	pairs, err := repo.GrantsForUser(ctx, req.GetId())
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to get grants for user: %v.", err)
+	}
+	var templateUserId string
+	if req.GetId() != "u_anon" {
+		templateUserId = req.GetId()
+	}
+
+	var res perms.Resource
+	var act action.Type
+	if req.GetAction() != "" {
+		act = action.Map[req.GetAction()]
+		res = perms.Resource{
+			ScopeId: req.GetScopeId(),
+			Id:      req.GetResourceId(),
+			Type:    resource.Map[req.GetResourceType()],
+			Pin:     req.GetPinId(),
+		}
+	}
+
+	resp := &pbs.GetUserEffectiveGrantsResponse{}
+	grants := make([]perms.Grant, 0, len(pairs))
+	for _, pair := range pairs {
+		out := &pb.EffectiveGrant{
+			RoleId:  pair.RoleId,
+			ScopeId: pair.ScopeId,
+			Raw:     pair.Grant,
+		}
+		parsed, err := perms.Parse(pair.ScopeId, pair.Grant, perms.WithUserId(templateUserId), perms.WithSkipFinalValidation(true))
+		if err != nil {
+			out.Canonical = "<parse_error>"
+		} else {
+			grants = append(grants, parsed)
+			out.Canonical = parsed.CanonicalString()
+			out.Deny = parsed.Deny()
+			out.Applies = act != action.Unknown && parsed.Applies(res, act)
+		}
+		resp.Items = append(resp.Items, out)
+	}
+	if act != action.Unknown {
+		results := perms.NewACL(grants...).Allowed(res, act)
+		resp.Allowed, resp.Denied = results.Allowed, results.Denied
+	}
+	return resp, nil
+}
+
 func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
 	res := auth.VerifyResults{}
 	repo, err := s.repoFn()
@@ -447,12 +558,49 @@ func validateListRequest(req *pbs.ListUsersRequest) error {
 		req.GetScopeId() != scope.Global.String() {
 		badFields["scope_id"] = "Must be 'global' or a valid org scope id when listing."
 	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if req.GetPageSize() > handlers.MaxPageSize {
+		badFields["page_size"] = fmt.Sprintf("This field must not be greater than %d.", handlers.MaxPageSize)
+	}
+	if _, err := handlers.NewPager(0, req.GetPageToken()); err != nil {
+		badFields["page_token"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
 	}
 	return nil
 }
 
+func validateGetUserEffectiveGrantsRequest(req *pbs.GetUserEffectiveGrantsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(iam.UserPrefix, req.GetId()) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetAction() == "" {
+		if req.GetScopeId() != "" || req.GetResourceId() != "" || req.GetResourceType() != "" || req.GetPinId() != "" {
+			badFields["action"] = "Required when a resource is provided."
+		}
+	} else {
+		if a := action.Map[req.GetAction()]; a == action.Unknown || a == action.All {
+			badFields["action"] = "Unknown action."
+		}
+		if req.GetScopeId() == "" {
+			badFields["scope_id"] = "Required when an action is provided."
+		}
+	}
+	if t := req.GetResourceType(); t != "" {
+		if rt := resource.Map[t]; rt == resource.Unknown || rt == resource.All {
+			badFields["resource_type"] = "Unknown resource type."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(iam.UserPrefix, req.GetId()) {
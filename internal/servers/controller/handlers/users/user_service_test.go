@@ -49,14 +49,15 @@ func TestGet(t *testing.T) {
 	}
 
 	wantU := &pb.User{
-		Id:          u.GetPublicId(),
-		ScopeId:     u.GetScopeId(),
-		Scope:       &scopes.ScopeInfo{Id: u.ScopeId, Type: scope.Org.String()},
-		Name:        &wrapperspb.StringValue{Value: u.GetName()},
-		Description: &wrapperspb.StringValue{Value: u.GetDescription()},
-		CreatedTime: u.CreateTime.GetTimestamp(),
-		UpdatedTime: u.UpdateTime.GetTimestamp(),
-		Version:     1,
+		Id:                u.GetPublicId(),
+		ScopeId:           u.GetScopeId(),
+		AuthorizedActions: users.IdActions.Strings(),
+		Scope:             &scopes.ScopeInfo{Id: u.ScopeId, Type: scope.Org.String()},
+		Name:              &wrapperspb.StringValue{Value: u.GetName()},
+		Description:       &wrapperspb.StringValue{Value: u.GetDescription()},
+		CreatedTime:       u.CreateTime.GetTimestamp(),
+		UpdatedTime:       u.UpdateTime.GetTimestamp(),
+		Version:           1,
 	}
 
 	cases := []struct {
@@ -128,12 +129,13 @@ func TestList(t *testing.T) {
 		u, err := repo.CreateUser(context.Background(), newU)
 		require.NoError(t, err)
 		wantUsers = append(wantUsers, &pb.User{
-			Id:          u.GetPublicId(),
-			ScopeId:     u.GetScopeId(),
-			Scope:       &scopes.ScopeInfo{Id: u.GetScopeId(), Type: scope.Org.String()},
-			CreatedTime: u.GetCreateTime().GetTimestamp(),
-			UpdatedTime: u.GetUpdateTime().GetTimestamp(),
-			Version:     1,
+			Id:                u.GetPublicId(),
+			ScopeId:           u.GetScopeId(),
+			AuthorizedActions: users.IdActions.Strings(),
+			Scope:             &scopes.ScopeInfo{Id: u.GetScopeId(), Type: scope.Org.String()},
+			CreatedTime:       u.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       u.GetUpdateTime().GetTimestamp(),
+			Version:           1,
 		})
 	}
 
@@ -153,6 +155,31 @@ func TestList(t *testing.T) {
 			req:  &pbs.ListUsersRequest{ScopeId: oNoUsers.GetPublicId()},
 			res:  &pbs.ListUsersResponse{},
 		},
+		{
+			name: "Filter To One User",
+			req:  &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), Filter: fmt.Sprintf(`"/item/id"==%q`, wantUsers[1].GetId())},
+			res:  &pbs.ListUsersResponse{Items: wantUsers[1:2]},
+		},
+		{
+			name: "Filter To No Users",
+			req:  &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), Filter: `"/item/id"=="doesnt match"`},
+			res:  &pbs.ListUsersResponse{},
+		},
+		{
+			name: "Filter Bad Format",
+			req:  &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), Filter: `"//id/"=="bad"`},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Page Size Too Large",
+			req:  &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), PageSize: handlers.MaxPageSize + 1},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Page Token Bad Format",
+			req:  &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), PageToken: "bad token"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -168,6 +195,58 @@ func TestList(t *testing.T) {
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListUsers(%q) got response %q, wanted %q", tc.req, got, tc.res)
 		})
 	}
+
+	t.Run("Page Through Users", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := users.NewService(repoFn)
+		require.NoError(err, "Couldn't create new user service.")
+
+		var got []*pb.User
+		req := &pbs.ListUsersRequest{ScopeId: oWithUsers.GetPublicId(), PageSize: 3}
+		for pages := 1; ; pages++ {
+			require.LessOrEqual(pages, 4)
+			res, err := s.ListUsers(auth.DisabledAuthTestContext(auth.WithScopeId(req.GetScopeId())), req)
+			require.NoError(err)
+			got = append(got, res.GetItems()...)
+			if res.GetNextPageToken() == "" {
+				break
+			}
+			require.Len(res.GetItems(), 3)
+			req.PageToken = res.GetNextPageToken()
+		}
+		assert.Empty(cmp.Diff(got, wantUsers, protocmp.Transform()))
+	})
+
+	t.Run("List Recursively", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		s, err := users.NewService(repoFn)
+		require.NoError(err, "Couldn't create new user service.")
+
+		// Without a scope override the auth results carry the scope of each
+		// listing scope, as they do when auth is enabled.
+		req := &pbs.ListUsersRequest{ScopeId: scope.Global.String(), Recursive: true}
+		got, err := s.ListUsers(auth.DisabledAuthTestContext(), req)
+		require.NoError(err)
+		var gotOrg []*pb.User
+		for _, u := range got.GetItems() {
+			switch u.GetScopeId() {
+			case scope.Global.String():
+				assert.Equal(scope.Global.String(), u.GetScope().GetType())
+			case oWithUsers.GetPublicId():
+				gotOrg = append(gotOrg, u)
+			default:
+				assert.Failf("unexpected scope", "user %q is in scope %q", u.GetId(), u.GetScopeId())
+			}
+		}
+		assert.Empty(cmp.Diff(gotOrg, wantUsers, protocmp.Transform()))
+
+		req.Recursive = false
+		got, err = s.ListUsers(auth.DisabledAuthTestContext(), req)
+		require.NoError(err)
+		for _, u := range got.GetItems() {
+			assert.Equal(scope.Global.String(), u.GetScopeId())
+		}
+	})
 }
 
 func TestDelete(t *testing.T) {
@@ -983,3 +1062,111 @@ func TestRemoveAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEffectiveGrants(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return repo, nil
+	}
+	o, p := iam.TestScopes(t, repo)
+	u := iam.TestUser(t, repo, o.GetPublicId())
+
+	ops := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, ops.GetPublicId(), "id=*;type=*;actions=*")
+	iam.TestRoleGrant(t, conn, ops.GetPublicId(), "deny=true;id=*;type=target;actions=delete")
+	iam.TestUserRole(t, conn, ops.GetPublicId(), u.GetPublicId())
+	self := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, self.GetPublicId(), "id={{user.id}};actions=read")
+	iam.TestUserRole(t, conn, self.GetPublicId(), u.GetPublicId())
+
+	s, err := users.NewService(repoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		req         *pbs.GetUserEffectiveGrantsRequest
+		wantApplies []string
+		wantAllowed bool
+		wantDenied  bool
+		err         error
+	}{
+		{
+			name: "All grants",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId()},
+		},
+		{
+			name:        "Allowed by wildcard",
+			req:         &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: p.GetPublicId(), Action: "read", ResourceId: "ttcp_1234567890", ResourceType: "target"},
+			wantApplies: []string{"id=*;type=*;actions=*"},
+			wantAllowed: true,
+		},
+		{
+			name:        "Denied",
+			req:         &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: p.GetPublicId(), Action: "delete", ResourceId: "ttcp_1234567890", ResourceType: "target"},
+			wantApplies: []string{"deny=true;id=*;type=target;actions=delete", "id=*;type=*;actions=*"},
+			wantDenied:  true,
+		},
+		{
+			name:        "Templated grant",
+			req:         &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: o.GetPublicId(), Action: "read", ResourceId: u.GetPublicId(), ResourceType: "user"},
+			wantApplies: []string{"id=" + u.GetPublicId() + ";actions=read"},
+			wantAllowed: true,
+		},
+		{
+			name: "No grant applies",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: o.GetPublicId(), Action: "delete", ResourceId: u.GetPublicId(), ResourceType: "user"},
+		},
+		{
+			name: "Missing action",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: o.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing scope",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown action",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: u.GetPublicId(), ScopeId: o.GetPublicId(), Action: "fly"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad user id",
+			req:  &pbs.GetUserEffectiveGrantsRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := s.GetUserEffectiveGrants(auth.DisabledAuthTestContext(auth.WithScopeId(o.GetPublicId())), tc.req)
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "GetUserEffectiveGrants(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantAllowed, got.GetAllowed())
+			assert.Equal(tc.wantDenied, got.GetDenied())
+
+			var canonical, applies []string
+			for _, g := range got.GetItems() {
+				canonical = append(canonical, g.GetCanonical())
+				if g.GetApplies() {
+					applies = append(applies, g.GetCanonical())
+				}
+				switch g.GetRoleId() {
+				case ops.GetPublicId():
+					assert.Equal(p.GetPublicId(), g.GetScopeId())
+				case self.GetPublicId():
+					assert.Equal(o.GetPublicId(), g.GetScopeId())
+				}
+			}
+			assert.Subset(canonical, []string{"id=*;type=*;actions=*", "deny=true;id=*;type=target;actions=delete", "id=" + u.GetPublicId() + ";actions=read"})
+			assert.ElementsMatch(tc.wantApplies, applies)
+		})
+	}
+}
@@ -2,16 +2,21 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/gen/controller/api/resources/targets"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/metrics"
+	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -44,6 +49,7 @@ var _ pbs.ServerCoordinationServiceServer = &workerServiceServer{}
 
 func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusRequest) (*pbs.StatusResponse, error) {
 	ws.logger.Trace("got status request from worker", "name", req.Worker.Name, "address", req.Worker.Address, "jobs", req.GetJobs())
+	defer metrics.MeasureWorkerStatusHandler(req.GetWorker().GetName(), time.Now())
 	ws.updateTimes.Store(req.Worker.Name, time.Now())
 	repo, err := ws.serversRepoFn()
 	if err != nil {
@@ -51,7 +57,7 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		return &pbs.StatusResponse{}, status.Errorf(codes.Internal, "Error aqcuiring repo to store worker status: %v", err)
 	}
 	req.Worker.Type = resource.Worker.String()
-	controllers, _, err := repo.UpsertServer(ctx, req.Worker)
+	controllers, _, err := repo.UpsertServer(ctx, req.Worker, servers.WithUpdateTags(req.GetUpdateTags()))
 	if err != nil {
 		ws.logger.Error("error storing worker status", "error", err)
 		return &pbs.StatusResponse{}, status.Errorf(codes.Internal, "Error storing worker status: %v", err)
@@ -78,6 +84,26 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 			if si == nil {
 				return nil, status.Error(codes.Internal, "Error getting session info at status time")
 			}
+			// Record the bytes transferred so far on open connections so
+			// long-lived connections show progress before they are closed
+			var bytesWith []session.BytesWith
+			for _, c := range si.GetConnections() {
+				if c.GetStatus() != pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+					continue
+				}
+				bytesWith = append(bytesWith, session.BytesWith{
+					ConnectionId: c.GetConnectionId(),
+					BytesUp:      c.GetBytesUp(),
+					BytesDown:    c.GetBytesDown(),
+				})
+			}
+			if len(bytesWith) > 0 {
+				if err := sessRepo.UpdateConnectionBytes(ctx, bytesWith); err != nil {
+					// Not fatal to the status update; the final counts are
+					// recorded when the connections are closed
+					ws.logger.Error("error updating connection bytes", "session_id", si.GetSessionId(), "error", err)
+				}
+			}
 			switch si.Status {
 			case pbs.SESSIONSTATUS_SESSIONSTATUS_CANCELING,
 				pbs.SESSIONSTATUS_SESSIONSTATUS_TERMINATED:
@@ -149,33 +175,47 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 			SessionId:   sessionInfo.GetPublicId(),
 			Certificate: sessionInfo.Certificate,
 		},
-		Status:          sessionInfo.States[0].Status.ProtoVal(),
-		Version:         sessionInfo.Version,
-		TofuToken:       string(sessionInfo.TofuToken),
-		Endpoint:        sessionInfo.Endpoint,
-		Expiration:      sessionInfo.ExpirationTime.Timestamp,
-		ConnectionLimit: sessionInfo.ConnectionLimit,
-		ConnectionsLeft: authzSummary.ConnectionLimit,
-		HostId:          sessionInfo.HostId,
-		HostSetId:       sessionInfo.HostSetId,
-		TargetId:        sessionInfo.TargetId,
-		UserId:          sessionInfo.UserId,
+		Status:                 sessionInfo.States[0].Status.ProtoVal(),
+		Version:                sessionInfo.Version,
+		TofuToken:              string(sessionInfo.TofuToken),
+		Endpoint:               sessionInfo.Endpoint,
+		Expiration:             sessionInfo.ExpirationTime.Timestamp,
+		ConnectionLimit:        sessionInfo.ConnectionLimit,
+		ConnectionsLeft:        authzSummary.ConnectionLimit,
+		HostId:                 sessionInfo.HostId,
+		HostSetId:              sessionInfo.HostSetId,
+		TargetId:               sessionInfo.TargetId,
+		UserId:                 sessionInfo.UserId,
+		EnableSessionRecording: sessionInfo.EnableSessionRecording,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
 	}
 
-	wrapper, err := ws.kms.GetWrapper(ctx, sessionInfo.ScopeId, kms.KeyPurposeSessions)
+	wrapper, err := ws.kms.GetWrapper(ctx, sessionInfo.ScopeId, kms.KeyPurposeSessions, kms.WithKeyId(sessionInfo.SessionsKeyId))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error getting sessions wrapper: %v", err)
 	}
+	// The multiwrapper derives from its current key version, which is not the
+	// version the certificate was derived from if the sessions key has been
+	// rotated since the session was authorized.  Sessions authorized before
+	// the key version was recorded fall back to the current version.
+	keyWrapper := wrapper
+	if mw, ok := wrapper.(*multiwrapper.MultiWrapper); ok && sessionInfo.SessionsKeyId != "" {
+		if keyWrapper = mw.WrapperForKeyID(sessionInfo.SessionsKeyId); keyWrapper == nil {
+			return nil, status.Errorf(codes.Internal, "Sessions key version %s not found", sessionInfo.SessionsKeyId)
+		}
+	}
 
 	// Derive the private key, which should match. Deriving on both ends allows
 	// us to not store it in the DB.
-	_, resp.Authorization.PrivateKey, err = session.DeriveED25519Key(wrapper, sessionInfo.UserId, sessionInfo.GetPublicId())
+	_, resp.Authorization.PrivateKey, err = session.DeriveED25519Key(keyWrapper, sessionInfo.UserId, sessionInfo.GetPublicId())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error deriving session key: %v", err)
 	}
+	if resp.EnableSessionRecording {
+		resp.RecordingKeyId = keyWrapper.KeyID()
+	}
 
 	return resp, nil
 }
@@ -346,7 +386,7 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 	}
 
 	for _, v := range req.GetCloseRequestData() {
-		ws.logger.Info("connection closed", "connection_id", v.ConnectionId)
+		ws.logger.Info("connection closed", "connection_id", v.ConnectionId, "bytes_up", v.GetBytesUp(), "bytes_down", v.GetBytesDown(), "reason", v.GetReason())
 	}
 
 	ret := &pbs.CloseConnectionResponse{
@@ -355,3 +395,25 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 
 	return ret, nil
 }
+
+func (ws *workerServiceServer) UploadRecordingChunk(ctx context.Context, req *pbs.UploadRecordingChunkRequest) (*pbs.UploadRecordingChunkResponse, error) {
+	sessRepo, err := ws.sessionRepoFn()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting session repo: %v", err)
+	}
+	err = sessRepo.AddRecordingChunk(ctx, session.RecordingChunk{
+		SessionId:    req.GetSessionId(),
+		ConnectionId: req.GetConnectionId(),
+		KeyId:        req.GetKeyId(),
+		Sequence:     req.GetSequence(),
+		Data:         req.GetData(),
+		Final:        req.GetFinal(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidParameter) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid recording chunk: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Error storing recording chunk: %v", err)
+	}
+	return &pbs.UploadRecordingChunkResponse{}, nil
+}
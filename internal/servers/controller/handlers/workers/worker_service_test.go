@@ -0,0 +1,62 @@
+package workers_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupSession_RotatedKeys(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kmsCache)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kmsCache)
+	}
+	ws := workers.NewWorkerServiceServer(hclog.NewNullLogger(), serversRepoFn, sessionRepoFn, new(sync.Map), kmsCache)
+
+	// Authorize the session as the targets service does
+	params := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	sessionsWrapper, err := kmsCache.GetWrapper(ctx, params.ScopeId, kms.KeyPurposeSessions)
+	require.NoError(err)
+	newSession, err := session.New(params)
+	require.NoError(err)
+	sessionRepo, err := sessionRepoFn()
+	require.NoError(err)
+	sess, _, err := sessionRepo.CreateSession(ctx, sessionsWrapper, newSession)
+	require.NoError(err)
+	assert.Equal(sessionsWrapper.KeyID(), sess.SessionsKeyId)
+
+	require.NoError(kmsCache.RotateKeys(ctx, params.ScopeId))
+	rotated, err := kmsCache.GetWrapper(ctx, params.ScopeId, kms.KeyPurposeSessions)
+	require.NoError(err)
+	require.NotEqual(sess.SessionsKeyId, rotated.KeyID())
+
+	resp, err := ws.LookupSession(ctx, &pbs.LookupSessionRequest{SessionId: sess.PublicId})
+	require.NoError(err)
+	cert, err := x509.ParseCertificate(resp.GetAuthorization().GetCertificate())
+	require.NoError(err)
+	privKey := ed25519.PrivateKey(resp.GetAuthorization().GetPrivateKey())
+	assert.Equal(cert.PublicKey, privKey.Public())
+}
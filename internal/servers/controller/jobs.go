@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+const (
+	recoveryNonceCleanupJob       = "recovery_nonce_cleanup"
+	terminateCompletedSessionsJob = "terminate_completed_sessions"
+
+	terminationInterval   = 1 * time.Minute
+	kmsRewrapDataInterval = 1 * time.Hour
+)
+
+// This is exported so it can be tweaked in tests
+var RecoveryNonceCleanupInterval = 2 * time.Minute
+
+// startScheduler registers the controller's background jobs and starts
+// running them. Job intervals may be overridden in the scheduler block of the
+// controller config.
+func (c *Controller) startScheduler() error {
+	var err error
+	c.scheduler, err = scheduler.New(c.conf.RawConfig.Controller.Name, c.SchedulerRepoFn, c.logger.Named("scheduler"))
+	if err != nil {
+		return fmt.Errorf("error creating scheduler: %w", err)
+	}
+
+	schedConf := c.conf.RawConfig.Controller.Scheduler
+	jobs := []struct {
+		name        string
+		description string
+		interval    time.Duration
+		run         scheduler.RunFunc
+	}{
+		{
+			name:        recoveryNonceCleanupJob,
+			description: "Removes recovery nonces that are past their validity period",
+			interval:    schedConf.JobInterval(recoveryNonceCleanupJob, RecoveryNonceCleanupInterval),
+			run:         c.cleanupRecoveryNonces,
+		},
+		{
+			name:        terminateCompletedSessionsJob,
+			description: "Terminates sessions that have expired or have no connections remaining",
+			interval:    schedConf.JobInterval(terminateCompletedSessionsJob, terminationInterval),
+			run:         c.terminateCompletedSessions,
+		},
+		{
+			name:        common.KmsRewrapDataJob,
+			description: "Re-encrypts data that is not encrypted with the newest key versions",
+			interval:    schedConf.JobInterval(common.KmsRewrapDataJob, kmsRewrapDataInterval),
+			run:         c.rewrapData,
+		},
+	}
+	for _, j := range jobs {
+		if err := c.scheduler.RegisterJob(c.baseContext, j.name, j.description, j.interval, j.run); err != nil {
+			return err
+		}
+	}
+
+	c.scheduler.Start(c.baseContext)
+	return nil
+}
+
+func (c *Controller) cleanupRecoveryNonces(ctx context.Context) error {
+	repo, err := c.ServersRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for recovery nonce cleanup: %w", err)
+	}
+	nonceCount, err := repo.CleanupNonces(ctx)
+	if err != nil {
+		return fmt.Errorf("error performing recovery nonce cleanup: %w", err)
+	}
+	if nonceCount > 0 {
+		c.logger.Info("recovery nonce cleanup successful", "nonces_cleaned", nonceCount)
+	}
+	return nil
+}
+
+func (c *Controller) terminateCompletedSessions(ctx context.Context) error {
+	repo, err := c.SessionRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for terminating completed sessions: %w", err)
+	}
+	terminationCount, err := repo.TerminateCompletedSessions(ctx)
+	if err != nil {
+		return fmt.Errorf("error performing termination of completed sessions: %w", err)
+	}
+	if terminationCount > 0 {
+		c.logger.Info("terminating completed sessions successful", "sessions_terminated", terminationCount)
+	}
+	return nil
+}
+
+// rewrapData re-encrypts all encrypted data that was not written with the
+// newest key version for its scope and purpose. Each step skips data that is
+// already current, so the job is cheap to run when no keys have been rotated.
+func (c *Controller) rewrapData(ctx context.Context) error {
+	if _, err := c.kms.ReconcileCache(ctx); err != nil {
+		return fmt.Errorf("error reconciling kms cache: %w", err)
+	}
+
+	tokenRepo, err := c.AuthTokenRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for rewrapping auth tokens: %w", err)
+	}
+	tokenCount, err := tokenRepo.RewrapAuthTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping auth tokens: %w", err)
+	}
+
+	passwordRepo, err := c.PasswordAuthRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for rewrapping password credentials: %w", err)
+	}
+	credentialCount, err := passwordRepo.RewrapCredentials(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping password credentials: %w", err)
+	}
+
+	oidcRepo, err := c.OidcAuthRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for rewrapping oidc auth methods: %w", err)
+	}
+	authMethodCount, err := oidcRepo.RewrapAuthMethods(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping oidc auth methods: %w", err)
+	}
+
+	ldapRepo, err := c.LdapAuthRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for rewrapping ldap auth methods: %w", err)
+	}
+	ldapAuthMethodCount, err := ldapRepo.RewrapAuthMethods(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping ldap auth methods: %w", err)
+	}
+
+	sessionRepo, err := c.SessionRepoFn()
+	if err != nil {
+		return fmt.Errorf("error fetching repository for rewrapping sessions: %w", err)
+	}
+	sessionCount, err := sessionRepo.RewrapSessions(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping sessions: %w", err)
+	}
+
+	oplogCount, err := c.kms.RewrapOplogEntries(ctx)
+	if err != nil {
+		return fmt.Errorf("error rewrapping oplog entries: %w", err)
+	}
+
+	if tokenCount+credentialCount+authMethodCount+ldapAuthMethodCount+sessionCount+oplogCount > 0 {
+		c.logger.Info("rewrapping data successful",
+			"auth_tokens", tokenCount,
+			"password_credentials", credentialCount,
+			"oidc_auth_methods", authMethodCount,
+			"ldap_auth_methods", ldapAuthMethodCount,
+			"sessions", sessionCount,
+			"oplog_entries", oplogCount)
+	}
+	return nil
+}
@@ -148,6 +148,8 @@ func (c *Controller) startListeners() error {
 				}
 			case "proxy":
 				// Do nothing, in a dev mode we might see it here
+			case "ops":
+				// Handled by the base server
 			default:
 				err = fmt.Errorf("unknown listener purpose %q", purpose)
 			}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+type requestMetricsKeyType struct{}
+
+var requestMetricsKey requestMetricsKeyType
+
+// requestMetrics carries the gRPC method an API request was routed to from
+// the gateway back out to the handler wrapper that records its metrics.
+type requestMetrics struct {
+	method string
+}
+
+// recordRpcMethod is registered as a metadata annotator on the gateway mux.
+// Annotators run after the gateway has matched the request to a method, so
+// this is the earliest point at which the method name is known.
+func recordRpcMethod(ctx context.Context, _ *http.Request) metadata.MD {
+	if rm, ok := ctx.Value(requestMetricsKey).(*requestMetrics); ok {
+		if method, ok := runtime.RPCMethod(ctx); ok {
+			rm.method = method
+		}
+	}
+	return nil
+}
+
+// statusRecorder captures the status code written to an http.ResponseWriter.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) code() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
@@ -2,23 +2,25 @@ package controller
 
 import (
 	"context"
-	"math/rand"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/metrics"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/types/resource"
 )
 
-// In the future we could make this configurable
 const (
-	statusInterval      = 10 * time.Second
-	terminationInterval = 1 * time.Minute
+	// statusInterval is how often the controller records its status unless
+	// the controller config sets status_interval.
+	statusInterval            = 10 * time.Second
+	kmsCacheReconcileInterval = 1 * time.Minute
 )
 
-// This is exported so it can be tweaked in tests
-var RecoveryNonceCleanupInterval = 2 * time.Minute
-
 func (c *Controller) startStatusTicking(cancelCtx context.Context) {
+	interval := statusInterval
+	if d := c.conf.RawConfig.Controller.StatusIntervalDuration; d > 0 {
+		interval = d
+	}
 	go func() {
 		timer := time.NewTimer(0)
 		for {
@@ -28,6 +30,7 @@ func (c *Controller) startStatusTicking(cancelCtx context.Context) {
 				return
 
 			case <-timer.C:
+				start := time.Now()
 				server := &servers.Server{
 					PrivateId:   c.conf.RawConfig.Controller.Name,
 					Name:        c.conf.RawConfig.Controller.Name,
@@ -46,72 +49,34 @@ func (c *Controller) startStatusTicking(cancelCtx context.Context) {
 						c.logger.Trace("controller status successfully saved")
 					}
 				}
-				timer.Reset(statusInterval)
-			}
-		}
-	}()
-}
-
-func (c *Controller) startRecoveryNonceCleanupTicking(cancelCtx context.Context) {
-	go func() {
-		timer := time.NewTimer(0)
-		for {
-			select {
-			case <-cancelCtx.Done():
-				c.logger.Info("recovery nonce ticking shutting down")
-				return
-
-			case <-timer.C:
-				repo, err := c.ServersRepoFn()
-				if err != nil {
-					c.logger.Error("error fetching repository for recovery nonce cleanup", "error", err)
-				} else {
-					nonceCount, err := repo.CleanupNonces(cancelCtx)
-					if err != nil {
-						c.logger.Error("error performing recovery nonce cleanup", "error", err)
-					} else if nonceCount > 0 {
-						c.logger.Info("recovery nonce cleanup successful", "nonces_cleaned", nonceCount)
-					}
-				}
-				timer.Reset(RecoveryNonceCleanupInterval)
+				metrics.MeasureTicker("status", start)
+				timer.Reset(interval)
 			}
 		}
 	}()
 }
 
-func (c *Controller) startTerminateCompletedSessionsTicking(cancelCtx context.Context) {
+// startKmsCacheTicking periodically drops cached wrappers whose keys have been
+// rotated, which may have happened on another controller.
+func (c *Controller) startKmsCacheTicking(cancelCtx context.Context) {
 	go func() {
-		r := rand.New(rand.NewSource(time.Now().UnixNano()))
-		// desynchronize calls from the controllers, to ease the load on the DB.
-		getRandomInterval := func() time.Duration {
-			// 0 to 0.5 adjustment to the base
-			f := r.Float64() / 2
-			// Half a chance to be faster, not slower
-			if r.Float32() > 0.5 {
-				f = -1 * f
-			}
-			return terminationInterval + time.Duration(f*float64(time.Minute))
-		}
-		timer := time.NewTimer(0)
+		timer := time.NewTimer(kmsCacheReconcileInterval)
 		for {
 			select {
 			case <-cancelCtx.Done():
-				c.logger.Info("terminating completed sessions ticking shutting down")
+				c.logger.Info("kms cache ticking shutting down")
 				return
 
 			case <-timer.C:
-				repo, err := c.SessionRepoFn()
+				start := time.Now()
+				dropped, err := c.kms.ReconcileCache(cancelCtx)
 				if err != nil {
-					c.logger.Error("error fetching repository for terminating completed sessions", "error", err)
-				} else {
-					terminationCount, err := repo.TerminateCompletedSessions(cancelCtx)
-					if err != nil {
-						c.logger.Error("error performing termination of completed sessions", "error", err)
-					} else if terminationCount > 0 {
-						c.logger.Info("terminating completed sessions successful", "sessions_terminated", terminationCount)
-					}
+					c.logger.Error("error reconciling kms cache", "error", err)
+				} else if dropped > 0 {
+					c.logger.Info("dropped rotated keys from kms cache", "dropped", dropped)
 				}
-				timer.Reset(getRandomInterval())
+				metrics.MeasureTicker("kms_cache", start)
+				timer.Reset(kmsCacheReconcileInterval)
 			}
 		}
 	}()
@@ -16,14 +16,16 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withLimit    int
-	withLiveness time.Duration
+	withLimit      int
+	withLiveness   time.Duration
+	withUpdateTags bool
 }
 
 func getDefaultOptions() options {
 	return options{
-		withLimit:    0,
-		withLiveness: 0,
+		withLimit:      0,
+		withLiveness:   0,
+		withUpdateTags: false,
 	}
 }
 
@@ -43,3 +45,11 @@ func WithLiveness(liveness time.Duration) Option {
 		o.withLiveness = liveness
 	}
 }
+
+// WithUpdateTags indicates that the tags on the server should replace any
+// tags currently stored for it
+func WithUpdateTags(updateTags bool) Option {
+	return func(o *options) {
+		o.withUpdateTags = updateTags
+	}
+}
@@ -2,4 +2,6 @@ package servers
 
 const (
 	deleteWhereSql = `create_time < $1`
+
+	deleteTagsSql = `delete from server_tag where server_id = $1 and server_type = $2`
 )
@@ -72,14 +72,57 @@ func (r *Repository) ListServers(ctx context.Context, serverType ServerType, opt
 	); err != nil {
 		return nil, fmt.Errorf("error listing servers: %w", err)
 	}
+	if serverType == ServerTypeWorker && len(servers) > 0 {
+		if err := r.populateTags(ctx, servers); err != nil {
+			return nil, fmt.Errorf("error listing servers: %w", err)
+		}
+	}
 	return servers, nil
 }
 
-// UpsertServer adds or updates a server in the DB
+// populateTags looks up the tags for the given workers and sets them on each
+// worker
+func (r *Repository) populateTags(ctx context.Context, servers []*Server) error {
+	serverIds := make([]string, 0, len(servers))
+	for _, s := range servers {
+		serverIds = append(serverIds, s.PrivateId)
+	}
+	var tags []*ServerTag
+	if err := r.reader.SearchWhere(
+		ctx,
+		&tags,
+		"server_id in (?) and server_type = ?",
+		[]interface{}{serverIds, ServerTypeWorker.String()},
+		db.WithLimit(-1),
+	); err != nil {
+		return fmt.Errorf("error looking up server tags: %w", err)
+	}
+	tagsById := make(map[string]map[string]*TagValues, len(servers))
+	for _, t := range tags {
+		serverTags := tagsById[t.ServerId]
+		if serverTags == nil {
+			serverTags = make(map[string]*TagValues)
+			tagsById[t.ServerId] = serverTags
+		}
+		if serverTags[t.Key] == nil {
+			serverTags[t.Key] = new(TagValues)
+		}
+		serverTags[t.Key].Values = append(serverTags[t.Key].Values, t.Value)
+	}
+	for _, s := range servers {
+		s.Tags = tagsById[s.PrivateId]
+	}
+	return nil
+}
+
+// UpsertServer adds or updates a server in the DB. Supports the
+// WithUpdateTags option which replaces the server's stored tags with the tags
+// on the given server.
 func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Option) ([]*Server, int, error) {
 	if server == nil {
 		return nil, db.NoRowsAffected, errors.New("cannot update server that is nil")
 	}
+	opts := getOpts(opt...)
 	// Ensure, for now at least, the private ID is always equivalent to the name
 	server.PrivateId = server.Name
 	// Build query
@@ -96,25 +139,76 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 		update_time = $6;
 	`
 
-	rowsAffected, err := r.writer.Exec(ctx, q,
-		[]interface{}{server.PrivateId,
-			server.Type,
-			server.Name,
-			server.Description,
-			server.Address,
-			time.Now().Format(time.RFC3339)})
+	var rowsAffected int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var err error
+			rowsAffected, err = w.Exec(ctx, q,
+				[]interface{}{server.PrivateId,
+					server.Type,
+					server.Name,
+					server.Description,
+					server.Address,
+					time.Now().Format(time.RFC3339)})
+			if err != nil {
+				return fmt.Errorf("error performing status upsert: %w", err)
+			}
+
+			if !opts.withUpdateTags {
+				return nil
+			}
+			if _, err := w.Exec(ctx, deleteTagsSql, []interface{}{server.PrivateId, server.Type}); err != nil {
+				return fmt.Errorf("error deleting existing tags: %w", err)
+			}
+			var tags []interface{}
+			for k, v := range server.Tags {
+				for _, val := range v.GetValues() {
+					tags = append(tags, &ServerTag{
+						ServerId:   server.PrivateId,
+						ServerType: server.Type,
+						Key:        k,
+						Value:      val,
+					})
+				}
+			}
+			if len(tags) == 0 {
+				return nil
+			}
+			if err := w.CreateItems(ctx, tags); err != nil {
+				return fmt.Errorf("error creating tags: %w", err)
+			}
+			return nil
+		},
+	)
 	if err != nil {
-		return nil, db.NoRowsAffected, fmt.Errorf("error performing status upsert: %w", err)
+		return nil, db.NoRowsAffected, err
 	}
 	// If updating a controller, done
 	if server.Type == resource.Controller.String() {
-		return nil, int(rowsAffected), nil
+		return nil, rowsAffected, nil
 	}
 	// Fetch current controllers to feed to the workers
 	controllers, err := r.ListServers(ctx, ServerTypeController)
 	return controllers, len(controllers), err
 }
 
+// ServerTag holds the information for a single server tag value. Tags with
+// multiple values are stored as multiple ServerTags sharing a key.
+type ServerTag struct {
+	ServerId   string
+	ServerType string
+	Key        string
+	Value      string
+}
+
+// TableName overrides the table name used by ServerTag to `server_tag`
+func (t *ServerTag) TableName() string {
+	return "server_tag"
+}
+
 type RecoveryNonce struct {
 	Nonce string
 }
@@ -1,12 +1,16 @@
 package servers_test
 
 import (
+	"context"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/boundary/api/roles"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/recovery"
@@ -78,3 +82,67 @@ func TestRecoveryNonces(t *testing.T) {
 		assert.Len(nonces, 0)
 	}
 }
+
+func TestTagUpdatingListing(t *testing.T) {
+	require := require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := servers.NewRepository(rw, rw, testKms)
+	require.NoError(err)
+
+	srv := &servers.Server{
+		PrivateId: "test1",
+		Name:      "test1",
+		Type:      servers.ServerTypeWorker.String(),
+		Address:   "127.0.0.1",
+		Tags: map[string]*servers.TagValues{
+			"tag1": {
+				Values: []string{"value1", "value2"},
+			},
+		},
+	}
+	_, _, err = repo.UpsertServer(context.Background(), srv, servers.WithUpdateTags(true))
+	require.NoError(err)
+
+	srv = &servers.Server{
+		PrivateId: "test2",
+		Name:      "test2",
+		Type:      servers.ServerTypeWorker.String(),
+		Address:   "127.0.0.1",
+		Tags: map[string]*servers.TagValues{
+			"tag2": {
+				Values: []string{"value1", "value2"},
+			},
+		},
+	}
+	_, _, err = repo.UpsertServer(context.Background(), srv, servers.WithUpdateTags(true))
+	require.NoError(err)
+
+	// Without WithUpdateTags the stored tags must not change
+	srv.Tags = map[string]*servers.TagValues{
+		"tag3": {
+			Values: []string{"value3"},
+		},
+	}
+	_, _, err = repo.UpsertServer(context.Background(), srv)
+	require.NoError(err)
+
+	workers, err := repo.ListServers(context.Background(), servers.ServerTypeWorker)
+	require.NoError(err)
+	require.Len(workers, 2)
+
+	exp := map[string]map[string][]string{
+		"test1": {"tag1": {"value1", "value2"}},
+		"test2": {"tag2": {"value1", "value2"}},
+	}
+	for _, worker := range workers {
+		got := make(map[string][]string, len(worker.Tags))
+		for k, v := range worker.Tags {
+			got[k] = v.GetValues()
+			sort.Strings(got[k])
+		}
+		assert.Equal(t, exp[worker.Name], got)
+	}
+}
@@ -24,6 +24,10 @@ func (w *Worker) startListeners() error {
 				// We may have this in dev mode; ignore
 				continue
 
+			case "ops":
+				// Handled by the base server
+				continue
+
 			case "proxy":
 				// Do nothing; handle below
 
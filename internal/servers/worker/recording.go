@@ -0,0 +1,216 @@
+package worker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// recordingUploadChunkSize is the maximum size of each chunk of a
+	// recording sent to the controller
+	recordingUploadChunkSize = 1 << 20
+
+	recordingUploadTimeout = 5 * time.Minute
+
+	// recordingUploadRetryMin and recordingUploadRetryMax bound the delay
+	// between attempts to upload a recording, which doubles after each
+	// failed attempt
+	recordingUploadRetryMin = 5 * time.Second
+	recordingUploadRetryMax = 5 * time.Minute
+)
+
+// connRecording records a connection to a file until the connection closes
+type connRecording struct {
+	*recording.Writer
+	file         *os.File
+	sessionId    string
+	connectionId string
+	keyId        string
+}
+
+// startRecording creates the file the connection is recorded to.  The
+// recording is encrypted with a key derived from the session's private key, so
+// only the worker and the controllers can read it.
+func (w *Worker) startRecording(resp *pbs.LookupSessionResponse, connectionId string) (*connRecording, error) {
+	sessionId := resp.GetAuthorization().GetSessionId()
+	key, err := recording.DeriveKey(ed25519.PrivateKey(resp.GetAuthorization().GetPrivateKey()), connectionId)
+	if err != nil {
+		return nil, err
+	}
+	f, err := ioutil.TempFile(w.conf.RawConfig.Worker.RecordingPath, connectionId+"-*"+recording.FileExtension)
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+	rw, err := recording.NewWriter(f, recording.Header{
+		SessionId:    sessionId,
+		ConnectionId: connectionId,
+		KeyId:        resp.GetRecordingKeyId(),
+	}, key)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return &connRecording{
+		Writer:       rw,
+		file:         f,
+		sessionId:    sessionId,
+		connectionId: connectionId,
+		keyId:        resp.GetRecordingKeyId(),
+	}, nil
+}
+
+// finishRecording closes the recording and uploads it to the controller.  The
+// file is removed once uploaded and kept otherwise, so it is not lost.
+func (w *Worker) finishRecording(r *connRecording) {
+	logger := w.logger.With("session_id", r.sessionId, "connection_id", r.connectionId, "path", r.file.Name())
+	if err := r.Close(); err != nil {
+		logger.Error("error writing session recording", "error", err)
+	}
+	if err := r.file.Close(); err != nil {
+		logger.Error("error closing session recording", "error", err)
+		return
+	}
+	w.uploadRecording(r.file.Name(), recording.Header{
+		SessionId:    r.sessionId,
+		ConnectionId: r.connectionId,
+		KeyId:        r.keyId,
+	})
+}
+
+// uploadLeftoverRecordings uploads the recordings left in the recording path
+// by a previous run of the worker which could not upload them, such as when
+// the controllers were unreachable or the worker was shut down first.  The
+// files are listed before the worker starts accepting connections, so none of
+// them are still being recorded.  Uploading a chunk again is not an error, so
+// recordings which were partly uploaded are simply uploaded again.
+func (w *Worker) uploadLeftoverRecordings() {
+	dir := w.conf.RawConfig.Worker.RecordingPath
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, session.ConnectionPrefix+"_*"+recording.FileExtension))
+	if err != nil {
+		w.logger.Error("error listing leftover session recordings", "error", err)
+		return
+	}
+	if len(paths) == 0 {
+		return
+	}
+	go func() {
+		for _, path := range paths {
+			h, err := readRecordingHeader(path)
+			if err != nil {
+				w.logger.Error("error reading leftover session recording, keeping it on disk", "path", path, "error", err)
+				continue
+			}
+			w.uploadRecording(path, h)
+		}
+	}()
+}
+
+func readRecordingHeader(path string) (recording.Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return recording.Header{}, err
+	}
+	defer f.Close()
+	return recording.ReadHeader(f)
+}
+
+// uploadRecording uploads the recording at path, retrying with an increasing
+// delay until it is uploaded, the controller rejects it or the worker shuts
+// down.  The file is removed once uploaded and kept otherwise, so a recording
+// the worker could not upload before shutting down is uploaded by
+// uploadLeftoverRecordings when it starts again.
+func (w *Worker) uploadRecording(path string, h recording.Header) {
+	logger := w.logger.With("session_id", h.SessionId, "connection_id", h.ConnectionId, "path", path)
+	delay := recordingUploadRetryMin
+	for {
+		ctx, cancel := context.WithTimeout(w.baseContext, recordingUploadTimeout)
+		err := w.uploadRecordingChunks(ctx, path, h)
+		cancel()
+		if err == nil {
+			break
+		}
+		var se interface{ GRPCStatus() *status.Status }
+		if errors.As(err, &se) && se.GRPCStatus().Code() == codes.InvalidArgument {
+			logger.Error("session recording rejected by the controller, keeping it on disk", "error", err)
+			return
+		}
+		logger.Warn("error uploading session recording, retrying", "error", err, "retry_in", delay)
+		select {
+		case <-w.baseContext.Done():
+			logger.Info("worker shutting down, keeping session recording on disk until the next start")
+			return
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > recordingUploadRetryMax {
+			delay = recordingUploadRetryMax
+		}
+	}
+	if err := os.Remove(path); err != nil {
+		logger.Warn("error removing uploaded session recording", "error", err)
+	}
+	logger.Debug("session recording uploaded")
+}
+
+func (w *Worker) uploadRecordingChunks(ctx context.Context, path string, h recording.Header) error {
+	rawConn := w.controllerSessionConn.Load()
+	if rawConn == nil {
+		return errors.New("could not get a controller client")
+	}
+	conn, ok := rawConn.(pbs.SessionServiceClient)
+	if !ok {
+		return errors.New("could not cast atomic controller client to the real thing")
+	}
+	if conn == nil {
+		return errors.New("controller client is nil")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	buf := make([]byte, recordingUploadChunkSize)
+	var sequence uint32
+	for {
+		n, err := io.ReadFull(f, buf)
+		final := false
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			final = true
+		case err != nil:
+			return err
+		}
+		if _, err := conn.UploadRecordingChunk(ctx, &pbs.UploadRecordingChunkRequest{
+			SessionId:    h.SessionId,
+			ConnectionId: h.ConnectionId,
+			KeyId:        h.KeyId,
+			Sequence:     sequence,
+			Data:         buf[:n],
+			Final:        final,
+		}); err != nil {
+			return fmt.Errorf("error uploading chunk %d: %w", sequence, err)
+		}
+		if final {
+			return nil
+		}
+		sequence++
+	}
+}
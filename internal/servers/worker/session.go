@@ -13,6 +13,7 @@ import (
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/session"
+	ua "go.uber.org/atomic"
 )
 
 const (
@@ -25,6 +26,14 @@ type connInfo struct {
 	connCancel context.CancelFunc
 	status     pbs.CONNECTIONSTATUS
 	closeTime  time.Time
+
+	// bytesUp and bytesDown are updated by the proxy as data is copied and
+	// are reported in status updates and when the connection is closed
+	bytesUp   ua.Uint64
+	bytesDown ua.Uint64
+	// closeReason is set when the worker knows why the connection ended; if
+	// unset when the connection is closed, session.UnknownReason is sent
+	closeReason session.ClosedReason
 }
 
 type sessionInfo struct {
@@ -231,11 +240,24 @@ func (w *Worker) closeConnections(ctx context.Context, closeMap map[string]strin
 	w.logger.Trace("marking connections as closed", "session_and_connection_ids", fmt.Sprintf("%#v", closeMap))
 
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeMap))
-	for connId := range closeMap {
-		closeData = append(closeData, &pbs.CloseConnectionRequestData{
+	for connId, sessionId := range closeMap {
+		data := &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
 			Reason:       session.UnknownReason.String(),
-		})
+		}
+		if siRaw, ok := w.sessionInfoMap.Load(sessionId); ok {
+			si := siRaw.(*sessionInfo)
+			si.RLock()
+			if ci, ok := si.connInfoMap[connId]; ok {
+				data.BytesUp = ci.bytesUp.Load()
+				data.BytesDown = ci.bytesDown.Load()
+				if ci.closeReason != "" {
+					data.Reason = ci.closeReason.String()
+				}
+			}
+			si.RUnlock()
+		}
+		closeData = append(closeData, data)
 	}
 	closeInfo := &pbs.CloseConnectionRequest{
 		CloseRequestData: closeData,
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/metrics"
 	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"google.golang.org/grpc/resolver"
 )
@@ -48,6 +50,7 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 				// First send info as-is. We'll perform cleanup duties after we
 				// get cancel/job change info back.
 				var activeJobs []*pbs.JobStatus
+				var activeSessions, activeConnections int
 				w.sessionInfoMap.Range(func(key, value interface{}) bool {
 					var jobInfo pbs.SessionJobInfo
 					sessionId := key.(string)
@@ -55,10 +58,18 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 					si.RLock()
 					status := si.status
 					connections := make([]*pbs.Connection, 0, len(si.connInfoMap))
+					if status == pbs.SESSIONSTATUS_SESSIONSTATUS_ACTIVE {
+						activeSessions++
+					}
 					for k, v := range si.connInfoMap {
+						if v.status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+							activeConnections++
+						}
 						connections = append(connections, &pbs.Connection{
 							ConnectionId: k,
 							Status:       v.status,
+							BytesUp:      v.bytesUp.Load(),
+							BytesDown:    v.bytesDown.Load(),
 						})
 					}
 					si.RUnlock()
@@ -77,7 +88,18 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 					})
 					return true
 				})
+				metrics.SetWorkerActive(w.conf.RawConfig.Worker.Name, activeSessions, activeConnections)
+
+				var tags map[string]*servers.TagValues
+				updateTags := w.updateTags.Load()
+				if updateTags {
+					tags = make(map[string]*servers.TagValues, len(w.conf.RawConfig.Worker.Tags))
+					for k, v := range w.conf.RawConfig.Worker.Tags {
+						tags[k] = &servers.TagValues{Values: v}
+					}
+				}
 				client := w.controllerStatusConn.Load().(pbs.ServerCoordinationServiceClient)
+				statusStart := time.Now()
 				result, err := client.Status(cancelCtx, &pbs.StatusRequest{
 					Jobs: activeJobs,
 					Worker: &servers.Server{
@@ -86,12 +108,18 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 						Type:        resource.Worker.String(),
 						Description: w.conf.RawConfig.Worker.Description,
 						Address:     w.conf.RawConfig.Worker.PublicAddr,
+						Tags:        tags,
 					},
+					UpdateTags: updateTags,
 				})
+				metrics.MeasureWorkerStatusRpc(statusStart, err)
 				if err != nil {
 					w.logger.Error("error making status request to controller", "error", err)
 				} else {
 					w.logger.Trace("successfully sent status to controller")
+					if updateTags {
+						w.updateTags.Store(false)
+					}
 					addrs := make([]resolver.Address, 0, len(result.Controllers))
 					strAddrs := make([]string, 0, len(result.Controllers))
 					for _, v := range result.Controllers {
@@ -141,10 +169,17 @@ func (w *Worker) startStatusTicking(cancelCtx context.Context) {
 					case si.status == pbs.SESSIONSTATUS_SESSIONSTATUS_CANCELING,
 						si.status == pbs.SESSIONSTATUS_SESSIONSTATUS_TERMINATED,
 						time.Until(si.lookupSessionResponse.Expiration.AsTime()) < 0:
+						reason := session.ConnectionCanceled
+						if time.Until(si.lookupSessionResponse.Expiration.AsTime()) < 0 {
+							reason = session.ConnectionTimedOut
+						}
 						var toClose int
 						for k, v := range si.connInfoMap {
 							if v.closeTime.IsZero() {
 								toClose++
+								if v.closeReason == "" {
+									v.closeReason = reason
+								}
 								v.connCancel()
 								w.logger.Info("terminated connection due to cancelation or expiration", "session_id", si.id, "connection_id", k)
 								closeInfo[k] = si.id
@@ -2,14 +2,19 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net"
 	"net/url"
+	"strings"
 	"sync"
 
 	"nhooyr.io/websocket"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	ua "go.uber.org/atomic"
 )
 
 func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAddr, conn *websocket.Conn, si *sessionInfo, connectionId, endpoint string) {
@@ -28,9 +33,12 @@ func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAd
 		conn.Close(websocket.StatusInternalError, "invalid scheme for type")
 		return
 	}
-	remoteConn, err := net.Dial("tcp", sessionUrl.Host)
+	remoteConn, err := w.dialEndpoint(sessionUrl, sessionId)
 	if err != nil {
 		w.logger.Error("error dialing endpoint", "error", err, "endpoint", endpoint)
+		si.Lock()
+		si.connInfoMap[connectionId].closeReason = session.ConnectionNetworkError
+		si.Unlock()
 		conn.Close(websocket.StatusInternalError, "endpoint dialing failed")
 		return
 	}
@@ -50,6 +58,9 @@ func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAd
 	connStatus, err := w.connectConnection(connCtx, connectionInfo)
 	if err != nil {
 		w.logger.Error("error marking connection as connected", "error", err)
+		si.Lock()
+		si.connInfoMap[connectionId].closeReason = session.ConnectionSystemError
+		si.Unlock()
 		conn.Close(websocket.StatusInternalError, "failed to mark connection as connected")
 		return
 	}
@@ -57,21 +68,110 @@ func (w *Worker) handleTcpProxyV1(connCtx context.Context, clientAddr *net.TCPAd
 	si.connInfoMap[connectionId].status = connStatus
 	si.Unlock()
 
+	si.RLock()
+	ci := si.connInfoMap[connectionId]
+	lookupResp := si.lookupSessionResponse
+	si.RUnlock()
+
 	// Get a wrapped net.Conn so we can use io.Copy
 	netConn := websocket.NetConn(connCtx, conn, websocket.MessageBinary)
 
+	var toClient, toEndpoint io.Writer = netConn, tcpRemoteConn
+	if lookupResp.GetEnableSessionRecording() {
+		rec, err := w.startRecording(lookupResp, connectionId)
+		if err != nil {
+			w.logger.Error("error starting session recording", "error", err, "session_id", sessionId, "connection_id", connectionId)
+			si.Lock()
+			ci.closeReason = session.ConnectionSystemError
+			si.Unlock()
+			tcpRemoteConn.Close()
+			conn.Close(websocket.StatusInternalError, "failed to start session recording")
+			return
+		}
+		defer func() {
+			go w.finishRecording(rec)
+		}()
+		toClient = io.MultiWriter(netConn, rec.Recorder(recording.EndpointToClient))
+		toEndpoint = io.MultiWriter(tcpRemoteConn, rec.Recorder(recording.ClientToEndpoint))
+	}
+
+	var upErr, downErr error
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
 	go func() {
 		defer connWg.Done()
-		_, err := io.Copy(netConn, tcpRemoteConn)
-		w.logger.Debug("copy from client to endpoint done", "error", err)
+		_, downErr = io.Copy(&countingWriter{Writer: toClient, count: &ci.bytesDown}, tcpRemoteConn)
+		w.logger.Debug("copy from endpoint to client done", "error", downErr)
 	}()
 	go func() {
 		defer connWg.Done()
-		_, err := io.Copy(tcpRemoteConn, netConn)
-		w.logger.Debug("copy from endpoint to client done", "error", err)
+		_, upErr = io.Copy(&countingWriter{Writer: toEndpoint, count: &ci.bytesUp}, netConn)
+		w.logger.Debug("copy from client to endpoint done", "error", upErr)
 	}()
 	connWg.Wait()
 
+	reason := session.ConnectionClosedByUser
+	switch {
+	case errors.Is(connCtx.Err(), context.DeadlineExceeded):
+		reason = session.ConnectionTimedOut
+	case connCtx.Err() != nil:
+		reason = session.ConnectionCanceled
+	case isNetworkError(upErr), isNetworkError(downErr):
+		reason = session.ConnectionNetworkError
+	}
+	si.Lock()
+	// A reason set by the status loop when canceling takes precedence
+	if ci.closeReason == "" {
+		ci.closeReason = reason
+	}
+	si.Unlock()
+}
+
+// dialEndpoint connects to the host of the session's endpoint url.  If that
+// fails the alternate addresses in the url, if any, are tried in order and the
+// first connection made is returned.  The error from the last attempt is
+// returned when none of them succeed.
+func (w *Worker) dialEndpoint(sessionUrl *url.URL, sessionId string) (net.Conn, error) {
+	addrs := append([]string{sessionUrl.Host}, sessionUrl.Query()["alternate"]...)
+	var err error
+	for _, addr := range addrs {
+		var remoteConn net.Conn
+		remoteConn, err = net.Dial("tcp", addr)
+		if err == nil {
+			return remoteConn, nil
+		}
+		w.logger.Debug("error dialing endpoint address", "error", err, "session_id", sessionId, "address", addr)
+	}
+	return nil, err
+}
+
+// countingWriter adds the number of bytes written to the underlying writer to
+// count, allowing the bytes transferred to be read while a copy is in
+// progress.
+type countingWriter struct {
+	io.Writer
+	count *ua.Uint64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	c.count.Add(uint64(n))
+	return n, err
+}
+
+// isNetworkError reports whether err, returned from copying data between the
+// client and the endpoint, is something other than one side closing its
+// connection.
+func isNetworkError(err error) bool {
+	switch {
+	case err == nil,
+		errors.Is(err, io.EOF),
+		strings.Contains(err.Error(), "use of closed network connection"):
+		return false
+	}
+	switch websocket.CloseStatus(err) {
+	case websocket.StatusNormalClosure, websocket.StatusGoingAway:
+		return false
+	}
+	return true
 }
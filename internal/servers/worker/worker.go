@@ -32,6 +32,10 @@ type Worker struct {
 
 	controllerSessionConn *atomic.Value
 	sessionInfoMap        *sync.Map
+
+	// Used to indicate that the worker's tags need to be sent to the
+	// controller; cleared once a status request has succeeded
+	updateTags ua.Bool
 }
 
 func New(conf *Config) (*Worker, error) {
@@ -96,6 +100,8 @@ func (w *Worker) Start() error {
 	w.controllerResolver.Store(controllerResolver)
 	w.controllerResolverCleanup.Store(controllerResolverCleanup)
 
+	w.uploadLeftoverRecordings()
+
 	if err := w.startListeners(); err != nil {
 		return fmt.Errorf("error starting worker listeners: %w", err)
 	}
@@ -103,6 +109,7 @@ func (w *Worker) Start() error {
 		return fmt.Errorf("error making controller connections: %w", err)
 	}
 
+	w.updateTags.Store(true)
 	w.startStatusTicking(w.baseContext)
 	w.started.Store(true)
 
@@ -0,0 +1,23 @@
+package session
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// BytesWith defines the boundary data that is saved in the repo when the
+// worker reports the bytes transferred so far on an open connection.
+type BytesWith struct {
+	ConnectionId string
+	BytesUp      uint64
+	BytesDown    uint64
+}
+
+func (b BytesWith) validate() error {
+	if b.ConnectionId == "" {
+		return fmt.Errorf("missing connection id: %w", db.ErrInvalidParameter)
+	}
+	// 0 is valid for BytesUp and BytesDown
+	return nil
+}
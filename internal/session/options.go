@@ -1,6 +1,8 @@
 package session
 
 import (
+	"github.com/hashicorp/boundary/internal/audit"
+	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 )
 
@@ -21,11 +23,14 @@ type options struct {
 	withLimit          int
 	withOrder          string
 	withScopeId        string
+	withScopeIds       []string
 	withUserId         string
 	withExpirationTime *timestamp.Timestamp
 	withTestTofu       []byte
 	withListingConvert bool
 	withSessionIds     []string
+	withEventer        *audit.Eventer
+	withStartPageAfter *db.PageCursor
 }
 
 func getDefaultOptions() options {
@@ -55,6 +60,14 @@ func WithScopeId(scopeId string) Option {
 	}
 }
 
+// WithScopeIds allows specifying that any of several scope IDs must match for
+// the function.
+func WithScopeIds(scopeIds []string) Option {
+	return func(o *options) {
+		o.withScopeIds = scopeIds
+	}
+}
+
 // WithUserId allows specifying a user ID criteria for the function.
 func WithUserId(userId string) Option {
 	return func(o *options) {
@@ -89,3 +102,19 @@ func withListingConvert(withListingConvert bool) Option {
 		o.withListingConvert = withListingConvert
 	}
 }
+
+// WithEventer specifies the eventer used to emit audit events for session and
+// connection state transitions.
+func WithEventer(e *audit.Eventer) Option {
+	return func(o *options) {
+		o.withEventer = e
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
@@ -38,6 +38,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withScopeId = "o_1234"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithScopeIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScopeIds([]string{"o_1234", "o_12342"}))
+		testOpts := getDefaultOptions()
+		testOpts.withScopeIds = []string{"o_1234", "o_12342"}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithOrder", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithOrder("create_time asc"))
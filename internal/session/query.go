@@ -147,12 +147,11 @@ from
 	sessionList = `
 select * 
 from
-	(select public_id from session %s) s,
+	(select public_id from session %s order by create_time asc, public_id asc %s) s,
 	session_with_state ss
 where 
 	s.public_id = ss.public_id 
-	%s
-%s
+order by %s
 `
 
 	// termSessionUpdate is one stmt that terminates sessions for the following
@@ -231,5 +230,108 @@ where
                	end_time is null
     )
 )
+returning us.public_id, us.termination_reason
+`
+
+	// updateConnectionBytes only updates connections which haven't been
+	// closed and never lowers the counts, so a late in-flight report can't
+	// overwrite the totals the worker sent when it closed the connection.
+	updateConnectionBytes = `
+update session_connection
+set
+	bytes_up = $2,
+	bytes_down = $3
+where
+	public_id = $1 and
+	closed_reason is null and
+	coalesce(bytes_up, 0) <= $2 and
+	coalesce(bytes_down, 0) <= $3
+`
+
+	// sessionsToRewrapQuery returns a page of sessions with a tofu token
+	// which is not known to be encrypted with the current database key
+	// version of the session's scope, starting after the given session id.
+	sessionsToRewrapQuery = `
+select public_id, tofu_token, coalesce(key_id, ''), scope_id
+from session
+where
+	public_id > $1
+	and tofu_token is not null
+	and scope_id is not null
+	and (
+		key_id is null
+		or key_id not in (
+			select key_version_id
+			from kms_current_key_version
+			where purpose = 'database'
+		)
+	)
+order by public_id
+limit $2;
+`
+
+	// rewrapSessionQuery replaces the encrypted tofu token of a session if it
+	// has not changed in the meantime.
+	rewrapSessionQuery = `
+update session
+set
+	tofu_token = $1,
+	key_id = $2
+where
+	public_id = $3
+	and tofu_token = $4;
+`
+
+	// insertRecordingQuery creates the recording of a connection the first
+	// time one of its chunks is uploaded.
+	insertRecordingQuery = `
+insert into session_recording
+	(connection_id, session_id, key_id)
+values
+	($1, $2, $3)
+on conflict (connection_id) do nothing;
+`
+
+	// insertRecordingChunkQuery stores a chunk of a recording.  Chunks are
+	// immutable, so a chunk uploaded again by a worker retrying is ignored.
+	insertRecordingChunkQuery = `
+insert into session_recording_chunk
+	(connection_id, sequence, data)
+values
+	($1, $2, $3)
+on conflict (connection_id, sequence) do nothing;
+`
+
+	completeRecordingQuery = `
+update session_recording
+set
+	complete = true
+where
+	connection_id = $1
+	and complete = false;
+`
+
+	listRecordingsQuery = `
+select connection_id, session_id, key_id, complete, create_time
+from session_recording
+where
+	session_id = $1
+order by create_time, connection_id;
+`
+
+	lookupRecordingQuery = `
+select connection_id, session_id, key_id, complete, create_time
+from session_recording
+where
+	session_id = $1
+	and connection_id = $2;
+`
+
+	recordingChunksQuery = `
+select data
+from session_recording_chunk
+where
+	connection_id = $1
+order by sequence;
 `
 )
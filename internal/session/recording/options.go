@@ -0,0 +1,81 @@
+package recording
+
+import "time"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withChunkSize int
+	withNow       func() time.Time
+	withSpeed     float64
+	withMaxIdle   time.Duration
+	withSleep     func(time.Duration)
+}
+
+func getDefaultOptions() options {
+	return options{
+		withChunkSize: 32 << 10,
+		withNow:       time.Now,
+		withSpeed:     1,
+		withMaxIdle:   2 * time.Second,
+		withSleep:     time.Sleep,
+	}
+}
+
+// WithChunkSize provides an optional number of bytes of frames buffered by a
+// Writer before they are written as a chunk.
+func WithChunkSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.withChunkSize = size
+		}
+	}
+}
+
+// WithNow provides an optional clock used by a Writer to timestamp frames.
+func WithNow(now func() time.Time) Option {
+	return func(o *options) {
+		if now != nil {
+			o.withNow = now
+		}
+	}
+}
+
+// WithSpeed provides an optional playback speed for Replay.  A speed of 2
+// replays the recording twice as fast as it was recorded; a speed of zero or
+// less replays it without any delays.
+func WithSpeed(speed float64) Option {
+	return func(o *options) {
+		o.withSpeed = speed
+	}
+}
+
+// WithMaxIdle provides an optional limit on the delay between frames during
+// Replay, so long periods of inactivity are skipped.  Zero or less means no
+// limit.
+func WithMaxIdle(d time.Duration) Option {
+	return func(o *options) {
+		o.withMaxIdle = d
+	}
+}
+
+// WithSleep provides an optional function used by Replay to wait between
+// frames.
+func WithSleep(sleep func(time.Duration)) Option {
+	return func(o *options) {
+		if sleep != nil {
+			o.withSleep = sleep
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Reader reads the frames of a recording
+type Reader struct {
+	r        *bufio.Reader
+	header   Header
+	aead     cipher.AEAD
+	aad      []byte
+	chunk    *bytes.Reader
+	sequence uint64
+}
+
+// NewReader reads the header of a recording from r and returns a Reader for
+// its frames.  key must be provided if the recording is encrypted and is
+// ignored otherwise.
+func NewReader(r io.Reader, key []byte) (*Reader, error) {
+	const op = "recording.NewReader"
+	if r == nil {
+		return nil, fmt.Errorf("%s: missing reader", op)
+	}
+	br := bufio.NewReader(r)
+	h, err := readHeader(br)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rr := &Reader{r: br, header: h}
+	rr.aad = []byte(rr.header.ConnectionId)
+	if rr.header.Encrypted {
+		if key == nil {
+			return nil, fmt.Errorf("%s: %w", op, ErrMissingKey)
+		}
+		if rr.aead, err = newAead(key); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return rr, nil
+}
+
+// ReadHeader reads the header of a recording from r.  The header is not
+// encrypted, so no key is needed to read it.
+func ReadHeader(r io.Reader) (Header, error) {
+	const op = "recording.ReadHeader"
+	if r == nil {
+		return Header{}, fmt.Errorf("%s: missing reader", op)
+	}
+	h, err := readHeader(r)
+	if err != nil {
+		return Header{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return h, nil
+}
+
+// readHeader reads the magic and the header block of a recording
+func readHeader(r io.Reader) (Header, error) {
+	var m [len(magic)]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		return Header{}, fmt.Errorf("unable to read magic: %v: %w", err, ErrInvalidRecording)
+	}
+	if m != magic {
+		return Header{}, fmt.Errorf("bad magic: %w", ErrInvalidRecording)
+	}
+	hdr, err := readBlock(r)
+	if err != nil {
+		return Header{}, fmt.Errorf("unable to read header: %w", err)
+	}
+	var h Header
+	if err := json.Unmarshal(hdr, &h); err != nil {
+		return Header{}, fmt.Errorf("unable to decode header: %v: %w", err, ErrInvalidRecording)
+	}
+	if h.Version != Version {
+		return Header{}, fmt.Errorf("unsupported recording version %d", h.Version)
+	}
+	return h, nil
+}
+
+// Header returns the header of the recording
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next frame of the recording, or io.EOF once all frames
+// have been read.  A recording which ends part way through a chunk returns
+// io.ErrUnexpectedEOF.
+func (r *Reader) Next() (*Frame, error) {
+	const op = "recording.(Reader).Next"
+	for r.chunk == nil || r.chunk.Len() == 0 {
+		chunk, err := readBlock(r.r)
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if r.aead != nil {
+			if chunk, err = r.aead.Open(chunk[:0], chunkNonce(r.aead.NonceSize(), r.sequence), chunk, r.aad); err != nil {
+				return nil, fmt.Errorf("%s: unable to decrypt chunk %d: %v: %w", op, r.sequence, err, ErrInvalidRecording)
+			}
+		}
+		r.sequence++
+		r.chunk = bytes.NewReader(chunk)
+	}
+
+	d, err := r.chunk.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidRecording)
+	}
+	offset, err := binary.ReadUvarint(r.chunk)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to read offset: %w", op, ErrInvalidRecording)
+	}
+	size, err := binary.ReadUvarint(r.chunk)
+	if err != nil || size > uint64(r.chunk.Len()) {
+		return nil, fmt.Errorf("%s: unable to read data length: %w", op, ErrInvalidRecording)
+	}
+	f := &Frame{
+		Direction: Direction(d),
+		Offset:    time.Duration(offset),
+		Data:      make([]byte, size),
+	}
+	if _, err := io.ReadFull(r.chunk, f.Data); err != nil {
+		return nil, fmt.Errorf("%s: unable to read data: %w", op, ErrInvalidRecording)
+	}
+	return f, nil
+}
+
+// Decrypt reads the encrypted recording from src and writes it to dst
+// without encryption.  Recordings that are not encrypted are copied as is.
+func Decrypt(dst io.Writer, src io.Reader, key []byte) error {
+	const op = "recording.Decrypt"
+	r, err := NewReader(src, key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	h := r.Header()
+	h.KeyId = ""
+	w, err := NewWriter(dst, h, nil, WithNow(func() time.Time { return h.StartTime }))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	for {
+		f, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Keep what could be read of an incomplete recording
+			if errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		w.now = func() time.Time { return h.StartTime.Add(f.Offset) }
+		if err := w.WriteFrame(f.Direction, f.Data); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// readBlock reads a length prefixed block
+func readBlock(r io.Reader) ([]byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(l[:])
+	if size > maxChunkSize {
+		return nil, fmt.Errorf("block of %d bytes is too large: %w", size, ErrInvalidRecording)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,146 @@
+// Package recording implements the on-disk format used by workers to record
+// the data proxied for a session connection.
+//
+// A recording starts with a header identifying the session and connection,
+// followed by chunks.  Each chunk holds one or more frames, and each frame
+// holds the data read from one side of the connection along with the offset
+// from the start of the recording at which it was read.  When a key is
+// provided, each chunk is sealed with AES-GCM so a recording can be stored
+// and uploaded without exposing the proxied data.
+//
+//	magic (8 bytes) | header length (uint32) | header (JSON)
+//	chunk length (uint32) | chunk
+//	...
+//
+// A chunk decodes to a sequence of frames:
+//
+//	direction (1 byte) | offset in ns (uvarint) | data length (uvarint) | data
+package recording
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// Version is the version of the recording format written by this package
+	Version = 1
+
+	// FileExtension is the extension used for recording files
+	FileExtension = ".rec"
+
+	// keySize is the size of the AES-256 key derived for a recording
+	keySize = 32
+
+	// maxChunkSize bounds the size of a chunk accepted by the reader, so that
+	// a corrupt length does not cause an arbitrarily large allocation
+	maxChunkSize = 16 << 20
+
+	keyDerivationInfo = "boundary session recording"
+)
+
+var magic = [8]byte{'B', 'N', 'D', 'Y', 'R', 'E', 'C', 0}
+
+var (
+	// ErrInvalidRecording is returned when the data read is not a recording
+	// or is corrupt.
+	ErrInvalidRecording = errors.New("invalid recording")
+
+	// ErrMissingKey is returned when reading an encrypted recording without
+	// a key.
+	ErrMissingKey = errors.New("recording is encrypted and no key was provided")
+)
+
+// Direction identifies the side of the connection that data was read from
+type Direction uint8
+
+const (
+	// ClientToEndpoint is data sent by the client to the endpoint
+	ClientToEndpoint Direction = 1
+	// EndpointToClient is data sent by the endpoint to the client
+	EndpointToClient Direction = 2
+)
+
+func (d Direction) String() string {
+	switch d {
+	case ClientToEndpoint:
+		return "client-to-endpoint"
+	case EndpointToClient:
+		return "endpoint-to-client"
+	}
+	return "unknown"
+}
+
+// Header identifies what was recorded and how the recording is protected
+type Header struct {
+	// Version of the recording format
+	Version int `json:"version"`
+	// SessionId of the recorded session
+	SessionId string `json:"session_id"`
+	// ConnectionId of the recorded connection
+	ConnectionId string `json:"connection_id"`
+	// KeyId is the ID of the version of the scope's sessions key from which
+	// the recording key was derived
+	KeyId string `json:"key_id,omitempty"`
+	// StartTime is the time the recording started; frame offsets are
+	// relative to it
+	StartTime time.Time `json:"start_time"`
+	// Encrypted is true if the chunks are sealed with the recording key
+	Encrypted bool `json:"encrypted"`
+}
+
+// Frame is data read from one side of the connection
+type Frame struct {
+	Direction Direction
+	// Offset from the start of the recording at which the data was read
+	Offset time.Duration
+	Data   []byte
+}
+
+// DeriveKey derives the key used to encrypt the recording of a connection
+// from the private key of the connection's session.  Both the worker, which
+// receives the private key when looking up the session, and the controller,
+// which can derive the private key again from the scope's sessions key, can
+// derive it.
+func DeriveKey(privKey ed25519.PrivateKey, connectionId string) ([]byte, error) {
+	if len(privKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("derive recording key: invalid private key")
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("derive recording key: missing connection id")
+	}
+	reader := hkdf.New(sha256.New, privKey.Seed(), []byte(connectionId), []byte(keyDerivationInfo))
+	key := make([]byte, keySize)
+	if _, err := io.ReadFull(reader, key); err != nil {
+		return nil, fmt.Errorf("derive recording key: %w", err)
+	}
+	return key, nil
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("invalid recording key length %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce used to seal the chunk at the given position.
+// Each recording has its own key, so the position is unique for the key.
+func chunkNonce(size int, sequence uint64) []byte {
+	nonce := make([]byte, size)
+	binary.BigEndian.PutUint64(nonce[size-8:], sequence)
+	return nonce
+}
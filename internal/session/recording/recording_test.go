@@ -0,0 +1,234 @@
+package recording
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(t *testing.T, connectionId string) []byte {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(bytes.NewReader(bytes.Repeat([]byte{1}, 32)))
+	require.NoError(t, err)
+	key, err := DeriveKey(priv, connectionId)
+	require.NoError(t, err)
+	return key
+}
+
+// testClock returns a clock which advances by step every time it is read
+func testClock(step time.Duration) func() time.Time {
+	now := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(step)
+		return now
+	}
+}
+
+func readAll(t *testing.T, r *Reader) []*Frame {
+	t.Helper()
+	var frames []*Frame
+	for {
+		f, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return frames
+		}
+		require.NoError(t, err)
+		frames = append(frames, f)
+	}
+}
+
+func TestDeriveKey(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		k1, err := DeriveKey(priv, "sc_1234567890")
+		require.NoError(err)
+		assert.Len(k1, keySize)
+		again, err := DeriveKey(priv, "sc_1234567890")
+		require.NoError(err)
+		assert.Equal(k1, again)
+		k2, err := DeriveKey(priv, "sc_0987654321")
+		require.NoError(err)
+		assert.NotEqual(k1, k2)
+	})
+	t.Run("missing-connection-id", func(t *testing.T) {
+		_, err := DeriveKey(priv, "")
+		assert.Error(t, err)
+	})
+	t.Run("invalid-private-key", func(t *testing.T) {
+		_, err := DeriveKey(priv[:10], "sc_1234567890")
+		assert.Error(t, err)
+	})
+}
+
+func TestWriterReader(t *testing.T) {
+	const connectionId = "sc_1234567890"
+	frames := []struct {
+		d    Direction
+		data string
+	}{
+		{ClientToEndpoint, "ls -l\n"},
+		{EndpointToClient, "total 0\n"},
+		{EndpointToClient, "$ "},
+		{ClientToEndpoint, "exit\n"},
+	}
+	tests := []struct {
+		name      string
+		key       []byte
+		chunkSize int
+	}{
+		{name: "plaintext"},
+		{name: "encrypted", key: testKey(t, connectionId)},
+		{name: "encrypted-chunk-per-frame", key: testKey(t, connectionId), chunkSize: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, Header{SessionId: "s_1234567890", ConnectionId: connectionId, KeyId: "kms_1234567890"}, tt.key,
+				WithNow(testClock(time.Second)), WithChunkSize(tt.chunkSize))
+			require.NoError(err)
+			for _, f := range frames {
+				require.NoError(w.WriteFrame(f.d, []byte(f.data)))
+			}
+			require.NoError(w.Close())
+			assert.Error(w.WriteFrame(ClientToEndpoint, []byte("late")))
+
+			if tt.key != nil {
+				assert.NotContains(buf.String(), "total 0")
+				_, err := NewReader(bytes.NewReader(buf.Bytes()), nil)
+				assert.True(errors.Is(err, ErrMissingKey))
+			}
+			h, err := ReadHeader(bytes.NewReader(buf.Bytes()))
+			require.NoError(err)
+			assert.Equal(connectionId, h.ConnectionId, "the header is read without the key")
+
+			r, err := NewReader(bytes.NewReader(buf.Bytes()), tt.key)
+			require.NoError(err)
+			h = r.Header()
+			assert.Equal(Version, h.Version)
+			assert.Equal("s_1234567890", h.SessionId)
+			assert.Equal(connectionId, h.ConnectionId)
+			assert.Equal("kms_1234567890", h.KeyId)
+			assert.Equal(tt.key != nil, h.Encrypted)
+
+			got := readAll(t, r)
+			require.Len(got, len(frames))
+			for i, f := range frames {
+				assert.Equal(f.d, got[i].Direction)
+				assert.Equal(f.data, string(got[i].Data))
+				assert.Equal(time.Duration(i+1)*time.Second, got[i].Offset)
+			}
+		})
+	}
+}
+
+func TestReader_Invalid(t *testing.T) {
+	const connectionId = "sc_1234567890"
+	key := testKey(t, connectionId)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{ConnectionId: connectionId}, key, WithChunkSize(1))
+	require.NoError(t, err)
+	require.NoError(t, w.WriteFrame(EndpointToClient, []byte("hello")))
+	require.NoError(t, w.WriteFrame(EndpointToClient, []byte("world")))
+	require.NoError(t, w.Close())
+	rec := buf.Bytes()
+
+	t.Run("not-a-recording", func(t *testing.T) {
+		_, err := NewReader(bytes.NewReader([]byte("SSH-2.0-OpenSSH_8.4\r\n")), key)
+		assert.True(t, errors.Is(err, ErrInvalidRecording))
+	})
+	t.Run("wrong-key", func(t *testing.T) {
+		r, err := NewReader(bytes.NewReader(rec), testKey(t, "sc_0987654321"))
+		require.NoError(t, err)
+		_, err = r.Next()
+		assert.True(t, errors.Is(err, ErrInvalidRecording))
+	})
+	t.Run("truncated", func(t *testing.T) {
+		r, err := NewReader(bytes.NewReader(rec[:len(rec)-3]), key)
+		require.NoError(t, err)
+		f, err := r.Next()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(f.Data))
+		_, err = r.Next()
+		assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+	})
+	t.Run("reordered-chunks", func(t *testing.T) {
+		r, err := NewReader(bytes.NewReader(rec), key)
+		require.NoError(t, err)
+		r.sequence = 1
+		_, err = r.Next()
+		assert.True(t, errors.Is(err, ErrInvalidRecording))
+	})
+}
+
+func TestWriter_Recorder(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Header{ConnectionId: "sc_1234567890"}, nil, WithChunkSize(64))
+	require.NoError(err)
+
+	// Both directions are recorded concurrently, as by the worker's proxy
+	var wg sync.WaitGroup
+	for _, d := range []Direction{ClientToEndpoint, EndpointToClient} {
+		wg.Add(1)
+		go func(d Direction) {
+			defer wg.Done()
+			out := io.MultiWriter(ioutil.Discard, w.Recorder(d))
+			for i := 0; i < 100; i++ {
+				_, err := out.Write([]byte("0123456789"))
+				assert.NoError(err)
+			}
+		}(d)
+	}
+	wg.Wait()
+	require.NoError(w.Close())
+
+	r, err := NewReader(&buf, nil)
+	require.NoError(err)
+	counts := map[Direction]int{}
+	for _, f := range readAll(t, r) {
+		counts[f.Direction] += len(f.Data)
+	}
+	assert.Equal(map[Direction]int{ClientToEndpoint: 1000, EndpointToClient: 1000}, counts)
+}
+
+func TestDecrypt(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	const connectionId = "sc_1234567890"
+	key := testKey(t, connectionId)
+	var enc bytes.Buffer
+	w, err := NewWriter(&enc, Header{SessionId: "s_1234567890", ConnectionId: connectionId, KeyId: "kms_1234567890"}, key, WithNow(testClock(time.Second)), WithChunkSize(1))
+	require.NoError(err)
+	require.NoError(w.WriteFrame(ClientToEndpoint, []byte("hello")))
+	require.NoError(w.WriteFrame(EndpointToClient, []byte("world")))
+	require.NoError(w.Close())
+
+	var dec bytes.Buffer
+	require.NoError(Decrypt(&dec, bytes.NewReader(enc.Bytes()), key))
+	assert.Contains(dec.String(), "world")
+
+	r, err := NewReader(&dec, nil)
+	require.NoError(err)
+	assert.False(r.Header().Encrypted)
+	assert.Empty(r.Header().KeyId)
+	assert.Equal(connectionId, r.Header().ConnectionId)
+	frames := readAll(t, r)
+	require.Len(frames, 2)
+	assert.Equal(ClientToEndpoint, frames[0].Direction)
+	assert.Equal(time.Second, frames[0].Offset)
+	assert.Equal("world", string(frames[1].Data))
+	assert.Equal(2*time.Second, frames[1].Offset)
+
+	require.Error(Decrypt(&dec, bytes.NewReader(enc.Bytes()), testKey(t, "sc_0987654321")))
+}
@@ -0,0 +1,152 @@
+package recording
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Protocol identifies the protocol spoken over a recorded connection, as far
+// as replay is concerned.
+type Protocol string
+
+const (
+	// ProtocolSsh is an SSH connection.  Its payload is encrypted end to end
+	// between the client and the endpoint, so only the identification
+	// banners can be replayed.
+	ProtocolSsh Protocol = "ssh"
+	// ProtocolTerminal is any other stream, whose data sent by the endpoint
+	// is replayed as terminal output.
+	ProtocolTerminal Protocol = "terminal"
+)
+
+// sshBannerPrefix starts the identification string sent first by both sides
+// of an SSH connection (RFC 4253, section 4.2).
+var sshBannerPrefix = []byte("SSH-")
+
+// Summary describes a replayed recording
+type Summary struct {
+	Protocol Protocol
+	// Duration between the start of the recording and its last frame
+	Duration time.Duration
+	// BytesUp is the number of bytes sent by the client
+	BytesUp uint64
+	// BytesDown is the number of bytes sent by the endpoint
+	BytesDown uint64
+	// ClientBanner and EndpointBanner are the SSH identification strings of
+	// each side, for SSH recordings
+	ClientBanner   string
+	EndpointBanner string
+}
+
+// Replay writes the recording read by r to out as it would have appeared on
+// the client's terminal, waiting between frames to reproduce the timing of the
+// recording.  SSH connections cannot be replayed because their payload is
+// encrypted by SSH, so for those only a description of the connection is
+// written.  WithSpeed, WithMaxIdle and WithSleep options are supported.
+func Replay(out io.Writer, r *Reader, opt ...Option) (*Summary, error) {
+	const op = "recording.Replay"
+	if out == nil {
+		return nil, fmt.Errorf("%s: missing writer", op)
+	}
+	if r == nil {
+		return nil, fmt.Errorf("%s: missing reader", op)
+	}
+	opts := getOpts(opt...)
+
+	s := &Summary{}
+	var clientStart, endpointStart []byte
+	var last time.Duration
+	for {
+		f, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return s, fmt.Errorf("%s: %w", op, err)
+		}
+		switch f.Direction {
+		case ClientToEndpoint:
+			s.BytesUp += uint64(len(f.Data))
+			clientStart = appendUpTo(clientStart, f.Data, 255)
+		case EndpointToClient:
+			s.BytesDown += uint64(len(f.Data))
+			endpointStart = appendUpTo(endpointStart, f.Data, 255)
+		}
+		s.Duration = f.Offset
+
+		if s.Protocol == "" {
+			s.Protocol = detectProtocol(f)
+		}
+		if s.Protocol != ProtocolTerminal || f.Direction != EndpointToClient {
+			continue
+		}
+		if delay := replayDelay(f.Offset-last, opts); delay > 0 {
+			opts.withSleep(delay)
+		}
+		last = f.Offset
+		if _, err := out.Write(f.Data); err != nil {
+			return s, fmt.Errorf("%s: unable to write output: %w", op, err)
+		}
+	}
+
+	if s.Protocol == "" {
+		s.Protocol = ProtocolTerminal
+	}
+	if s.Protocol == ProtocolSsh {
+		s.ClientBanner = sshBanner(clientStart)
+		s.EndpointBanner = sshBanner(endpointStart)
+		h := r.Header()
+		if _, err := fmt.Fprintf(out,
+			"SSH connection %s of session %s, started %s and lasting %s.\n"+
+				"Client: %s\nEndpoint: %s\n"+
+				"%d bytes sent by the client and %d bytes sent by the endpoint.\n"+
+				"The SSH protocol encrypts the session's data, so it cannot be replayed.\n",
+			h.ConnectionId, h.SessionId, h.StartTime.Format(time.RFC3339), s.Duration.Round(time.Millisecond),
+			s.ClientBanner, s.EndpointBanner, s.BytesUp, s.BytesDown); err != nil {
+			return s, fmt.Errorf("%s: unable to write output: %w", op, err)
+		}
+	}
+	return s, nil
+}
+
+// detectProtocol identifies the protocol from the first frame sent by either
+// side.  Both sides of an SSH connection start by sending their banner.
+func detectProtocol(f *Frame) Protocol {
+	if bytes.HasPrefix(f.Data, sshBannerPrefix) {
+		return ProtocolSsh
+	}
+	return ProtocolTerminal
+}
+
+func replayDelay(gap time.Duration, opts options) time.Duration {
+	if opts.withSpeed <= 0 || gap <= 0 {
+		return 0
+	}
+	delay := time.Duration(float64(gap) / opts.withSpeed)
+	if opts.withMaxIdle > 0 && delay > opts.withMaxIdle {
+		delay = opts.withMaxIdle
+	}
+	return delay
+}
+
+// sshBanner returns the identification string at the start of data, without
+// its line ending.
+func sshBanner(data []byte) string {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[:i]
+	}
+	return string(bytes.TrimRight(data, "\r"))
+}
+
+func appendUpTo(dst, src []byte, max int) []byte {
+	if room := max - len(dst); room > 0 {
+		if len(src) > room {
+			src = src[:room]
+		}
+		dst = append(dst, src...)
+	}
+	return dst
+}
@@ -0,0 +1,147 @@
+package recording
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplay(t *testing.T) {
+	type frame struct {
+		d    Direction
+		data string
+	}
+	tests := []struct {
+		name       string
+		frames     []frame
+		opt        []Option
+		wantOutput []string
+		wantSleeps []time.Duration
+		want       Summary
+	}{
+		{
+			name: "terminal",
+			frames: []frame{
+				{EndpointToClient, "login: "},
+				{ClientToEndpoint, "user\n"},
+				{EndpointToClient, "$ "},
+			},
+			wantOutput: []string{"login: $ "},
+			wantSleeps: []time.Duration{time.Second, 2 * time.Second},
+			want: Summary{
+				Protocol:  ProtocolTerminal,
+				Duration:  3 * time.Second,
+				BytesUp:   5,
+				BytesDown: 9,
+			},
+		},
+		{
+			name: "terminal-fast",
+			frames: []frame{
+				{EndpointToClient, "login: "},
+				{ClientToEndpoint, "user\n"},
+				{EndpointToClient, "$ "},
+			},
+			opt:        []Option{WithSpeed(4)},
+			wantOutput: []string{"login: $ "},
+			wantSleeps: []time.Duration{250 * time.Millisecond, 500 * time.Millisecond},
+			want: Summary{
+				Protocol:  ProtocolTerminal,
+				Duration:  3 * time.Second,
+				BytesUp:   5,
+				BytesDown: 9,
+			},
+		},
+		{
+			name: "terminal-max-idle",
+			frames: []frame{
+				{EndpointToClient, "login: "},
+				{ClientToEndpoint, "user\n"},
+				{EndpointToClient, "$ "},
+			},
+			opt:        []Option{WithMaxIdle(1500 * time.Millisecond)},
+			wantOutput: []string{"login: $ "},
+			wantSleeps: []time.Duration{time.Second, 1500 * time.Millisecond},
+			want: Summary{
+				Protocol:  ProtocolTerminal,
+				Duration:  3 * time.Second,
+				BytesUp:   5,
+				BytesDown: 9,
+			},
+		},
+		{
+			name: "terminal-no-delay",
+			frames: []frame{
+				{EndpointToClient, "login: "},
+				{EndpointToClient, "$ "},
+			},
+			opt:        []Option{WithSpeed(0)},
+			wantOutput: []string{"login: $ "},
+			want: Summary{
+				Protocol:  ProtocolTerminal,
+				Duration:  2 * time.Second,
+				BytesDown: 9,
+			},
+		},
+		{
+			name: "ssh",
+			frames: []frame{
+				{ClientToEndpoint, "SSH-2.0-OpenSSH_8.4\r\n"},
+				{EndpointToClient, "SSH-2.0-OpenSSH_7.9p1 Debian-10\r\n\x00\x00\x01"},
+				{ClientToEndpoint, "\x00\x00\x02\x00"},
+			},
+			wantOutput: []string{
+				"SSH connection sc_1234567890 of session s_1234567890",
+				"Client: SSH-2.0-OpenSSH_8.4\n",
+				"Endpoint: SSH-2.0-OpenSSH_7.9p1 Debian-10\n",
+				"25 bytes sent by the client and 36 bytes sent by the endpoint",
+				"cannot be replayed",
+			},
+			want: Summary{
+				Protocol:       ProtocolSsh,
+				Duration:       3 * time.Second,
+				BytesUp:        25,
+				BytesDown:      36,
+				ClientBanner:   "SSH-2.0-OpenSSH_8.4",
+				EndpointBanner: "SSH-2.0-OpenSSH_7.9p1 Debian-10",
+			},
+		},
+		{
+			name: "empty",
+			want: Summary{
+				Protocol: ProtocolTerminal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			var rec bytes.Buffer
+			w, err := NewWriter(&rec, Header{SessionId: "s_1234567890", ConnectionId: "sc_1234567890"}, nil, WithNow(testClock(time.Second)))
+			require.NoError(err)
+			for _, f := range tt.frames {
+				require.NoError(w.WriteFrame(f.d, []byte(f.data)))
+			}
+			require.NoError(w.Close())
+			r, err := NewReader(&rec, nil)
+			require.NoError(err)
+
+			var sleeps []time.Duration
+			opt := append([]Option{WithSleep(func(d time.Duration) { sleeps = append(sleeps, d) })}, tt.opt...)
+			var out bytes.Buffer
+			got, err := Replay(&out, r, opt...)
+			require.NoError(err)
+			assert.Equal(tt.want, *got)
+			assert.Equal(tt.wantSleeps, sleeps)
+			for _, o := range tt.wantOutput {
+				assert.Contains(out.String(), o)
+			}
+			if len(tt.wantOutput) == 0 {
+				assert.Empty(out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package recording
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Writer writes a recording.  Frames are buffered and written as a chunk once
+// the buffer reaches the chunk size, or when the Writer is flushed or closed.
+// It is safe for concurrent use, so both directions of a connection can be
+// recorded by the goroutines copying them.
+type Writer struct {
+	l sync.Mutex
+
+	w         io.Writer
+	aead      cipher.AEAD
+	aad       []byte
+	start     time.Time
+	now       func() time.Time
+	chunkSize int
+	buf       bytes.Buffer
+	sequence  uint64
+	err       error
+	closed    bool
+}
+
+// NewWriter writes the header of a recording to w and returns a Writer for
+// its frames.  If key is not nil the chunks are encrypted with it; see
+// DeriveKey.  The version, start time and encrypted fields of the header are
+// set by NewWriter.  WithChunkSize and WithNow options are supported.
+func NewWriter(w io.Writer, h Header, key []byte, opt ...Option) (*Writer, error) {
+	const op = "recording.NewWriter"
+	if w == nil {
+		return nil, fmt.Errorf("%s: missing writer", op)
+	}
+	if h.ConnectionId == "" {
+		return nil, fmt.Errorf("%s: missing connection id", op)
+	}
+	opts := getOpts(opt...)
+	rw := &Writer{
+		w:         w,
+		now:       opts.withNow,
+		chunkSize: opts.withChunkSize,
+		aad:       []byte(h.ConnectionId),
+	}
+	if key != nil {
+		var err error
+		if rw.aead, err = newAead(key); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	rw.start = rw.now()
+	h.Version = Version
+	h.StartTime = rw.start.UTC()
+	h.Encrypted = rw.aead != nil
+
+	hdr, err := json.Marshal(h)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to encode header: %w", op, err)
+	}
+	out := make([]byte, 0, len(magic)+4+len(hdr))
+	out = append(out, magic[:]...)
+	out = appendUint32(out, uint32(len(hdr)))
+	out = append(out, hdr...)
+	if _, err := w.Write(out); err != nil {
+		return nil, fmt.Errorf("%s: unable to write header: %w", op, err)
+	}
+	return rw, nil
+}
+
+// WriteFrame records data read from the given side of the connection.  The
+// data is copied, so the caller may reuse it.
+func (w *Writer) WriteFrame(d Direction, data []byte) error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	switch {
+	case w.err != nil:
+		return w.err
+	case w.closed:
+		return fmt.Errorf("recording.(Writer).WriteFrame: writer is closed")
+	}
+
+	var hdr [1 + 2*binary.MaxVarintLen64]byte
+	hdr[0] = byte(d)
+	n := 1
+	n += binary.PutUvarint(hdr[n:], uint64(w.now().Sub(w.start)))
+	n += binary.PutUvarint(hdr[n:], uint64(len(data)))
+	w.buf.Write(hdr[:n])
+	w.buf.Write(data)
+	if w.buf.Len() >= w.chunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// Recorder returns an io.Writer which records everything written to it as
+// frames in the given direction.  It never returns an error, so it can be
+// used with io.MultiWriter without a failed recording interrupting the
+// connection being recorded; the error is returned by Close instead.
+func (w *Writer) Recorder(d Direction) io.Writer {
+	return &recorder{w: w, d: d}
+}
+
+type recorder struct {
+	w *Writer
+	d Direction
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	_ = r.w.WriteFrame(r.d, p)
+	return len(p), nil
+}
+
+// Flush writes any buffered frames as a chunk
+func (w *Writer) Flush() error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	return w.flush()
+}
+
+// Close flushes any buffered frames.  It does not close the underlying
+// writer.  It returns the first error encountered while recording.
+func (w *Writer) Close() error {
+	w.l.Lock()
+	defer w.l.Unlock()
+	if w.closed {
+		return w.err
+	}
+	w.closed = true
+	if w.err != nil {
+		return w.err
+	}
+	return w.flush()
+}
+
+// flush must be called with the lock held
+func (w *Writer) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	chunk := w.buf.Bytes()
+	if w.aead != nil {
+		chunk = w.aead.Seal(nil, chunkNonce(w.aead.NonceSize(), w.sequence), chunk, w.aad)
+	}
+	out := make([]byte, 0, 4+len(chunk))
+	out = appendUint32(out, uint32(len(chunk)))
+	out = append(out, chunk...)
+	if _, err := w.w.Write(out); err != nil {
+		w.err = fmt.Errorf("recording: unable to write chunk: %w", err)
+		return w.err
+	}
+	w.sequence++
+	w.buf.Reset()
+	return nil
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var tmp [4]byte
+	binary.BigEndian.PutUint32(tmp[:], v)
+	return append(b, tmp[:]...)
+}
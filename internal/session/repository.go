@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/kms"
 )
@@ -21,12 +22,16 @@ type Repository struct {
 	writer db.Writer
 	kms    *kms.Kms
 
+	// eventer emits audit events for state transitions; it may be nil
+	eventer *audit.Eventer
+
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
 }
 
 // NewRepository creates a new session Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations and
+// WithEventer which sets the eventer used to audit state transitions.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	if r == nil {
 		return nil, errors.New("error creating db repository with nil reader")
@@ -46,6 +51,7 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		reader:       r,
 		writer:       w,
 		kms:          kms,
+		eventer:      opts.withEventer,
 		defaultLimit: opts.withLimit,
 	}, nil
 }
@@ -139,3 +145,29 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionsWithState []
 	sessions = append(sessions, workingSession)
 	return sessions, nil
 }
+
+// auditSession emits an audit event for a session transitioning to status.
+func (r *Repository) auditSession(ctx context.Context, sessionId string, status Status, reason string) {
+	r.eventer.Audit(ctx, &audit.Event{
+		Type: audit.SessionStateChange,
+		Session: &audit.Session{
+			SessionId: sessionId,
+			Status:    status.String(),
+			Reason:    reason,
+		},
+	})
+}
+
+// auditConnection emits an audit event for a session connection
+// transitioning to status.
+func (r *Repository) auditConnection(ctx context.Context, sessionId, connectionId string, status ConnectionStatus, reason string) {
+	r.eventer.Audit(ctx, &audit.Event{
+		Type: audit.ConnectionStateChange,
+		Session: &audit.Session{
+			SessionId:    sessionId,
+			ConnectionId: connectionId,
+			Status:       status.String(),
+			Reason:       reason,
+		},
+	})
+}
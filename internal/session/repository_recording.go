@@ -0,0 +1,216 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/wrappers/multiwrapper"
+)
+
+// Recording is the recording of a connection uploaded by the worker which
+// proxied it.
+type Recording struct {
+	ConnectionId string
+	SessionId    string
+	// KeyId is the ID of the version of the scope's sessions key from which
+	// the recording key was derived
+	KeyId string
+	// Complete is true once the worker has uploaded the final chunk
+	Complete   bool
+	CreateTime *timestamp.Timestamp
+}
+
+// RecordingChunk is a chunk of a recording uploaded by a worker.  Chunks are
+// opaque to the repository.
+type RecordingChunk struct {
+	SessionId    string
+	ConnectionId string
+	KeyId        string
+	Sequence     uint32
+	Data         []byte
+	// Final is set on the last chunk of the recording
+	Final bool
+}
+
+func (c RecordingChunk) validate() error {
+	switch {
+	case c.SessionId == "":
+		return fmt.Errorf("missing session id: %w", db.ErrInvalidParameter)
+	case c.ConnectionId == "":
+		return fmt.Errorf("missing connection id: %w", db.ErrInvalidParameter)
+	case c.KeyId == "":
+		return fmt.Errorf("missing key id: %w", db.ErrInvalidParameter)
+	case len(c.Data) == 0 && !c.Final:
+		return fmt.Errorf("missing data: %w", db.ErrInvalidParameter)
+	}
+	return nil
+}
+
+// AddRecordingChunk stores a chunk of a connection's recording, creating the
+// recording for its first chunk.  Uploading a chunk again is not an error, so
+// workers can safely retry.  The recording is marked complete when the final
+// chunk is added.
+func (r *Repository) AddRecordingChunk(ctx context.Context, c RecordingChunk) error {
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("add recording chunk: %w", err)
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, insertRecordingQuery, []interface{}{c.ConnectionId, c.SessionId, c.KeyId}); err != nil {
+				return fmt.Errorf("unable to create recording: %w", err)
+			}
+			if len(c.Data) > 0 {
+				if _, err := w.Exec(ctx, insertRecordingChunkQuery, []interface{}{c.ConnectionId, c.Sequence, c.Data}); err != nil {
+					return fmt.Errorf("unable to insert chunk: %w", err)
+				}
+			}
+			if c.Final {
+				if _, err := w.Exec(ctx, completeRecordingQuery, []interface{}{c.ConnectionId}); err != nil {
+					return fmt.Errorf("unable to complete recording: %w", err)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("add recording chunk: %s: %w", c.ConnectionId, err)
+	}
+	return nil
+}
+
+// ListRecordings returns the recordings of a session's connections in the
+// order they were created.
+func (r *Repository) ListRecordings(ctx context.Context, sessionId string) ([]*Recording, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("list recordings: missing session id: %w", db.ErrInvalidParameter)
+	}
+	rows, err := r.reader.Query(ctx, listRecordingsQuery, []interface{}{sessionId})
+	if err != nil {
+		return nil, fmt.Errorf("list recordings: %w", err)
+	}
+	defer rows.Close()
+	var recordings []*Recording
+	for rows.Next() {
+		var rec Recording
+		if err := r.reader.ScanRows(rows, &rec); err != nil {
+			return nil, fmt.Errorf("list recordings: scan row failed: %w", err)
+		}
+		recordings = append(recordings, &rec)
+	}
+	return recordings, nil
+}
+
+// LookupRecording returns the recording of the connection connectionId of
+// the session sessionId.  A nil Recording is returned if the connection was
+// not recorded or does not belong to the session.
+func (r *Repository) LookupRecording(ctx context.Context, sessionId, connectionId string) (*Recording, error) {
+	switch {
+	case sessionId == "":
+		return nil, fmt.Errorf("lookup recording: missing session id: %w", db.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("lookup recording: missing connection id: %w", db.ErrInvalidParameter)
+	}
+	rows, err := r.reader.Query(ctx, lookupRecordingQuery, []interface{}{sessionId, connectionId})
+	if err != nil {
+		return nil, fmt.Errorf("lookup recording: %w", err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, nil
+	}
+	var rec Recording
+	if err := r.reader.ScanRows(rows, &rec); err != nil {
+		return nil, fmt.Errorf("lookup recording: scan row failed: %w", err)
+	}
+	return &rec, nil
+}
+
+// ReadRecording writes the decrypted recording of a connection to w.  The
+// recording key is derived again from the version of the session scope's
+// sessions key recorded with the recording.  The chunks are read and
+// decrypted one at a time as w is written to, so the recording is never held
+// in memory.
+func (r *Repository) ReadRecording(ctx context.Context, rec *Recording, w io.Writer) error {
+	switch {
+	case rec == nil:
+		return fmt.Errorf("read recording: missing recording: %w", db.ErrInvalidParameter)
+	case rec.ConnectionId == "":
+		return fmt.Errorf("read recording: missing connection id: %w", db.ErrInvalidParameter)
+	case w == nil:
+		return fmt.Errorf("read recording: missing writer: %w", db.ErrInvalidParameter)
+	}
+	s := AllocSession()
+	s.PublicId = rec.SessionId
+	if err := r.reader.LookupById(ctx, &s); err != nil {
+		return fmt.Errorf("read recording: unable to look up session %s: %w", rec.SessionId, err)
+	}
+	key, err := r.recordingKey(ctx, &s, rec)
+	if err != nil {
+		return fmt.Errorf("read recording: %w", err)
+	}
+
+	rows, err := r.reader.Query(ctx, recordingChunksQuery, []interface{}{rec.ConnectionId})
+	if err != nil {
+		return fmt.Errorf("read recording: %w", err)
+	}
+	defer rows.Close()
+	if err := recording.Decrypt(w, &chunkReader{rows: rows}, key); err != nil {
+		return fmt.Errorf("read recording: %s: %w", rec.ConnectionId, err)
+	}
+	return nil
+}
+
+// chunkReader reads the data of the recording chunks in rows, scanning the
+// next row only once the previous chunk has been read.
+type chunkReader struct {
+	rows *sql.Rows
+	data []byte
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.data) == 0 {
+		if !c.rows.Next() {
+			if err := c.rows.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if err := c.rows.Scan(&c.data); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.data)
+	c.data = c.data[n:]
+	return n, nil
+}
+
+// recordingKey derives the key the worker used to encrypt the recording
+func (r *Repository) recordingKey(ctx context.Context, s *Session, rec *Recording) ([]byte, error) {
+	wrapper, err := r.kms.GetWrapper(ctx, s.ScopeId, kms.KeyPurposeSessions, kms.WithKeyId(rec.KeyId))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sessions wrapper: %w", err)
+	}
+	// The multiwrapper derives from its current key version, which may not
+	// be the version the worker derived from.
+	var keyWrapper wrapping.Wrapper = wrapper
+	if mw, ok := wrapper.(*multiwrapper.MultiWrapper); ok {
+		if keyWrapper = mw.WrapperForKeyID(rec.KeyId); keyWrapper == nil {
+			return nil, fmt.Errorf("sessions key version %s not found", rec.KeyId)
+		}
+	}
+	_, privKey, err := DeriveED25519Key(keyWrapper, s.UserId, s.PublicId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive session key: %w", err)
+	}
+	return recording.DeriveKey(privKey, rec.ConnectionId)
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session/recording"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Recordings(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kmsCache)
+	require.NoError(err)
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	c := TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+
+	// Record as the worker does, using the private key it is given when
+	// looking up the session.
+	sessionsWrapper, err := kmsCache.GetWrapper(ctx, s.ScopeId, kms.KeyPurposeSessions)
+	require.NoError(err)
+	_, privKey, err := DeriveED25519Key(sessionsWrapper, s.UserId, s.PublicId)
+	require.NoError(err)
+	key, err := recording.DeriveKey(privKey, c.PublicId)
+	require.NoError(err)
+	var buf bytes.Buffer
+	w, err := recording.NewWriter(&buf, recording.Header{SessionId: s.PublicId, ConnectionId: c.PublicId, KeyId: sessionsWrapper.KeyID()}, key)
+	require.NoError(err)
+	require.NoError(w.WriteFrame(recording.ClientToEndpoint, []byte("ls\n")))
+	require.NoError(w.WriteFrame(recording.EndpointToClient, []byte("file\n")))
+	require.NoError(w.Close())
+	data := buf.Bytes()
+
+	t.Run("invalid", func(t *testing.T) {
+		err := repo.AddRecordingChunk(ctx, RecordingChunk{ConnectionId: c.PublicId, KeyId: sessionsWrapper.KeyID(), Data: data})
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		err = repo.AddRecordingChunk(ctx, RecordingChunk{SessionId: s.PublicId, ConnectionId: c.PublicId, Data: data})
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.ListRecordings(ctx, "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+		_, err = repo.LookupRecording(ctx, s.PublicId, "")
+		assert.True(errors.Is(err, db.ErrInvalidParameter))
+	})
+
+	chunk := RecordingChunk{SessionId: s.PublicId, ConnectionId: c.PublicId, KeyId: sessionsWrapper.KeyID()}
+	chunk.Data = data[:len(data)/2]
+	require.NoError(repo.AddRecordingChunk(ctx, chunk))
+	// Retried uploads are ignored
+	require.NoError(repo.AddRecordingChunk(ctx, chunk))
+
+	recs, err := repo.ListRecordings(ctx, s.PublicId)
+	require.NoError(err)
+	require.Len(recs, 1)
+	assert.Equal(c.PublicId, recs[0].ConnectionId)
+	assert.False(recs[0].Complete)
+
+	chunk.Sequence, chunk.Data, chunk.Final = 1, data[len(data)/2:], true
+	require.NoError(repo.AddRecordingChunk(ctx, chunk))
+	recs, err = repo.ListRecordings(ctx, s.PublicId)
+	require.NoError(err)
+	require.Len(recs, 1)
+	assert.True(recs[0].Complete)
+
+	// Rotating the keys does not prevent the recording from being read
+	require.NoError(kmsCache.RotateKeys(ctx, s.ScopeId))
+
+	rec, err := repo.LookupRecording(ctx, s.PublicId, c.PublicId)
+	require.NoError(err)
+	require.NotNil(rec)
+	assert.Equal(recs[0].KeyId, rec.KeyId)
+	rec, err = repo.LookupRecording(ctx, "s_unknown", c.PublicId)
+	require.NoError(err)
+	assert.Nil(rec, "a connection of another session is not found")
+
+	var out bytes.Buffer
+	require.NoError(repo.ReadRecording(ctx, recs[0], &out))
+	r, err := recording.NewReader(&out, nil)
+	require.NoError(err)
+	assert.False(r.Header().Encrypted)
+	var frames []*recording.Frame
+	for {
+		f, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(err)
+		frames = append(frames, f)
+	}
+	require.Len(frames, 2)
+	assert.Equal("ls\n", string(frames[0].Data))
+	assert.Equal("file\n", string(frames[1].Data))
+}
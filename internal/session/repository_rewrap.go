@@ -0,0 +1,82 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// RewrapSessions re-encrypts the tofu token of every session that is not
+// encrypted with the current database key version of the session's scope
+// under the current version. Returns the number of sessions that were
+// rewrapped. Supports the WithLimit option, which sets the number of sessions
+// read from the database at a time.
+func (r *Repository) RewrapSessions(ctx context.Context, opt ...Option) (int, error) {
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit > 0 {
+		limit = opts.withLimit
+	}
+
+	var lastId string
+	return r.kms.RewrapDatabaseValues(ctx, "sessions", func() ([]kms.Rewrappable, error) {
+		rows, err := r.reader.Query(ctx, sessionsToRewrapQuery, []interface{}{lastId, limit})
+		if err != nil {
+			return nil, err
+		}
+		var sessions []*Session
+		for rows.Next() {
+			s := AllocSession()
+			if err := rows.Scan(&s.PublicId, &s.CtTofuToken, &s.KeyId, &s.ScopeId); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			sessions = append(sessions, &s)
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rows.Close()
+
+		var values []kms.Rewrappable
+		for _, s := range sessions {
+			lastId = s.PublicId
+			oldCtTofuToken := s.CtTofuToken
+			// The key id column is not reliably set for older sessions, so
+			// the key id is taken from the encrypted value itself.
+			blobInfo := new(wrapping.EncryptedBlobInfo)
+			if err := proto.Unmarshal(oldCtTofuToken, blobInfo); err != nil {
+				return nil, fmt.Errorf("%s: %w", s.PublicId, err)
+			}
+			oldKeyId := blobInfo.GetKeyInfo().GetKeyID()
+			if s.KeyId == "" {
+				// Sessions already encrypted with the current key version
+				// are skipped by the rewrap, so their key id is recorded
+				// here.
+				if _, err := r.writer.Exec(ctx, rewrapSessionQuery, []interface{}{oldCtTofuToken, oldKeyId, s.PublicId, oldCtTofuToken}); err != nil {
+					return nil, fmt.Errorf("%s: %w", s.PublicId, err)
+				}
+			}
+			s := s
+			values = append(values, kms.Rewrappable{
+				Id:      s.PublicId,
+				ScopeId: s.ScopeId,
+				KeyId:   oldKeyId,
+				Rewrap: func(ctx context.Context, databaseWrapper wrapping.Wrapper) (int, error) {
+					if err := s.decrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					if err := s.encrypt(ctx, databaseWrapper); err != nil {
+						return 0, err
+					}
+					return r.writer.Exec(ctx, rewrapSessionQuery, []interface{}{s.CtTofuToken, s.KeyId, s.PublicId, oldCtTofuToken})
+				},
+			})
+		}
+		return values, nil
+	})
+}
@@ -75,6 +75,7 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	}
 	newSession.Certificate = certBytes
 	newSession.PublicId = id
+	newSession.SessionsKeyId = sessionWrapper.KeyID()
 
 	var returnedSession *Session
 	_, err = r.writer.DoTx(
@@ -107,6 +108,7 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	if err != nil {
 		return nil, nil, fmt.Errorf("create session: %w", err)
 	}
+	r.auditSession(ctx, returnedSession.PublicId, StatusPending, "")
 	return returnedSession, privKey, err
 }
 
@@ -162,7 +164,8 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, opt ..
 	return &session, authzSummary, nil
 }
 
-// ListSessions will sessions.  Supports the WithLimit, WithScopeId and WithSessionIds options.
+// ListSessions will sessions ordered by create time.  Supports the WithLimit,
+// WithScopeId, WithScopeIds, WithSessionIds and WithStartPageAfter options.
 func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Session, error) {
 	opts := getOpts(opt...)
 	var where []string
@@ -173,6 +176,13 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 	case opts.withScopeId != "":
 		inClauseCnt += 1
 		where, args = append(where, fmt.Sprintf("scope_id = $%d", inClauseCnt)), append(args, opts.withScopeId)
+	case len(opts.withScopeIds) > 0:
+		scopesInClause := make([]string, 0, len(opts.withScopeIds))
+		for _, id := range opts.withScopeIds {
+			inClauseCnt += 1
+			scopesInClause, args = append(scopesInClause, fmt.Sprintf("$%d", inClauseCnt)), append(args, id)
+		}
+		where = append(where, fmt.Sprintf("scope_id in(%s)", strings.Join(scopesInClause, ",")))
 	case opts.withUserId != "":
 		inClauseCnt += 1
 		where, args = append(where, fmt.Sprintf("user_id = $%d", inClauseCnt)), append(args, opts.withUserId)
@@ -183,7 +193,12 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 			inClauseCnt += 1
 			idsInClause, args = append(idsInClause, fmt.Sprintf("$%d", inClauseCnt)), append(args, id)
 		}
-		where = append(where, fmt.Sprintf("s.public_id in(%s)", strings.Join(idsInClause, ",")))
+		where = append(where, fmt.Sprintf("public_id in(%s)", strings.Join(idsInClause, ",")))
+	}
+	if c := opts.withStartPageAfter; c != nil {
+		where = append(where, fmt.Sprintf("(create_time, public_id) > ($%d, $%d)", inClauseCnt+1, inClauseCnt+2))
+		args = append(args, c.CreateTime, c.PublicId)
+		inClauseCnt += 2
 	}
 
 	var limit string
@@ -196,16 +211,19 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 		limit = fmt.Sprintf("limit %d", opts.withLimit)
 	}
 
+	// the states of a session must be adjacent to each other in the results so
+	// they can be converted into a single session
+	order := "ss.create_time asc, ss.public_id asc"
 	if opts.withOrder != "" {
-		opts.withOrder = fmt.Sprintf("order by %s", opts.withOrder)
+		order = opts.withOrder
 	}
 
 	var whereClause string
 	if len(where) > 0 {
-		whereClause = " and " + strings.Join(where, " and")
+		whereClause = "where " + strings.Join(where, " and ")
 	}
 	q := sessionList
-	query := fmt.Sprintf(q, limit, whereClause, opts.withOrder)
+	query := fmt.Sprintf(q, whereClause, limit, order)
 
 	rows, err := r.reader.Query(ctx, query, args)
 	if err != nil {
@@ -328,6 +346,7 @@ func (r *Repository) TerminateSession(ctx context.Context, sessionId string, ses
 	if err != nil {
 		return nil, fmt.Errorf("terminate session: %w", err)
 	}
+	r.auditSession(ctx, sessionId, StatusTerminated, reason.String())
 	return &updatedSession, nil
 }
 
@@ -338,24 +357,39 @@ func (r *Repository) TerminateSession(ctx context.Context, sessionId string, ses
 // This function should called on a periodic basis a Controllers via it's
 // "ticker" pattern.
 func (r *Repository) TerminateCompletedSessions(ctx context.Context) (int, error) {
-	var rowsAffected int
+	type terminated struct {
+		PublicId          string
+		TerminationReason string
+	}
+	var terminatedSessions []terminated
 	_, err := r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
-			var err error
-			rowsAffected, err = w.Exec(ctx, termSessionsUpdate, nil)
+			terminatedSessions = nil
+			rows, err := reader.Query(ctx, termSessionsUpdate, nil)
 			if err != nil {
 				return err
 			}
-			return nil
+			defer rows.Close()
+			for rows.Next() {
+				var t terminated
+				if err := reader.ScanRows(rows, &t); err != nil {
+					return fmt.Errorf("scan row failed: %w", err)
+				}
+				terminatedSessions = append(terminatedSessions, t)
+			}
+			return rows.Err()
 		},
 	)
 	if err != nil {
 		return db.NoRowsAffected, fmt.Errorf("terminate completed sessions: %w", err)
 	}
-	return rowsAffected, nil
+	for _, t := range terminatedSessions {
+		r.auditSession(ctx, t.PublicId, StatusTerminated, t.TerminationReason)
+	}
+	return len(terminatedSessions), nil
 }
 
 // AuthorizeConnection will check to see if a connection is allowed.  Currently,
@@ -402,6 +436,7 @@ func (r *Repository) AuthorizeConnection(ctx context.Context, sessionId string)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	r.auditConnection(ctx, connection.SessionId, connection.PublicId, StatusAuthorized, "")
 	authzSummary, err := r.sessionAuthzSummary(ctx, connection.SessionId)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("authorize connection: %w", err)
@@ -468,6 +503,9 @@ func (r *Repository) ConnectConnection(ctx context.Context, c ConnectWith) (*Con
 				// return err, which will result in a rollback of the update
 				return errors.New("error more than 1 connection would have been updated ")
 			}
+			if err := reader.LookupById(ctx, &connection); err != nil {
+				return fmt.Errorf("lookup connection: failed for %s: %w", c.ConnectionId, err)
+			}
 			newState, err := NewConnectionState(connection.PublicId, StatusConnected)
 			if err != nil {
 				return err
@@ -485,6 +523,7 @@ func (r *Repository) ConnectConnection(ctx context.Context, c ConnectWith) (*Con
 	if err != nil {
 		return nil, nil, fmt.Errorf("connect session: %w", err)
 	}
+	r.auditConnection(ctx, connection.SessionId, connection.PublicId, StatusConnected, "")
 	return &connection, connectionStates, nil
 }
 
@@ -533,6 +572,9 @@ func (r *Repository) CloseConnections(ctx context.Context, closeWith []CloseWith
 				if rowsUpdated != 1 {
 					return fmt.Errorf("%d would have been updated for connection %s", rowsUpdated, cw.ConnectionId)
 				}
+				if err := reader.LookupById(ctx, &updateConnection); err != nil {
+					return fmt.Errorf("lookup connection: failed for %s: %w", cw.ConnectionId, err)
+				}
 				states, err := fetchConnectionStates(ctx, reader, cw.ConnectionId, db.WithOrder("start_time desc"))
 				if err != nil {
 					return err
@@ -549,9 +591,44 @@ func (r *Repository) CloseConnections(ctx context.Context, closeWith []CloseWith
 	if err != nil {
 		return nil, fmt.Errorf("close connections: %w", err)
 	}
+	for _, c := range resp {
+		r.auditConnection(ctx, c.Connection.SessionId, c.Connection.PublicId, StatusClosed, c.Connection.ClosedReason)
+	}
 	return resp, nil
 }
 
+// UpdateConnectionBytes records the bytes transferred so far on open
+// connections.  It's called periodically by a worker with the in-flight
+// counts of its connections. Connections which have already been closed are
+// not updated.
+func (r *Repository) UpdateConnectionBytes(ctx context.Context, bytesWith []BytesWith) error {
+	if len(bytesWith) == 0 {
+		return fmt.Errorf("update connection bytes: missing connections to update: %w", db.ErrInvalidParameter)
+	}
+	for _, bw := range bytesWith {
+		if err := bw.validate(); err != nil {
+			return fmt.Errorf("update connection bytes: %s was invalid: %w", bw.ConnectionId, err)
+		}
+	}
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, bw := range bytesWith {
+				if _, err := w.Exec(ctx, updateConnectionBytes, []interface{}{bw.ConnectionId, bw.BytesUp, bw.BytesDown}); err != nil {
+					return fmt.Errorf("unable to update connection %s: %w", bw.ConnectionId, err)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("update connection bytes: %w", err)
+	}
+	return nil
+}
+
 // ActivateSession will activate the session and is called by a worker after
 // authenticating the session. The session must be in a "pending" state to be
 // activated. States are ordered by start time descending. Returns an
@@ -608,7 +685,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 			if err := updatedSession.encrypt(ctx, databaseWrapper); err != nil {
 				return err
 			}
-			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken"}, nil)
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"CtTofuToken", "KeyId"}, nil)
 			if err != nil {
 				return err
 			}
@@ -627,6 +704,7 @@ func (r *Repository) ActivateSession(ctx context.Context, sessionId string, sess
 	if err != nil {
 		return nil, nil, fmt.Errorf("activate session: %w", err)
 	}
+	r.auditSession(ctx, sessionId, StatusActive, "")
 	return &updatedSession, returnedStates, nil
 }
 
@@ -698,6 +776,9 @@ func (r *Repository) updateState(ctx context.Context, sessionId string, sessionV
 	if err != nil {
 		return nil, nil, fmt.Errorf("update session state: error creating new state: %w", err)
 	}
+	if rowsAffected == 1 {
+		r.auditSession(ctx, sessionId, s, "")
+	}
 	return &updatedSession, returnedStates, nil
 }
 
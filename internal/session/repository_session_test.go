@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/boundary/internal/audit"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	authtokenStore "github.com/hashicorp/boundary/internal/authtoken/store"
 	"github.com/hashicorp/boundary/internal/db"
@@ -1036,6 +1037,116 @@ func TestRepository_CloseConnections(t *testing.T) {
 	}
 }
 
+func TestRepository_UpdateConnectionBytes(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	setupFn := func() *Connection {
+		s := TestDefaultSession(t, conn, wrapper, iamRepo)
+		srv := TestWorker(t, conn, wrapper)
+		tofu := TestTofu(t)
+		s, _, err := repo.ActivateSession(context.Background(), s.PublicId, s.Version, srv.PrivateId, srv.Type, tofu)
+		require.NoError(t, err)
+		return TestConnection(t, conn, s.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222)
+	}
+	t.Run("open-connection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := setupFn()
+		err := repo.UpdateConnectionBytes(context.Background(), []BytesWith{{ConnectionId: c.PublicId, BytesUp: 10, BytesDown: 20}})
+		require.NoError(err)
+		found, _, err := repo.LookupConnection(context.Background(), c.PublicId)
+		require.NoError(err)
+		assert.Equal(uint64(10), found.BytesUp)
+		assert.Equal(uint64(20), found.BytesDown)
+
+		// lower counts are ignored
+		err = repo.UpdateConnectionBytes(context.Background(), []BytesWith{{ConnectionId: c.PublicId, BytesUp: 5, BytesDown: 5}})
+		require.NoError(err)
+		found, _, err = repo.LookupConnection(context.Background(), c.PublicId)
+		require.NoError(err)
+		assert.Equal(uint64(10), found.BytesUp)
+		assert.Equal(uint64(20), found.BytesDown)
+	})
+	t.Run("closed-connection", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := setupFn()
+		_, err := repo.CloseConnections(context.Background(), []CloseWith{{ConnectionId: c.PublicId, BytesUp: 1, BytesDown: 2, ClosedReason: ConnectionClosedByUser}})
+		require.NoError(err)
+		err = repo.UpdateConnectionBytes(context.Background(), []BytesWith{{ConnectionId: c.PublicId, BytesUp: 10, BytesDown: 20}})
+		require.NoError(err)
+		found, _, err := repo.LookupConnection(context.Background(), c.PublicId)
+		require.NoError(err)
+		assert.Equal(uint64(1), found.BytesUp)
+		assert.Equal(uint64(2), found.BytesDown)
+	})
+	t.Run("empty-bytes-with", func(t *testing.T) {
+		err := repo.UpdateConnectionBytes(context.Background(), []BytesWith{})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+	t.Run("missing-ConnectionId", func(t *testing.T) {
+		err := repo.UpdateConnectionBytes(context.Background(), []BytesWith{{BytesUp: 1}})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, db.ErrInvalidParameter))
+	})
+}
+
+func TestRepository_AuditEvents(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	eventer, sink := audit.TestEventer(t)
+	repo, err := NewRepository(rw, rw, kms, WithEventer(eventer))
+	require.NoError(err)
+	ctx := context.Background()
+
+	s := TestDefaultSession(t, conn, wrapper, iamRepo)
+	srv := TestWorker(t, conn, wrapper)
+	s, _, err = repo.ActivateSession(ctx, s.PublicId, s.Version, srv.PrivateId, srv.Type, TestTofu(t))
+	require.NoError(err)
+	c, _, _, err := repo.AuthorizeConnection(ctx, s.PublicId)
+	require.NoError(err)
+	_, _, err = repo.ConnectConnection(ctx, ConnectWith{
+		ConnectionId:       c.PublicId,
+		ClientTcpAddress:   "127.0.0.1",
+		ClientTcpPort:      22,
+		EndpointTcpAddress: "127.0.0.1",
+		EndpointTcpPort:    2222,
+	})
+	require.NoError(err)
+	_, err = repo.CloseConnections(ctx, []CloseWith{{ConnectionId: c.PublicId, ClosedReason: ConnectionClosedByUser}})
+	require.NoError(err)
+
+	type transition struct {
+		eventType    audit.EventType
+		connectionId string
+		status       string
+		reason       string
+	}
+	var got []transition
+	for _, e := range sink.Events() {
+		require.NotNil(e.Session)
+		assert.Equal(s.PublicId, e.Session.SessionId)
+		got = append(got, transition{e.Type, e.Session.ConnectionId, e.Session.Status, e.Session.Reason})
+	}
+	assert.Equal([]transition{
+		{audit.SessionStateChange, "", StatusActive.String(), ""},
+		{audit.ConnectionStateChange, c.PublicId, StatusAuthorized.String(), ""},
+		{audit.ConnectionStateChange, c.PublicId, StatusConnected.String(), ""},
+		{audit.ConnectionStateChange, c.PublicId, StatusClosed.String(), ConnectionClosedByUser.String()},
+	}, got)
+}
+
 func TestRepository_CancelSession(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -44,6 +44,9 @@ type ComposedOf struct {
 	ExpirationTime *timestamp.Timestamp
 	// Max connections for the session
 	ConnectionLimit int32
+	// EnableSessionRecording determines whether the worker records the
+	// session's connections
+	EnableSessionRecording bool
 }
 
 // Session contains information about a user's session with a target
@@ -88,6 +91,9 @@ type Session struct {
 	Endpoint string `json:"-" gorm:"default:null"`
 	// Maximum number of connections in a session
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
+	// EnableSessionRecording determines whether the worker records the
+	// session's connections
+	EnableSessionRecording bool `json:"enable_session_recording,omitempty" gorm:"default:false"`
 
 	// key_id is the key ID that was used for the encryption operation. It can be
 	// used to identify a specific version of the key needed to decrypt the value,
@@ -95,6 +101,10 @@ type Session struct {
 	// @inject_tag: `gorm:"not_null"`
 	KeyId string `json:"key_id,omitempty" gorm:"not_null"`
 
+	// SessionsKeyId is the ID of the version of the scope's sessions key from
+	// which the certificate and private key were derived
+	SessionsKeyId string `json:"sessions_key_id,omitempty" gorm:"default:null"`
+
 	// States for the session which are for read only and are ignored during
 	// write operations
 	States    []*State `gorm:"-"`
@@ -111,15 +121,16 @@ var _ db.VetForWriter = (*Session)(nil)
 // New creates a new in memory session.
 func New(c ComposedOf, opt ...Option) (*Session, error) {
 	s := Session{
-		UserId:          c.UserId,
-		HostId:          c.HostId,
-		TargetId:        c.TargetId,
-		HostSetId:       c.HostSetId,
-		AuthTokenId:     c.AuthTokenId,
-		ScopeId:         c.ScopeId,
-		Endpoint:        c.Endpoint,
-		ExpirationTime:  c.ExpirationTime,
-		ConnectionLimit: c.ConnectionLimit,
+		UserId:                 c.UserId,
+		HostId:                 c.HostId,
+		TargetId:               c.TargetId,
+		HostSetId:              c.HostSetId,
+		AuthTokenId:            c.AuthTokenId,
+		ScopeId:                c.ScopeId,
+		Endpoint:               c.Endpoint,
+		ExpirationTime:         c.ExpirationTime,
+		ConnectionLimit:        c.ConnectionLimit,
+		EnableSessionRecording: c.EnableSessionRecording,
 	}
 	if err := s.validateNewSession("new session:"); err != nil {
 		return nil, err
@@ -135,19 +146,21 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() interface{} {
 	clone := &Session{
-		PublicId:          s.PublicId,
-		UserId:            s.UserId,
-		HostId:            s.HostId,
-		ServerId:          s.ServerId,
-		ServerType:        s.ServerType,
-		TargetId:          s.TargetId,
-		HostSetId:         s.HostSetId,
-		AuthTokenId:       s.AuthTokenId,
-		ScopeId:           s.ScopeId,
-		TerminationReason: s.TerminationReason,
-		Version:           s.Version,
-		Endpoint:          s.Endpoint,
-		ConnectionLimit:   s.ConnectionLimit,
+		PublicId:               s.PublicId,
+		UserId:                 s.UserId,
+		HostId:                 s.HostId,
+		ServerId:               s.ServerId,
+		ServerType:             s.ServerType,
+		TargetId:               s.TargetId,
+		HostSetId:              s.HostSetId,
+		AuthTokenId:            s.AuthTokenId,
+		ScopeId:                s.ScopeId,
+		TerminationReason:      s.TerminationReason,
+		Version:                s.Version,
+		Endpoint:               s.Endpoint,
+		ConnectionLimit:        s.ConnectionLimit,
+		EnableSessionRecording: s.EnableSessionRecording,
+		SessionsKeyId:          s.SessionsKeyId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -236,6 +249,10 @@ func (s *Session) VetForWrite(ctx context.Context, r db.Reader, opType db.OpType
 			return fmt.Errorf("session vet for write: expiration time is immutable: %w", db.ErrInvalidParameter)
 		case contains(opts.WithFieldMaskPaths, "ConnectionLimit"):
 			return fmt.Errorf("session vet for write: connection limit is immutable: %w", db.ErrInvalidParameter)
+		case contains(opts.WithFieldMaskPaths, "EnableSessionRecording"):
+			return fmt.Errorf("session vet for write: enable session recording is immutable: %w", db.ErrInvalidParameter)
+		case contains(opts.WithFieldMaskPaths, "SessionsKeyId"):
+			return fmt.Errorf("session vet for write: sessions key id is immutable: %w", db.ErrInvalidParameter)
 		case contains(opts.WithFieldMaskPaths, "TerminationReason"):
 			if _, err := convertToReason(s.TerminationReason); err != nil {
 				return fmt.Errorf("session vet for write: termination reason '%s' is invalid: %w", s.TerminationReason, db.ErrInvalidParameter)
@@ -1,6 +1,10 @@
 package target
 
-import "time"
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -21,12 +25,16 @@ type options struct {
 	withDefaultPort            uint32
 	withLimit                  int
 	withScopeId                string
+	withScopeIds               []string
 	withUserId                 string
 	withTargetType             *TargetType
 	withHostSets               []string
 	withSessionMaxSeconds      uint32
 	withSessionConnectionLimit int32
 	withPublicId               string
+	withWorkerFilter           string
+	withSessionRecording       bool
+	withStartPageAfter         *db.PageCursor
 }
 
 func getDefaultOptions() options {
@@ -42,6 +50,8 @@ func getDefaultOptions() options {
 		withSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
 		withSessionConnectionLimit: 1,
 		withPublicId:               "",
+		withWorkerFilter:           "",
+		withSessionRecording:       false,
 	}
 }
 
@@ -82,6 +92,13 @@ func WithScopeId(scopeId string) Option {
 	}
 }
 
+// WithScopeIds provides an option to search by any of several scope ids
+func WithScopeIds(scopeIds []string) Option {
+	return func(o *options) {
+		o.withScopeIds = scopeIds
+	}
+}
+
 // WithUserId provides an option to search by a user public id
 func WithUserId(userId string) Option {
 	return func(o *options) {
@@ -121,3 +138,26 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithWorkerFilter provides an optional worker filter
+func WithWorkerFilter(filter string) Option {
+	return func(o *options) {
+		o.withWorkerFilter = filter
+	}
+}
+
+// WithEnableSessionRecording provides an optional flag to record the
+// connections of sessions
+func WithEnableSessionRecording(enable bool) Option {
+	return func(o *options) {
+		o.withSessionRecording = enable
+	}
+}
+
+// WithStartPageAfter provides an option to return the page of a listing which
+// starts after the resource at the cursor.  A nil cursor returns the first page.
+func WithStartPageAfter(cursor *db.PageCursor) Option {
+	return func(o *options) {
+		o.withStartPageAfter = cursor
+	}
+}
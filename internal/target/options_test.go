@@ -68,6 +68,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withScopeId = "testId"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithScopeIds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithScopeIds([]string{"testId", "testId2"}))
+		testOpts := getDefaultOptions()
+		testOpts.withScopeIds = []string{"testId", "testId2"}
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPublicId", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithPublicId("testId"))
@@ -90,4 +97,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withHostSets = []string{"alice", "bob"}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithWorkerFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithWorkerFilter(`"/name" matches "test"`))
+		testOpts := getDefaultOptions()
+		testOpts.withWorkerFilter = `"/name" matches "test"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithEnableSessionRecording", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithEnableSessionRecording(true))
+		testOpts := getDefaultOptions()
+		testOpts.withSessionRecording = true
+		assert.Equal(opts, testOpts)
+	})
 }
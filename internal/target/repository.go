@@ -104,10 +104,12 @@ func fetchSets(ctx context.Context, r db.Reader, targetId string) ([]*TargetSet,
 	return hostSets, nil
 }
 
-// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithTargetType options.
+// ListTargets in targets in a scope ordered by create time.  Supports the
+// WithScopeId, WithScopeIds, WithLimit, WithTargetType and WithStartPageAfter
+// options.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	opts := getOpts(opt...)
-	if opts.withScopeId == "" && opts.withUserId == "" {
+	if opts.withScopeId == "" && len(opts.withScopeIds) == 0 && opts.withUserId == "" {
 		return nil, fmt.Errorf("list targets: must specify either a scope id or user id: %w", db.ErrInvalidParameter)
 	}
 	// TODO (jimlambrt 8/2020) - implement WithUserId() optional filtering.
@@ -116,6 +118,9 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	if opts.withScopeId != "" {
 		where, args = append(where, "scope_id = ?"), append(args, opts.withScopeId)
 	}
+	if len(opts.withScopeIds) > 0 {
+		where, args = append(where, "scope_id in (?)"), append(args, opts.withScopeIds)
+	}
 	if opts.withTargetType != nil {
 		where, args = append(where, "type = ?"), append(args, opts.withTargetType.String())
 	}
@@ -138,8 +143,8 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	return targets, nil
 }
 
-// list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// list will return a listing of resources ordered by db.PageOrder and honor the
+// WithLimit option or the repo defaultLimit and the WithStartPageAfter option
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	opts := getOpts(opt...)
 	limit := r.defaultLimit
@@ -148,7 +153,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithLimit(limit), db.WithStartPageAfter(opts.withStartPageAfter))
 	return r.reader.SearchWhere(ctx, resources, where, args, dbOpts...)
 }
 
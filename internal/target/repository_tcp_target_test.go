@@ -168,6 +168,8 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 		name           string
 		description    string
 		port           uint32
+		workerFilter   string
+		recording      bool
 		fieldMaskPaths []string
 		opt            []Option
 		ScopeId        string
@@ -247,6 +249,30 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 			wantErr:        false,
 			wantRowsUpdate: 1,
 		},
+		{
+			name: "valid-worker-filter",
+			args: args{
+				workerFilter:   `"/tags/type" contains "web"`,
+				fieldMaskPaths: []string{"WorkerFilter"},
+				ScopeId:        proj.PublicId,
+			},
+			newScopeId:     proj.PublicId,
+			newName:        "valid-worker-filter" + id,
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
+		{
+			name: "valid-enable-session-recording",
+			args: args{
+				recording:      true,
+				fieldMaskPaths: []string{"EnableSessionRecording"},
+				ScopeId:        proj.PublicId,
+			},
+			newScopeId:     proj.PublicId,
+			newName:        "valid-enable-session-recording" + id,
+			wantErr:        false,
+			wantRowsUpdate: 1,
+		},
 		{
 			name: "empty-field-mask",
 			args: args{
@@ -377,6 +403,8 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 			updateTarget.Name = tt.args.name
 			updateTarget.Description = tt.args.description
 			updateTarget.DefaultPort = tt.args.port
+			updateTarget.WorkerFilter = tt.args.workerFilter
+			updateTarget.EnableSessionRecording = tt.args.recording
 
 			targetAfterUpdate, hostSets, updatedRows, err := repo.UpdateTcpTarget(context.Background(), &updateTarget, target.Version, tt.args.fieldMaskPaths, tt.args.opt...)
 			if tt.wantErr {
@@ -415,6 +443,10 @@ func TestRepository_UpdateTcpTarget(t *testing.T) {
 				assert.Equal(foundTarget.GetDescription(), "")
 				dbassert.IsNull(foundTarget, "description")
 			}
+			if tt.args.workerFilter != "" {
+				assert.Equal(tt.args.workerFilter, foundTarget.GetWorkerFilter())
+			}
+			assert.Equal(tt.args.recording, foundTarget.GetEnableSessionRecording())
 			err = db.TestVerifyOplog(t, rw, target.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second))
 			assert.NoError(err)
 		})
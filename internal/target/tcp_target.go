@@ -23,8 +23,9 @@ var _ Target = (*TcpTarget)(nil)
 var _ db.VetForWriter = (*TcpTarget)(nil)
 var _ oplog.ReplayableMessage = (*TcpTarget)(nil)
 
-// NewTcpTarget creates a new in memory tcp target.  WithName, WithDescription and
-// WithDefaultPort options are supported
+// NewTcpTarget creates a new in memory tcp target.  WithName, WithDescription,
+// WithDefaultPort, WithWorkerFilter and WithEnableSessionRecording options are
+// supported
 func NewTcpTarget(scopeId string, opt ...Option) (*TcpTarget, error) {
 	opts := getOpts(opt...)
 	if scopeId == "" {
@@ -38,6 +39,8 @@ func NewTcpTarget(scopeId string, opt ...Option) (*TcpTarget, error) {
 			DefaultPort:            opts.withDefaultPort,
 			SessionConnectionLimit: opts.withSessionConnectionLimit,
 			SessionMaxSeconds:      opts.withSessionMaxSeconds,
+			WorkerFilter:           opts.withWorkerFilter,
+			EnableSessionRecording: opts.withSessionRecording,
 		},
 	}
 	return t, nil
@@ -75,6 +75,38 @@ func TestAction(t *testing.T) {
 			action: Deauthenticate,
 			want:   "deauthenticate",
 		},
+		{
+			action: Run,
+			want:   "run",
+		},
+		{
+			action: RotateKeys,
+			want:   "rotate-keys",
+		},
+		{
+			action: RewrapKeys,
+			want:   "rewrap-keys",
+		},
+		{
+			action: DownloadRecording,
+			want:   "download-recording",
+		},
+		{
+			action: EffectiveGrants,
+			want:   "effective-grants",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: VerifyTotp,
+			want:   "verify-totp",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -83,3 +115,9 @@ func TestAction(t *testing.T) {
 		})
 	}
 }
+
+func TestActionSet_Strings(t *testing.T) {
+	assert.Nil(t, ActionSet(nil).Strings())
+	assert.Equal(t, []string{}, ActionSet{}.Strings())
+	assert.Equal(t, []string{"read", "update", "add-grants"}, ActionSet{Read, Update, AddGrants}.Strings())
+}
@@ -20,6 +20,7 @@ const (
 	Controller  Type = 13
 	Worker      Type = 14
 	Session     Type = 15
+	Job         Type = 16
 )
 
 func (r Type) String() string {
@@ -40,6 +41,7 @@ func (r Type) String() string {
 		"controller",
 		"worker",
 		"session",
+		"job",
 	}[r]
 }
 
@@ -60,4 +62,5 @@ var Map = map[string]Type{
 	Controller.String():  Controller,
 	Worker.String():      Worker,
 	Session.String():     Session,
+	Job.String():         Job,
 }
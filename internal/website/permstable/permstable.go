@@ -70,6 +70,7 @@ func main() {
 		host,
 		hostCatalog,
 		hostSet,
+		job,
 		role,
 		scope,
 		session,
@@ -219,6 +220,11 @@ func (e *Endpoint) Marshal() (ret []string) {
 				fmt.Sprintf(`%s<li><code>%s</code></li>`, indent(14), escape(x)),
 			)
 		}
+		if deny := v.denyExample(); deny != "" {
+			ret = append(ret,
+				fmt.Sprintf(`%s<li><code>%s</code></li>`, indent(14), escape(deny)),
+			)
+		}
 		ret = append(ret,
 			fmt.Sprintf(`%s</ul>`, indent(12)),
 		)
@@ -232,6 +238,15 @@ func (e *Endpoint) Marshal() (ret []string) {
 	return
 }
 
+// denyExample returns the deny form of the action's first example, which
+// removes the action even when another grant allows it
+func (a *Action) denyExample() string {
+	if len(a.Examples) == 0 {
+		return ""
+	}
+	return "deny=true;" + a.Examples[0]
+}
+
 func escape(s string) string {
 	ret := strings.Replace(s, "<", "&lt;", -1)
 	return strings.Replace(ret, ">", "&gt;", -1)
@@ -339,6 +354,30 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "unlock",
+					Description: "Unlock an account locked after failed authentication attempts",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Start a TOTP enrollment for an account",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "verify-totp",
+					Description: "Verify the TOTP enrollment of an account",
+					Examples: []string{
+						"id=<id>;actions=verify-totp",
+						"id=<pin>;type=<type>;actions=verify-totp",
+					},
+				},
 			),
 		},
 	},
@@ -559,6 +598,51 @@ var hostSet = &Resource{
 	},
 }
 
+var job = &Resource{
+	Type:   "Job",
+	Scopes: []string{"Global"},
+	Endpoints: []*Endpoint{
+		{
+			Path: "/jobs",
+			Params: map[string]string{
+				"Type": "job",
+			},
+			Actions: []*Action{
+				{
+					Name:        "list",
+					Description: "List jobs",
+					Examples: []string{
+						"type=<type>;actions=list",
+					},
+				},
+			},
+		},
+		{
+			Path: "/jobs/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "job",
+			},
+			Actions: []*Action{
+				{
+					Name:        "read",
+					Description: "Read a job",
+					Examples: []string{
+						"id=<id>;actions=read",
+					},
+				},
+				{
+					Name:        "run",
+					Description: "Run a job immediately",
+					Examples: []string{
+						"id=<id>;actions=run",
+					},
+				},
+			},
+		},
+	},
+}
+
 var role = &Resource{
 	Type:   "Role",
 	Scopes: append(iamScopes, infraScope...),
@@ -642,7 +726,23 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "rotate-keys",
+					Description: "Create new versions of a scope's keys",
+					Examples: []string{
+						"id=<id>;actions=rotate-keys",
+					},
+				},
+				&Action{
+					Name:        "rewrap-keys",
+					Description: "Re-encrypt a scope's keys and data under their newest versions",
+					Examples: []string{
+						"id=<id>;actions=rewrap-keys",
+					},
+				},
+			),
 		},
 	},
 }
@@ -687,6 +787,13 @@ var session = &Resource{
 						"id=<id>;actions=cancel",
 					},
 				},
+				{
+					Name:        "download-recording",
+					Description: "List and download the recordings of the session's connections",
+					Examples: []string{
+						"id=<id>;actions=download-recording",
+					},
+				},
 			},
 		},
 	},
@@ -784,6 +891,13 @@ var user = &Resource{
 						"id=<id>;actions=remove-accounts",
 					},
 				},
+				&Action{
+					Name:        "effective-grants",
+					Description: "List the grants a user receives and explain whether they allow an action",
+					Examples: []string{
+						"id=<id>;actions=effective-grants",
+					},
+				},
 			),
 		},
 	},
@@ -77,6 +77,7 @@ export default [
       },
       'controller',
       'worker',
+      'events',
     ],
   },
   {